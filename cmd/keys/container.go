@@ -0,0 +1,21 @@
+package main
+
+import (
+	"github.com/maisiq/go-auth-service/cmd/wire"
+	"github.com/maisiq/go-auth-service/cmd/wire/providers"
+)
+
+func BuildContainer(configPath string) *wire.DIContainer {
+	di := wire.New(configPath)
+	wire.Provide(di, providers.ConfigProvider)
+	wire.Provide(di, providers.SecretsConfigProvider)
+	wire.Provide(di, providers.LoggerProvider)
+
+	wire.Provide(di, providers.HTTPClientProvider)
+	wire.Provide(di, providers.VaultAuthProvider)
+	wire.Provide(di, providers.SecretRepoProvider)
+	wire.Provide(di, providers.InMemoryDBProvider)
+	wire.Provide(di, providers.InMemoryCacheProvider)
+	wire.Provide(di, providers.KeyManagerProvider)
+	return di
+}
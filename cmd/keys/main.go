@@ -0,0 +1,99 @@
+// keys manages versions of the JWT signing key.
+//
+//	keys list
+//	keys rotate
+//	keys retire -min-version N [-force]
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/maisiq/go-auth-service/cmd/wire"
+	"github.com/maisiq/go-auth-service/internal/service"
+)
+
+const usage = `usage: keys <command> [flags]
+
+commands:
+  list     show signing key versions and their state
+  rotate   create a new signing key version, the previous one keeps verifying for grace period
+  retire   remove versions below -min-version from the verification set
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	cfgName, ok := os.LookupEnv("CONFIG_FILENAME")
+	if !ok {
+		panic("config path is not provided")
+	}
+
+	container := BuildContainer(fmt.Sprintf("./configs/%s", cfgName))
+	keys := wire.Get[service.KeyManager](container)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	err := run(ctx, keys, os.Args[1], os.Args[2:])
+	cancel()
+	container.ShutdownResources()
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, keys service.KeyManager, cmd string, args []string) error {
+	switch cmd {
+	case "list":
+		return list(ctx, keys)
+	case "rotate":
+		kid, err := keys.RotateSigningKey(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("signing key rotated, new version: %s\n", kid)
+		return nil
+	case "retire":
+		fs := flag.NewFlagSet("retire", flag.ExitOnError)
+		minVersion := fs.Int("min-version", 0, "the oldest version to keep")
+		force := fs.Bool("force", false, "retire versions which may have signed still valid tokens")
+		if err := fs.Parse(args); err != nil {
+			return err
+		}
+
+		err := keys.RetireSigningKeys(ctx, *minVersion, *force)
+		if errors.Is(err, service.ErrKeyVersionInUse) {
+			return fmt.Errorf("%w, wait for grace period to end or use -force", err)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("versions below %d retired\n", *minVersion)
+		return nil
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		return fmt.Errorf("unknown command: %s", cmd)
+	}
+}
+
+func list(ctx context.Context, keys service.KeyManager) error {
+	versions, err := keys.SigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tCREATED\tSIGNING\tVERIFYING")
+	for _, k := range versions {
+		fmt.Fprintf(w, "%d\t%s\t%t\t%t\n", k.Version, k.CreatedAt.Format(time.RFC3339), k.Signing, k.Verifying)
+	}
+	return w.Flush()
+}
@@ -9,6 +9,8 @@ func BuildContainer(configPath string) *wire.DIContainer {
 	di := wire.New(configPath)
 
 	wire.Provide(di, providers.ConfigProvider)
+	wire.Provide(di, providers.AuthConfigProvider)
+	wire.Provide(di, providers.SecretsConfigProvider)
 	wire.Provide(di, providers.LoggerProvider)
 
 	// storages
@@ -16,13 +18,23 @@ func BuildContainer(configPath string) *wire.DIContainer {
 	wire.Provide(di, providers.InMemoryDBProvider)
 	wire.Provide(di, providers.HTTPClientProvider)
 
+	// mail
+	wire.Provide(di, providers.MailerProvider)
+
 	// OAuth providers
-	wire.Provide(di, providers.YandexOAuthProvider)
+	wire.Provide(di, providers.OAuthRegistryProvider)
+
+	// vault
+	wire.Provide(di, providers.VaultAuthProvider)
 
 	// repositories
 	wire.Provide(di, providers.UserRepoProvider)
 	wire.Provide(di, providers.SecretRepoProvider)
 	wire.Provide(di, providers.TokenRepoProvider)
+	wire.Provide(di, providers.MFARepoProvider)
+	wire.Provide(di, providers.WebAuthnRepoProvider)
+	wire.Provide(di, providers.IdentityRepoProvider)
+	wire.Provide(di, providers.ClientRepoProvider)
 
 	// cache
 	wire.Provide(di, providers.InMemoryCacheProvider)
@@ -37,9 +49,12 @@ func BuildContainer(configPath string) *wire.DIContainer {
 	wire.Provide(di, providers.OAuthServiceProvider)
 	wire.Provide(di, providers.SecretServiceProvider)
 	wire.Provide(di, providers.UserServiceProvider)
+	wire.Provide(di, providers.WebAuthnServiceProvider)
+	wire.Provide(di, providers.AuthorizationServiceProvider)
 
 	// http server
 	wire.Provide(di, providers.ServerParamsProvider)
+	wire.Provide(di, providers.RateLimiterProvider)
 	wire.Provide(di, providers.RouterParamsProvider)
 	wire.Provide(di, providers.RouterProvider)
 	wire.Provide(di, providers.HTTPServerProvider)
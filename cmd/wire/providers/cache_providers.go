@@ -1,12 +1,25 @@
 package providers
 
 import (
+	"context"
+
 	"github.com/maisiq/go-auth-service/cmd/wire"
 	"github.com/maisiq/go-auth-service/internal/cache"
+	"github.com/maisiq/go-auth-service/internal/db"
 )
 
+// InMemoryCacheProvider keeps the cache in process, deletions are broadcast via memory db,
+// so key rotation made by keys command invalidates cached keys of every replica
 func InMemoryCacheProvider(di *wire.DIContainer) *cache.Cache {
-	client := cache.NewInMemoryCacheClient()
+	rdb := wire.Get[db.RedisClient](di)
+	client := cache.NewBroadcastClient(cache.NewInMemoryCacheClient(), rdb, cache.InvalidationChannel)
+
+	sub := rdb.Subscribe(context.Background(), cache.InvalidationChannel)
+	di.AddToCloser(func() error {
+		return sub.Close()
+	})
+	go client.Listen(sub.Channel())
+
 	return &cache.Cache{
 		Client: client,
 	}
@@ -6,6 +6,7 @@ import (
 	"github.com/maisiq/go-auth-service/cmd/wire"
 	"github.com/maisiq/go-auth-service/internal/configs"
 	"github.com/maisiq/go-auth-service/internal/logger"
+	"github.com/maisiq/go-auth-service/internal/service"
 	"go.uber.org/zap"
 )
 
@@ -22,6 +23,24 @@ func ConfigProvider(c *wire.DIContainer) *configs.Config {
 	return cfg
 }
 
+// AuthConfigProvider fails startup if auth config is unsafe to use
+func AuthConfigProvider(c *wire.DIContainer) *configs.AuthConfig {
+	cfg := wire.Get[*configs.Config](c)
+	if err := cfg.Auth.Validate(); err != nil {
+		panic(err)
+	}
+	return cfg.Auth
+}
+
+// SecretsConfigProvider fails startup if rotated signing keys would stop verifying live tokens
+func SecretsConfigProvider(c *wire.DIContainer) *configs.SecretsConfig {
+	cfg := wire.Get[*configs.Config](c)
+	if err := cfg.Secrets.Validate(service.AccessTokenTTL); err != nil {
+		panic(err)
+	}
+	return cfg.Secrets
+}
+
 func LoggerProvider(c *wire.DIContainer) *zap.SugaredLogger {
 	cfg := wire.Get[*configs.Config](c)
 	return logger.InitLogger(cfg.App.Debug)
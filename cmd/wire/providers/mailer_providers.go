@@ -0,0 +1,18 @@
+package providers
+
+import (
+	"github.com/maisiq/go-auth-service/cmd/wire"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/mailer"
+	"go.uber.org/zap"
+)
+
+func MailerProvider(c *wire.DIContainer) mailer.Mailer {
+	cfg := wire.Get[*configs.Config](c)
+	logger := wire.Get[*zap.SugaredLogger](c)
+	m, err := mailer.New(cfg.Mailer, logger)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
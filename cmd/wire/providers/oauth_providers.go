@@ -2,15 +2,37 @@ package providers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/maisiq/go-auth-service/cmd/wire"
 	"github.com/maisiq/go-auth-service/internal/configs"
 	"github.com/maisiq/go-auth-service/internal/oauth"
+	"github.com/maisiq/go-auth-service/internal/oauth/providers/oidc"
 	"github.com/maisiq/go-auth-service/internal/oauth/providers/yandex"
 )
 
-func YandexOAuthProvider(c *wire.DIContainer) oauth.OAuthProvider {
+// OAuthRegistryProvider registers Yandex and OpenID Connect providers from config
+func OAuthRegistryProvider(c *wire.DIContainer) *oauth.Registry {
 	cfg := wire.Get[*configs.Config](c)
 	client := wire.Get[*http.Client](c)
-	return yandex.NewYandexOAuthProvider(cfg.Yandex, client)
+
+	registry := oauth.NewRegistry()
+	if cfg.Yandex != nil {
+		if err := registry.Register(oauth.YandexProvider, yandex.NewYandexOAuthProvider(cfg.Yandex, client)); err != nil {
+			panic(err)
+		}
+	}
+	if cfg.OAuth == nil {
+		return registry
+	}
+	for name, providerCfg := range cfg.OAuth.Providers {
+		if providerCfg.RedirectURL == "" {
+			providerCfg.RedirectURL = strings.TrimSuffix(cfg.App.PublicURL, "/") + "/oauth/" + name + "/callback"
+		}
+		provider := oidc.NewProvider(oauth.OAuthProviderT(name), providerCfg, client)
+		if err := registry.Register(oauth.OAuthProviderT(name), provider); err != nil {
+			panic(err)
+		}
+	}
+	return registry
 }
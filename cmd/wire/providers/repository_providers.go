@@ -1,13 +1,18 @@
 package providers
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/maisiq/go-auth-service/cmd/wire"
+	"github.com/maisiq/go-auth-service/internal/cache"
 	"github.com/maisiq/go-auth-service/internal/configs"
 	"github.com/maisiq/go-auth-service/internal/db"
 	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/internal/vault"
+	"go.uber.org/zap"
 )
 
 func UserRepoProvider(c *wire.DIContainer) repository.IUserRepository {
@@ -15,13 +20,62 @@ func UserRepoProvider(c *wire.DIContainer) repository.IUserRepository {
 	return repository.NewUserRepository(db)
 }
 
+func MFARepoProvider(c *wire.DIContainer) repository.IMFARepository {
+	db := wire.Get[*sqlx.DB](c)
+	return repository.NewMFARepository(db)
+}
+
+func WebAuthnRepoProvider(c *wire.DIContainer) repository.IWebAuthnRepository {
+	db := wire.Get[*sqlx.DB](c)
+	return repository.NewWebAuthnRepository(db)
+}
+
+func IdentityRepoProvider(c *wire.DIContainer) repository.IIdentityRepository {
+	db := wire.Get[*sqlx.DB](c)
+	return repository.NewIdentityRepository(db)
+}
+
+func ClientRepoProvider(c *wire.DIContainer) repository.IClientRepository {
+	db := wire.Get[*sqlx.DB](c)
+	return repository.NewClientRepository(db)
+}
+
 func TokenRepoProvider(c *wire.DIContainer) repository.ITokenRepository {
 	db := wire.Get[db.RedisClient](c)
 	return repository.NewTokenRepository(db)
 }
 
+// SecretRepoProvider returns repository of the configured driver with cached signing key metadata
 func SecretRepoProvider(c *wire.DIContainer) repository.SecretRepository {
 	cfg := wire.Get[*configs.Config](c)
-	client := wire.Get[*http.Client](c)
-	return repository.NewVaultSecretRepository(cfg.Vault, client)
+	logger := wire.Get[*zap.SugaredLogger](c)
+	cache := wire.Get[*cache.Cache](c)
+
+	var refresh time.Duration
+	if cfg.Secrets != nil {
+		refresh = cfg.Secrets.SigningKeyRefresh
+	}
+	repo := repository.NewCachedSecretRepository(logger, secretRepo(c, cfg), cache, refresh)
+	c.AddToCloser(repo.Close)
+	return repo
+}
+
+func secretRepo(c *wire.DIContainer, cfg *configs.Config) repository.SecretRepository {
+	driver := "vault"
+	if cfg.Secrets != nil && cfg.Secrets.Driver != "" {
+		driver = cfg.Secrets.Driver
+	}
+	switch driver {
+	case "vault":
+		client := wire.Get[*http.Client](c)
+		return repository.NewVaultSecretRepository(cfg.Vault, client, wire.Get[*vault.Auth](c))
+	case "local":
+		repo, err := repository.NewLocalSecretRepository(cfg.Secrets.KeyringDir, cfg.Secrets.SigningKeyType)
+		if err != nil {
+			panic(err)
+		}
+		return repo
+	default:
+		panic(fmt.Sprintf("unknown secrets driver: %s", driver))
+	}
 }
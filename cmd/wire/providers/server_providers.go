@@ -1,13 +1,16 @@
 package providers
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 	"github.com/maisiq/go-auth-service/cmd/wire"
 	"github.com/maisiq/go-auth-service/internal/configs"
-	"github.com/maisiq/go-auth-service/internal/oauth"
+	"github.com/maisiq/go-auth-service/internal/db"
 	"github.com/maisiq/go-auth-service/internal/service"
 	xhttp "github.com/maisiq/go-auth-service/internal/transport/http"
 	"github.com/maisiq/go-auth-service/internal/transport/http/router"
+	"github.com/maisiq/go-auth-service/pkg/ratelimit"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
@@ -23,16 +26,33 @@ func ServerParamsProvider(di *wire.DIContainer) *xhttp.ServerParams {
 func RouterParamsProvider(di *wire.DIContainer) *router.RouterParams {
 	cfg := wire.Get[*configs.Config](di)
 	params := &router.RouterParams{
-		UserService:    wire.Get[service.IUserService](di),
-		OAuthService:   wire.Get[service.IOAuthService](di),
-		SecretService:  wire.Get[service.SecretService](di),
-		YandexProvider: wire.Get[oauth.OAuthProvider](di),
-		Config:         cfg.App,
-		Tracer:         wire.GetNamed[trace.Tracer](di, "http-server"),
+		UserService:   wire.Get[service.IUserService](di),
+		OAuthService:  wire.Get[service.IOAuthService](di),
+		SecretService: wire.Get[service.SecretService](di),
+		WebAuthn:      wire.Get[service.IWebAuthnService](di),
+		Authorization: wire.Get[service.IAuthorizationService](di),
+		Limiter:       wire.Get[ratelimit.Limiter](di),
+		Config:        cfg.App,
+		Issuer:        wire.Get[*configs.AuthConfig](di).Issuer,
+		Tracer:        wire.GetNamed[trace.Tracer](di, "http-server"),
 	}
 	return params
 }
 
+func RateLimiterProvider(di *wire.DIContainer) ratelimit.Limiter {
+	cfg := wire.Get[*configs.Config](di)
+	switch cfg.App.Limiter.Backend {
+	case "redis":
+		return ratelimit.NewRedisLimiter(wire.Get[db.RedisClient](di), "ratelimit:")
+	case "memory", "":
+		limiter := ratelimit.NewMemoryLimiter()
+		di.AddToCloser(limiter.Close)
+		return limiter
+	default:
+		panic(fmt.Sprintf("unknown rate limiter backend: %s", cfg.App.Limiter.Backend))
+	}
+}
+
 func RouterProvider(di *wire.DIContainer) *gin.Engine {
 	params := wire.Get[*router.RouterParams](di)
 	return router.NewRouter(params)
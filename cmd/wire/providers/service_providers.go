@@ -3,6 +3,9 @@ package providers
 import (
 	"github.com/maisiq/go-auth-service/cmd/wire"
 	"github.com/maisiq/go-auth-service/internal/cache"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/mailer"
+	"github.com/maisiq/go-auth-service/internal/oauth"
 	"github.com/maisiq/go-auth-service/internal/repository"
 	"github.com/maisiq/go-auth-service/internal/service"
 	"github.com/maisiq/go-auth-service/pkg/resilience"
@@ -21,15 +24,30 @@ func UserServiceProvider(c *wire.DIContainer) service.IUserService {
 	userRepo := wire.Get[repository.IUserRepository](c)
 	tokenRepo := wire.Get[repository.ITokenRepository](c)
 	secretRepo := wire.Get[repository.SecretRepository](c)
+	mfaRepo := wire.Get[repository.IMFARepository](c)
+	passkeys := wire.Get[repository.IWebAuthnRepository](c)
+	mailer := wire.Get[mailer.Mailer](c)
+	authCfg := wire.Get[*configs.AuthConfig](c)
 	tracer := wire.GetNamed[trace.Tracer](c, "user-service")
-	return service.NewUserService(logger, tracer, userRepo, tokenRepo, secretRepo, dbCB, retryDB)
+	return service.NewUserService(logger, tracer, userRepo, tokenRepo, secretRepo, mfaRepo, passkeys, mailer, authCfg, dbCB, retryDB)
 }
 
 func SecretServiceProvider(c *wire.DIContainer) service.SecretService {
 	logger := wire.Get[*zap.SugaredLogger](c)
 	secretRepo := wire.Get[repository.SecretRepository](c)
+	tokenRepo := wire.Get[repository.ITokenRepository](c)
 	cache := wire.Get[*cache.Cache](c)
-	return service.NewVaultService(logger, secretRepo, cache)
+	cfg := wire.Get[*configs.SecretsConfig](c)
+	return service.NewVaultService(logger, secretRepo, tokenRepo, cache, cfg)
+}
+
+// KeyManagerProvider doesn't need token repository, it is used by keys command
+func KeyManagerProvider(c *wire.DIContainer) service.KeyManager {
+	logger := wire.Get[*zap.SugaredLogger](c)
+	secretRepo := wire.Get[repository.SecretRepository](c)
+	cache := wire.Get[*cache.Cache](c)
+	cfg := wire.Get[*configs.SecretsConfig](c)
+	return service.NewVaultService(logger, secretRepo, nil, cache, cfg)
 }
 
 func OAuthServiceProvider(c *wire.DIContainer) service.IOAuthService {
@@ -37,5 +55,30 @@ func OAuthServiceProvider(c *wire.DIContainer) service.IOAuthService {
 	userRepo := wire.Get[repository.IUserRepository](c)
 	tokenRepo := wire.Get[repository.ITokenRepository](c)
 	secretRepo := wire.Get[repository.SecretRepository](c)
-	return service.NewOAuthService(logger, userRepo, tokenRepo, secretRepo)
+	identities := wire.Get[repository.IIdentityRepository](c)
+	providers := wire.Get[*oauth.Registry](c)
+	authCfg := wire.Get[*configs.AuthConfig](c)
+	return service.NewOAuthService(logger, userRepo, tokenRepo, secretRepo, identities, providers, authCfg)
+}
+
+func AuthorizationServiceProvider(c *wire.DIContainer) service.IAuthorizationService {
+	logger := wire.Get[*zap.SugaredLogger](c)
+	clients := wire.Get[repository.IClientRepository](c)
+	userRepo := wire.Get[repository.IUserRepository](c)
+	tokenRepo := wire.Get[repository.ITokenRepository](c)
+	secretRepo := wire.Get[repository.SecretRepository](c)
+	users := wire.Get[service.IUserService](c)
+	authCfg := wire.Get[*configs.AuthConfig](c)
+	return service.NewAuthorizationService(logger, clients, userRepo, tokenRepo, secretRepo, users, authCfg)
+}
+
+func WebAuthnServiceProvider(c *wire.DIContainer) service.IWebAuthnService {
+	logger := wire.Get[*zap.SugaredLogger](c)
+	userRepo := wire.Get[repository.IUserRepository](c)
+	tokenRepo := wire.Get[repository.ITokenRepository](c)
+	secretRepo := wire.Get[repository.SecretRepository](c)
+	passkeys := wire.Get[repository.IWebAuthnRepository](c)
+	authCfg := wire.Get[*configs.AuthConfig](c)
+	cfg := wire.Get[*configs.Config](c)
+	return service.NewWebAuthnService(logger, userRepo, tokenRepo, secretRepo, passkeys, authCfg, cfg.WebAuthn)
 }
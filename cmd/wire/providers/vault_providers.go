@@ -0,0 +1,29 @@
+package providers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/maisiq/go-auth-service/cmd/wire"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/vault"
+	"go.uber.org/zap"
+)
+
+func VaultAuthProvider(c *wire.DIContainer) *vault.Auth {
+	cfg := wire.Get[*configs.Config](c)
+	client := wire.Get[*http.Client](c)
+	logger := wire.Get[*zap.SugaredLogger](c)
+
+	auth, err := vault.NewAuth(cfg.Vault, client, logger)
+	if err != nil {
+		panic(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	auth.Start(ctx)
+
+	c.AddToCloser(auth.Close)
+	return auth
+}
@@ -5,5 +5,6 @@ vault server -dev &
 echo $VAULT_TOKEN | vault login -
 vault secrets enable transit
 vault write -f transit/keys/jwt-key type=ecdsa-p256
+vault write -f transit/keys/mfa-key type=aes256-gcm96
 
 wait -n
\ No newline at end of file
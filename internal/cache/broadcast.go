@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var _ CacheClient = (*BroadcastClient)(nil)
+
+// InvalidationChannel is the pub/sub channel deleted cache keys are sent to
+const InvalidationChannel = "cache-invalidation"
+
+type redisPublisher interface {
+	Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd
+}
+
+// BroadcastClient is an in-process cache whose deletions are sent to other processes,
+// so every replica drops the keys while reads stay local. Messages missed during
+// reconnect aren't resent, such keys expire with their TTL
+type BroadcastClient struct {
+	CacheClient
+	publisher redisPublisher
+	channel   string
+}
+
+func NewBroadcastClient(local CacheClient, publisher redisPublisher, channel string) *BroadcastClient {
+	return &BroadcastClient{
+		CacheClient: local,
+		publisher:   publisher,
+		channel:     channel,
+	}
+}
+
+func (c *BroadcastClient) Delete(ctx context.Context, keys ...string) error {
+	if err := c.CacheClient.Delete(ctx, keys...); err != nil {
+		return err
+	}
+	payload, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	return c.publisher.Publish(ctx, c.channel, payload).Err()
+}
+
+// Listen drops keys deleted by other processes until messages is closed
+func (c *BroadcastClient) Listen(messages <-chan *redis.Message) {
+	for msg := range messages {
+		var keys []string
+		if err := json.Unmarshal([]byte(msg.Payload), &keys); err != nil {
+			continue
+		}
+		_ = c.CacheClient.Delete(context.Background(), keys...)
+	}
+}
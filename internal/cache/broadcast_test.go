@@ -0,0 +1,51 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/cache"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBus delivers published messages to every subscriber like redis pub/sub does
+type fakeBus struct {
+	subscribers []chan *redis.Message
+}
+
+func (b *fakeBus) subscribe() <-chan *redis.Message {
+	ch := make(chan *redis.Message, 1)
+	b.subscribers = append(b.subscribers, ch)
+	return ch
+}
+
+func (b *fakeBus) Publish(ctx context.Context, channel string, message interface{}) *redis.IntCmd {
+	for _, ch := range b.subscribers {
+		ch <- &redis.Message{Channel: channel, Payload: string(message.([]byte))}
+	}
+	return redis.NewIntResult(int64(len(b.subscribers)), nil)
+}
+
+func TestBroadcastClient(t *testing.T) {
+	ctx := context.Background()
+	bus := &fakeBus{}
+
+	replica := cache.NewBroadcastClient(cache.NewInMemoryCacheClient(), bus, cache.InvalidationChannel)
+	go replica.Listen(bus.subscribe())
+	cli := cache.NewBroadcastClient(cache.NewInMemoryCacheClient(), bus, cache.InvalidationChannel)
+
+	require.NoError(t, replica.Set(ctx, "jwt-key", []byte("keys"), time.Minute))
+	require.NoError(t, replica.Set(ctx, "other", []byte("value"), time.Minute))
+
+	require.NoError(t, cli.Delete(ctx, "jwt-key"))
+
+	require.Eventually(t, func() bool {
+		_, err := replica.Get(ctx, "jwt-key")
+		return err == cache.ErrNotFound
+	}, time.Second, 10*time.Millisecond)
+	value, err := replica.Get(ctx, "other")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+}
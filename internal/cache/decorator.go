@@ -9,6 +9,7 @@ import (
 type CacheClient interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
 	Close() error
 }
 
@@ -16,6 +17,15 @@ type Cache struct {
 	Client CacheClient
 }
 
+// Set replaces cached value of the key
+func Set[T any](c *Cache, ctx context.Context, key string, ttl time.Duration, value T) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.Client.Set(ctx, key, data, ttl)
+}
+
 func GetOrSet[T any](c *Cache, ctx context.Context, key string, ttl time.Duration, fetch func() (T, error)) (T, error) {
 	var empty T
 
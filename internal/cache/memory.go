@@ -39,6 +39,13 @@ func (c *InMemoryCacheClient) Set(_ context.Context, key string, value interface
 	return nil
 }
 
+func (c *InMemoryCacheClient) Delete(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		c.storage.Delete(key)
+	}
+	return nil
+}
+
 func (c *InMemoryCacheClient) Close() error {
 	c.storage.Flush()
 	return nil
@@ -28,6 +28,10 @@ func (c *RedisClient) Set(ctx context.Context, key string, value interface{}, ex
 	return res.Err()
 }
 
+func (c *RedisClient) Delete(ctx context.Context, keys ...string) error {
+	return c.client.Del(ctx, keys...).Err()
+}
+
 func (c *RedisClient) Close() error {
 	return c.client.Close()
 }
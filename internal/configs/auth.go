@@ -0,0 +1,102 @@
+package configs
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+// MinTokenSecretLength is the minimal length of TokenSecret in bytes
+const MinTokenSecretLength = 32
+
+type AuthConfig struct {
+	// Issuer is iss claim of access tokens and issuer of OIDC discovery document, so it must be
+	// the absolute https URL the document is served under. Plain http is allowed for localhost only
+	Issuer string `mapstructure:"issuer"`
+	// RequireVerifiedEmail rejects login of users who haven't confirmed their email yet
+	RequireVerifiedEmail bool `mapstructure:"require_verified_email"`
+	// TokenSecret is HMAC key for one-time and refresh tokens kept in memory db.
+	// Changing it invalidates all issued refresh tokens
+	TokenSecret string `mapstructure:"token_secret"`
+	// VerifyEmailURL is a page the link in verification email leads to. Token is passed in query string
+	VerifyEmailURL  string        `mapstructure:"verify_email_url"`
+	VerificationTTL time.Duration `mapstructure:"verification_ttl"`
+	// ResetPasswordURL is a page the link in password reset email leads to. Token is passed in query string
+	ResetPasswordURL string        `mapstructure:"reset_password_url"`
+	PasswordResetTTL time.Duration `mapstructure:"password_reset_ttl"`
+	// MFAIssuer is the account issuer shown in authenticator apps
+	MFAIssuer string        `mapstructure:"mfa_issuer"`
+	Lockout   LockoutConfig `mapstructure:"lockout"`
+	// Permissions maps role to permissions put into access tokens of its users
+	Permissions map[string][]string `mapstructure:"permissions"`
+	// AllowedRedirects are origins (scheme://host[:port]) of frontends users may return to after OAuth login.
+	// Paths of this service are always allowed
+	AllowedRedirects []string `mapstructure:"allowed_redirects"`
+}
+
+// Validate checks settings the service can't run safely without
+func (c *AuthConfig) Validate() error {
+	if c == nil {
+		return errors.New("auth config is missing")
+	}
+	if len(c.TokenSecret) < MinTokenSecretLength {
+		return fmt.Errorf("auth.token_secret must be at least %d bytes long", MinTokenSecretLength)
+	}
+	if err := validateIssuer(c.Issuer); err != nil {
+		return err
+	}
+	return c.Lockout.Validate()
+}
+
+func validateIssuer(issuer string) error {
+	u, err := url.Parse(issuer)
+	if err != nil || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return errors.New("auth.issuer must be an absolute URL without query and fragment")
+	}
+	if u.Scheme == "https" {
+		return nil
+	}
+	if u.Scheme == "http" && isLoopback(u.Hostname()) {
+		return nil
+	}
+	return errors.New("auth.issuer must be an https URL, http is allowed for localhost only")
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// LockoutConfig is a brute-force protection policy of password login
+type LockoutConfig struct {
+	// MaxAttempts is a number of failed attempts per account within Window before lockout, zero disables it
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// IPMaxAttempts is a number of failed attempts per client IP within Window before lockout, zero disables it
+	IPMaxAttempts int           `mapstructure:"ip_max_attempts"`
+	Window        time.Duration `mapstructure:"window"`
+	// BaseLockout is duration of the first lockout, every next one is twice as long up to MaxLockout
+	BaseLockout time.Duration `mapstructure:"base_lockout"`
+	MaxLockout  time.Duration `mapstructure:"max_lockout"`
+}
+
+// Validate rejects policies which would never lock out or lock out forever. Disabled policy isn't checked
+func (c LockoutConfig) Validate() error {
+	if c.MaxAttempts <= 0 && c.IPMaxAttempts <= 0 {
+		return nil
+	}
+	if c.Window <= 0 {
+		return errors.New("auth.lockout.window must be positive")
+	}
+	if c.BaseLockout <= 0 {
+		return errors.New("auth.lockout.base_lockout must be positive")
+	}
+	if c.MaxLockout < c.BaseLockout {
+		return errors.New("auth.lockout.max_lockout must not be less than base_lockout")
+	}
+	return nil
+}
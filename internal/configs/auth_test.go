@@ -0,0 +1,54 @@
+package configs_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthConfigValidate(t *testing.T) {
+	valid := func() *configs.AuthConfig {
+		return &configs.AuthConfig{
+			Issuer:      "https://auth.example.com",
+			TokenSecret: strings.Repeat("s", configs.MinTokenSecretLength),
+			Lockout: configs.LockoutConfig{
+				MaxAttempts: 5,
+				Window:      15 * time.Minute,
+				BaseLockout: time.Minute,
+				MaxLockout:  time.Hour,
+			},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	var missing *configs.AuthConfig
+	require.Error(t, missing.Validate())
+
+	for name, modify := range map[string]func(c *configs.AuthConfig){
+		"empty token secret":     func(c *configs.AuthConfig) { c.TokenSecret = "" },
+		"short token secret":     func(c *configs.AuthConfig) { c.TokenSecret = "secret" },
+		"empty issuer":           func(c *configs.AuthConfig) { c.Issuer = "" },
+		"relative issuer":        func(c *configs.AuthConfig) { c.Issuer = "auth-service" },
+		"http issuer":            func(c *configs.AuthConfig) { c.Issuer = "http://auth.example.com" },
+		"issuer with query":      func(c *configs.AuthConfig) { c.Issuer = "https://auth.example.com?tenant=1" },
+		"zero window":            func(c *configs.AuthConfig) { c.Lockout.Window = 0 },
+		"zero base lockout":      func(c *configs.AuthConfig) { c.Lockout.BaseLockout = 0 },
+		"negative base lockout":  func(c *configs.AuthConfig) { c.Lockout.BaseLockout = -time.Minute },
+		"max below base lockout": func(c *configs.AuthConfig) { c.Lockout.MaxLockout = time.Second },
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := valid()
+			modify(c)
+			require.Error(t, c.Validate())
+		})
+	}
+
+	t.Run("disabled lockout isn't checked", func(t *testing.T) {
+		c := valid()
+		c.Lockout = configs.LockoutConfig{}
+		require.NoError(t, c.Validate())
+	})
+}
@@ -25,16 +25,65 @@ type MemoryDBConfig struct {
 
 type VaultConfig struct {
 	BaseURL string `mapstructure:"base_url"`
-	TOKEN   string `mapstructure:"token"`
+	// Auth is one of: token (static TOKEN, default), token_file, approle, kubernetes
+	Auth  string `mapstructure:"auth"`
+	TOKEN string `mapstructure:"token"`
+	// TokenFile is a file with token kept up to date by other process, e.g. Vault Agent
+	TokenFile  string                `mapstructure:"token_file"`
+	AppRole    VaultAppRoleConfig    `mapstructure:"approle"`
+	Kubernetes VaultKubernetesConfig `mapstructure:"kubernetes"`
+}
+
+type VaultAppRoleConfig struct {
+	// Mount is a path of the auth method, approle by default
+	Mount  string `mapstructure:"mount"`
+	RoleID string `mapstructure:"role_id"`
+	// SecretIDFile is read on every login instead of SecretID if set
+	SecretID     string `mapstructure:"secret_id"`
+	SecretIDFile string `mapstructure:"secret_id_file"`
+}
+
+type VaultKubernetesConfig struct {
+	// Mount is a path of the auth method, kubernetes by default
+	Mount string `mapstructure:"mount"`
+	Role  string `mapstructure:"role"`
+	// TokenPath is a service account token file, the default projected token is used if empty
+	TokenPath string `mapstructure:"token_path"`
+}
+
+// RateLimitPolicy allows Requests per Period for every client identified by Key
+type RateLimitPolicy struct {
+	Requests int           `mapstructure:"requests"`
+	Period   time.Duration `mapstructure:"period"`
+	// Burst is a capacity of the in-memory token bucket, defaults to Requests
+	Burst int `mapstructure:"burst"`
+	// Key is either "ip", "user" or "api_key", user and API key fall back to IP for anonymous requests
+	Key string `mapstructure:"key"`
+}
+
+type RouteRateLimit struct {
+	Method          string `mapstructure:"method"`
+	Path            string `mapstructure:"path"`
+	RateLimitPolicy `mapstructure:",squash"`
+}
+
+type LimiterConfig struct {
+	// Backend is either "memory" (per replica) or "redis" (shared by replicas)
+	Backend string `mapstructure:"backend"`
+	// Default is a policy of routes without their own one, all of them share the quota
+	Default RateLimitPolicy  `mapstructure:"default"`
+	Routes  []RouteRateLimit `mapstructure:"routes"`
 }
 
 type AppConfig struct {
-	Debug   bool   `mapstructure:"debug"`
-	Addr    string `mapstructure:"addr"`
-	Limiter struct {
-		Limit int `mapstructure:"limit"`
-		Burst int `mapstructure:"burst"`
-	} `mapstructure:"limiter"`
+	Debug   bool          `mapstructure:"debug"`
+	Addr    string        `mapstructure:"addr"`
+	Limiter LimiterConfig `mapstructure:"limiter"`
+	// PublicURL is an external address of the service, used to build absolute links in OIDC documents
+	PublicURL string `mapstructure:"public_url"`
+	// TrustedProxies are addresses or CIDRs of reverse proxies whose X-Forwarded-For identifies the client.
+	// Forwarded headers are ignored if it is empty, so the client is identified by peer address
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
 }
 
 type Config struct {
@@ -42,7 +91,12 @@ type Config struct {
 	Database *DatabaseConfig       `mapstructure:"database"`
 	MemoryDB *MemoryDBConfig       `mapstructure:"memorydb"`
 	Vault    *VaultConfig          `mapstructure:"vault"`
+	Secrets  *SecretsConfig        `mapstructure:"secrets"`
 	Yandex   *YandexProviderConfig `mapstructure:"yandex"`
+	OAuth    *OAuthConfig          `mapstructure:"oauth"`
+	Auth     *AuthConfig           `mapstructure:"auth"`
+	Mailer   *MailerConfig         `mapstructure:"mailer"`
+	WebAuthn *WebAuthnConfig       `mapstructure:"webauthn"`
 }
 
 func initConfig(path string, onChange chan<- interface{}) (*viper.Viper, error) {
@@ -0,0 +1,15 @@
+package configs
+
+type MailerConfig struct {
+	// Driver is one of: smtp, file, log
+	Driver string `mapstructure:"driver"`
+	From   string `mapstructure:"from"`
+	// Dir is a directory for messages written by file driver
+	Dir  string `mapstructure:"dir"`
+	SMTP struct {
+		Host     string `mapstructure:"host"`
+		Port     int    `mapstructure:"port"`
+		Username string `mapstructure:"username"`
+		Password string `mapstructure:"password"`
+	} `mapstructure:"smtp"`
+}
@@ -0,0 +1,19 @@
+package configs
+
+// OAuthConfig lists OpenID Connect providers by name, the name is used in /oauth/{provider} routes
+type OAuthConfig struct {
+	Providers map[string]OIDCProviderConfig `mapstructure:"providers"`
+}
+
+type OIDCProviderConfig struct {
+	// DiscoveryURL is an issuer URL or a full URL of its /.well-known/openid-configuration document
+	DiscoveryURL string `mapstructure:"discovery_url"`
+	ClientID     string `mapstructure:"client_id"`
+	ClientSecret string `mapstructure:"client_secret"`
+	// Scopes are requested from the provider, openid, email and profile by default
+	Scopes []string `mapstructure:"scopes"`
+	// RedirectURL is a callback registered in the provider, <public_url>/oauth/<name>/callback by default
+	RedirectURL string `mapstructure:"redirect_url"`
+	// TrustEmail accepts emails without email_verified claim, only for providers which verify all emails
+	TrustEmail bool `mapstructure:"trust_email"`
+}
@@ -0,0 +1,34 @@
+package configs
+
+import (
+	"fmt"
+	"time"
+)
+
+type SecretsConfig struct {
+	// Driver is one of: vault, local
+	Driver string `mapstructure:"driver"`
+	// KeyringDir is a directory with keys of local driver, one subdirectory with versioned PEM files per key.
+	// Missing keys are generated on first use
+	KeyringDir string `mapstructure:"keyring_dir"`
+	// SigningKeyType is a type of signing keys generated by local driver, named like vault transit types:
+	// ecdsa-p256 (default), ecdsa-p384, ecdsa-p521, rsa-2048, rsa-3072, rsa-4096, ed25519
+	SigningKeyType string `mapstructure:"signing_key_type"`
+	// KeyGracePeriod is how long a signing key version stays in the verification set after rotation.
+	// It must be at least access token lifetime. Zero means "never expire": versions verify until they are retired
+	KeyGracePeriod time.Duration `mapstructure:"key_grace_period"`
+	// SigningKeyRefresh is how often cached KID and algorithm of the signing key are refreshed, a minute by default
+	SigningKeyRefresh time.Duration `mapstructure:"signing_key_refresh"`
+}
+
+// Validate checks that rotated signing keys keep verifying as long as tokens signed with them live.
+// Missing config is valid, defaults are used then
+func (c *SecretsConfig) Validate(tokenTTL time.Duration) error {
+	if c == nil {
+		return nil
+	}
+	if c.KeyGracePeriod != 0 && c.KeyGracePeriod < tokenTTL {
+		return fmt.Errorf("secrets.key_grace_period must be 0 (never expire) or at least %s", tokenTTL)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package configs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretsConfigValidate(t *testing.T) {
+	const tokenTTL = 5 * time.Minute
+
+	var missing *configs.SecretsConfig
+	require.NoError(t, missing.Validate(tokenTTL))
+
+	for grace, valid := range map[time.Duration]bool{
+		0:            true,
+		tokenTTL:     true,
+		time.Hour:    true,
+		time.Minute:  false,
+		-time.Hour:   false,
+		tokenTTL - 1: false,
+	} {
+		err := (&configs.SecretsConfig{KeyGracePeriod: grace}).Validate(tokenTTL)
+		if valid {
+			require.NoError(t, err, grace)
+		} else {
+			require.Error(t, err, grace)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package configs
+
+type WebAuthnConfig struct {
+	// RPID is a domain of the relying party. Credentials are bound to it and can't be moved to another domain
+	RPID   string `mapstructure:"rp_id"`
+	RPName string `mapstructure:"rp_name"`
+	// Origins are allowed origins of frontends running WebAuthn ceremonies
+	Origins []string `mapstructure:"origins"`
+}
@@ -19,20 +19,23 @@ type YandexProviderConfig struct {
 	Scope        string `mapstructure:"scope"`
 }
 
-func (c *YandexProviderConfig) GetAuthorizeURL(state string) string {
+func (c *YandexProviderConfig) GetAuthorizeURL(state, codeChallenge string) string {
 	query := url.Values{}
 	query.Set("client_id", c.ClientID)
 	query.Set("redirect_uri", c.RedirectURL)
 	query.Set("response_type", "code")
 	query.Set("scope", c.Scope)
 	query.Set("state", state)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
 	return fmt.Sprintf(c.AuthorizeURL+"?%s", query.Encode())
 }
 
-func (c *YandexProviderConfig) GetTokenRequestPayload(authorizationCode string) io.Reader {
+func (c *YandexProviderConfig) GetTokenRequestPayload(authorizationCode, codeVerifier string) io.Reader {
 	data := url.Values{}
 	data.Set("grant_type", "authorization_code")
 	data.Set("code", authorizationCode)
+	data.Set("code_verifier", codeVerifier)
 	data.Set("client_id", c.ClientID)
 	data.Set("client_secret", c.ClientSecret)
 	return strings.NewReader(data.Encode())
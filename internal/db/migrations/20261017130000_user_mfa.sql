@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_mfa (
+    user_id varchar PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    -- encrypted with vault transit engine
+    secret text NOT NULL,
+    created_at bigint NOT NULL,
+    confirmed_at bigint
+);
+
+CREATE TABLE mfa_recovery_codes (
+    user_id varchar NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash varchar NOT NULL,
+    PRIMARY KEY (user_id, code_hash)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE mfa_recovery_codes;
+DROP TABLE user_mfa;
+-- +goose StatementEnd
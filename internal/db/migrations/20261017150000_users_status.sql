@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    ADD COLUMN status varchar NOT NULL DEFAULT 'active',
+    ADD COLUMN status_reason text,
+    ADD COLUMN status_changed_at bigint,
+    ADD COLUMN locked_until bigint;
+
+UPDATE users SET status = 'pending_verification' WHERE verified_at IS NULL AND NOT social_account;
+
+-- admin user list is ordered and filtered by creation time
+CREATE INDEX users_created_at_idx ON users (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX users_created_at_idx;
+ALTER TABLE users
+    DROP COLUMN status,
+    DROP COLUMN status_reason,
+    DROP COLUMN status_changed_at,
+    DROP COLUMN locked_until;
+-- +goose StatementEnd
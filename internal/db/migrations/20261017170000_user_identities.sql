@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_identities (
+    provider varchar NOT NULL,
+    subject varchar NOT NULL,
+    user_id varchar NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    email varchar NOT NULL DEFAULT '',
+    linked_at bigint NOT NULL,
+    PRIMARY KEY (provider, subject)
+);
+
+CREATE INDEX user_identities_user_id_idx ON user_identities(user_id);
+
+INSERT INTO user_identities(provider, subject, user_id, email, linked_at)
+SELECT social_provider, social_id, id, email, COALESCE(created_at, 0) FROM users
+WHERE social_account AND social_provider <> '' AND social_id <> ''
+ON CONFLICT DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_identities;
+-- +goose StatementEnd
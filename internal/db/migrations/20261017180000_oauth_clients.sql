@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE oauth_clients (
+    id varchar PRIMARY KEY,
+    name varchar NOT NULL,
+    secret_hash text,
+    redirect_uris text NOT NULL,
+    scopes text NOT NULL DEFAULT '',
+    public boolean NOT NULL DEFAULT false,
+    first_party boolean NOT NULL DEFAULT false,
+    created_at bigint NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE oauth_clients;
+-- +goose StatementEnd
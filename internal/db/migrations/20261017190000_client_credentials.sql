@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- clients registered before keep the grants of authorization code flow
+ALTER TABLE oauth_clients ADD COLUMN grant_types text NOT NULL DEFAULT 'authorization_code refresh_token';
+ALTER TABLE oauth_clients ADD COLUMN audiences text NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE oauth_clients DROP COLUMN audiences;
+ALTER TABLE oauth_clients DROP COLUMN grant_types;
+-- +goose StatementEnd
@@ -14,6 +14,31 @@ const (
 	AdminRole   Role = "admin"
 )
 
+type AccountStatus string
+
+const (
+	StatusActive              AccountStatus = "active"
+	StatusLocked              AccountStatus = "locked"
+	StatusDisabled            AccountStatus = "disabled"
+	StatusPendingVerification AccountStatus = "pending_verification"
+)
+
+func (s AccountStatus) Valid() bool {
+	switch s {
+	case StatusActive, StatusLocked, StatusDisabled, StatusPendingVerification:
+		return true
+	}
+	return false
+}
+
+func (r Role) Valid() bool {
+	switch r {
+	case UserRole, PremiumRole, AdminRole:
+		return true
+	}
+	return false
+}
+
 type User struct {
 	ID             uuid.UUID
 	Email          string
@@ -22,12 +47,183 @@ type User struct {
 
 	LastLoogedAt time.Time `db:"last_logged_at"`
 	CreatedAT    time.Time `db:"created_at"`
+	VerifiedAt   time.Time `db:"verified_at"` // zero if email is not confirmed yet
+
+	Status          AccountStatus
+	StatusReason    string
+	StatusChangedAt time.Time
+	LockedUntil     time.Time // zero if locked account is locked until unlocked explicitly
 
 	SocialAccount  bool // Tells whether this user was created via one of OAuth providers
 	SocialID       string
 	SocialProvider string
 }
 
+func (u User) EmailVerified() bool {
+	return !u.VerifiedAt.IsZero()
+}
+
+func (u User) Disabled() bool {
+	return u.Status == StatusDisabled
+}
+
+// Locked reports whether the account is locked at the moment
+func (u User) Locked(now time.Time) bool {
+	return u.Status == StatusLocked && (u.LockedUntil.IsZero() || now.Before(u.LockedUntil))
+}
+
+// SetStatus changes status of the account recording reason and time of the change
+func (u *User) SetStatus(status AccountStatus, reason string, now time.Time) {
+	u.Status = status
+	u.StatusReason = reason
+	u.StatusChangedAt = now
+	if status != StatusLocked {
+		u.LockedUntil = time.Time{}
+	}
+}
+
+// UserFilter selects a page of users, zero fields are not applied
+type UserFilter struct {
+	Email          string // part of email
+	Role           Role
+	Status         AccountStatus
+	SocialProvider string
+	CreatedFrom    time.Time
+	CreatedTo      time.Time
+
+	Limit  int
+	Offset int
+}
+
+// MFA is a TOTP second factor of the user
+type MFA struct {
+	UserID      uuid.UUID
+	Secret      string // encrypted
+	CreatedAt   time.Time
+	ConfirmedAt time.Time // zero until user enters the first valid code
+}
+
+func (m MFA) Enabled() bool {
+	return !m.ConfirmedAt.IsZero()
+}
+
+// WebAuthnCredential is a public key credential (passkey or security key) registered by the user
+type WebAuthnCredential struct {
+	ID         []byte
+	UserID     uuid.UUID
+	Name       string
+	PublicKey  []byte // COSE_Key
+	SignCount  uint32
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+// UserIdentity is an account of the user in an OAuth provider.
+// The user may log in with any of the linked identities
+type UserIdentity struct {
+	Provider string    `json:"provider"`
+	Subject  string    `json:"subject"` // id of the account in the provider
+	UserID   uuid.UUID `json:"-"`
+	Email    string    `json:"email"` // email reported by the provider when the identity was linked
+	LinkedAt time.Time `json:"linked_at"`
+}
+
+// RefreshToken is a refresh token with its rotation history.
+// Tokens issued by rotation from the same login form a family
+type RefreshToken struct {
+	ID        string // keyed hash of the token, raw tokens are never stored
+	Email     string
+	FamilyID  string
+	ParentID  string // token this one was exchanged for, empty for the first token of the family
+	IssuedAt  time.Time
+	RotatedAt time.Time // zero while token is active
+	// ClientID is set for tokens issued to OAuth clients, they are refreshed by the same client only
+	ClientID   string
+	Scope      []string
+	ThirdParty bool
+}
+
+func (t RefreshToken) Rotated() bool {
+	return !t.RotatedAt.IsZero()
+}
+
+// Session is a login of the user on some device.
+// Its ID is the family ID of the refresh tokens issued for it
+type Session struct {
+	ID         string    `json:"id"`
+	Email      string    `json:"-"`
+	Method     string    `json:"method"` // password, webauthn, oauth provider or authorization_code
+	ClientID   string    `json:"client_id,omitempty"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+// OAuthState is a login via OAuth provider kept between redirect to the provider and callback
+type OAuthState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+	// RedirectTo is a page of frontend the user returns to after login
+	RedirectTo string `json:"redirect_to,omitempty"`
+	// LinkUserID is set when signed in user links the identity instead of logging in
+	LinkUserID string `json:"link_user_id,omitempty"`
+}
+
+// OAuthClient is an application which obtains tokens of users via authorization code flow
+type OAuthClient struct {
+	ID           string    `json:"client_id"`
+	Name         string    `json:"name"`
+	SecretHash   string    `json:"-"` // empty for public clients
+	RedirectURIs []string  `json:"redirect_uris"`
+	Scopes       []string  `json:"scopes"` // scopes the client may request
+	GrantTypes   []string  `json:"grant_types"`
+	Audiences    []string  `json:"audiences,omitempty"` // services the client may get client_credentials tokens for
+	Public       bool      `json:"public"`              // public clients can't keep a secret, e.g. SPA or mobile apps
+	FirstParty   bool      `json:"first_party"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// Grant types OAuth client may be allowed to use at token endpoint
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantRefreshToken      = "refresh_token"
+	// GrantClientCredentials issues tokens of the client itself, it is used by service accounts
+	GrantClientCredentials = "client_credentials"
+)
+
+func (c OAuthClient) AllowsGrant(grantType string) bool {
+	for _, g := range c.GrantTypes {
+		if g == grantType {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthorizationRequest is a request of the client kept while user logs in and consents
+type AuthorizationRequest struct {
+	ClientID    string `json:"client_id"`
+	RedirectURI string `json:"redirect_uri"`
+	// ExplicitRedirect tells that redirect_uri was passed, then it must be passed to token endpoint as well
+	ExplicitRedirect bool     `json:"explicit_redirect"`
+	Scope            []string `json:"scope"`
+	State            string   `json:"state"`
+	CodeChallenge    string   `json:"code_challenge"`
+	UserID           string   `json:"user_id,omitempty"` // set after user logs in
+}
+
+// AuthorizationCode is a grant of the user exchanged by the client for tokens
+type AuthorizationCode struct {
+	ClientID string `json:"client_id"`
+	// RedirectURI is empty if it wasn't passed to authorization endpoint
+	RedirectURI   string   `json:"redirect_uri"`
+	UserID        string   `json:"user_id"`
+	Scope         []string `json:"scope"`
+	CodeChallenge string   `json:"code_challenge"`
+}
+
 // UserLog is a record for user's each logging try
 type UserLog struct {
 	ID        uuid.UUID `json:"id"`
@@ -36,3 +232,10 @@ type UserLog struct {
 	IP        string    `json:"ip"`
 	LoggedAt  time.Time `json:"logged_at"`
 }
+
+// KeyVersion is a version of a key in the secret repository.
+// Versions grow with every rotation, the latest one is used for signing and encryption
+type KeyVersion struct {
+	Version   int
+	CreatedAt time.Time
+}
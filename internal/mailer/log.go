@@ -0,0 +1,32 @@
+package mailer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+var _ Mailer = (*LogMailer)(nil)
+
+// LogMailer doesn't deliver messages anywhere, it only writes them to the log
+type LogMailer struct {
+	from string
+	log  *zap.SugaredLogger
+}
+
+func NewLogMailer(from string, log *zap.SugaredLogger) *LogMailer {
+	return &LogMailer{
+		from: from,
+		log:  log,
+	}
+}
+
+func (m *LogMailer) Send(_ context.Context, msg Message) error {
+	m.log.Infow("mail sent",
+		"from", m.from,
+		"to", msg.To,
+		"subject", msg.Subject,
+		"body", msg.Body,
+	)
+	return nil
+}
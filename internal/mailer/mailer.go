@@ -0,0 +1,39 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"go.uber.org/zap"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+const (
+	SMTPDriver = "smtp"
+	FileDriver = "file"
+	LogDriver  = "log"
+)
+
+// New returns mailer for configured driver. Log driver is used by default
+func New(cfg *configs.MailerConfig, log *zap.SugaredLogger) (Mailer, error) {
+	switch cfg.Driver {
+	case SMTPDriver:
+		return NewSMTPMailer(cfg), nil
+	case FileDriver:
+		return NewFileMailer(cfg.From, cfg.Dir)
+	case LogDriver, "":
+		return NewLogMailer(cfg.From, log), nil
+	default:
+		return nil, fmt.Errorf("unknown mailer driver: %s", cfg.Driver)
+	}
+}
@@ -1,9 +1,18 @@
 package oauth
 
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
 type OAuthData struct {
 	UserID   string
 	Email    string
 	Provider OAuthProviderT
+	// EmailVerified tells that the provider confirmed the user owns the email,
+	// only such identities are linked to existing accounts without signing in
+	EmailVerified bool
 }
 
 type OAuthProviderT string
@@ -12,6 +21,24 @@ const (
 	YandexProvider OAuthProviderT = "yandex"
 )
 
+// AuthRequest binds the redirect to the provider with its callback
+type AuthRequest struct {
+	State string
+	// CodeVerifier is a PKCE secret, the provider gets its S256 challenge on redirect
+	CodeVerifier string
+	// Nonce is put into ID token by OpenID Connect providers
+	Nonce string
+}
+
+// CodeChallenge returns PKCE S256 challenge of the verifier
+func (r AuthRequest) CodeChallenge() string {
+	sum := sha256.Sum256([]byte(r.CodeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
 type OAuthProvider interface {
-	GetData(authorizationCode string) (OAuthData, error)
+	// AuthorizeURL returns page of the provider where user grants access
+	AuthorizeURL(ctx context.Context, req AuthRequest) (string, error)
+	// GetData exchanges code for the user's identity, req is the one code was issued for
+	GetData(ctx context.Context, authorizationCode string, req AuthRequest) (OAuthData, error)
 }
@@ -0,0 +1,270 @@
+// Package oidc is a generic OpenID Connect provider configured from the provider's discovery document
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/oauth"
+)
+
+const discoveryPath = "/.well-known/openid-configuration"
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+var ErrInvalidAuthCode = fmt.Errorf("invalid auth code")
+var ErrEmailNotVerified = fmt.Errorf("email is not verified by provider")
+var ErrInvalidNonce = fmt.Errorf("invalid id token nonce")
+
+// metadata is a part of the discovery document used by the provider
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	IDToken     string `json:"id_token"`
+}
+
+type errorTokenResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type identity struct {
+	jwt.RegisteredClaims
+	Nonce string `json:"nonce"`
+	Email string `json:"email"`
+	// EmailVerified is a boolean, but some providers send it as a string
+	EmailVerified any `json:"email_verified"`
+}
+
+type Provider struct {
+	name   oauth.OAuthProviderT
+	cfg    configs.OIDCProviderConfig
+	client *http.Client
+
+	// meta is fetched on first use, failed discovery is retried on the next request
+	mu   sync.Mutex
+	meta *metadata
+}
+
+func NewProvider(name oauth.OAuthProviderT, cfg configs.OIDCProviderConfig, client *http.Client) *Provider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = defaultScopes
+	}
+	return &Provider{
+		name:   name,
+		cfg:    cfg,
+		client: client,
+	}
+}
+
+func (p *Provider) AuthorizeURL(ctx context.Context, req oauth.AuthRequest) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid authorization endpoint of %s: %w", p.name, err)
+	}
+	query := u.Query()
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("response_type", "code")
+	query.Set("scope", strings.Join(p.cfg.Scopes, " "))
+	query.Set("state", req.State)
+	query.Set("nonce", req.Nonce)
+	query.Set("code_challenge", req.CodeChallenge())
+	query.Set("code_challenge_method", "S256")
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// GetData exchanges code for ID token. The token is received from the provider directly over TLS,
+// so its issuer, audience and expiration are checked without verifying the signature (OIDC Core 3.1.3.7)
+func (p *Provider) GetData(ctx context.Context, authorizationCode string, req oauth.AuthRequest) (oauth.OAuthData, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return oauth.OAuthData{}, err
+	}
+	tokens, err := p.exchange(ctx, meta, authorizationCode, req.CodeVerifier)
+	if err != nil {
+		return oauth.OAuthData{}, err
+	}
+
+	var id identity
+	if _, _, err := jwt.NewParser().ParseUnverified(tokens.IDToken, &id); err != nil {
+		return oauth.OAuthData{}, fmt.Errorf("failed to parse id token: %w", err)
+	}
+	validator := jwt.NewValidator(
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err := validator.Validate(id); err != nil {
+		return oauth.OAuthData{}, fmt.Errorf("invalid id token: %w", err)
+	}
+	if id.Subject == "" {
+		return oauth.OAuthData{}, fmt.Errorf("id token has no subject")
+	}
+	// id token issued for another authorization request is replayed
+	if subtle.ConstantTimeCompare([]byte(id.Nonce), []byte(req.Nonce)) != 1 {
+		return oauth.OAuthData{}, ErrInvalidNonce
+	}
+
+	// providers may put email into userinfo only
+	if id.Email == "" && meta.UserinfoEndpoint != "" {
+		if err := p.userInfo(ctx, meta, tokens.AccessToken, &id); err != nil {
+			return oauth.OAuthData{}, err
+		}
+	}
+	if id.Email == "" {
+		return oauth.OAuthData{}, fmt.Errorf("provider %s returned no email, check scopes", p.name)
+	}
+	// accounts are matched by email, so unverified ones can't be trusted
+	if !emailVerified(id.EmailVerified, p.cfg.TrustEmail) {
+		return oauth.OAuthData{}, ErrEmailNotVerified
+	}
+	return oauth.OAuthData{
+		UserID:        id.Subject,
+		Email:         id.Email,
+		Provider:      p.name,
+		EmailVerified: true,
+	}, nil
+}
+
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	discoveryURL := p.cfg.DiscoveryURL
+	if !strings.HasSuffix(discoveryURL, discoveryPath) {
+		discoveryURL = strings.TrimSuffix(discoveryURL, "/") + discoveryPath
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch discovery document of %s: %w", p.name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch discovery document of %s: status %d", p.name, resp.StatusCode)
+	}
+
+	var meta metadata
+	if err := json.NewDecoder(resp.Body).Decode(&meta); err != nil {
+		return nil, fmt.Errorf("failed to decode discovery document of %s: %w", p.name, err)
+	}
+	if meta.Issuer == "" || meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" {
+		return nil, fmt.Errorf("incomplete discovery document of %s", p.name)
+	}
+	p.meta = &meta
+	return p.meta, nil
+}
+
+func (p *Provider) exchange(ctx context.Context, meta *metadata, authorizationCode, codeVerifier string) (tokenResponse, error) {
+	data := url.Values{}
+	data.Set("grant_type", "authorization_code")
+	data.Set("code", authorizationCode)
+	data.Set("code_verifier", codeVerifier)
+	data.Set("redirect_uri", p.cfg.RedirectURL)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return tokenResponse{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// client_secret_basic is the default authentication method of token endpoint
+	req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return tokenResponse{}, fmt.Errorf("failed to retrieve the token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var response errorTokenResponse
+		if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+			return tokenResponse{}, fmt.Errorf("failed to retrieve the token: status %d", resp.StatusCode)
+		}
+		if response.Error == "invalid_grant" {
+			return tokenResponse{}, ErrInvalidAuthCode
+		}
+		return tokenResponse{}, fmt.Errorf("failed to retrieve the token: %s %s", response.Error, response.ErrorDescription)
+	}
+
+	var response tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return tokenResponse{}, err
+	}
+	if response.IDToken == "" {
+		return tokenResponse{}, fmt.Errorf("provider %s returned no id token, check openid scope", p.name)
+	}
+	return response, nil
+}
+
+// userInfo fills email of id, userinfo of another subject is rejected
+func (p *Provider) userInfo(ctx context.Context, meta *metadata, accessToken string, id *identity) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, meta.UserinfoEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get userinfo: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get userinfo: status %d", resp.StatusCode)
+	}
+
+	var info struct {
+		Subject       string `json:"sub"`
+		Email         string `json:"email"`
+		EmailVerified any    `json:"email_verified"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return fmt.Errorf("failed to decode userinfo: %w", err)
+	}
+	if info.Subject != id.Subject {
+		return fmt.Errorf("userinfo subject doesn't match id token")
+	}
+	id.Email = info.Email
+	id.EmailVerified = info.EmailVerified
+	return nil
+}
+
+// emailVerified returns trustMissing if the claim is missing
+func emailVerified(claim any, trustMissing bool) bool {
+	switch v := claim.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return trustMissing
+	}
+}
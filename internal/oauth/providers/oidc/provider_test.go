@@ -0,0 +1,184 @@
+package oidc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/oauth"
+	"github.com/maisiq/go-auth-service/internal/oauth/providers/oidc"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvider issues id tokens with claims for code "valid" and verifier of the challenge
+type fakeProvider struct {
+	*httptest.Server
+	challenge string
+	claims    jwt.MapClaims
+	userinfo  map[string]any
+}
+
+func newFakeProvider(t *testing.T) *fakeProvider {
+	p := &fakeProvider{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"userinfo_endpoint":      p.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, secret, _ := r.BasicAuth()
+		if clientID != "client" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+		verifier := oauth.AuthRequest{CodeVerifier: r.PostFormValue("code_verifier")}
+		if r.PostFormValue("code") != "valid" || verifier.CodeChallenge() != p.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		// signature isn't checked for tokens received from token endpoint
+		idToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, p.claims).SignedString([]byte("key"))
+		_ = json.NewEncoder(w).Encode(map[string]string{"access_token": "access", "id_token": idToken})
+	})
+	mux.HandleFunc("GET /userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(p.userinfo)
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func TestProvider(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeProvider(t)
+	provider := oidc.NewProvider("keycloak", configs.OIDCProviderConfig{
+		DiscoveryURL: fake.URL,
+		ClientID:     "client",
+		ClientSecret: "secret",
+		RedirectURL:  "https://auth.example.com/oauth/keycloak/callback",
+	}, http.DefaultClient)
+	req := oauth.AuthRequest{State: "state", CodeVerifier: "verifier", Nonce: "nonce"}
+	fake.challenge = req.CodeChallenge()
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            fake.URL,
+			"aud":            "client",
+			"sub":            "subject",
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"email":          "user@example.com",
+			"email_verified": true,
+			"nonce":          "nonce",
+		}
+	}
+
+	t.Run("authorize url", func(t *testing.T) {
+		redirect, err := provider.AuthorizeURL(ctx, req)
+		require.NoError(t, err)
+		u, err := url.Parse(redirect)
+		require.NoError(t, err)
+		require.Equal(t, fake.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+		require.Equal(t, "client", u.Query().Get("client_id"))
+		require.Equal(t, "openid email profile", u.Query().Get("scope"))
+		require.Equal(t, "state", u.Query().Get("state"))
+		require.Equal(t, "code", u.Query().Get("response_type"))
+		require.Equal(t, "nonce", u.Query().Get("nonce"))
+		require.Equal(t, fake.challenge, u.Query().Get("code_challenge"))
+		require.Equal(t, "S256", u.Query().Get("code_challenge_method"))
+	})
+
+	t.Run("identity from id token", func(t *testing.T) {
+		fake.claims = validClaims()
+		data, err := provider.GetData(ctx, "valid", req)
+		require.NoError(t, err)
+		require.Equal(t, oauth.OAuthData{UserID: "subject", Email: "user@example.com", Provider: "keycloak", EmailVerified: true}, data)
+	})
+
+	t.Run("email from userinfo", func(t *testing.T) {
+		fake.claims = validClaims()
+		delete(fake.claims, "email")
+		delete(fake.claims, "email_verified")
+		fake.userinfo = map[string]any{"sub": "subject", "email": "info@example.com", "email_verified": "true"}
+
+		data, err := provider.GetData(ctx, "valid", req)
+		require.NoError(t, err)
+		require.Equal(t, "info@example.com", data.Email)
+
+		fake.userinfo["sub"] = "other"
+		_, err = provider.GetData(ctx, "valid", req)
+		require.Error(t, err)
+	})
+
+	t.Run("unverified email is rejected", func(t *testing.T) {
+		fake.claims = validClaims()
+		fake.claims["email_verified"] = false
+		_, err := provider.GetData(ctx, "valid", req)
+		require.ErrorIs(t, err, oidc.ErrEmailNotVerified)
+
+		delete(fake.claims, "email_verified")
+		_, err = provider.GetData(ctx, "valid", req)
+		require.ErrorIs(t, err, oidc.ErrEmailNotVerified)
+	})
+
+	t.Run("id token for another client is rejected", func(t *testing.T) {
+		fake.claims = validClaims()
+		fake.claims["aud"] = "other-client"
+		_, err := provider.GetData(ctx, "valid", req)
+		require.ErrorIs(t, err, jwt.ErrTokenInvalidAudience)
+
+		fake.claims = validClaims()
+		fake.claims["iss"] = "https://evil.example.com"
+		_, err = provider.GetData(ctx, "valid", req)
+		require.ErrorIs(t, err, jwt.ErrTokenInvalidIssuer)
+	})
+
+	t.Run("id token of another request is rejected", func(t *testing.T) {
+		fake.claims = validClaims()
+		fake.claims["nonce"] = "other"
+		_, err := provider.GetData(ctx, "valid", req)
+		require.ErrorIs(t, err, oidc.ErrInvalidNonce)
+	})
+
+	t.Run("code without verifier is rejected", func(t *testing.T) {
+		fake.claims = validClaims()
+		_, err := provider.GetData(ctx, "valid", oauth.AuthRequest{CodeVerifier: "other", Nonce: "nonce"})
+		require.ErrorIs(t, err, oidc.ErrInvalidAuthCode)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		_, err := provider.GetData(ctx, "invalid", req)
+		require.ErrorIs(t, err, oidc.ErrInvalidAuthCode)
+	})
+}
+
+func TestRegistry(t *testing.T) {
+	registry := oauth.NewRegistry()
+	provider := oidc.NewProvider("gitlab", configs.OIDCProviderConfig{}, http.DefaultClient)
+
+	require.NoError(t, registry.Register("gitlab", provider))
+	require.Error(t, registry.Register("gitlab", provider))
+
+	p, err := registry.Get("gitlab")
+	require.NoError(t, err)
+	require.Same(t, provider, p)
+
+	_, err = registry.Get("google")
+	require.ErrorIs(t, err, oauth.ErrUnknownProvider)
+	require.Equal(t, []oauth.OAuthProviderT{"gitlab"}, registry.Names())
+}
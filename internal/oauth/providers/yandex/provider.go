@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -39,16 +40,21 @@ func NewYandexOAuthProvider(cfg *configs.YandexProviderConfig, client *http.Clie
 	}
 }
 
-func (p *YandexOAuthProvider) GetData(authorizationCode string) (oauth.OAuthData, error) {
+func (p *YandexOAuthProvider) AuthorizeURL(_ context.Context, req oauth.AuthRequest) (string, error) {
+	return p.cfg.GetAuthorizeURL(req.State, req.CodeChallenge()), nil
+}
+
+func (p *YandexOAuthProvider) GetData(ctx context.Context, authorizationCode string, req oauth.AuthRequest) (oauth.OAuthData, error) {
 	var data oauth.OAuthData
-	token, err := p.accessToken(authorizationCode)
+	token, err := p.accessToken(ctx, authorizationCode, req.CodeVerifier)
 	if err != nil {
 		return oauth.OAuthData{}, err
 	}
-	udata, err := p.getUserData(token)
+	udata, err := p.getUserData(ctx, token)
 	if err != nil {
 		return data, err
 	}
+	// Yandex doesn't report whether the email is verified
 	return oauth.OAuthData{
 		UserID:   udata.ID,
 		Email:    udata.Email,
@@ -56,8 +62,8 @@ func (p *YandexOAuthProvider) GetData(authorizationCode string) (oauth.OAuthData
 	}, nil
 }
 
-func (p *YandexOAuthProvider) getUserData(accessToken string) (*userData, error) {
-	req, _ := http.NewRequest(http.MethodGet, p.cfg.UserInfoURL, nil)
+func (p *YandexOAuthProvider) getUserData(ctx context.Context, accessToken string) (*userData, error) {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, p.cfg.UserInfoURL, nil)
 
 	req.Header.Add("Authorization", fmt.Sprintf("OAuth %s", accessToken))
 
@@ -73,15 +79,21 @@ func (p *YandexOAuthProvider) getUserData(accessToken string) (*userData, error)
 	return &data, nil
 }
 
-func (p *YandexOAuthProvider) accessToken(authorizationCode string) (string, error) {
-	r, err := p.client.Post(
+func (p *YandexOAuthProvider) accessToken(ctx context.Context, authorizationCode, codeVerifier string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
 		p.cfg.GetTokenURL,
-		"application/x-www-form-urlencoded",
-		p.cfg.GetTokenRequestPayload(authorizationCode),
+		p.cfg.GetTokenRequestPayload(authorizationCode, codeVerifier),
 	)
 	if err != nil {
 		return "", err
 	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
 	if r.StatusCode != 200 {
 		var response errorTokenResponse
 		if err := json.NewDecoder(r.Body).Decode(&response); err != nil {
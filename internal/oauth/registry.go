@@ -0,0 +1,51 @@
+package oauth
+
+import (
+	"fmt"
+	"sort"
+)
+
+var ErrUnknownProvider = fmt.Errorf("unknown oauth provider")
+
+// Registry keeps configured providers by their names
+type Registry struct {
+	providers map[OAuthProviderT]OAuthProvider
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[OAuthProviderT]OAuthProvider),
+	}
+}
+
+// Register adds provider, names must be unique
+func (r *Registry) Register(name OAuthProviderT, provider OAuthProvider) error {
+	if name == "" {
+		return fmt.Errorf("empty oauth provider name")
+	}
+	if _, ok := r.providers[name]; ok {
+		return fmt.Errorf("oauth provider %s is already registered", name)
+	}
+	r.providers[name] = provider
+	return nil
+}
+
+func (r *Registry) Get(name OAuthProviderT) (OAuthProvider, error) {
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, name)
+	}
+	return provider, nil
+}
+
+// Names returns sorted names of registered providers
+func (r *Registry) Names() []OAuthProviderT {
+	names := make([]OAuthProviderT, 0, len(r.providers))
+	for name := range r.providers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i] < names[j]
+	})
+	return names
+}
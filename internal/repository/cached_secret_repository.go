@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/cache"
+	"go.uber.org/zap"
+)
+
+var _ SecretRepository = (*CachedSecretRepository)(nil)
+
+// signingKeyCachePrefix is a cache key prefix of signing key metadata
+const signingKeyCachePrefix = "signing-key:"
+
+const defaultSigningKeyRefresh = time.Minute
+
+// signingKeyMeta is the current version and algorithm of a signing key
+type signingKeyMeta struct {
+	KID string `json:"kid"`
+	Alg string `json:"alg"`
+}
+
+// CachedSecretRepository serves KID and signing algorithm from cache, so tokens are signed without
+// extra round trips to the secret repository. Metadata of used keys is refreshed in background
+// and invalidated on rotation and on signing with a retired version
+type CachedSecretRepository struct {
+	SecretRepository
+
+	cache   *cache.Cache
+	log     *zap.SugaredLogger
+	refresh time.Duration
+	ttl     time.Duration
+	// used are names of signing keys refreshed in background
+	used sync.Map
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// NewCachedSecretRepository wraps repo and refreshes cached metadata every refresh interval, a minute by default.
+// Cached metadata outlives a couple of failed refreshes before the secret repository is asked again
+func NewCachedSecretRepository(
+	log *zap.SugaredLogger,
+	repo SecretRepository,
+	c *cache.Cache,
+	refresh time.Duration,
+) *CachedSecretRepository {
+	if refresh <= 0 {
+		refresh = defaultSigningKeyRefresh
+	}
+	r := &CachedSecretRepository{
+		SecretRepository: repo,
+		cache:            c,
+		log:              log,
+		refresh:          refresh,
+		ttl:              3 * refresh,
+		stop:             make(chan struct{}),
+		done:             make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+func (r *CachedSecretRepository) GetKID(ctx context.Context, keyName string) (string, error) {
+	meta, err := r.signingKey(ctx, keyName)
+	if err != nil {
+		return "", err
+	}
+	return meta.KID, nil
+}
+
+func (r *CachedSecretRepository) SigningAlgorithm(ctx context.Context, keyName string) (string, error) {
+	meta, err := r.signingKey(ctx, keyName)
+	if err != nil {
+		return "", err
+	}
+	return meta.Alg, nil
+}
+
+func (r *CachedSecretRepository) SignJWT(ctx context.Context, data, keyName, version string) (string, error) {
+	signed, err := r.SecretRepository.SignJWT(ctx, data, keyName, version)
+	if errors.Is(err, ErrKeyVersionRetired) {
+		r.invalidate(ctx, keyName)
+	}
+	return signed, err
+}
+
+func (r *CachedSecretRepository) RotateKey(ctx context.Context, keyName string) (string, error) {
+	version, err := r.SecretRepository.RotateKey(ctx, keyName)
+	r.invalidate(ctx, keyName)
+	return version, err
+}
+
+func (r *CachedSecretRepository) RetireKeyVersions(ctx context.Context, keyName string, minVersion int) error {
+	err := r.SecretRepository.RetireKeyVersions(ctx, keyName, minVersion)
+	r.invalidate(ctx, keyName)
+	return err
+}
+
+// Close stops background refresh
+func (r *CachedSecretRepository) Close() error {
+	r.once.Do(func() {
+		close(r.stop)
+	})
+	<-r.done
+	return nil
+}
+
+func (r *CachedSecretRepository) signingKey(ctx context.Context, keyName string) (signingKeyMeta, error) {
+	r.used.Store(keyName, struct{}{})
+	return cache.GetOrSet(r.cache, ctx, signingKeyCachePrefix+keyName, r.ttl, func() (signingKeyMeta, error) {
+		return r.fetch(ctx, keyName)
+	})
+}
+
+func (r *CachedSecretRepository) fetch(ctx context.Context, keyName string) (signingKeyMeta, error) {
+	alg, err := r.SecretRepository.SigningAlgorithm(ctx, keyName)
+	if err != nil {
+		return signingKeyMeta{}, err
+	}
+	kid, err := r.SecretRepository.GetKID(ctx, keyName)
+	if err != nil {
+		return signingKeyMeta{}, err
+	}
+	return signingKeyMeta{KID: kid, Alg: alg}, nil
+}
+
+func (r *CachedSecretRepository) invalidate(ctx context.Context, keyName string) {
+	if err := r.cache.Client.Delete(ctx, signingKeyCachePrefix+keyName); err != nil {
+		r.log.Errorw("failed to invalidate signing key cache", "key", keyName, "error", err)
+	}
+}
+
+func (r *CachedSecretRepository) run() {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.refresh)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+		r.used.Range(func(k, _ any) bool {
+			ctx, cancel := context.WithTimeout(context.Background(), r.refresh)
+			defer cancel()
+			if err := r.update(ctx, k.(string)); err != nil {
+				r.log.Warnw("failed to refresh signing key", "key", k, "error", err)
+			}
+			return true
+		})
+	}
+}
+
+// update replaces cached metadata, the old one is kept if the secret repository is unavailable
+func (r *CachedSecretRepository) update(ctx context.Context, keyName string) error {
+	meta, err := r.fetch(ctx, keyName)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("failed to marshal signing key: %w", err)
+	}
+	return r.cache.Client.Set(ctx, signingKeyCachePrefix+keyName, data, r.ttl)
+}
@@ -0,0 +1,98 @@
+package repository_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/maisiq/go-auth-service/internal/cache"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/internal/repository/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCachedSecretRepository(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewNop().Sugar()
+
+	t.Run("kid and algorithm are cached", func(t *testing.T) {
+		inner := mocks.NewSecretRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		repo := repository.NewCachedSecretRepository(logger, inner, c, time.Hour)
+		t.Cleanup(func() { _ = repo.Close() })
+
+		inner.GetKIDMock.Expect(minimock.AnyContext, "jwt-key").Return("1", nil)
+		inner.SigningAlgorithmMock.Expect(minimock.AnyContext, "jwt-key").Return("ES256", nil)
+
+		for i := 0; i < 3; i++ {
+			kid, err := repo.GetKID(ctx, "jwt-key")
+			require.NoError(t, err)
+			require.Equal(t, "1", kid)
+			alg, err := repo.SigningAlgorithm(ctx, "jwt-key")
+			require.NoError(t, err)
+			require.Equal(t, "ES256", alg)
+		}
+		require.Equal(t, uint64(1), inner.GetKIDAfterCounter())
+		require.Equal(t, uint64(1), inner.SigningAlgorithmAfterCounter())
+	})
+
+	t.Run("kid is refreshed in background", func(t *testing.T) {
+		inner := mocks.NewSecretRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		repo := repository.NewCachedSecretRepository(logger, inner, c, 20*time.Millisecond)
+		t.Cleanup(func() { _ = repo.Close() })
+
+		var latest atomic.Value
+		latest.Store("1")
+		inner.SigningAlgorithmMock.Return("ES256", nil)
+		inner.GetKIDMock.Set(func(context.Context, string) (string, error) {
+			return latest.Load().(string), nil
+		})
+		kid, err := repo.GetKID(ctx, "jwt-key")
+		require.NoError(t, err)
+		require.Equal(t, "1", kid)
+
+		// key is rotated by another process
+		latest.Store("2")
+		require.Eventually(t, func() bool {
+			kid, err := repo.GetKID(ctx, "jwt-key")
+			return err == nil && kid == "2"
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("retired version is invalidated", func(t *testing.T) {
+		local, err := repository.NewLocalSecretRepository(t.TempDir(), "")
+		require.NoError(t, err)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		repo := repository.NewCachedSecretRepository(logger, local, c, time.Hour)
+		t.Cleanup(func() { _ = repo.Close() })
+
+		kid, err := repo.GetKID(ctx, "jwt-key")
+		require.NoError(t, err)
+		require.Equal(t, "1", kid)
+
+		// keys CLI rotates and retires the key bypassing the cache
+		_, err = local.RotateKey(ctx, "jwt-key")
+		require.NoError(t, err)
+		require.NoError(t, local.RetireKeyVersions(ctx, "jwt-key", 2))
+
+		kid, err = repo.GetKID(ctx, "jwt-key")
+		require.NoError(t, err)
+		require.Equal(t, "1", kid)
+
+		unsigned, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"sub": "user"}).SigningString()
+		require.NoError(t, err)
+		_, err = repo.SignJWT(ctx, unsigned, "jwt-key", kid)
+		require.ErrorIs(t, err, repository.ErrKeyVersionRetired)
+
+		kid, err = repo.GetKID(ctx, "jwt-key")
+		require.NoError(t, err)
+		require.Equal(t, "2", kid)
+		_, err = repo.SignJWT(ctx, unsigned, "jwt-key", kid)
+		require.NoError(t, err)
+	})
+}
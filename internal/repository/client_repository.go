@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jmoiron/sqlx"
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+const clientColumns = "id, name, secret_hash, redirect_uris, scopes, grant_types, audiences, public, first_party, created_at"
+
+// ClientRepository keeps lists of the client space separated, their items may not contain spaces
+type ClientRepository struct {
+	client *sqlx.DB
+}
+
+func NewClientRepository(c *sqlx.DB) *ClientRepository {
+	return &ClientRepository{
+		client: c,
+	}
+}
+
+func (r *ClientRepository) Add(ctx context.Context, client domain.OAuthClient) error {
+	stmt := `INSERT INTO oauth_clients(id, name, secret_hash, redirect_uris, scopes, grant_types, audiences, public, first_party, created_at)
+			 VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	_, err := r.client.ExecContext(ctx, stmt,
+		client.ID,
+		client.Name,
+		sql.NullString{String: client.SecretHash, Valid: client.SecretHash != ""},
+		strings.Join(client.RedirectURIs, " "),
+		strings.Join(client.Scopes, " "),
+		strings.Join(client.GrantTypes, " "),
+		strings.Join(client.Audiences, " "),
+		client.Public,
+		client.FirstParty,
+		client.CreatedAt.Unix(),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *ClientRepository) Get(ctx context.Context, id string) (domain.OAuthClient, error) {
+	query := "SELECT " + clientColumns + " FROM oauth_clients WHERE id=$1"
+	return scanClient(r.client.QueryRowContext(ctx, query, id))
+}
+
+func (r *ClientRepository) List(ctx context.Context) ([]domain.OAuthClient, error) {
+	var clients = make([]domain.OAuthClient, 0)
+
+	query := "SELECT " + clientColumns + " FROM oauth_clients ORDER BY created_at"
+	rows, err := r.client.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		client, err := scanClient(rows)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return clients, nil
+}
+
+func (r *ClientRepository) Delete(ctx context.Context, id string) error {
+	res, err := r.client.ExecContext(ctx, "DELETE FROM oauth_clients WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func scanClient(row rowScanner) (domain.OAuthClient, error) {
+	var (
+		client       domain.OAuthClient
+		secretHash   sql.NullString
+		redirectURIs string
+		scopes       string
+		grantTypes   string
+		audiences    string
+		createdAt    int64
+	)
+
+	err := row.Scan(
+		&client.ID, &client.Name, &secretHash, &redirectURIs, &scopes, &grantTypes, &audiences,
+		&client.Public, &client.FirstParty, &createdAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.OAuthClient{}, ErrNotFound
+		}
+		return domain.OAuthClient{}, err
+	}
+	client.SecretHash = secretHash.String
+	client.RedirectURIs = strings.Fields(redirectURIs)
+	client.Scopes = strings.Fields(scopes)
+	client.GrantTypes = strings.Fields(grantTypes)
+	client.Audiences = strings.Fields(audiences)
+	client.CreatedAt = time.Unix(createdAt, 0)
+	return client, nil
+}
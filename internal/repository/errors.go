@@ -4,3 +4,6 @@ import "fmt"
 
 var ErrAlreadyExists = fmt.Errorf("already exists")
 var ErrNotFound = fmt.Errorf("not found")
+var ErrUnsupportedKeyType = fmt.Errorf("unsupported key type")
+var ErrKeyVersionRetired = fmt.Errorf("key version is retired")
+var ErrLastLoginMethod = fmt.Errorf("last login method")
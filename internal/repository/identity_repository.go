@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jmoiron/sqlx"
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+const identityColumns = "provider, subject, user_id, email, linked_at"
+
+type IdentityRepository struct {
+	client *sqlx.DB
+}
+
+func NewIdentityRepository(c *sqlx.DB) *IdentityRepository {
+	return &IdentityRepository{
+		client: c,
+	}
+}
+
+func (r *IdentityRepository) Add(ctx context.Context, identity domain.UserIdentity) error {
+	stmt := `INSERT INTO user_identities(provider, subject, user_id, email, linked_at)
+			 VALUES($1, $2, $3, $4, $5)`
+	_, err := r.client.ExecContext(ctx, stmt,
+		identity.Provider, identity.Subject, identity.UserID, identity.Email, identity.LinkedAt.Unix(),
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *IdentityRepository) Get(ctx context.Context, provider, subject string) (domain.UserIdentity, error) {
+	query := "SELECT " + identityColumns + " FROM user_identities WHERE provider=$1 AND subject=$2"
+	return scanIdentity(r.client.QueryRowContext(ctx, query, provider, subject))
+}
+
+func (r *IdentityRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.UserIdentity, error) {
+	var identities = make([]domain.UserIdentity, 0)
+
+	query := "SELECT " + identityColumns + " FROM user_identities WHERE user_id=$1 ORDER BY linked_at"
+	rows, err := r.client.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		identity, err := scanIdentity(rows)
+		if err != nil {
+			return nil, err
+		}
+		identities = append(identities, identity)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return identities, nil
+}
+
+// Delete checks login methods left and deletes the identity in one transaction.
+// The user row is locked, so concurrent deletes can't remove the last login method together
+func (r *IdentityRepository) Delete(ctx context.Context, userID uuid.UUID, provider, subject string) error {
+	tx, err := r.client.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var hashedPassword string
+	query := "SELECT COALESCE(hashed_password, '') FROM users WHERE id = $1 FOR UPDATE"
+	if err := tx.QueryRowContext(ctx, query, userID).Scan(&hashedPassword); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+
+	stmt := "DELETE FROM user_identities WHERE user_id = $1 AND provider = $2 AND subject = $3"
+	res, err := tx.ExecContext(ctx, stmt, userID, provider, subject)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+
+	if hashedPassword == "" {
+		var hasLogin bool
+		query := `SELECT EXISTS(SELECT 1 FROM user_identities WHERE user_id = $1)
+				  OR EXISTS(SELECT 1 FROM webauthn_credentials WHERE user_id = $1)`
+		if err := tx.QueryRowContext(ctx, query, userID).Scan(&hasLogin); err != nil {
+			return err
+		}
+		if !hasLogin {
+			return ErrLastLoginMethod
+		}
+	}
+	return tx.Commit()
+}
+
+func scanIdentity(row rowScanner) (domain.UserIdentity, error) {
+	var (
+		identity domain.UserIdentity
+		linkedAt int64
+	)
+
+	err := row.Scan(&identity.Provider, &identity.Subject, &identity.UserID, &identity.Email, &linkedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.UserIdentity{}, ErrNotFound
+		}
+		return domain.UserIdentity{}, err
+	}
+	identity.LinkedAt = time.Unix(linkedAt, 0)
+	return identity, nil
+}
@@ -0,0 +1,533 @@
+package repository
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+const (
+	pemSigningKey    = "PRIVATE KEY"
+	pemECSigningKey  = "EC PRIVATE KEY"
+	pemRSASigningKey = "RSA PRIVATE KEY"
+	pemEncryptionKey = "AES KEY"
+
+	localCiphertextPrefix = "local:v"
+)
+
+var _ SecretRepository = (*LocalSecretRepository)(nil)
+
+// LocalSecretRepository keeps keys in process, loaded from keyring directory.
+// Every key is a directory of <version>.pem files, the latest version is used for signing and encryption.
+// Versions are immutable, so they are parsed once, but the directory is listed on every call
+// to pick up versions added by other processes
+type LocalSecretRepository struct {
+	dir            string
+	signingKeyType string
+
+	mu   sync.Mutex
+	keys map[string]any
+}
+
+// NewLocalSecretRepository creates repository over keyring directory.
+// signingKeyType is a vault transit type of generated signing keys, ecdsa-p256 by default.
+// Rotated keys keep the type of their latest version
+func NewLocalSecretRepository(dir, signingKeyType string) (*LocalSecretRepository, error) {
+	if signingKeyType == "" {
+		signingKeyType = "ecdsa-p256"
+	}
+	if _, ok := vaultSigningAlgorithms[signingKeyType]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, signingKeyType)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create keyring directory: %w", err)
+	}
+	return &LocalSecretRepository{
+		dir:            dir,
+		signingKeyType: signingKeyType,
+		keys:           make(map[string]any),
+	}, nil
+}
+
+func (r *LocalSecretRepository) GetKID(_ context.Context, keyName string) (string, error) {
+	versions, err := r.versions(keyName, r.newSigningKey)
+	if err != nil {
+		return "", err
+	}
+	latest := versions[len(versions)-1]
+	if _, err := r.signingKey(keyName, latest); err != nil {
+		return "", err
+	}
+	return strconv.Itoa(latest), nil
+}
+
+func (r *LocalSecretRepository) GetPublicKeys(_ context.Context, keyName string) (map[string]string, error) {
+	versions, err := r.versions(keyName, r.newSigningKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]string, len(versions))
+	for _, v := range versions {
+		key, err := r.signingKey(keyName, v)
+		if err != nil {
+			return nil, err
+		}
+		der, err := x509.MarshalPKIXPublicKey(key.Public())
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal public key: %w", err)
+		}
+		keys[strconv.Itoa(v)] = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	}
+	return keys, nil
+}
+
+func (r *LocalSecretRepository) SigningAlgorithm(_ context.Context, keyName string) (string, error) {
+	key, err := r.latestSigningKey(keyName)
+	if err != nil {
+		return "", err
+	}
+	return vaultSigningAlgorithms[localKeyType(key)], nil
+}
+
+// SignJWT signs data with the key version, signature is in JWS format of the key algorithm
+func (r *LocalSecretRepository) SignJWT(_ context.Context, data, keyName, version string) (string, error) {
+	var key crypto.Signer
+	var err error
+	if version == "" {
+		key, err = r.latestSigningKey(keyName)
+	} else {
+		key, err = r.versionSigningKey(keyName, version)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var sig []byte
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		sig, err = signECDSA(k, []byte(data))
+	case *rsa.PrivateKey:
+		digest := sha256.Sum256([]byte(data))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(k, []byte(data))
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to sign jwt token: %w", err)
+	}
+	return fmt.Sprintf("%s.%s", data, base64.RawURLEncoding.EncodeToString(sig)), nil
+}
+
+// signECDSA returns signature in JWS format: r and s padded to the curve size
+func signECDSA(key *ecdsa.PrivateKey, data []byte) ([]byte, error) {
+	size := (key.Curve.Params().BitSize + 7) / 8
+	h := ecdsaHashes[size].New()
+	h.Write(data)
+	sigR, sigS, err := ecdsa.Sign(rand.Reader, key, h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return append(sigR.FillBytes(make([]byte, size)), sigS.FillBytes(make([]byte, size))...), nil
+}
+
+// versionSigningKey returns the key version, versions deleted by retirement are ErrKeyVersionRetired
+func (r *LocalSecretRepository) versionSigningKey(keyName, version string) (crypto.Signer, error) {
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key version: %q", version)
+	}
+	versions, err := r.versions(keyName, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) > 0 && v < versions[0] {
+		return nil, fmt.Errorf("%w: %s version %d", ErrKeyVersionRetired, keyName, v)
+	}
+	return r.signingKey(keyName, v)
+}
+
+func (r *LocalSecretRepository) latestSigningKey(keyName string) (crypto.Signer, error) {
+	versions, err := r.versions(keyName, r.newSigningKey)
+	if err != nil {
+		return nil, err
+	}
+	return r.signingKey(keyName, versions[len(versions)-1])
+}
+
+// Encrypt seals plaintext with AES-GCM, ciphertext format follows Vault: local:v<version>:<base64(nonce|data)>
+func (r *LocalSecretRepository) Encrypt(_ context.Context, plaintext string, keyName string) (string, error) {
+	versions, err := r.versions(keyName, newEncryptionKey)
+	if err != nil {
+		return "", err
+	}
+	version := versions[len(versions)-1]
+	aead, err := r.encryptionKey(keyName, version)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return fmt.Sprintf("%s%d:%s", localCiphertextPrefix, version, base64.StdEncoding.EncodeToString(sealed)), nil
+}
+
+func (r *LocalSecretRepository) Decrypt(_ context.Context, ciphertext string, keyName string) (string, error) {
+	rest, ok := strings.CutPrefix(ciphertext, localCiphertextPrefix)
+	if !ok {
+		return "", fmt.Errorf("unknown ciphertext format")
+	}
+	ver, b64, ok := strings.Cut(rest, ":")
+	if !ok {
+		return "", fmt.Errorf("unknown ciphertext format")
+	}
+	version, err := strconv.Atoi(ver)
+	if err != nil {
+		return "", fmt.Errorf("invalid ciphertext key version: %w", err)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode ciphertext: %w", err)
+	}
+
+	aead, err := r.encryptionKey(keyName, version)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("ciphertext is too short")
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+func (r *LocalSecretRepository) KeyVersions(_ context.Context, keyName string) ([]domain.KeyVersion, error) {
+	versions, err := r.versions(keyName, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, ErrNotFound
+	}
+
+	res := make([]domain.KeyVersion, 0, len(versions))
+	for _, v := range versions {
+		info, err := os.Stat(r.path(keyName, v))
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat key: %w", err)
+		}
+		res = append(res, domain.KeyVersion{Version: v, CreatedAt: info.ModTime()})
+	}
+	return res, nil
+}
+
+// RetireKeyVersions removes versions below minVersion from the keyring, the latest version is always kept
+func (r *LocalSecretRepository) RetireKeyVersions(_ context.Context, keyName string, minVersion int) error {
+	versions, err := r.versions(keyName, nil)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		return ErrNotFound
+	}
+	if minVersion > versions[len(versions)-1] {
+		return fmt.Errorf("can't retire the latest version of key %s", keyName)
+	}
+
+	for _, v := range versions {
+		if v >= minVersion {
+			break
+		}
+		path := r.path(keyName, v)
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove key: %w", err)
+		}
+		r.mu.Lock()
+		delete(r.keys, path)
+		r.mu.Unlock()
+	}
+	return nil
+}
+
+// RotateKey adds a new version of the key of the same type and returns it
+func (r *LocalSecretRepository) RotateKey(_ context.Context, keyName string) (string, error) {
+	versions, err := r.versions(keyName, nil)
+	if err != nil {
+		return "", err
+	}
+	if len(versions) == 0 {
+		return "", ErrNotFound
+	}
+
+	latest, err := r.load(keyName, versions[len(versions)-1])
+	if err != nil {
+		return "", err
+	}
+	generate := newEncryptionKey
+	if key, ok := latest.(crypto.Signer); ok {
+		keyType := localKeyType(key)
+		generate = func() (*pem.Block, error) {
+			return newSigningKey(keyType)
+		}
+	}
+
+	version := versions[len(versions)-1] + 1
+	if err := r.generate(keyName, version, generate); err != nil {
+		return "", err
+	}
+	return strconv.Itoa(version), nil
+}
+
+// versions returns sorted versions of the key. Missing key is created with generate unless it's nil
+func (r *LocalSecretRepository) versions(keyName string, generate func() (*pem.Block, error)) ([]int, error) {
+	if keyName == "" || filepath.Base(keyName) != keyName || strings.HasPrefix(keyName, ".") {
+		return nil, fmt.Errorf("invalid key name: %q", keyName)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(r.dir, keyName))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read keyring: %w", err)
+	}
+	var versions []int
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".pem")
+		if !ok || e.IsDir() {
+			continue
+		}
+		v, err := strconv.Atoi(name)
+		if err != nil || v < 1 {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	sort.Ints(versions)
+
+	if len(versions) == 0 && generate != nil {
+		if err := r.generate(keyName, 1, generate); err != nil && !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		versions = []int{1}
+	}
+	return versions, nil
+}
+
+func (r *LocalSecretRepository) generate(keyName string, version int, generate func() (*pem.Block, error)) error {
+	block, err := generate()
+	if err != nil {
+		return fmt.Errorf("failed to generate key: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Join(r.dir, keyName), 0o700); err != nil {
+		return fmt.Errorf("failed to create key directory: %w", err)
+	}
+
+	// key is written to a temporary file and then linked, so other processes never see a partial key
+	// and concurrent writers can't overwrite each other's version
+	f, err := os.CreateTemp(filepath.Join(r.dir, keyName), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create key file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	err = pem.Encode(f, block)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write key: %w", err)
+	}
+	return os.Link(f.Name(), r.path(keyName, version))
+}
+
+func (r *LocalSecretRepository) path(keyName string, version int) string {
+	return filepath.Join(r.dir, keyName, strconv.Itoa(version)+".pem")
+}
+
+// load returns parsed key version, either crypto.Signer or cipher.AEAD
+func (r *LocalSecretRepository) load(keyName string, version int) (any, error) {
+	path := r.path(keyName, version)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if key, ok := r.keys[path]; ok {
+		return key, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to read key: %w", err)
+	}
+	key, err := parseLocalKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key %s: %w", path, err)
+	}
+	r.keys[path] = key
+	return key, nil
+}
+
+func (r *LocalSecretRepository) signingKey(keyName string, version int) (crypto.Signer, error) {
+	key, err := r.load(keyName, version)
+	if err != nil {
+		return nil, err
+	}
+	pk, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("key %s is not a signing key", keyName)
+	}
+	return pk, nil
+}
+
+func (r *LocalSecretRepository) encryptionKey(keyName string, version int) (cipher.AEAD, error) {
+	key, err := r.load(keyName, version)
+	if err != nil {
+		return nil, err
+	}
+	aead, ok := key.(cipher.AEAD)
+	if !ok {
+		return nil, fmt.Errorf("key %s is not an encryption key", keyName)
+	}
+	return aead, nil
+}
+
+func parseLocalKey(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to parse PEM block")
+	}
+
+	switch block.Type {
+	case pemSigningKey:
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", ErrUnsupportedKeyType, key)
+		}
+		return checkSigningKey(signer)
+	case pemECSigningKey:
+		key, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return checkSigningKey(key)
+	case pemRSASigningKey:
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return checkSigningKey(key)
+	case pemEncryptionKey:
+		c, err := aes.NewCipher(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cipher.NewGCM(c)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+// localKeyType returns vault transit type of the key, empty for unsupported keys
+func localKeyType(key crypto.Signer) string {
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return "ecdsa-p256"
+		case elliptic.P384():
+			return "ecdsa-p384"
+		case elliptic.P521():
+			return "ecdsa-p521"
+		}
+	case *rsa.PrivateKey:
+		return fmt.Sprintf("rsa-%d", k.N.BitLen())
+	case ed25519.PrivateKey:
+		return "ed25519"
+	}
+	return ""
+}
+
+func checkSigningKey(key crypto.Signer) (crypto.Signer, error) {
+	keyType := localKeyType(key)
+	if _, ok := vaultSigningAlgorithms[keyType]; !ok {
+		return nil, fmt.Errorf("%w: %T %s", ErrUnsupportedKeyType, key, keyType)
+	}
+	return key, nil
+}
+
+// ecdsaHashes are hash functions of ECDSA JWS algorithms by curve size
+var ecdsaHashes = map[int]crypto.Hash{
+	32: crypto.SHA256,
+	48: crypto.SHA384,
+	66: crypto.SHA512,
+}
+
+func (r *LocalSecretRepository) newSigningKey() (*pem.Block, error) {
+	return newSigningKey(r.signingKeyType)
+}
+
+func newSigningKey(keyType string) (*pem.Block, error) {
+	var key crypto.Signer
+	var err error
+	switch keyType {
+	case "ecdsa-p256":
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "ecdsa-p384":
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case "ecdsa-p521":
+		key, err = ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	case "rsa-2048", "rsa-3072", "rsa-4096":
+		bits, _ := strconv.Atoi(strings.TrimPrefix(keyType, "rsa-"))
+		key, err = rsa.GenerateKey(rand.Reader, bits)
+	case "ed25519":
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyType, keyType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &pem.Block{Type: pemSigningKey, Bytes: der}, nil
+}
+
+func newEncryptionKey() (*pem.Block, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return &pem.Block{Type: pemEncryptionKey, Bytes: key}, nil
+}
@@ -0,0 +1,96 @@
+package repository_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalSecretRepositorySign(t *testing.T) {
+	ctx := context.Background()
+	repo, err := repository.NewLocalSecretRepository(t.TempDir(), "")
+	require.NoError(t, err)
+
+	kid, err := repo.GetKID(ctx, "jwt-key")
+	require.NoError(t, err)
+	require.Equal(t, "1", kid)
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"sub": "user"}).SigningString()
+	require.NoError(t, err)
+	token, err := repo.SignJWT(ctx, unsigned, "jwt-key", kid)
+	require.NoError(t, err)
+
+	rotated, err := repo.RotateKey(ctx, "jwt-key")
+	require.NoError(t, err)
+	require.Equal(t, "2", rotated)
+
+	kid, err = repo.GetKID(ctx, "jwt-key")
+	require.NoError(t, err)
+	require.Equal(t, "2", kid)
+
+	keys, err := repo.GetPublicKeys(ctx, "jwt-key")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	// token signed before rotation is still verified with its version
+	block, _ := pem.Decode([]byte(keys["1"]))
+	require.NotNil(t, block)
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+
+	_, err = jwt.Parse(token, func(*jwt.Token) (any, error) {
+		return pub.(*ecdsa.PublicKey), nil
+	}, jwt.WithValidMethods([]string{"ES256"}))
+	require.NoError(t, err)
+
+	require.Error(t, repo.RetireKeyVersions(ctx, "jwt-key", 3))
+	require.NoError(t, repo.RetireKeyVersions(ctx, "jwt-key", 2))
+
+	versions, err := repo.KeyVersions(ctx, "jwt-key")
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	require.Equal(t, 2, versions[0].Version)
+}
+
+func TestLocalSecretRepositoryEncrypt(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	repo, err := repository.NewLocalSecretRepository(dir, "")
+	require.NoError(t, err)
+
+	ciphertext, err := repo.Encrypt(ctx, "secret", "mfa-key")
+	require.NoError(t, err)
+	require.Contains(t, ciphertext, "local:v1:")
+
+	_, err = repo.RotateKey(ctx, "mfa-key")
+	require.NoError(t, err)
+
+	// keys are loaded from the keyring by another instance
+	repo, err = repository.NewLocalSecretRepository(dir, "")
+	require.NoError(t, err)
+
+	plaintext, err := repo.Decrypt(ctx, ciphertext, "mfa-key")
+	require.NoError(t, err)
+	require.Equal(t, "secret", plaintext)
+
+	ciphertext, err = repo.Encrypt(ctx, "secret", "mfa-key")
+	require.NoError(t, err)
+	require.Contains(t, ciphertext, "local:v2:")
+
+	_, err = repo.GetKID(ctx, "mfa-key")
+	require.Error(t, err)
+}
+
+func TestLocalSecretRepositoryInvalidKeyName(t *testing.T) {
+	repo, err := repository.NewLocalSecretRepository(t.TempDir(), "")
+	require.NoError(t, err)
+
+	_, err = repo.GetKID(context.Background(), "../jwt-key")
+	require.Error(t, err)
+}
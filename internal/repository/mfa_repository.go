@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+type MFARepository struct {
+	client *sqlx.DB
+}
+
+func NewMFARepository(c *sqlx.DB) *MFARepository {
+	return &MFARepository{
+		client: c,
+	}
+}
+
+func (r *MFARepository) Get(ctx context.Context, userID uuid.UUID) (domain.MFA, error) {
+	query := "SELECT user_id, secret, created_at, confirmed_at FROM user_mfa WHERE user_id=$1"
+	var (
+		mfa         domain.MFA
+		createdAt   int64
+		confirmedAt sql.NullInt64
+	)
+
+	err := r.client.QueryRowContext(ctx, query, userID).Scan(&mfa.UserID, &mfa.Secret, &createdAt, &confirmedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.MFA{}, ErrNotFound
+		}
+		return domain.MFA{}, err
+	}
+	mfa.CreatedAt = time.Unix(createdAt, 0)
+	if confirmedAt.Valid {
+		mfa.ConfirmedAt = time.Unix(confirmedAt.Int64, 0)
+	}
+	return mfa, nil
+}
+
+func (r *MFARepository) Save(ctx context.Context, mfa domain.MFA) error {
+	stmt := `INSERT INTO user_mfa(user_id, secret, created_at, confirmed_at)
+			 VALUES($1, $2, $3, $4)
+			 ON CONFLICT (user_id) DO UPDATE
+			 SET secret = EXCLUDED.secret, created_at = EXCLUDED.created_at, confirmed_at = EXCLUDED.confirmed_at`
+	_, err := r.client.ExecContext(ctx, stmt, mfa.UserID, mfa.Secret, mfa.CreatedAt.Unix(), nullableUnix(mfa.ConfirmedAt))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *MFARepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	tx, err := r.client.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id=$1", userID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_mfa WHERE user_id=$1", userID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *MFARepository) SetRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	tx, err := r.client.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM mfa_recovery_codes WHERE user_id=$1", userID); err != nil {
+		return err
+	}
+	for _, h := range codeHashes {
+		stmt := "INSERT INTO mfa_recovery_codes(user_id, code_hash) VALUES($1, $2)"
+		if _, err := tx.ExecContext(ctx, stmt, userID, h); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *MFARepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	stmt := "DELETE FROM mfa_recovery_codes WHERE user_id=$1 AND code_hash=$2"
+	res, err := r.client.ExecContext(ctx, stmt, userID, codeHash)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,1453 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-auth-service/internal/repository.IClientRepository -o i_client_repository_mock.go -n IClientRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+// IClientRepositoryMock implements mm_repository.IClientRepository
+type IClientRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAdd          func(ctx context.Context, client domain.OAuthClient) (err error)
+	funcAddOrigin    string
+	inspectFuncAdd   func(ctx context.Context, client domain.OAuthClient)
+	afterAddCounter  uint64
+	beforeAddCounter uint64
+	AddMock          mIClientRepositoryMockAdd
+
+	funcDelete          func(ctx context.Context, id string) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, id string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mIClientRepositoryMockDelete
+
+	funcGet          func(ctx context.Context, id string) (o1 domain.OAuthClient, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mIClientRepositoryMockGet
+
+	funcList          func(ctx context.Context) (oa1 []domain.OAuthClient, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mIClientRepositoryMockList
+}
+
+// NewIClientRepositoryMock returns a mock for mm_repository.IClientRepository
+func NewIClientRepositoryMock(t minimock.Tester) *IClientRepositoryMock {
+	m := &IClientRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMock = mIClientRepositoryMockAdd{mock: m}
+	m.AddMock.callArgs = []*IClientRepositoryMockAddParams{}
+
+	m.DeleteMock = mIClientRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*IClientRepositoryMockDeleteParams{}
+
+	m.GetMock = mIClientRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*IClientRepositoryMockGetParams{}
+
+	m.ListMock = mIClientRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*IClientRepositoryMockListParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIClientRepositoryMockAdd struct {
+	optional           bool
+	mock               *IClientRepositoryMock
+	defaultExpectation *IClientRepositoryMockAddExpectation
+	expectations       []*IClientRepositoryMockAddExpectation
+
+	callArgs []*IClientRepositoryMockAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IClientRepositoryMockAddExpectation specifies expectation struct of the IClientRepository.Add
+type IClientRepositoryMockAddExpectation struct {
+	mock               *IClientRepositoryMock
+	params             *IClientRepositoryMockAddParams
+	paramPtrs          *IClientRepositoryMockAddParamPtrs
+	expectationOrigins IClientRepositoryMockAddExpectationOrigins
+	results            *IClientRepositoryMockAddResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IClientRepositoryMockAddParams contains parameters of the IClientRepository.Add
+type IClientRepositoryMockAddParams struct {
+	ctx    context.Context
+	client domain.OAuthClient
+}
+
+// IClientRepositoryMockAddParamPtrs contains pointers to parameters of the IClientRepository.Add
+type IClientRepositoryMockAddParamPtrs struct {
+	ctx    *context.Context
+	client *domain.OAuthClient
+}
+
+// IClientRepositoryMockAddResults contains results of the IClientRepository.Add
+type IClientRepositoryMockAddResults struct {
+	err error
+}
+
+// IClientRepositoryMockAddOrigins contains origins of expectations of the IClientRepository.Add
+type IClientRepositoryMockAddExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originClient string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAdd *mIClientRepositoryMockAdd) Optional() *mIClientRepositoryMockAdd {
+	mmAdd.optional = true
+	return mmAdd
+}
+
+// Expect sets up expected params for IClientRepository.Add
+func (mmAdd *mIClientRepositoryMockAdd) Expect(ctx context.Context, client domain.OAuthClient) *mIClientRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IClientRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IClientRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs != nil {
+		mmAdd.mock.t.Fatalf("IClientRepositoryMock.Add mock is already set by ExpectParams functions")
+	}
+
+	mmAdd.defaultExpectation.params = &IClientRepositoryMockAddParams{ctx, client}
+	mmAdd.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdd.expectations {
+		if minimock.Equal(e.params, mmAdd.defaultExpectation.params) {
+			mmAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IClientRepository.Add
+func (mmAdd *mIClientRepositoryMockAdd) ExpectCtxParam1(ctx context.Context) *mIClientRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IClientRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IClientRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("IClientRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &IClientRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdd.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// ExpectClientParam2 sets up expected param client for IClientRepository.Add
+func (mmAdd *mIClientRepositoryMockAdd) ExpectClientParam2(client domain.OAuthClient) *mIClientRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IClientRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IClientRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("IClientRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &IClientRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.client = &client
+	mmAdd.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the IClientRepository.Add
+func (mmAdd *mIClientRepositoryMockAdd) Inspect(f func(ctx context.Context, client domain.OAuthClient)) *mIClientRepositoryMockAdd {
+	if mmAdd.mock.inspectFuncAdd != nil {
+		mmAdd.mock.t.Fatalf("Inspect function is already set for IClientRepositoryMock.Add")
+	}
+
+	mmAdd.mock.inspectFuncAdd = f
+
+	return mmAdd
+}
+
+// Return sets up results that will be returned by IClientRepository.Add
+func (mmAdd *mIClientRepositoryMockAdd) Return(err error) *IClientRepositoryMock {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IClientRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IClientRepositoryMockAddExpectation{mock: mmAdd.mock}
+	}
+	mmAdd.defaultExpectation.results = &IClientRepositoryMockAddResults{err}
+	mmAdd.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// Set uses given function f to mock the IClientRepository.Add method
+func (mmAdd *mIClientRepositoryMockAdd) Set(f func(ctx context.Context, client domain.OAuthClient) (err error)) *IClientRepositoryMock {
+	if mmAdd.defaultExpectation != nil {
+		mmAdd.mock.t.Fatalf("Default expectation is already set for the IClientRepository.Add method")
+	}
+
+	if len(mmAdd.expectations) > 0 {
+		mmAdd.mock.t.Fatalf("Some expectations are already set for the IClientRepository.Add method")
+	}
+
+	mmAdd.mock.funcAdd = f
+	mmAdd.mock.funcAddOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// When sets expectation for the IClientRepository.Add which will trigger the result defined by the following
+// Then helper
+func (mmAdd *mIClientRepositoryMockAdd) When(ctx context.Context, client domain.OAuthClient) *IClientRepositoryMockAddExpectation {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IClientRepositoryMock.Add mock is already set by Set")
+	}
+
+	expectation := &IClientRepositoryMockAddExpectation{
+		mock:               mmAdd.mock,
+		params:             &IClientRepositoryMockAddParams{ctx, client},
+		expectationOrigins: IClientRepositoryMockAddExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAdd.expectations = append(mmAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IClientRepository.Add return parameters for the expectation previously defined by the When method
+func (e *IClientRepositoryMockAddExpectation) Then(err error) *IClientRepositoryMock {
+	e.results = &IClientRepositoryMockAddResults{err}
+	return e.mock
+}
+
+// Times sets number of times IClientRepository.Add should be invoked
+func (mmAdd *mIClientRepositoryMockAdd) Times(n uint64) *mIClientRepositoryMockAdd {
+	if n == 0 {
+		mmAdd.mock.t.Fatalf("Times of IClientRepositoryMock.Add mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdd.expectedInvocations, n)
+	mmAdd.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdd
+}
+
+func (mmAdd *mIClientRepositoryMockAdd) invocationsDone() bool {
+	if len(mmAdd.expectations) == 0 && mmAdd.defaultExpectation == nil && mmAdd.mock.funcAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdd.mock.afterAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Add implements mm_repository.IClientRepository
+func (mmAdd *IClientRepositoryMock) Add(ctx context.Context, client domain.OAuthClient) (err error) {
+	mm_atomic.AddUint64(&mmAdd.beforeAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdd.afterAddCounter, 1)
+
+	mmAdd.t.Helper()
+
+	if mmAdd.inspectFuncAdd != nil {
+		mmAdd.inspectFuncAdd(ctx, client)
+	}
+
+	mm_params := IClientRepositoryMockAddParams{ctx, client}
+
+	// Record call args
+	mmAdd.AddMock.mutex.Lock()
+	mmAdd.AddMock.callArgs = append(mmAdd.AddMock.callArgs, &mm_params)
+	mmAdd.AddMock.mutex.Unlock()
+
+	for _, e := range mmAdd.AddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAdd.AddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdd.AddMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdd.AddMock.defaultExpectation.params
+		mm_want_ptrs := mmAdd.AddMock.defaultExpectation.paramPtrs
+
+		mm_got := IClientRepositoryMockAddParams{ctx, client}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdd.t.Errorf("IClientRepositoryMock.Add got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmAdd.t.Errorf("IClientRepositoryMock.Add got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdd.t.Errorf("IClientRepositoryMock.Add got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdd.AddMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdd.AddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdd.t.Fatal("No results are set for the IClientRepositoryMock.Add")
+		}
+		return (*mm_results).err
+	}
+	if mmAdd.funcAdd != nil {
+		return mmAdd.funcAdd(ctx, client)
+	}
+	mmAdd.t.Fatalf("Unexpected call to IClientRepositoryMock.Add. %v %v", ctx, client)
+	return
+}
+
+// AddAfterCounter returns a count of finished IClientRepositoryMock.Add invocations
+func (mmAdd *IClientRepositoryMock) AddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.afterAddCounter)
+}
+
+// AddBeforeCounter returns a count of IClientRepositoryMock.Add invocations
+func (mmAdd *IClientRepositoryMock) AddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.beforeAddCounter)
+}
+
+// Calls returns a list of arguments used in each call to IClientRepositoryMock.Add.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdd *mIClientRepositoryMockAdd) Calls() []*IClientRepositoryMockAddParams {
+	mmAdd.mutex.RLock()
+
+	argCopy := make([]*IClientRepositoryMockAddParams, len(mmAdd.callArgs))
+	copy(argCopy, mmAdd.callArgs)
+
+	mmAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDone returns true if the count of the Add invocations corresponds
+// the number of defined expectations
+func (m *IClientRepositoryMock) MinimockAddDone() bool {
+	if m.AddMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMock.invocationsDone()
+}
+
+// MinimockAddInspect logs each unmet expectation
+func (m *IClientRepositoryMock) MinimockAddInspect() {
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IClientRepositoryMock.Add at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddCounter := mm_atomic.LoadUint64(&m.afterAddCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMock.defaultExpectation != nil && afterAddCounter < 1 {
+		if m.AddMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IClientRepositoryMock.Add at\n%s", m.AddMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IClientRepositoryMock.Add at\n%s with params: %#v", m.AddMock.defaultExpectation.expectationOrigins.origin, *m.AddMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdd != nil && afterAddCounter < 1 {
+		m.t.Errorf("Expected call to IClientRepositoryMock.Add at\n%s", m.funcAddOrigin)
+	}
+
+	if !m.AddMock.invocationsDone() && afterAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to IClientRepositoryMock.Add at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMock.expectedInvocations), m.AddMock.expectedInvocationsOrigin, afterAddCounter)
+	}
+}
+
+type mIClientRepositoryMockDelete struct {
+	optional           bool
+	mock               *IClientRepositoryMock
+	defaultExpectation *IClientRepositoryMockDeleteExpectation
+	expectations       []*IClientRepositoryMockDeleteExpectation
+
+	callArgs []*IClientRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IClientRepositoryMockDeleteExpectation specifies expectation struct of the IClientRepository.Delete
+type IClientRepositoryMockDeleteExpectation struct {
+	mock               *IClientRepositoryMock
+	params             *IClientRepositoryMockDeleteParams
+	paramPtrs          *IClientRepositoryMockDeleteParamPtrs
+	expectationOrigins IClientRepositoryMockDeleteExpectationOrigins
+	results            *IClientRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IClientRepositoryMockDeleteParams contains parameters of the IClientRepository.Delete
+type IClientRepositoryMockDeleteParams struct {
+	ctx context.Context
+	id  string
+}
+
+// IClientRepositoryMockDeleteParamPtrs contains pointers to parameters of the IClientRepository.Delete
+type IClientRepositoryMockDeleteParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// IClientRepositoryMockDeleteResults contains results of the IClientRepository.Delete
+type IClientRepositoryMockDeleteResults struct {
+	err error
+}
+
+// IClientRepositoryMockDeleteOrigins contains origins of expectations of the IClientRepository.Delete
+type IClientRepositoryMockDeleteExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mIClientRepositoryMockDelete) Optional() *mIClientRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for IClientRepository.Delete
+func (mmDelete *mIClientRepositoryMockDelete) Expect(ctx context.Context, id string) *mIClientRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IClientRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IClientRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("IClientRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &IClientRepositoryMockDeleteParams{ctx, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IClientRepository.Delete
+func (mmDelete *mIClientRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mIClientRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IClientRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IClientRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IClientRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IClientRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for IClientRepository.Delete
+func (mmDelete *mIClientRepositoryMockDelete) ExpectIdParam2(id string) *mIClientRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IClientRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IClientRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IClientRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IClientRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the IClientRepository.Delete
+func (mmDelete *mIClientRepositoryMockDelete) Inspect(f func(ctx context.Context, id string)) *mIClientRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for IClientRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by IClientRepository.Delete
+func (mmDelete *mIClientRepositoryMockDelete) Return(err error) *IClientRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IClientRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IClientRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &IClientRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the IClientRepository.Delete method
+func (mmDelete *mIClientRepositoryMockDelete) Set(f func(ctx context.Context, id string) (err error)) *IClientRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the IClientRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the IClientRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the IClientRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mIClientRepositoryMockDelete) When(ctx context.Context, id string) *IClientRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IClientRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &IClientRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &IClientRepositoryMockDeleteParams{ctx, id},
+		expectationOrigins: IClientRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IClientRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *IClientRepositoryMockDeleteExpectation) Then(err error) *IClientRepositoryMock {
+	e.results = &IClientRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times IClientRepository.Delete should be invoked
+func (mmDelete *mIClientRepositoryMockDelete) Times(n uint64) *mIClientRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of IClientRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mIClientRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.IClientRepository
+func (mmDelete *IClientRepositoryMock) Delete(ctx context.Context, id string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id)
+	}
+
+	mm_params := IClientRepositoryMockDeleteParams{ctx, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := IClientRepositoryMockDeleteParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("IClientRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("IClientRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("IClientRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the IClientRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to IClientRepositoryMock.Delete. %v %v", ctx, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished IClientRepositoryMock.Delete invocations
+func (mmDelete *IClientRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of IClientRepositoryMock.Delete invocations
+func (mmDelete *IClientRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to IClientRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mIClientRepositoryMockDelete) Calls() []*IClientRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*IClientRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *IClientRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *IClientRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IClientRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IClientRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IClientRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to IClientRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to IClientRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mIClientRepositoryMockGet struct {
+	optional           bool
+	mock               *IClientRepositoryMock
+	defaultExpectation *IClientRepositoryMockGetExpectation
+	expectations       []*IClientRepositoryMockGetExpectation
+
+	callArgs []*IClientRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IClientRepositoryMockGetExpectation specifies expectation struct of the IClientRepository.Get
+type IClientRepositoryMockGetExpectation struct {
+	mock               *IClientRepositoryMock
+	params             *IClientRepositoryMockGetParams
+	paramPtrs          *IClientRepositoryMockGetParamPtrs
+	expectationOrigins IClientRepositoryMockGetExpectationOrigins
+	results            *IClientRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IClientRepositoryMockGetParams contains parameters of the IClientRepository.Get
+type IClientRepositoryMockGetParams struct {
+	ctx context.Context
+	id  string
+}
+
+// IClientRepositoryMockGetParamPtrs contains pointers to parameters of the IClientRepository.Get
+type IClientRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// IClientRepositoryMockGetResults contains results of the IClientRepository.Get
+type IClientRepositoryMockGetResults struct {
+	o1  domain.OAuthClient
+	err error
+}
+
+// IClientRepositoryMockGetOrigins contains origins of expectations of the IClientRepository.Get
+type IClientRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mIClientRepositoryMockGet) Optional() *mIClientRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for IClientRepository.Get
+func (mmGet *mIClientRepositoryMockGet) Expect(ctx context.Context, id string) *mIClientRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IClientRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IClientRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("IClientRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &IClientRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IClientRepository.Get
+func (mmGet *mIClientRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mIClientRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IClientRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IClientRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IClientRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IClientRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for IClientRepository.Get
+func (mmGet *mIClientRepositoryMockGet) ExpectIdParam2(id string) *mIClientRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IClientRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IClientRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IClientRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IClientRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the IClientRepository.Get
+func (mmGet *mIClientRepositoryMockGet) Inspect(f func(ctx context.Context, id string)) *mIClientRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for IClientRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by IClientRepository.Get
+func (mmGet *mIClientRepositoryMockGet) Return(o1 domain.OAuthClient, err error) *IClientRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IClientRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IClientRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &IClientRepositoryMockGetResults{o1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the IClientRepository.Get method
+func (mmGet *mIClientRepositoryMockGet) Set(f func(ctx context.Context, id string) (o1 domain.OAuthClient, err error)) *IClientRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the IClientRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the IClientRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the IClientRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mIClientRepositoryMockGet) When(ctx context.Context, id string) *IClientRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IClientRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &IClientRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &IClientRepositoryMockGetParams{ctx, id},
+		expectationOrigins: IClientRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IClientRepository.Get return parameters for the expectation previously defined by the When method
+func (e *IClientRepositoryMockGetExpectation) Then(o1 domain.OAuthClient, err error) *IClientRepositoryMock {
+	e.results = &IClientRepositoryMockGetResults{o1, err}
+	return e.mock
+}
+
+// Times sets number of times IClientRepository.Get should be invoked
+func (mmGet *mIClientRepositoryMockGet) Times(n uint64) *mIClientRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of IClientRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mIClientRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.IClientRepository
+func (mmGet *IClientRepositoryMock) Get(ctx context.Context, id string) (o1 domain.OAuthClient, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := IClientRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.o1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := IClientRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("IClientRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("IClientRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("IClientRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the IClientRepositoryMock.Get")
+		}
+		return (*mm_results).o1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to IClientRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished IClientRepositoryMock.Get invocations
+func (mmGet *IClientRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of IClientRepositoryMock.Get invocations
+func (mmGet *IClientRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to IClientRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mIClientRepositoryMockGet) Calls() []*IClientRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*IClientRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *IClientRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *IClientRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IClientRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IClientRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IClientRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to IClientRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to IClientRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mIClientRepositoryMockList struct {
+	optional           bool
+	mock               *IClientRepositoryMock
+	defaultExpectation *IClientRepositoryMockListExpectation
+	expectations       []*IClientRepositoryMockListExpectation
+
+	callArgs []*IClientRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IClientRepositoryMockListExpectation specifies expectation struct of the IClientRepository.List
+type IClientRepositoryMockListExpectation struct {
+	mock               *IClientRepositoryMock
+	params             *IClientRepositoryMockListParams
+	paramPtrs          *IClientRepositoryMockListParamPtrs
+	expectationOrigins IClientRepositoryMockListExpectationOrigins
+	results            *IClientRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IClientRepositoryMockListParams contains parameters of the IClientRepository.List
+type IClientRepositoryMockListParams struct {
+	ctx context.Context
+}
+
+// IClientRepositoryMockListParamPtrs contains pointers to parameters of the IClientRepository.List
+type IClientRepositoryMockListParamPtrs struct {
+	ctx *context.Context
+}
+
+// IClientRepositoryMockListResults contains results of the IClientRepository.List
+type IClientRepositoryMockListResults struct {
+	oa1 []domain.OAuthClient
+	err error
+}
+
+// IClientRepositoryMockListOrigins contains origins of expectations of the IClientRepository.List
+type IClientRepositoryMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mIClientRepositoryMockList) Optional() *mIClientRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for IClientRepository.List
+func (mmList *mIClientRepositoryMockList) Expect(ctx context.Context) *mIClientRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("IClientRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &IClientRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("IClientRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &IClientRepositoryMockListParams{ctx}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IClientRepository.List
+func (mmList *mIClientRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mIClientRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("IClientRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &IClientRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("IClientRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &IClientRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the IClientRepository.List
+func (mmList *mIClientRepositoryMockList) Inspect(f func(ctx context.Context)) *mIClientRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for IClientRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by IClientRepository.List
+func (mmList *mIClientRepositoryMockList) Return(oa1 []domain.OAuthClient, err error) *IClientRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("IClientRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &IClientRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &IClientRepositoryMockListResults{oa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the IClientRepository.List method
+func (mmList *mIClientRepositoryMockList) Set(f func(ctx context.Context) (oa1 []domain.OAuthClient, err error)) *IClientRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the IClientRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the IClientRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the IClientRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mIClientRepositoryMockList) When(ctx context.Context) *IClientRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("IClientRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &IClientRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &IClientRepositoryMockListParams{ctx},
+		expectationOrigins: IClientRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IClientRepository.List return parameters for the expectation previously defined by the When method
+func (e *IClientRepositoryMockListExpectation) Then(oa1 []domain.OAuthClient, err error) *IClientRepositoryMock {
+	e.results = &IClientRepositoryMockListResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times IClientRepository.List should be invoked
+func (mmList *mIClientRepositoryMockList) Times(n uint64) *mIClientRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of IClientRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mIClientRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.IClientRepository
+func (mmList *IClientRepositoryMock) List(ctx context.Context) (oa1 []domain.OAuthClient, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx)
+	}
+
+	mm_params := IClientRepositoryMockListParams{ctx}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := IClientRepositoryMockListParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("IClientRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("IClientRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the IClientRepositoryMock.List")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx)
+	}
+	mmList.t.Fatalf("Unexpected call to IClientRepositoryMock.List. %v", ctx)
+	return
+}
+
+// ListAfterCounter returns a count of finished IClientRepositoryMock.List invocations
+func (mmList *IClientRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of IClientRepositoryMock.List invocations
+func (mmList *IClientRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to IClientRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mIClientRepositoryMockList) Calls() []*IClientRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*IClientRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *IClientRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *IClientRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IClientRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IClientRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IClientRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to IClientRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to IClientRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IClientRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IClientRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IClientRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListDone()
+}
@@ -0,0 +1,1578 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-auth-service/internal/repository.IIdentityRepository -o i_identity_repository_mock.go -n IIdentityRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+// IIdentityRepositoryMock implements mm_repository.IIdentityRepository
+type IIdentityRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAdd          func(ctx context.Context, identity domain.UserIdentity) (err error)
+	funcAddOrigin    string
+	inspectFuncAdd   func(ctx context.Context, identity domain.UserIdentity)
+	afterAddCounter  uint64
+	beforeAddCounter uint64
+	AddMock          mIIdentityRepositoryMockAdd
+
+	funcDelete          func(ctx context.Context, userID uuid.UUID, provider string, subject string) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, userID uuid.UUID, provider string, subject string)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mIIdentityRepositoryMockDelete
+
+	funcGet          func(ctx context.Context, provider string, subject string) (u1 domain.UserIdentity, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, provider string, subject string)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mIIdentityRepositoryMockGet
+
+	funcListByUser          func(ctx context.Context, userID uuid.UUID) (ua1 []domain.UserIdentity, err error)
+	funcListByUserOrigin    string
+	inspectFuncListByUser   func(ctx context.Context, userID uuid.UUID)
+	afterListByUserCounter  uint64
+	beforeListByUserCounter uint64
+	ListByUserMock          mIIdentityRepositoryMockListByUser
+}
+
+// NewIIdentityRepositoryMock returns a mock for mm_repository.IIdentityRepository
+func NewIIdentityRepositoryMock(t minimock.Tester) *IIdentityRepositoryMock {
+	m := &IIdentityRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMock = mIIdentityRepositoryMockAdd{mock: m}
+	m.AddMock.callArgs = []*IIdentityRepositoryMockAddParams{}
+
+	m.DeleteMock = mIIdentityRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*IIdentityRepositoryMockDeleteParams{}
+
+	m.GetMock = mIIdentityRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*IIdentityRepositoryMockGetParams{}
+
+	m.ListByUserMock = mIIdentityRepositoryMockListByUser{mock: m}
+	m.ListByUserMock.callArgs = []*IIdentityRepositoryMockListByUserParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIIdentityRepositoryMockAdd struct {
+	optional           bool
+	mock               *IIdentityRepositoryMock
+	defaultExpectation *IIdentityRepositoryMockAddExpectation
+	expectations       []*IIdentityRepositoryMockAddExpectation
+
+	callArgs []*IIdentityRepositoryMockAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IIdentityRepositoryMockAddExpectation specifies expectation struct of the IIdentityRepository.Add
+type IIdentityRepositoryMockAddExpectation struct {
+	mock               *IIdentityRepositoryMock
+	params             *IIdentityRepositoryMockAddParams
+	paramPtrs          *IIdentityRepositoryMockAddParamPtrs
+	expectationOrigins IIdentityRepositoryMockAddExpectationOrigins
+	results            *IIdentityRepositoryMockAddResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IIdentityRepositoryMockAddParams contains parameters of the IIdentityRepository.Add
+type IIdentityRepositoryMockAddParams struct {
+	ctx      context.Context
+	identity domain.UserIdentity
+}
+
+// IIdentityRepositoryMockAddParamPtrs contains pointers to parameters of the IIdentityRepository.Add
+type IIdentityRepositoryMockAddParamPtrs struct {
+	ctx      *context.Context
+	identity *domain.UserIdentity
+}
+
+// IIdentityRepositoryMockAddResults contains results of the IIdentityRepository.Add
+type IIdentityRepositoryMockAddResults struct {
+	err error
+}
+
+// IIdentityRepositoryMockAddOrigins contains origins of expectations of the IIdentityRepository.Add
+type IIdentityRepositoryMockAddExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originIdentity string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAdd *mIIdentityRepositoryMockAdd) Optional() *mIIdentityRepositoryMockAdd {
+	mmAdd.optional = true
+	return mmAdd
+}
+
+// Expect sets up expected params for IIdentityRepository.Add
+func (mmAdd *mIIdentityRepositoryMockAdd) Expect(ctx context.Context, identity domain.UserIdentity) *mIIdentityRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IIdentityRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IIdentityRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs != nil {
+		mmAdd.mock.t.Fatalf("IIdentityRepositoryMock.Add mock is already set by ExpectParams functions")
+	}
+
+	mmAdd.defaultExpectation.params = &IIdentityRepositoryMockAddParams{ctx, identity}
+	mmAdd.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdd.expectations {
+		if minimock.Equal(e.params, mmAdd.defaultExpectation.params) {
+			mmAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IIdentityRepository.Add
+func (mmAdd *mIIdentityRepositoryMockAdd) ExpectCtxParam1(ctx context.Context) *mIIdentityRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IIdentityRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IIdentityRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("IIdentityRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &IIdentityRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdd.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// ExpectIdentityParam2 sets up expected param identity for IIdentityRepository.Add
+func (mmAdd *mIIdentityRepositoryMockAdd) ExpectIdentityParam2(identity domain.UserIdentity) *mIIdentityRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IIdentityRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IIdentityRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("IIdentityRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &IIdentityRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.identity = &identity
+	mmAdd.defaultExpectation.expectationOrigins.originIdentity = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the IIdentityRepository.Add
+func (mmAdd *mIIdentityRepositoryMockAdd) Inspect(f func(ctx context.Context, identity domain.UserIdentity)) *mIIdentityRepositoryMockAdd {
+	if mmAdd.mock.inspectFuncAdd != nil {
+		mmAdd.mock.t.Fatalf("Inspect function is already set for IIdentityRepositoryMock.Add")
+	}
+
+	mmAdd.mock.inspectFuncAdd = f
+
+	return mmAdd
+}
+
+// Return sets up results that will be returned by IIdentityRepository.Add
+func (mmAdd *mIIdentityRepositoryMockAdd) Return(err error) *IIdentityRepositoryMock {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IIdentityRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IIdentityRepositoryMockAddExpectation{mock: mmAdd.mock}
+	}
+	mmAdd.defaultExpectation.results = &IIdentityRepositoryMockAddResults{err}
+	mmAdd.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// Set uses given function f to mock the IIdentityRepository.Add method
+func (mmAdd *mIIdentityRepositoryMockAdd) Set(f func(ctx context.Context, identity domain.UserIdentity) (err error)) *IIdentityRepositoryMock {
+	if mmAdd.defaultExpectation != nil {
+		mmAdd.mock.t.Fatalf("Default expectation is already set for the IIdentityRepository.Add method")
+	}
+
+	if len(mmAdd.expectations) > 0 {
+		mmAdd.mock.t.Fatalf("Some expectations are already set for the IIdentityRepository.Add method")
+	}
+
+	mmAdd.mock.funcAdd = f
+	mmAdd.mock.funcAddOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// When sets expectation for the IIdentityRepository.Add which will trigger the result defined by the following
+// Then helper
+func (mmAdd *mIIdentityRepositoryMockAdd) When(ctx context.Context, identity domain.UserIdentity) *IIdentityRepositoryMockAddExpectation {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IIdentityRepositoryMock.Add mock is already set by Set")
+	}
+
+	expectation := &IIdentityRepositoryMockAddExpectation{
+		mock:               mmAdd.mock,
+		params:             &IIdentityRepositoryMockAddParams{ctx, identity},
+		expectationOrigins: IIdentityRepositoryMockAddExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAdd.expectations = append(mmAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IIdentityRepository.Add return parameters for the expectation previously defined by the When method
+func (e *IIdentityRepositoryMockAddExpectation) Then(err error) *IIdentityRepositoryMock {
+	e.results = &IIdentityRepositoryMockAddResults{err}
+	return e.mock
+}
+
+// Times sets number of times IIdentityRepository.Add should be invoked
+func (mmAdd *mIIdentityRepositoryMockAdd) Times(n uint64) *mIIdentityRepositoryMockAdd {
+	if n == 0 {
+		mmAdd.mock.t.Fatalf("Times of IIdentityRepositoryMock.Add mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdd.expectedInvocations, n)
+	mmAdd.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdd
+}
+
+func (mmAdd *mIIdentityRepositoryMockAdd) invocationsDone() bool {
+	if len(mmAdd.expectations) == 0 && mmAdd.defaultExpectation == nil && mmAdd.mock.funcAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdd.mock.afterAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Add implements mm_repository.IIdentityRepository
+func (mmAdd *IIdentityRepositoryMock) Add(ctx context.Context, identity domain.UserIdentity) (err error) {
+	mm_atomic.AddUint64(&mmAdd.beforeAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdd.afterAddCounter, 1)
+
+	mmAdd.t.Helper()
+
+	if mmAdd.inspectFuncAdd != nil {
+		mmAdd.inspectFuncAdd(ctx, identity)
+	}
+
+	mm_params := IIdentityRepositoryMockAddParams{ctx, identity}
+
+	// Record call args
+	mmAdd.AddMock.mutex.Lock()
+	mmAdd.AddMock.callArgs = append(mmAdd.AddMock.callArgs, &mm_params)
+	mmAdd.AddMock.mutex.Unlock()
+
+	for _, e := range mmAdd.AddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAdd.AddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdd.AddMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdd.AddMock.defaultExpectation.params
+		mm_want_ptrs := mmAdd.AddMock.defaultExpectation.paramPtrs
+
+		mm_got := IIdentityRepositoryMockAddParams{ctx, identity}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdd.t.Errorf("IIdentityRepositoryMock.Add got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.identity != nil && !minimock.Equal(*mm_want_ptrs.identity, mm_got.identity) {
+				mmAdd.t.Errorf("IIdentityRepositoryMock.Add got unexpected parameter identity, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originIdentity, *mm_want_ptrs.identity, mm_got.identity, minimock.Diff(*mm_want_ptrs.identity, mm_got.identity))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdd.t.Errorf("IIdentityRepositoryMock.Add got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdd.AddMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdd.AddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdd.t.Fatal("No results are set for the IIdentityRepositoryMock.Add")
+		}
+		return (*mm_results).err
+	}
+	if mmAdd.funcAdd != nil {
+		return mmAdd.funcAdd(ctx, identity)
+	}
+	mmAdd.t.Fatalf("Unexpected call to IIdentityRepositoryMock.Add. %v %v", ctx, identity)
+	return
+}
+
+// AddAfterCounter returns a count of finished IIdentityRepositoryMock.Add invocations
+func (mmAdd *IIdentityRepositoryMock) AddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.afterAddCounter)
+}
+
+// AddBeforeCounter returns a count of IIdentityRepositoryMock.Add invocations
+func (mmAdd *IIdentityRepositoryMock) AddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.beforeAddCounter)
+}
+
+// Calls returns a list of arguments used in each call to IIdentityRepositoryMock.Add.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdd *mIIdentityRepositoryMockAdd) Calls() []*IIdentityRepositoryMockAddParams {
+	mmAdd.mutex.RLock()
+
+	argCopy := make([]*IIdentityRepositoryMockAddParams, len(mmAdd.callArgs))
+	copy(argCopy, mmAdd.callArgs)
+
+	mmAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDone returns true if the count of the Add invocations corresponds
+// the number of defined expectations
+func (m *IIdentityRepositoryMock) MinimockAddDone() bool {
+	if m.AddMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMock.invocationsDone()
+}
+
+// MinimockAddInspect logs each unmet expectation
+func (m *IIdentityRepositoryMock) MinimockAddInspect() {
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.Add at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddCounter := mm_atomic.LoadUint64(&m.afterAddCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMock.defaultExpectation != nil && afterAddCounter < 1 {
+		if m.AddMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.Add at\n%s", m.AddMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.Add at\n%s with params: %#v", m.AddMock.defaultExpectation.expectationOrigins.origin, *m.AddMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdd != nil && afterAddCounter < 1 {
+		m.t.Errorf("Expected call to IIdentityRepositoryMock.Add at\n%s", m.funcAddOrigin)
+	}
+
+	if !m.AddMock.invocationsDone() && afterAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to IIdentityRepositoryMock.Add at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMock.expectedInvocations), m.AddMock.expectedInvocationsOrigin, afterAddCounter)
+	}
+}
+
+type mIIdentityRepositoryMockDelete struct {
+	optional           bool
+	mock               *IIdentityRepositoryMock
+	defaultExpectation *IIdentityRepositoryMockDeleteExpectation
+	expectations       []*IIdentityRepositoryMockDeleteExpectation
+
+	callArgs []*IIdentityRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IIdentityRepositoryMockDeleteExpectation specifies expectation struct of the IIdentityRepository.Delete
+type IIdentityRepositoryMockDeleteExpectation struct {
+	mock               *IIdentityRepositoryMock
+	params             *IIdentityRepositoryMockDeleteParams
+	paramPtrs          *IIdentityRepositoryMockDeleteParamPtrs
+	expectationOrigins IIdentityRepositoryMockDeleteExpectationOrigins
+	results            *IIdentityRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IIdentityRepositoryMockDeleteParams contains parameters of the IIdentityRepository.Delete
+type IIdentityRepositoryMockDeleteParams struct {
+	ctx      context.Context
+	userID   uuid.UUID
+	provider string
+	subject  string
+}
+
+// IIdentityRepositoryMockDeleteParamPtrs contains pointers to parameters of the IIdentityRepository.Delete
+type IIdentityRepositoryMockDeleteParamPtrs struct {
+	ctx      *context.Context
+	userID   *uuid.UUID
+	provider *string
+	subject  *string
+}
+
+// IIdentityRepositoryMockDeleteResults contains results of the IIdentityRepository.Delete
+type IIdentityRepositoryMockDeleteResults struct {
+	err error
+}
+
+// IIdentityRepositoryMockDeleteOrigins contains origins of expectations of the IIdentityRepository.Delete
+type IIdentityRepositoryMockDeleteExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originProvider string
+	originSubject  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mIIdentityRepositoryMockDelete) Optional() *mIIdentityRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for IIdentityRepository.Delete
+func (mmDelete *mIIdentityRepositoryMockDelete) Expect(ctx context.Context, userID uuid.UUID, provider string, subject string) *mIIdentityRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IIdentityRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &IIdentityRepositoryMockDeleteParams{ctx, userID, provider, subject}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IIdentityRepository.Delete
+func (mmDelete *mIIdentityRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mIIdentityRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IIdentityRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IIdentityRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IIdentityRepository.Delete
+func (mmDelete *mIIdentityRepositoryMockDelete) ExpectUserIDParam2(userID uuid.UUID) *mIIdentityRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IIdentityRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IIdentityRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.userID = &userID
+	mmDelete.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectProviderParam3 sets up expected param provider for IIdentityRepository.Delete
+func (mmDelete *mIIdentityRepositoryMockDelete) ExpectProviderParam3(provider string) *mIIdentityRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IIdentityRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IIdentityRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.provider = &provider
+	mmDelete.defaultExpectation.expectationOrigins.originProvider = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectSubjectParam4 sets up expected param subject for IIdentityRepository.Delete
+func (mmDelete *mIIdentityRepositoryMockDelete) ExpectSubjectParam4(subject string) *mIIdentityRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IIdentityRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IIdentityRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.subject = &subject
+	mmDelete.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the IIdentityRepository.Delete
+func (mmDelete *mIIdentityRepositoryMockDelete) Inspect(f func(ctx context.Context, userID uuid.UUID, provider string, subject string)) *mIIdentityRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for IIdentityRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by IIdentityRepository.Delete
+func (mmDelete *mIIdentityRepositoryMockDelete) Return(err error) *IIdentityRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IIdentityRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &IIdentityRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the IIdentityRepository.Delete method
+func (mmDelete *mIIdentityRepositoryMockDelete) Set(f func(ctx context.Context, userID uuid.UUID, provider string, subject string) (err error)) *IIdentityRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the IIdentityRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the IIdentityRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the IIdentityRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mIIdentityRepositoryMockDelete) When(ctx context.Context, userID uuid.UUID, provider string, subject string) *IIdentityRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IIdentityRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &IIdentityRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &IIdentityRepositoryMockDeleteParams{ctx, userID, provider, subject},
+		expectationOrigins: IIdentityRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IIdentityRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *IIdentityRepositoryMockDeleteExpectation) Then(err error) *IIdentityRepositoryMock {
+	e.results = &IIdentityRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times IIdentityRepository.Delete should be invoked
+func (mmDelete *mIIdentityRepositoryMockDelete) Times(n uint64) *mIIdentityRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of IIdentityRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mIIdentityRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.IIdentityRepository
+func (mmDelete *IIdentityRepositoryMock) Delete(ctx context.Context, userID uuid.UUID, provider string, subject string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, userID, provider, subject)
+	}
+
+	mm_params := IIdentityRepositoryMockDeleteParams{ctx, userID, provider, subject}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := IIdentityRepositoryMockDeleteParams{ctx, userID, provider, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("IIdentityRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDelete.t.Errorf("IIdentityRepositoryMock.Delete got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.provider != nil && !minimock.Equal(*mm_want_ptrs.provider, mm_got.provider) {
+				mmDelete.t.Errorf("IIdentityRepositoryMock.Delete got unexpected parameter provider, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originProvider, *mm_want_ptrs.provider, mm_got.provider, minimock.Diff(*mm_want_ptrs.provider, mm_got.provider))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmDelete.t.Errorf("IIdentityRepositoryMock.Delete got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("IIdentityRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the IIdentityRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, userID, provider, subject)
+	}
+	mmDelete.t.Fatalf("Unexpected call to IIdentityRepositoryMock.Delete. %v %v %v %v", ctx, userID, provider, subject)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished IIdentityRepositoryMock.Delete invocations
+func (mmDelete *IIdentityRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of IIdentityRepositoryMock.Delete invocations
+func (mmDelete *IIdentityRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to IIdentityRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mIIdentityRepositoryMockDelete) Calls() []*IIdentityRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*IIdentityRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *IIdentityRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *IIdentityRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to IIdentityRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to IIdentityRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mIIdentityRepositoryMockGet struct {
+	optional           bool
+	mock               *IIdentityRepositoryMock
+	defaultExpectation *IIdentityRepositoryMockGetExpectation
+	expectations       []*IIdentityRepositoryMockGetExpectation
+
+	callArgs []*IIdentityRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IIdentityRepositoryMockGetExpectation specifies expectation struct of the IIdentityRepository.Get
+type IIdentityRepositoryMockGetExpectation struct {
+	mock               *IIdentityRepositoryMock
+	params             *IIdentityRepositoryMockGetParams
+	paramPtrs          *IIdentityRepositoryMockGetParamPtrs
+	expectationOrigins IIdentityRepositoryMockGetExpectationOrigins
+	results            *IIdentityRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IIdentityRepositoryMockGetParams contains parameters of the IIdentityRepository.Get
+type IIdentityRepositoryMockGetParams struct {
+	ctx      context.Context
+	provider string
+	subject  string
+}
+
+// IIdentityRepositoryMockGetParamPtrs contains pointers to parameters of the IIdentityRepository.Get
+type IIdentityRepositoryMockGetParamPtrs struct {
+	ctx      *context.Context
+	provider *string
+	subject  *string
+}
+
+// IIdentityRepositoryMockGetResults contains results of the IIdentityRepository.Get
+type IIdentityRepositoryMockGetResults struct {
+	u1  domain.UserIdentity
+	err error
+}
+
+// IIdentityRepositoryMockGetOrigins contains origins of expectations of the IIdentityRepository.Get
+type IIdentityRepositoryMockGetExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originProvider string
+	originSubject  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mIIdentityRepositoryMockGet) Optional() *mIIdentityRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for IIdentityRepository.Get
+func (mmGet *mIIdentityRepositoryMockGet) Expect(ctx context.Context, provider string, subject string) *mIIdentityRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IIdentityRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &IIdentityRepositoryMockGetParams{ctx, provider, subject}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IIdentityRepository.Get
+func (mmGet *mIIdentityRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mIIdentityRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IIdentityRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IIdentityRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectProviderParam2 sets up expected param provider for IIdentityRepository.Get
+func (mmGet *mIIdentityRepositoryMockGet) ExpectProviderParam2(provider string) *mIIdentityRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IIdentityRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IIdentityRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.provider = &provider
+	mmGet.defaultExpectation.expectationOrigins.originProvider = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectSubjectParam3 sets up expected param subject for IIdentityRepository.Get
+func (mmGet *mIIdentityRepositoryMockGet) ExpectSubjectParam3(subject string) *mIIdentityRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IIdentityRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IIdentityRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.subject = &subject
+	mmGet.defaultExpectation.expectationOrigins.originSubject = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the IIdentityRepository.Get
+func (mmGet *mIIdentityRepositoryMockGet) Inspect(f func(ctx context.Context, provider string, subject string)) *mIIdentityRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for IIdentityRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by IIdentityRepository.Get
+func (mmGet *mIIdentityRepositoryMockGet) Return(u1 domain.UserIdentity, err error) *IIdentityRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IIdentityRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &IIdentityRepositoryMockGetResults{u1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the IIdentityRepository.Get method
+func (mmGet *mIIdentityRepositoryMockGet) Set(f func(ctx context.Context, provider string, subject string) (u1 domain.UserIdentity, err error)) *IIdentityRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the IIdentityRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the IIdentityRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the IIdentityRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mIIdentityRepositoryMockGet) When(ctx context.Context, provider string, subject string) *IIdentityRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IIdentityRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &IIdentityRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &IIdentityRepositoryMockGetParams{ctx, provider, subject},
+		expectationOrigins: IIdentityRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IIdentityRepository.Get return parameters for the expectation previously defined by the When method
+func (e *IIdentityRepositoryMockGetExpectation) Then(u1 domain.UserIdentity, err error) *IIdentityRepositoryMock {
+	e.results = &IIdentityRepositoryMockGetResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times IIdentityRepository.Get should be invoked
+func (mmGet *mIIdentityRepositoryMockGet) Times(n uint64) *mIIdentityRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of IIdentityRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mIIdentityRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.IIdentityRepository
+func (mmGet *IIdentityRepositoryMock) Get(ctx context.Context, provider string, subject string) (u1 domain.UserIdentity, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, provider, subject)
+	}
+
+	mm_params := IIdentityRepositoryMockGetParams{ctx, provider, subject}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := IIdentityRepositoryMockGetParams{ctx, provider, subject}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("IIdentityRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.provider != nil && !minimock.Equal(*mm_want_ptrs.provider, mm_got.provider) {
+				mmGet.t.Errorf("IIdentityRepositoryMock.Get got unexpected parameter provider, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originProvider, *mm_want_ptrs.provider, mm_got.provider, minimock.Diff(*mm_want_ptrs.provider, mm_got.provider))
+			}
+
+			if mm_want_ptrs.subject != nil && !minimock.Equal(*mm_want_ptrs.subject, mm_got.subject) {
+				mmGet.t.Errorf("IIdentityRepositoryMock.Get got unexpected parameter subject, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originSubject, *mm_want_ptrs.subject, mm_got.subject, minimock.Diff(*mm_want_ptrs.subject, mm_got.subject))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("IIdentityRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the IIdentityRepositoryMock.Get")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, provider, subject)
+	}
+	mmGet.t.Fatalf("Unexpected call to IIdentityRepositoryMock.Get. %v %v %v", ctx, provider, subject)
+	return
+}
+
+// GetAfterCounter returns a count of finished IIdentityRepositoryMock.Get invocations
+func (mmGet *IIdentityRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of IIdentityRepositoryMock.Get invocations
+func (mmGet *IIdentityRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to IIdentityRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mIIdentityRepositoryMockGet) Calls() []*IIdentityRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*IIdentityRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *IIdentityRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *IIdentityRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to IIdentityRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to IIdentityRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mIIdentityRepositoryMockListByUser struct {
+	optional           bool
+	mock               *IIdentityRepositoryMock
+	defaultExpectation *IIdentityRepositoryMockListByUserExpectation
+	expectations       []*IIdentityRepositoryMockListByUserExpectation
+
+	callArgs []*IIdentityRepositoryMockListByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IIdentityRepositoryMockListByUserExpectation specifies expectation struct of the IIdentityRepository.ListByUser
+type IIdentityRepositoryMockListByUserExpectation struct {
+	mock               *IIdentityRepositoryMock
+	params             *IIdentityRepositoryMockListByUserParams
+	paramPtrs          *IIdentityRepositoryMockListByUserParamPtrs
+	expectationOrigins IIdentityRepositoryMockListByUserExpectationOrigins
+	results            *IIdentityRepositoryMockListByUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IIdentityRepositoryMockListByUserParams contains parameters of the IIdentityRepository.ListByUser
+type IIdentityRepositoryMockListByUserParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// IIdentityRepositoryMockListByUserParamPtrs contains pointers to parameters of the IIdentityRepository.ListByUser
+type IIdentityRepositoryMockListByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// IIdentityRepositoryMockListByUserResults contains results of the IIdentityRepository.ListByUser
+type IIdentityRepositoryMockListByUserResults struct {
+	ua1 []domain.UserIdentity
+	err error
+}
+
+// IIdentityRepositoryMockListByUserOrigins contains origins of expectations of the IIdentityRepository.ListByUser
+type IIdentityRepositoryMockListByUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListByUser *mIIdentityRepositoryMockListByUser) Optional() *mIIdentityRepositoryMockListByUser {
+	mmListByUser.optional = true
+	return mmListByUser
+}
+
+// Expect sets up expected params for IIdentityRepository.ListByUser
+func (mmListByUser *mIIdentityRepositoryMockListByUser) Expect(ctx context.Context, userID uuid.UUID) *mIIdentityRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IIdentityRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &IIdentityRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs != nil {
+		mmListByUser.mock.t.Fatalf("IIdentityRepositoryMock.ListByUser mock is already set by ExpectParams functions")
+	}
+
+	mmListByUser.defaultExpectation.params = &IIdentityRepositoryMockListByUserParams{ctx, userID}
+	mmListByUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListByUser.expectations {
+		if minimock.Equal(e.params, mmListByUser.defaultExpectation.params) {
+			mmListByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmListByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IIdentityRepository.ListByUser
+func (mmListByUser *mIIdentityRepositoryMockListByUser) ExpectCtxParam1(ctx context.Context) *mIIdentityRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IIdentityRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &IIdentityRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("IIdentityRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &IIdentityRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListByUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IIdentityRepository.ListByUser
+func (mmListByUser *mIIdentityRepositoryMockListByUser) ExpectUserIDParam2(userID uuid.UUID) *mIIdentityRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IIdentityRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &IIdentityRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("IIdentityRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &IIdentityRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.userID = &userID
+	mmListByUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the IIdentityRepository.ListByUser
+func (mmListByUser *mIIdentityRepositoryMockListByUser) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mIIdentityRepositoryMockListByUser {
+	if mmListByUser.mock.inspectFuncListByUser != nil {
+		mmListByUser.mock.t.Fatalf("Inspect function is already set for IIdentityRepositoryMock.ListByUser")
+	}
+
+	mmListByUser.mock.inspectFuncListByUser = f
+
+	return mmListByUser
+}
+
+// Return sets up results that will be returned by IIdentityRepository.ListByUser
+func (mmListByUser *mIIdentityRepositoryMockListByUser) Return(ua1 []domain.UserIdentity, err error) *IIdentityRepositoryMock {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IIdentityRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &IIdentityRepositoryMockListByUserExpectation{mock: mmListByUser.mock}
+	}
+	mmListByUser.defaultExpectation.results = &IIdentityRepositoryMockListByUserResults{ua1, err}
+	mmListByUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListByUser.mock
+}
+
+// Set uses given function f to mock the IIdentityRepository.ListByUser method
+func (mmListByUser *mIIdentityRepositoryMockListByUser) Set(f func(ctx context.Context, userID uuid.UUID) (ua1 []domain.UserIdentity, err error)) *IIdentityRepositoryMock {
+	if mmListByUser.defaultExpectation != nil {
+		mmListByUser.mock.t.Fatalf("Default expectation is already set for the IIdentityRepository.ListByUser method")
+	}
+
+	if len(mmListByUser.expectations) > 0 {
+		mmListByUser.mock.t.Fatalf("Some expectations are already set for the IIdentityRepository.ListByUser method")
+	}
+
+	mmListByUser.mock.funcListByUser = f
+	mmListByUser.mock.funcListByUserOrigin = minimock.CallerInfo(1)
+	return mmListByUser.mock
+}
+
+// When sets expectation for the IIdentityRepository.ListByUser which will trigger the result defined by the following
+// Then helper
+func (mmListByUser *mIIdentityRepositoryMockListByUser) When(ctx context.Context, userID uuid.UUID) *IIdentityRepositoryMockListByUserExpectation {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IIdentityRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	expectation := &IIdentityRepositoryMockListByUserExpectation{
+		mock:               mmListByUser.mock,
+		params:             &IIdentityRepositoryMockListByUserParams{ctx, userID},
+		expectationOrigins: IIdentityRepositoryMockListByUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListByUser.expectations = append(mmListByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IIdentityRepository.ListByUser return parameters for the expectation previously defined by the When method
+func (e *IIdentityRepositoryMockListByUserExpectation) Then(ua1 []domain.UserIdentity, err error) *IIdentityRepositoryMock {
+	e.results = &IIdentityRepositoryMockListByUserResults{ua1, err}
+	return e.mock
+}
+
+// Times sets number of times IIdentityRepository.ListByUser should be invoked
+func (mmListByUser *mIIdentityRepositoryMockListByUser) Times(n uint64) *mIIdentityRepositoryMockListByUser {
+	if n == 0 {
+		mmListByUser.mock.t.Fatalf("Times of IIdentityRepositoryMock.ListByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListByUser.expectedInvocations, n)
+	mmListByUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListByUser
+}
+
+func (mmListByUser *mIIdentityRepositoryMockListByUser) invocationsDone() bool {
+	if len(mmListByUser.expectations) == 0 && mmListByUser.defaultExpectation == nil && mmListByUser.mock.funcListByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListByUser.mock.afterListByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListByUser implements mm_repository.IIdentityRepository
+func (mmListByUser *IIdentityRepositoryMock) ListByUser(ctx context.Context, userID uuid.UUID) (ua1 []domain.UserIdentity, err error) {
+	mm_atomic.AddUint64(&mmListByUser.beforeListByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmListByUser.afterListByUserCounter, 1)
+
+	mmListByUser.t.Helper()
+
+	if mmListByUser.inspectFuncListByUser != nil {
+		mmListByUser.inspectFuncListByUser(ctx, userID)
+	}
+
+	mm_params := IIdentityRepositoryMockListByUserParams{ctx, userID}
+
+	// Record call args
+	mmListByUser.ListByUserMock.mutex.Lock()
+	mmListByUser.ListByUserMock.callArgs = append(mmListByUser.ListByUserMock.callArgs, &mm_params)
+	mmListByUser.ListByUserMock.mutex.Unlock()
+
+	for _, e := range mmListByUser.ListByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmListByUser.ListByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListByUser.ListByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmListByUser.ListByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmListByUser.ListByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := IIdentityRepositoryMockListByUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListByUser.t.Errorf("IIdentityRepositoryMock.ListByUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListByUser.t.Errorf("IIdentityRepositoryMock.ListByUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListByUser.t.Errorf("IIdentityRepositoryMock.ListByUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListByUser.ListByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListByUser.t.Fatal("No results are set for the IIdentityRepositoryMock.ListByUser")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmListByUser.funcListByUser != nil {
+		return mmListByUser.funcListByUser(ctx, userID)
+	}
+	mmListByUser.t.Fatalf("Unexpected call to IIdentityRepositoryMock.ListByUser. %v %v", ctx, userID)
+	return
+}
+
+// ListByUserAfterCounter returns a count of finished IIdentityRepositoryMock.ListByUser invocations
+func (mmListByUser *IIdentityRepositoryMock) ListByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.afterListByUserCounter)
+}
+
+// ListByUserBeforeCounter returns a count of IIdentityRepositoryMock.ListByUser invocations
+func (mmListByUser *IIdentityRepositoryMock) ListByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.beforeListByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to IIdentityRepositoryMock.ListByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListByUser *mIIdentityRepositoryMockListByUser) Calls() []*IIdentityRepositoryMockListByUserParams {
+	mmListByUser.mutex.RLock()
+
+	argCopy := make([]*IIdentityRepositoryMockListByUserParams, len(mmListByUser.callArgs))
+	copy(argCopy, mmListByUser.callArgs)
+
+	mmListByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListByUserDone returns true if the count of the ListByUser invocations corresponds
+// the number of defined expectations
+func (m *IIdentityRepositoryMock) MinimockListByUserDone() bool {
+	if m.ListByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListByUserMock.invocationsDone()
+}
+
+// MinimockListByUserInspect logs each unmet expectation
+func (m *IIdentityRepositoryMock) MinimockListByUserInspect() {
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.ListByUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListByUserCounter := mm_atomic.LoadUint64(&m.afterListByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListByUserMock.defaultExpectation != nil && afterListByUserCounter < 1 {
+		if m.ListByUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.ListByUser at\n%s", m.ListByUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IIdentityRepositoryMock.ListByUser at\n%s with params: %#v", m.ListByUserMock.defaultExpectation.expectationOrigins.origin, *m.ListByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListByUser != nil && afterListByUserCounter < 1 {
+		m.t.Errorf("Expected call to IIdentityRepositoryMock.ListByUser at\n%s", m.funcListByUserOrigin)
+	}
+
+	if !m.ListByUserMock.invocationsDone() && afterListByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to IIdentityRepositoryMock.ListByUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListByUserMock.expectedInvocations), m.ListByUserMock.expectedInvocationsOrigin, afterListByUserCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IIdentityRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListByUserInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IIdentityRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IIdentityRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListByUserDone()
+}
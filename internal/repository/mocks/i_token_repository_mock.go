@@ -12,6 +12,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/maisiq/go-auth-service/internal/domain"
 )
 
 // ITokenRepositoryMock implements mm_repository.ITokenRepository
@@ -26,6 +27,20 @@ type ITokenRepositoryMock struct {
 	beforeAddCounter uint64
 	AddMock          mITokenRepositoryMockAdd
 
+	funcAddNX          func(ctx context.Context, key string, value string, expiration time.Duration) (err error)
+	funcAddNXOrigin    string
+	inspectFuncAddNX   func(ctx context.Context, key string, value string, expiration time.Duration)
+	afterAddNXCounter  uint64
+	beforeAddNXCounter uint64
+	AddNXMock          mITokenRepositoryMockAddNX
+
+	funcAddRefreshToken          func(ctx context.Context, token domain.RefreshToken, expiration time.Duration) (err error)
+	funcAddRefreshTokenOrigin    string
+	inspectFuncAddRefreshToken   func(ctx context.Context, token domain.RefreshToken, expiration time.Duration)
+	afterAddRefreshTokenCounter  uint64
+	beforeAddRefreshTokenCounter uint64
+	AddRefreshTokenMock          mITokenRepositoryMockAddRefreshToken
+
 	funcDelete          func(ctx context.Context, keys ...string) (err error)
 	funcDeleteOrigin    string
 	inspectFuncDelete   func(ctx context.Context, keys ...string)
@@ -33,6 +48,27 @@ type ITokenRepositoryMock struct {
 	beforeDeleteCounter uint64
 	DeleteMock          mITokenRepositoryMockDelete
 
+	funcDeleteRefreshTokens          func(ctx context.Context, ids ...string) (err error)
+	funcDeleteRefreshTokensOrigin    string
+	inspectFuncDeleteRefreshTokens   func(ctx context.Context, ids ...string)
+	afterDeleteRefreshTokensCounter  uint64
+	beforeDeleteRefreshTokensCounter uint64
+	DeleteRefreshTokensMock          mITokenRepositoryMockDeleteRefreshTokens
+
+	funcDeleteSessions          func(ctx context.Context, email string, ids ...string) (err error)
+	funcDeleteSessionsOrigin    string
+	inspectFuncDeleteSessions   func(ctx context.Context, email string, ids ...string)
+	afterDeleteSessionsCounter  uint64
+	beforeDeleteSessionsCounter uint64
+	DeleteSessionsMock          mITokenRepositoryMockDeleteSessions
+
+	funcFamilyTokens          func(ctx context.Context, familyID string) (sa1 []string, err error)
+	funcFamilyTokensOrigin    string
+	inspectFuncFamilyTokens   func(ctx context.Context, familyID string)
+	afterFamilyTokensCounter  uint64
+	beforeFamilyTokensCounter uint64
+	FamilyTokensMock          mITokenRepositoryMockFamilyTokens
+
 	funcGet          func(ctx context.Context, key string) (s1 string, err error)
 	funcGetOrigin    string
 	inspectFuncGet   func(ctx context.Context, key string)
@@ -40,6 +76,34 @@ type ITokenRepositoryMock struct {
 	beforeGetCounter uint64
 	GetMock          mITokenRepositoryMockGet
 
+	funcGetDel          func(ctx context.Context, key string) (s1 string, err error)
+	funcGetDelOrigin    string
+	inspectFuncGetDel   func(ctx context.Context, key string)
+	afterGetDelCounter  uint64
+	beforeGetDelCounter uint64
+	GetDelMock          mITokenRepositoryMockGetDel
+
+	funcGetRefreshToken          func(ctx context.Context, id string) (r1 domain.RefreshToken, err error)
+	funcGetRefreshTokenOrigin    string
+	inspectFuncGetRefreshToken   func(ctx context.Context, id string)
+	afterGetRefreshTokenCounter  uint64
+	beforeGetRefreshTokenCounter uint64
+	GetRefreshTokenMock          mITokenRepositoryMockGetRefreshToken
+
+	funcGetSession          func(ctx context.Context, id string) (s1 domain.Session, err error)
+	funcGetSessionOrigin    string
+	inspectFuncGetSession   func(ctx context.Context, id string)
+	afterGetSessionCounter  uint64
+	beforeGetSessionCounter uint64
+	GetSessionMock          mITokenRepositoryMockGetSession
+
+	funcIncr          func(ctx context.Context, key string, expiration time.Duration) (i1 int64, err error)
+	funcIncrOrigin    string
+	inspectFuncIncr   func(ctx context.Context, key string, expiration time.Duration)
+	afterIncrCounter  uint64
+	beforeIncrCounter uint64
+	IncrMock          mITokenRepositoryMockIncr
+
 	funcList          func(ctx context.Context, key string) (sa1 []string, err error)
 	funcListOrigin    string
 	inspectFuncList   func(ctx context.Context, key string)
@@ -47,12 +111,47 @@ type ITokenRepositoryMock struct {
 	beforeListCounter uint64
 	ListMock          mITokenRepositoryMockList
 
+	funcListSessions          func(ctx context.Context, email string) (sa1 []domain.Session, err error)
+	funcListSessionsOrigin    string
+	inspectFuncListSessions   func(ctx context.Context, email string)
+	afterListSessionsCounter  uint64
+	beforeListSessionsCounter uint64
+	ListSessionsMock          mITokenRepositoryMockListSessions
+
+	funcMarkRefreshTokenRotated          func(ctx context.Context, token domain.RefreshToken, expiration time.Duration) (err error)
+	funcMarkRefreshTokenRotatedOrigin    string
+	inspectFuncMarkRefreshTokenRotated   func(ctx context.Context, token domain.RefreshToken, expiration time.Duration)
+	afterMarkRefreshTokenRotatedCounter  uint64
+	beforeMarkRefreshTokenRotatedCounter uint64
+	MarkRefreshTokenRotatedMock          mITokenRepositoryMockMarkRefreshTokenRotated
+
+	funcMigrateRefreshToken          func(ctx context.Context, token string, id string, expiration time.Duration) (err error)
+	funcMigrateRefreshTokenOrigin    string
+	inspectFuncMigrateRefreshToken   func(ctx context.Context, token string, id string, expiration time.Duration)
+	afterMigrateRefreshTokenCounter  uint64
+	beforeMigrateRefreshTokenCounter uint64
+	MigrateRefreshTokenMock          mITokenRepositoryMockMigrateRefreshToken
+
 	funcPush          func(ctx context.Context, key string, values ...string) (err error)
 	funcPushOrigin    string
 	inspectFuncPush   func(ctx context.Context, key string, values ...string)
 	afterPushCounter  uint64
 	beforePushCounter uint64
 	PushMock          mITokenRepositoryMockPush
+
+	funcSaveSession          func(ctx context.Context, session domain.Session, expiration time.Duration) (err error)
+	funcSaveSessionOrigin    string
+	inspectFuncSaveSession   func(ctx context.Context, session domain.Session, expiration time.Duration)
+	afterSaveSessionCounter  uint64
+	beforeSaveSessionCounter uint64
+	SaveSessionMock          mITokenRepositoryMockSaveSession
+
+	funcTTL          func(ctx context.Context, key string) (d1 time.Duration, err error)
+	funcTTLOrigin    string
+	inspectFuncTTL   func(ctx context.Context, key string)
+	afterTTLCounter  uint64
+	beforeTTLCounter uint64
+	TTLMock          mITokenRepositoryMockTTL
 }
 
 // NewITokenRepositoryMock returns a mock for mm_repository.ITokenRepository
@@ -66,18 +165,60 @@ func NewITokenRepositoryMock(t minimock.Tester) *ITokenRepositoryMock {
 	m.AddMock = mITokenRepositoryMockAdd{mock: m}
 	m.AddMock.callArgs = []*ITokenRepositoryMockAddParams{}
 
+	m.AddNXMock = mITokenRepositoryMockAddNX{mock: m}
+	m.AddNXMock.callArgs = []*ITokenRepositoryMockAddNXParams{}
+
+	m.AddRefreshTokenMock = mITokenRepositoryMockAddRefreshToken{mock: m}
+	m.AddRefreshTokenMock.callArgs = []*ITokenRepositoryMockAddRefreshTokenParams{}
+
 	m.DeleteMock = mITokenRepositoryMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*ITokenRepositoryMockDeleteParams{}
 
+	m.DeleteRefreshTokensMock = mITokenRepositoryMockDeleteRefreshTokens{mock: m}
+	m.DeleteRefreshTokensMock.callArgs = []*ITokenRepositoryMockDeleteRefreshTokensParams{}
+
+	m.DeleteSessionsMock = mITokenRepositoryMockDeleteSessions{mock: m}
+	m.DeleteSessionsMock.callArgs = []*ITokenRepositoryMockDeleteSessionsParams{}
+
+	m.FamilyTokensMock = mITokenRepositoryMockFamilyTokens{mock: m}
+	m.FamilyTokensMock.callArgs = []*ITokenRepositoryMockFamilyTokensParams{}
+
 	m.GetMock = mITokenRepositoryMockGet{mock: m}
 	m.GetMock.callArgs = []*ITokenRepositoryMockGetParams{}
 
+	m.GetDelMock = mITokenRepositoryMockGetDel{mock: m}
+	m.GetDelMock.callArgs = []*ITokenRepositoryMockGetDelParams{}
+
+	m.GetRefreshTokenMock = mITokenRepositoryMockGetRefreshToken{mock: m}
+	m.GetRefreshTokenMock.callArgs = []*ITokenRepositoryMockGetRefreshTokenParams{}
+
+	m.GetSessionMock = mITokenRepositoryMockGetSession{mock: m}
+	m.GetSessionMock.callArgs = []*ITokenRepositoryMockGetSessionParams{}
+
+	m.IncrMock = mITokenRepositoryMockIncr{mock: m}
+	m.IncrMock.callArgs = []*ITokenRepositoryMockIncrParams{}
+
 	m.ListMock = mITokenRepositoryMockList{mock: m}
 	m.ListMock.callArgs = []*ITokenRepositoryMockListParams{}
 
+	m.ListSessionsMock = mITokenRepositoryMockListSessions{mock: m}
+	m.ListSessionsMock.callArgs = []*ITokenRepositoryMockListSessionsParams{}
+
+	m.MarkRefreshTokenRotatedMock = mITokenRepositoryMockMarkRefreshTokenRotated{mock: m}
+	m.MarkRefreshTokenRotatedMock.callArgs = []*ITokenRepositoryMockMarkRefreshTokenRotatedParams{}
+
+	m.MigrateRefreshTokenMock = mITokenRepositoryMockMigrateRefreshToken{mock: m}
+	m.MigrateRefreshTokenMock.callArgs = []*ITokenRepositoryMockMigrateRefreshTokenParams{}
+
 	m.PushMock = mITokenRepositoryMockPush{mock: m}
 	m.PushMock.callArgs = []*ITokenRepositoryMockPushParams{}
 
+	m.SaveSessionMock = mITokenRepositoryMockSaveSession{mock: m}
+	m.SaveSessionMock.callArgs = []*ITokenRepositoryMockSaveSessionParams{}
+
+	m.TTLMock = mITokenRepositoryMockTTL{mock: m}
+	m.TTLMock.callArgs = []*ITokenRepositoryMockTTLParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -487,52 +628,58 @@ func (m *ITokenRepositoryMock) MinimockAddInspect() {
 	}
 }
 
-type mITokenRepositoryMockDelete struct {
+type mITokenRepositoryMockAddNX struct {
 	optional           bool
 	mock               *ITokenRepositoryMock
-	defaultExpectation *ITokenRepositoryMockDeleteExpectation
-	expectations       []*ITokenRepositoryMockDeleteExpectation
+	defaultExpectation *ITokenRepositoryMockAddNXExpectation
+	expectations       []*ITokenRepositoryMockAddNXExpectation
 
-	callArgs []*ITokenRepositoryMockDeleteParams
+	callArgs []*ITokenRepositoryMockAddNXParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ITokenRepositoryMockDeleteExpectation specifies expectation struct of the ITokenRepository.Delete
-type ITokenRepositoryMockDeleteExpectation struct {
+// ITokenRepositoryMockAddNXExpectation specifies expectation struct of the ITokenRepository.AddNX
+type ITokenRepositoryMockAddNXExpectation struct {
 	mock               *ITokenRepositoryMock
-	params             *ITokenRepositoryMockDeleteParams
-	paramPtrs          *ITokenRepositoryMockDeleteParamPtrs
-	expectationOrigins ITokenRepositoryMockDeleteExpectationOrigins
-	results            *ITokenRepositoryMockDeleteResults
+	params             *ITokenRepositoryMockAddNXParams
+	paramPtrs          *ITokenRepositoryMockAddNXParamPtrs
+	expectationOrigins ITokenRepositoryMockAddNXExpectationOrigins
+	results            *ITokenRepositoryMockAddNXResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ITokenRepositoryMockDeleteParams contains parameters of the ITokenRepository.Delete
-type ITokenRepositoryMockDeleteParams struct {
-	ctx  context.Context
-	keys []string
+// ITokenRepositoryMockAddNXParams contains parameters of the ITokenRepository.AddNX
+type ITokenRepositoryMockAddNXParams struct {
+	ctx        context.Context
+	key        string
+	value      string
+	expiration time.Duration
 }
 
-// ITokenRepositoryMockDeleteParamPtrs contains pointers to parameters of the ITokenRepository.Delete
-type ITokenRepositoryMockDeleteParamPtrs struct {
-	ctx  *context.Context
-	keys *[]string
+// ITokenRepositoryMockAddNXParamPtrs contains pointers to parameters of the ITokenRepository.AddNX
+type ITokenRepositoryMockAddNXParamPtrs struct {
+	ctx        *context.Context
+	key        *string
+	value      *string
+	expiration *time.Duration
 }
 
-// ITokenRepositoryMockDeleteResults contains results of the ITokenRepository.Delete
-type ITokenRepositoryMockDeleteResults struct {
+// ITokenRepositoryMockAddNXResults contains results of the ITokenRepository.AddNX
+type ITokenRepositoryMockAddNXResults struct {
 	err error
 }
 
-// ITokenRepositoryMockDeleteOrigins contains origins of expectations of the ITokenRepository.Delete
-type ITokenRepositoryMockDeleteExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originKeys string
+// ITokenRepositoryMockAddNXOrigins contains origins of expectations of the ITokenRepository.AddNX
+type ITokenRepositoryMockAddNXExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originKey        string
+	originValue      string
+	originExpiration string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -540,342 +687,400 @@ type ITokenRepositoryMockDeleteExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDelete *mITokenRepositoryMockDelete) Optional() *mITokenRepositoryMockDelete {
-	mmDelete.optional = true
-	return mmDelete
+func (mmAddNX *mITokenRepositoryMockAddNX) Optional() *mITokenRepositoryMockAddNX {
+	mmAddNX.optional = true
+	return mmAddNX
 }
 
-// Expect sets up expected params for ITokenRepository.Delete
-func (mmDelete *mITokenRepositoryMockDelete) Expect(ctx context.Context, keys ...string) *mITokenRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
+// Expect sets up expected params for ITokenRepository.AddNX
+func (mmAddNX *mITokenRepositoryMockAddNX) Expect(ctx context.Context, key string, value string, expiration time.Duration) *mITokenRepositoryMockAddNX {
+	if mmAddNX.mock.funcAddNX != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ITokenRepositoryMockDeleteExpectation{}
+	if mmAddNX.defaultExpectation == nil {
+		mmAddNX.defaultExpectation = &ITokenRepositoryMockAddNXExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs != nil {
-		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by ExpectParams functions")
+	if mmAddNX.defaultExpectation.paramPtrs != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by ExpectParams functions")
 	}
 
-	mmDelete.defaultExpectation.params = &ITokenRepositoryMockDeleteParams{ctx, keys}
-	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDelete.expectations {
-		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
-			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+	mmAddNX.defaultExpectation.params = &ITokenRepositoryMockAddNXParams{ctx, key, value, expiration}
+	mmAddNX.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddNX.expectations {
+		if minimock.Equal(e.params, mmAddNX.defaultExpectation.params) {
+			mmAddNX.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddNX.defaultExpectation.params)
 		}
 	}
 
-	return mmDelete
+	return mmAddNX
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.Delete
-func (mmDelete *mITokenRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.AddNX
+func (mmAddNX *mITokenRepositoryMockAddNX) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockAddNX {
+	if mmAddNX.mock.funcAddNX != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ITokenRepositoryMockDeleteExpectation{}
+	if mmAddNX.defaultExpectation == nil {
+		mmAddNX.defaultExpectation = &ITokenRepositoryMockAddNXExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Expect")
+	if mmAddNX.defaultExpectation.params != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &ITokenRepositoryMockDeleteParamPtrs{}
+	if mmAddNX.defaultExpectation.paramPtrs == nil {
+		mmAddNX.defaultExpectation.paramPtrs = &ITokenRepositoryMockAddNXParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddNX.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddNX.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmAddNX
 }
 
-// ExpectKeysParam2 sets up expected param keys for ITokenRepository.Delete
-func (mmDelete *mITokenRepositoryMockDelete) ExpectKeysParam2(keys ...string) *mITokenRepositoryMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
+// ExpectKeyParam2 sets up expected param key for ITokenRepository.AddNX
+func (mmAddNX *mITokenRepositoryMockAddNX) ExpectKeyParam2(key string) *mITokenRepositoryMockAddNX {
+	if mmAddNX.mock.funcAddNX != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ITokenRepositoryMockDeleteExpectation{}
+	if mmAddNX.defaultExpectation == nil {
+		mmAddNX.defaultExpectation = &ITokenRepositoryMockAddNXExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Expect")
+	if mmAddNX.defaultExpectation.params != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &ITokenRepositoryMockDeleteParamPtrs{}
+	if mmAddNX.defaultExpectation.paramPtrs == nil {
+		mmAddNX.defaultExpectation.paramPtrs = &ITokenRepositoryMockAddNXParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.keys = &keys
-	mmDelete.defaultExpectation.expectationOrigins.originKeys = minimock.CallerInfo(1)
+	mmAddNX.defaultExpectation.paramPtrs.key = &key
+	mmAddNX.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmAddNX
 }
 
-// Inspect accepts an inspector function that has same arguments as the ITokenRepository.Delete
-func (mmDelete *mITokenRepositoryMockDelete) Inspect(f func(ctx context.Context, keys ...string)) *mITokenRepositoryMockDelete {
-	if mmDelete.mock.inspectFuncDelete != nil {
-		mmDelete.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.Delete")
+// ExpectValueParam3 sets up expected param value for ITokenRepository.AddNX
+func (mmAddNX *mITokenRepositoryMockAddNX) ExpectValueParam3(value string) *mITokenRepositoryMockAddNX {
+	if mmAddNX.mock.funcAddNX != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Set")
 	}
 
-	mmDelete.mock.inspectFuncDelete = f
+	if mmAddNX.defaultExpectation == nil {
+		mmAddNX.defaultExpectation = &ITokenRepositoryMockAddNXExpectation{}
+	}
 
-	return mmDelete
+	if mmAddNX.defaultExpectation.params != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Expect")
+	}
+
+	if mmAddNX.defaultExpectation.paramPtrs == nil {
+		mmAddNX.defaultExpectation.paramPtrs = &ITokenRepositoryMockAddNXParamPtrs{}
+	}
+	mmAddNX.defaultExpectation.paramPtrs.value = &value
+	mmAddNX.defaultExpectation.expectationOrigins.originValue = minimock.CallerInfo(1)
+
+	return mmAddNX
 }
 
-// Return sets up results that will be returned by ITokenRepository.Delete
-func (mmDelete *mITokenRepositoryMockDelete) Return(err error) *ITokenRepositoryMock {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
+// ExpectExpirationParam4 sets up expected param expiration for ITokenRepository.AddNX
+func (mmAddNX *mITokenRepositoryMockAddNX) ExpectExpirationParam4(expiration time.Duration) *mITokenRepositoryMockAddNX {
+	if mmAddNX.mock.funcAddNX != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ITokenRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	if mmAddNX.defaultExpectation == nil {
+		mmAddNX.defaultExpectation = &ITokenRepositoryMockAddNXExpectation{}
 	}
-	mmDelete.defaultExpectation.results = &ITokenRepositoryMockDeleteResults{err}
-	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+
+	if mmAddNX.defaultExpectation.params != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Expect")
+	}
+
+	if mmAddNX.defaultExpectation.paramPtrs == nil {
+		mmAddNX.defaultExpectation.paramPtrs = &ITokenRepositoryMockAddNXParamPtrs{}
+	}
+	mmAddNX.defaultExpectation.paramPtrs.expiration = &expiration
+	mmAddNX.defaultExpectation.expectationOrigins.originExpiration = minimock.CallerInfo(1)
+
+	return mmAddNX
 }
 
-// Set uses given function f to mock the ITokenRepository.Delete method
-func (mmDelete *mITokenRepositoryMockDelete) Set(f func(ctx context.Context, keys ...string) (err error)) *ITokenRepositoryMock {
-	if mmDelete.defaultExpectation != nil {
-		mmDelete.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.Delete method")
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.AddNX
+func (mmAddNX *mITokenRepositoryMockAddNX) Inspect(f func(ctx context.Context, key string, value string, expiration time.Duration)) *mITokenRepositoryMockAddNX {
+	if mmAddNX.mock.inspectFuncAddNX != nil {
+		mmAddNX.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.AddNX")
 	}
 
-	if len(mmDelete.expectations) > 0 {
-		mmDelete.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.Delete method")
+	mmAddNX.mock.inspectFuncAddNX = f
+
+	return mmAddNX
+}
+
+// Return sets up results that will be returned by ITokenRepository.AddNX
+func (mmAddNX *mITokenRepositoryMockAddNX) Return(err error) *ITokenRepositoryMock {
+	if mmAddNX.mock.funcAddNX != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Set")
 	}
 
-	mmDelete.mock.funcDelete = f
-	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	if mmAddNX.defaultExpectation == nil {
+		mmAddNX.defaultExpectation = &ITokenRepositoryMockAddNXExpectation{mock: mmAddNX.mock}
+	}
+	mmAddNX.defaultExpectation.results = &ITokenRepositoryMockAddNXResults{err}
+	mmAddNX.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddNX.mock
 }
 
-// When sets expectation for the ITokenRepository.Delete which will trigger the result defined by the following
+// Set uses given function f to mock the ITokenRepository.AddNX method
+func (mmAddNX *mITokenRepositoryMockAddNX) Set(f func(ctx context.Context, key string, value string, expiration time.Duration) (err error)) *ITokenRepositoryMock {
+	if mmAddNX.defaultExpectation != nil {
+		mmAddNX.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.AddNX method")
+	}
+
+	if len(mmAddNX.expectations) > 0 {
+		mmAddNX.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.AddNX method")
+	}
+
+	mmAddNX.mock.funcAddNX = f
+	mmAddNX.mock.funcAddNXOrigin = minimock.CallerInfo(1)
+	return mmAddNX.mock
+}
+
+// When sets expectation for the ITokenRepository.AddNX which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mITokenRepositoryMockDelete) When(ctx context.Context, keys ...string) *ITokenRepositoryMockDeleteExpectation {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
+func (mmAddNX *mITokenRepositoryMockAddNX) When(ctx context.Context, key string, value string, expiration time.Duration) *ITokenRepositoryMockAddNXExpectation {
+	if mmAddNX.mock.funcAddNX != nil {
+		mmAddNX.mock.t.Fatalf("ITokenRepositoryMock.AddNX mock is already set by Set")
 	}
 
-	expectation := &ITokenRepositoryMockDeleteExpectation{
-		mock:               mmDelete.mock,
-		params:             &ITokenRepositoryMockDeleteParams{ctx, keys},
-		expectationOrigins: ITokenRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ITokenRepositoryMockAddNXExpectation{
+		mock:               mmAddNX.mock,
+		params:             &ITokenRepositoryMockAddNXParams{ctx, key, value, expiration},
+		expectationOrigins: ITokenRepositoryMockAddNXExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	mmAddNX.expectations = append(mmAddNX.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ITokenRepository.Delete return parameters for the expectation previously defined by the When method
-func (e *ITokenRepositoryMockDeleteExpectation) Then(err error) *ITokenRepositoryMock {
-	e.results = &ITokenRepositoryMockDeleteResults{err}
+// Then sets up ITokenRepository.AddNX return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockAddNXExpectation) Then(err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockAddNXResults{err}
 	return e.mock
 }
 
-// Times sets number of times ITokenRepository.Delete should be invoked
-func (mmDelete *mITokenRepositoryMockDelete) Times(n uint64) *mITokenRepositoryMockDelete {
+// Times sets number of times ITokenRepository.AddNX should be invoked
+func (mmAddNX *mITokenRepositoryMockAddNX) Times(n uint64) *mITokenRepositoryMockAddNX {
 	if n == 0 {
-		mmDelete.mock.t.Fatalf("Times of ITokenRepositoryMock.Delete mock can not be zero")
+		mmAddNX.mock.t.Fatalf("Times of ITokenRepositoryMock.AddNX mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
-	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDelete
+	mm_atomic.StoreUint64(&mmAddNX.expectedInvocations, n)
+	mmAddNX.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddNX
 }
 
-func (mmDelete *mITokenRepositoryMockDelete) invocationsDone() bool {
-	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+func (mmAddNX *mITokenRepositoryMockAddNX) invocationsDone() bool {
+	if len(mmAddNX.expectations) == 0 && mmAddNX.defaultExpectation == nil && mmAddNX.mock.funcAddNX == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddNX.mock.afterAddNXCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddNX.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Delete implements mm_repository.ITokenRepository
-func (mmDelete *ITokenRepositoryMock) Delete(ctx context.Context, keys ...string) (err error) {
-	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
-	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+// AddNX implements mm_repository.ITokenRepository
+func (mmAddNX *ITokenRepositoryMock) AddNX(ctx context.Context, key string, value string, expiration time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmAddNX.beforeAddNXCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddNX.afterAddNXCounter, 1)
 
-	mmDelete.t.Helper()
+	mmAddNX.t.Helper()
 
-	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, keys...)
+	if mmAddNX.inspectFuncAddNX != nil {
+		mmAddNX.inspectFuncAddNX(ctx, key, value, expiration)
 	}
 
-	mm_params := ITokenRepositoryMockDeleteParams{ctx, keys}
+	mm_params := ITokenRepositoryMockAddNXParams{ctx, key, value, expiration}
 
 	// Record call args
-	mmDelete.DeleteMock.mutex.Lock()
-	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
-	mmDelete.DeleteMock.mutex.Unlock()
+	mmAddNX.AddNXMock.mutex.Lock()
+	mmAddNX.AddNXMock.callArgs = append(mmAddNX.AddNXMock.callArgs, &mm_params)
+	mmAddNX.AddNXMock.mutex.Unlock()
 
-	for _, e := range mmDelete.DeleteMock.expectations {
+	for _, e := range mmAddNX.AddNXMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDelete.DeleteMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
-		mm_want := mmDelete.DeleteMock.defaultExpectation.params
-		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+	if mmAddNX.AddNXMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddNX.AddNXMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddNX.AddNXMock.defaultExpectation.params
+		mm_want_ptrs := mmAddNX.AddNXMock.defaultExpectation.paramPtrs
 
-		mm_got := ITokenRepositoryMockDeleteParams{ctx, keys}
+		mm_got := ITokenRepositoryMockAddNXParams{ctx, key, value, expiration}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDelete.t.Errorf("ITokenRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddNX.t.Errorf("ITokenRepositoryMock.AddNX got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddNX.AddNXMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
-				mmDelete.t.Errorf("ITokenRepositoryMock.Delete got unexpected parameter keys, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originKeys, *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmAddNX.t.Errorf("ITokenRepositoryMock.AddNX got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddNX.AddNXMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.value != nil && !minimock.Equal(*mm_want_ptrs.value, mm_got.value) {
+				mmAddNX.t.Errorf("ITokenRepositoryMock.AddNX got unexpected parameter value, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddNX.AddNXMock.defaultExpectation.expectationOrigins.originValue, *mm_want_ptrs.value, mm_got.value, minimock.Diff(*mm_want_ptrs.value, mm_got.value))
+			}
+
+			if mm_want_ptrs.expiration != nil && !minimock.Equal(*mm_want_ptrs.expiration, mm_got.expiration) {
+				mmAddNX.t.Errorf("ITokenRepositoryMock.AddNX got unexpected parameter expiration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddNX.AddNXMock.defaultExpectation.expectationOrigins.originExpiration, *mm_want_ptrs.expiration, mm_got.expiration, minimock.Diff(*mm_want_ptrs.expiration, mm_got.expiration))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDelete.t.Errorf("ITokenRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddNX.t.Errorf("ITokenRepositoryMock.AddNX got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddNX.AddNXMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		mm_results := mmAddNX.AddNXMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDelete.t.Fatal("No results are set for the ITokenRepositoryMock.Delete")
+			mmAddNX.t.Fatal("No results are set for the ITokenRepositoryMock.AddNX")
 		}
 		return (*mm_results).err
 	}
-	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, keys...)
+	if mmAddNX.funcAddNX != nil {
+		return mmAddNX.funcAddNX(ctx, key, value, expiration)
 	}
-	mmDelete.t.Fatalf("Unexpected call to ITokenRepositoryMock.Delete. %v %v", ctx, keys)
+	mmAddNX.t.Fatalf("Unexpected call to ITokenRepositoryMock.AddNX. %v %v %v %v", ctx, key, value, expiration)
 	return
 }
 
-// DeleteAfterCounter returns a count of finished ITokenRepositoryMock.Delete invocations
-func (mmDelete *ITokenRepositoryMock) DeleteAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+// AddNXAfterCounter returns a count of finished ITokenRepositoryMock.AddNX invocations
+func (mmAddNX *ITokenRepositoryMock) AddNXAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddNX.afterAddNXCounter)
 }
 
-// DeleteBeforeCounter returns a count of ITokenRepositoryMock.Delete invocations
-func (mmDelete *ITokenRepositoryMock) DeleteBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+// AddNXBeforeCounter returns a count of ITokenRepositoryMock.AddNX invocations
+func (mmAddNX *ITokenRepositoryMock) AddNXBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddNX.beforeAddNXCounter)
 }
 
-// Calls returns a list of arguments used in each call to ITokenRepositoryMock.Delete.
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.AddNX.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDelete *mITokenRepositoryMockDelete) Calls() []*ITokenRepositoryMockDeleteParams {
-	mmDelete.mutex.RLock()
+func (mmAddNX *mITokenRepositoryMockAddNX) Calls() []*ITokenRepositoryMockAddNXParams {
+	mmAddNX.mutex.RLock()
 
-	argCopy := make([]*ITokenRepositoryMockDeleteParams, len(mmDelete.callArgs))
-	copy(argCopy, mmDelete.callArgs)
+	argCopy := make([]*ITokenRepositoryMockAddNXParams, len(mmAddNX.callArgs))
+	copy(argCopy, mmAddNX.callArgs)
 
-	mmDelete.mutex.RUnlock()
+	mmAddNX.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// MinimockAddNXDone returns true if the count of the AddNX invocations corresponds
 // the number of defined expectations
-func (m *ITokenRepositoryMock) MinimockDeleteDone() bool {
-	if m.DeleteMock.optional {
+func (m *ITokenRepositoryMock) MinimockAddNXDone() bool {
+	if m.AddNXMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteMock.expectations {
+	for _, e := range m.AddNXMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteMock.invocationsDone()
+	return m.AddNXMock.invocationsDone()
 }
 
-// MinimockDeleteInspect logs each unmet expectation
-func (m *ITokenRepositoryMock) MinimockDeleteInspect() {
-	for _, e := range m.DeleteMock.expectations {
+// MinimockAddNXInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockAddNXInspect() {
+	for _, e := range m.AddNXMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ITokenRepositoryMock.AddNX at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	afterAddNXCounter := mm_atomic.LoadUint64(&m.afterAddNXCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
-		if m.DeleteMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+	if m.AddNXMock.defaultExpectation != nil && afterAddNXCounter < 1 {
+		if m.AddNXMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.AddNX at\n%s", m.AddNXMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ITokenRepositoryMock.AddNX at\n%s with params: %#v", m.AddNXMock.defaultExpectation.expectationOrigins.origin, *m.AddNXMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDelete != nil && afterDeleteCounter < 1 {
-		m.t.Errorf("Expected call to ITokenRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	if m.funcAddNX != nil && afterAddNXCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.AddNX at\n%s", m.funcAddNXOrigin)
 	}
 
-	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
-		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.Delete at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	if !m.AddNXMock.invocationsDone() && afterAddNXCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.AddNX at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddNXMock.expectedInvocations), m.AddNXMock.expectedInvocationsOrigin, afterAddNXCounter)
 	}
 }
 
-type mITokenRepositoryMockGet struct {
+type mITokenRepositoryMockAddRefreshToken struct {
 	optional           bool
 	mock               *ITokenRepositoryMock
-	defaultExpectation *ITokenRepositoryMockGetExpectation
-	expectations       []*ITokenRepositoryMockGetExpectation
+	defaultExpectation *ITokenRepositoryMockAddRefreshTokenExpectation
+	expectations       []*ITokenRepositoryMockAddRefreshTokenExpectation
 
-	callArgs []*ITokenRepositoryMockGetParams
+	callArgs []*ITokenRepositoryMockAddRefreshTokenParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ITokenRepositoryMockGetExpectation specifies expectation struct of the ITokenRepository.Get
-type ITokenRepositoryMockGetExpectation struct {
+// ITokenRepositoryMockAddRefreshTokenExpectation specifies expectation struct of the ITokenRepository.AddRefreshToken
+type ITokenRepositoryMockAddRefreshTokenExpectation struct {
 	mock               *ITokenRepositoryMock
-	params             *ITokenRepositoryMockGetParams
-	paramPtrs          *ITokenRepositoryMockGetParamPtrs
-	expectationOrigins ITokenRepositoryMockGetExpectationOrigins
-	results            *ITokenRepositoryMockGetResults
+	params             *ITokenRepositoryMockAddRefreshTokenParams
+	paramPtrs          *ITokenRepositoryMockAddRefreshTokenParamPtrs
+	expectationOrigins ITokenRepositoryMockAddRefreshTokenExpectationOrigins
+	results            *ITokenRepositoryMockAddRefreshTokenResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ITokenRepositoryMockGetParams contains parameters of the ITokenRepository.Get
-type ITokenRepositoryMockGetParams struct {
-	ctx context.Context
-	key string
+// ITokenRepositoryMockAddRefreshTokenParams contains parameters of the ITokenRepository.AddRefreshToken
+type ITokenRepositoryMockAddRefreshTokenParams struct {
+	ctx        context.Context
+	token      domain.RefreshToken
+	expiration time.Duration
 }
 
-// ITokenRepositoryMockGetParamPtrs contains pointers to parameters of the ITokenRepository.Get
-type ITokenRepositoryMockGetParamPtrs struct {
-	ctx *context.Context
-	key *string
+// ITokenRepositoryMockAddRefreshTokenParamPtrs contains pointers to parameters of the ITokenRepository.AddRefreshToken
+type ITokenRepositoryMockAddRefreshTokenParamPtrs struct {
+	ctx        *context.Context
+	token      *domain.RefreshToken
+	expiration *time.Duration
 }
 
-// ITokenRepositoryMockGetResults contains results of the ITokenRepository.Get
-type ITokenRepositoryMockGetResults struct {
-	s1  string
+// ITokenRepositoryMockAddRefreshTokenResults contains results of the ITokenRepository.AddRefreshToken
+type ITokenRepositoryMockAddRefreshTokenResults struct {
 	err error
 }
 
-// ITokenRepositoryMockGetOrigins contains origins of expectations of the ITokenRepository.Get
-type ITokenRepositoryMockGetExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originKey string
+// ITokenRepositoryMockAddRefreshTokenOrigins contains origins of expectations of the ITokenRepository.AddRefreshToken
+type ITokenRepositoryMockAddRefreshTokenExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originToken      string
+	originExpiration string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -883,342 +1088,369 @@ type ITokenRepositoryMockGetExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGet *mITokenRepositoryMockGet) Optional() *mITokenRepositoryMockGet {
-	mmGet.optional = true
-	return mmGet
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) Optional() *mITokenRepositoryMockAddRefreshToken {
+	mmAddRefreshToken.optional = true
+	return mmAddRefreshToken
 }
 
-// Expect sets up expected params for ITokenRepository.Get
-func (mmGet *mITokenRepositoryMockGet) Expect(ctx context.Context, key string) *mITokenRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+// Expect sets up expected params for ITokenRepository.AddRefreshToken
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) Expect(ctx context.Context, token domain.RefreshToken, expiration time.Duration) *mITokenRepositoryMockAddRefreshToken {
+	if mmAddRefreshToken.mock.funcAddRefreshToken != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ITokenRepositoryMockGetExpectation{}
+	if mmAddRefreshToken.defaultExpectation == nil {
+		mmAddRefreshToken.defaultExpectation = &ITokenRepositoryMockAddRefreshTokenExpectation{}
 	}
 
-	if mmGet.defaultExpectation.paramPtrs != nil {
-		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by ExpectParams functions")
+	if mmAddRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by ExpectParams functions")
 	}
 
-	mmGet.defaultExpectation.params = &ITokenRepositoryMockGetParams{ctx, key}
-	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGet.expectations {
-		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
-			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+	mmAddRefreshToken.defaultExpectation.params = &ITokenRepositoryMockAddRefreshTokenParams{ctx, token, expiration}
+	mmAddRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddRefreshToken.expectations {
+		if minimock.Equal(e.params, mmAddRefreshToken.defaultExpectation.params) {
+			mmAddRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddRefreshToken.defaultExpectation.params)
 		}
 	}
 
-	return mmGet
+	return mmAddRefreshToken
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.Get
-func (mmGet *mITokenRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.AddRefreshToken
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockAddRefreshToken {
+	if mmAddRefreshToken.mock.funcAddRefreshToken != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ITokenRepositoryMockGetExpectation{}
+	if mmAddRefreshToken.defaultExpectation == nil {
+		mmAddRefreshToken.defaultExpectation = &ITokenRepositoryMockAddRefreshTokenExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Expect")
+	if mmAddRefreshToken.defaultExpectation.params != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetParamPtrs{}
+	if mmAddRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmAddRefreshToken.defaultExpectation.paramPtrs = &ITokenRepositoryMockAddRefreshTokenParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmAddRefreshToken
 }
 
-// ExpectKeyParam2 sets up expected param key for ITokenRepository.Get
-func (mmGet *mITokenRepositoryMockGet) ExpectKeyParam2(key string) *mITokenRepositoryMockGet {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+// ExpectTokenParam2 sets up expected param token for ITokenRepository.AddRefreshToken
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) ExpectTokenParam2(token domain.RefreshToken) *mITokenRepositoryMockAddRefreshToken {
+	if mmAddRefreshToken.mock.funcAddRefreshToken != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by Set")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ITokenRepositoryMockGetExpectation{}
+	if mmAddRefreshToken.defaultExpectation == nil {
+		mmAddRefreshToken.defaultExpectation = &ITokenRepositoryMockAddRefreshTokenExpectation{}
 	}
 
-	if mmGet.defaultExpectation.params != nil {
-		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Expect")
+	if mmAddRefreshToken.defaultExpectation.params != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by Expect")
 	}
 
-	if mmGet.defaultExpectation.paramPtrs == nil {
-		mmGet.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetParamPtrs{}
+	if mmAddRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmAddRefreshToken.defaultExpectation.paramPtrs = &ITokenRepositoryMockAddRefreshTokenParamPtrs{}
 	}
-	mmGet.defaultExpectation.paramPtrs.key = &key
-	mmGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+	mmAddRefreshToken.defaultExpectation.paramPtrs.token = &token
+	mmAddRefreshToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
 
-	return mmGet
+	return mmAddRefreshToken
 }
 
-// Inspect accepts an inspector function that has same arguments as the ITokenRepository.Get
-func (mmGet *mITokenRepositoryMockGet) Inspect(f func(ctx context.Context, key string)) *mITokenRepositoryMockGet {
-	if mmGet.mock.inspectFuncGet != nil {
-		mmGet.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.Get")
+// ExpectExpirationParam3 sets up expected param expiration for ITokenRepository.AddRefreshToken
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) ExpectExpirationParam3(expiration time.Duration) *mITokenRepositoryMockAddRefreshToken {
+	if mmAddRefreshToken.mock.funcAddRefreshToken != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by Set")
 	}
 
-	mmGet.mock.inspectFuncGet = f
+	if mmAddRefreshToken.defaultExpectation == nil {
+		mmAddRefreshToken.defaultExpectation = &ITokenRepositoryMockAddRefreshTokenExpectation{}
+	}
 
-	return mmGet
+	if mmAddRefreshToken.defaultExpectation.params != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by Expect")
+	}
+
+	if mmAddRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmAddRefreshToken.defaultExpectation.paramPtrs = &ITokenRepositoryMockAddRefreshTokenParamPtrs{}
+	}
+	mmAddRefreshToken.defaultExpectation.paramPtrs.expiration = &expiration
+	mmAddRefreshToken.defaultExpectation.expectationOrigins.originExpiration = minimock.CallerInfo(1)
+
+	return mmAddRefreshToken
 }
 
-// Return sets up results that will be returned by ITokenRepository.Get
-func (mmGet *mITokenRepositoryMockGet) Return(s1 string, err error) *ITokenRepositoryMock {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.AddRefreshToken
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) Inspect(f func(ctx context.Context, token domain.RefreshToken, expiration time.Duration)) *mITokenRepositoryMockAddRefreshToken {
+	if mmAddRefreshToken.mock.inspectFuncAddRefreshToken != nil {
+		mmAddRefreshToken.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.AddRefreshToken")
 	}
 
-	if mmGet.defaultExpectation == nil {
-		mmGet.defaultExpectation = &ITokenRepositoryMockGetExpectation{mock: mmGet.mock}
+	mmAddRefreshToken.mock.inspectFuncAddRefreshToken = f
+
+	return mmAddRefreshToken
+}
+
+// Return sets up results that will be returned by ITokenRepository.AddRefreshToken
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) Return(err error) *ITokenRepositoryMock {
+	if mmAddRefreshToken.mock.funcAddRefreshToken != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by Set")
 	}
-	mmGet.defaultExpectation.results = &ITokenRepositoryMockGetResults{s1, err}
-	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+
+	if mmAddRefreshToken.defaultExpectation == nil {
+		mmAddRefreshToken.defaultExpectation = &ITokenRepositoryMockAddRefreshTokenExpectation{mock: mmAddRefreshToken.mock}
+	}
+	mmAddRefreshToken.defaultExpectation.results = &ITokenRepositoryMockAddRefreshTokenResults{err}
+	mmAddRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddRefreshToken.mock
 }
 
-// Set uses given function f to mock the ITokenRepository.Get method
-func (mmGet *mITokenRepositoryMockGet) Set(f func(ctx context.Context, key string) (s1 string, err error)) *ITokenRepositoryMock {
-	if mmGet.defaultExpectation != nil {
-		mmGet.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.Get method")
+// Set uses given function f to mock the ITokenRepository.AddRefreshToken method
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) Set(f func(ctx context.Context, token domain.RefreshToken, expiration time.Duration) (err error)) *ITokenRepositoryMock {
+	if mmAddRefreshToken.defaultExpectation != nil {
+		mmAddRefreshToken.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.AddRefreshToken method")
 	}
 
-	if len(mmGet.expectations) > 0 {
-		mmGet.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.Get method")
+	if len(mmAddRefreshToken.expectations) > 0 {
+		mmAddRefreshToken.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.AddRefreshToken method")
 	}
 
-	mmGet.mock.funcGet = f
-	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
-	return mmGet.mock
+	mmAddRefreshToken.mock.funcAddRefreshToken = f
+	mmAddRefreshToken.mock.funcAddRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmAddRefreshToken.mock
 }
 
-// When sets expectation for the ITokenRepository.Get which will trigger the result defined by the following
+// When sets expectation for the ITokenRepository.AddRefreshToken which will trigger the result defined by the following
 // Then helper
-func (mmGet *mITokenRepositoryMockGet) When(ctx context.Context, key string) *ITokenRepositoryMockGetExpectation {
-	if mmGet.mock.funcGet != nil {
-		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) When(ctx context.Context, token domain.RefreshToken, expiration time.Duration) *ITokenRepositoryMockAddRefreshTokenExpectation {
+	if mmAddRefreshToken.mock.funcAddRefreshToken != nil {
+		mmAddRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.AddRefreshToken mock is already set by Set")
 	}
 
-	expectation := &ITokenRepositoryMockGetExpectation{
-		mock:               mmGet.mock,
-		params:             &ITokenRepositoryMockGetParams{ctx, key},
-		expectationOrigins: ITokenRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ITokenRepositoryMockAddRefreshTokenExpectation{
+		mock:               mmAddRefreshToken.mock,
+		params:             &ITokenRepositoryMockAddRefreshTokenParams{ctx, token, expiration},
+		expectationOrigins: ITokenRepositoryMockAddRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGet.expectations = append(mmGet.expectations, expectation)
+	mmAddRefreshToken.expectations = append(mmAddRefreshToken.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ITokenRepository.Get return parameters for the expectation previously defined by the When method
-func (e *ITokenRepositoryMockGetExpectation) Then(s1 string, err error) *ITokenRepositoryMock {
-	e.results = &ITokenRepositoryMockGetResults{s1, err}
+// Then sets up ITokenRepository.AddRefreshToken return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockAddRefreshTokenExpectation) Then(err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockAddRefreshTokenResults{err}
 	return e.mock
 }
 
-// Times sets number of times ITokenRepository.Get should be invoked
-func (mmGet *mITokenRepositoryMockGet) Times(n uint64) *mITokenRepositoryMockGet {
+// Times sets number of times ITokenRepository.AddRefreshToken should be invoked
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) Times(n uint64) *mITokenRepositoryMockAddRefreshToken {
 	if n == 0 {
-		mmGet.mock.t.Fatalf("Times of ITokenRepositoryMock.Get mock can not be zero")
+		mmAddRefreshToken.mock.t.Fatalf("Times of ITokenRepositoryMock.AddRefreshToken mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
-	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGet
+	mm_atomic.StoreUint64(&mmAddRefreshToken.expectedInvocations, n)
+	mmAddRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddRefreshToken
 }
 
-func (mmGet *mITokenRepositoryMockGet) invocationsDone() bool {
-	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) invocationsDone() bool {
+	if len(mmAddRefreshToken.expectations) == 0 && mmAddRefreshToken.defaultExpectation == nil && mmAddRefreshToken.mock.funcAddRefreshToken == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddRefreshToken.mock.afterAddRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddRefreshToken.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Get implements mm_repository.ITokenRepository
-func (mmGet *ITokenRepositoryMock) Get(ctx context.Context, key string) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
-	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+// AddRefreshToken implements mm_repository.ITokenRepository
+func (mmAddRefreshToken *ITokenRepositoryMock) AddRefreshToken(ctx context.Context, token domain.RefreshToken, expiration time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmAddRefreshToken.beforeAddRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddRefreshToken.afterAddRefreshTokenCounter, 1)
 
-	mmGet.t.Helper()
+	mmAddRefreshToken.t.Helper()
 
-	if mmGet.inspectFuncGet != nil {
-		mmGet.inspectFuncGet(ctx, key)
+	if mmAddRefreshToken.inspectFuncAddRefreshToken != nil {
+		mmAddRefreshToken.inspectFuncAddRefreshToken(ctx, token, expiration)
 	}
 
-	mm_params := ITokenRepositoryMockGetParams{ctx, key}
+	mm_params := ITokenRepositoryMockAddRefreshTokenParams{ctx, token, expiration}
 
 	// Record call args
-	mmGet.GetMock.mutex.Lock()
-	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
-	mmGet.GetMock.mutex.Unlock()
+	mmAddRefreshToken.AddRefreshTokenMock.mutex.Lock()
+	mmAddRefreshToken.AddRefreshTokenMock.callArgs = append(mmAddRefreshToken.AddRefreshTokenMock.callArgs, &mm_params)
+	mmAddRefreshToken.AddRefreshTokenMock.mutex.Unlock()
 
-	for _, e := range mmGet.GetMock.expectations {
+	for _, e := range mmAddRefreshToken.AddRefreshTokenMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGet.GetMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
-		mm_want := mmGet.GetMock.defaultExpectation.params
-		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+	if mmAddRefreshToken.AddRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddRefreshToken.AddRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddRefreshToken.AddRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmAddRefreshToken.AddRefreshTokenMock.defaultExpectation.paramPtrs
 
-		mm_got := ITokenRepositoryMockGetParams{ctx, key}
+		mm_got := ITokenRepositoryMockAddRefreshTokenParams{ctx, token, expiration}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGet.t.Errorf("ITokenRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddRefreshToken.t.Errorf("ITokenRepositoryMock.AddRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRefreshToken.AddRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
-				mmGet.t.Errorf("ITokenRepositoryMock.Get got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGet.GetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmAddRefreshToken.t.Errorf("ITokenRepositoryMock.AddRefreshToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRefreshToken.AddRefreshTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.expiration != nil && !minimock.Equal(*mm_want_ptrs.expiration, mm_got.expiration) {
+				mmAddRefreshToken.t.Errorf("ITokenRepositoryMock.AddRefreshToken got unexpected parameter expiration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRefreshToken.AddRefreshTokenMock.defaultExpectation.expectationOrigins.originExpiration, *mm_want_ptrs.expiration, mm_got.expiration, minimock.Diff(*mm_want_ptrs.expiration, mm_got.expiration))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGet.t.Errorf("ITokenRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddRefreshToken.t.Errorf("ITokenRepositoryMock.AddRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddRefreshToken.AddRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGet.GetMock.defaultExpectation.results
+		mm_results := mmAddRefreshToken.AddRefreshTokenMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGet.t.Fatal("No results are set for the ITokenRepositoryMock.Get")
+			mmAddRefreshToken.t.Fatal("No results are set for the ITokenRepositoryMock.AddRefreshToken")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGet.funcGet != nil {
-		return mmGet.funcGet(ctx, key)
+	if mmAddRefreshToken.funcAddRefreshToken != nil {
+		return mmAddRefreshToken.funcAddRefreshToken(ctx, token, expiration)
 	}
-	mmGet.t.Fatalf("Unexpected call to ITokenRepositoryMock.Get. %v %v", ctx, key)
+	mmAddRefreshToken.t.Fatalf("Unexpected call to ITokenRepositoryMock.AddRefreshToken. %v %v %v", ctx, token, expiration)
 	return
 }
 
-// GetAfterCounter returns a count of finished ITokenRepositoryMock.Get invocations
-func (mmGet *ITokenRepositoryMock) GetAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+// AddRefreshTokenAfterCounter returns a count of finished ITokenRepositoryMock.AddRefreshToken invocations
+func (mmAddRefreshToken *ITokenRepositoryMock) AddRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRefreshToken.afterAddRefreshTokenCounter)
 }
 
-// GetBeforeCounter returns a count of ITokenRepositoryMock.Get invocations
-func (mmGet *ITokenRepositoryMock) GetBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+// AddRefreshTokenBeforeCounter returns a count of ITokenRepositoryMock.AddRefreshToken invocations
+func (mmAddRefreshToken *ITokenRepositoryMock) AddRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddRefreshToken.beforeAddRefreshTokenCounter)
 }
 
-// Calls returns a list of arguments used in each call to ITokenRepositoryMock.Get.
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.AddRefreshToken.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGet *mITokenRepositoryMockGet) Calls() []*ITokenRepositoryMockGetParams {
-	mmGet.mutex.RLock()
+func (mmAddRefreshToken *mITokenRepositoryMockAddRefreshToken) Calls() []*ITokenRepositoryMockAddRefreshTokenParams {
+	mmAddRefreshToken.mutex.RLock()
 
-	argCopy := make([]*ITokenRepositoryMockGetParams, len(mmGet.callArgs))
-	copy(argCopy, mmGet.callArgs)
+	argCopy := make([]*ITokenRepositoryMockAddRefreshTokenParams, len(mmAddRefreshToken.callArgs))
+	copy(argCopy, mmAddRefreshToken.callArgs)
 
-	mmGet.mutex.RUnlock()
+	mmAddRefreshToken.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetDone returns true if the count of the Get invocations corresponds
+// MinimockAddRefreshTokenDone returns true if the count of the AddRefreshToken invocations corresponds
 // the number of defined expectations
-func (m *ITokenRepositoryMock) MinimockGetDone() bool {
-	if m.GetMock.optional {
+func (m *ITokenRepositoryMock) MinimockAddRefreshTokenDone() bool {
+	if m.AddRefreshTokenMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetMock.expectations {
+	for _, e := range m.AddRefreshTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetMock.invocationsDone()
+	return m.AddRefreshTokenMock.invocationsDone()
 }
 
-// MinimockGetInspect logs each unmet expectation
-func (m *ITokenRepositoryMock) MinimockGetInspect() {
-	for _, e := range m.GetMock.expectations {
+// MinimockAddRefreshTokenInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockAddRefreshTokenInspect() {
+	for _, e := range m.AddRefreshTokenMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ITokenRepositoryMock.AddRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	afterAddRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterAddRefreshTokenCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
-		if m.GetMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+	if m.AddRefreshTokenMock.defaultExpectation != nil && afterAddRefreshTokenCounter < 1 {
+		if m.AddRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.AddRefreshToken at\n%s", m.AddRefreshTokenMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ITokenRepositoryMock.AddRefreshToken at\n%s with params: %#v", m.AddRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.AddRefreshTokenMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGet != nil && afterGetCounter < 1 {
-		m.t.Errorf("Expected call to ITokenRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	if m.funcAddRefreshToken != nil && afterAddRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.AddRefreshToken at\n%s", m.funcAddRefreshTokenOrigin)
 	}
 
-	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
-		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.Get at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	if !m.AddRefreshTokenMock.invocationsDone() && afterAddRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.AddRefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddRefreshTokenMock.expectedInvocations), m.AddRefreshTokenMock.expectedInvocationsOrigin, afterAddRefreshTokenCounter)
 	}
 }
 
-type mITokenRepositoryMockList struct {
+type mITokenRepositoryMockDelete struct {
 	optional           bool
 	mock               *ITokenRepositoryMock
-	defaultExpectation *ITokenRepositoryMockListExpectation
-	expectations       []*ITokenRepositoryMockListExpectation
+	defaultExpectation *ITokenRepositoryMockDeleteExpectation
+	expectations       []*ITokenRepositoryMockDeleteExpectation
 
-	callArgs []*ITokenRepositoryMockListParams
+	callArgs []*ITokenRepositoryMockDeleteParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ITokenRepositoryMockListExpectation specifies expectation struct of the ITokenRepository.List
-type ITokenRepositoryMockListExpectation struct {
+// ITokenRepositoryMockDeleteExpectation specifies expectation struct of the ITokenRepository.Delete
+type ITokenRepositoryMockDeleteExpectation struct {
 	mock               *ITokenRepositoryMock
-	params             *ITokenRepositoryMockListParams
-	paramPtrs          *ITokenRepositoryMockListParamPtrs
-	expectationOrigins ITokenRepositoryMockListExpectationOrigins
-	results            *ITokenRepositoryMockListResults
+	params             *ITokenRepositoryMockDeleteParams
+	paramPtrs          *ITokenRepositoryMockDeleteParamPtrs
+	expectationOrigins ITokenRepositoryMockDeleteExpectationOrigins
+	results            *ITokenRepositoryMockDeleteResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ITokenRepositoryMockListParams contains parameters of the ITokenRepository.List
-type ITokenRepositoryMockListParams struct {
-	ctx context.Context
-	key string
+// ITokenRepositoryMockDeleteParams contains parameters of the ITokenRepository.Delete
+type ITokenRepositoryMockDeleteParams struct {
+	ctx  context.Context
+	keys []string
 }
 
-// ITokenRepositoryMockListParamPtrs contains pointers to parameters of the ITokenRepository.List
-type ITokenRepositoryMockListParamPtrs struct {
-	ctx *context.Context
-	key *string
+// ITokenRepositoryMockDeleteParamPtrs contains pointers to parameters of the ITokenRepository.Delete
+type ITokenRepositoryMockDeleteParamPtrs struct {
+	ctx  *context.Context
+	keys *[]string
 }
 
-// ITokenRepositoryMockListResults contains results of the ITokenRepository.List
-type ITokenRepositoryMockListResults struct {
-	sa1 []string
+// ITokenRepositoryMockDeleteResults contains results of the ITokenRepository.Delete
+type ITokenRepositoryMockDeleteResults struct {
 	err error
 }
 
-// ITokenRepositoryMockListOrigins contains origins of expectations of the ITokenRepository.List
-type ITokenRepositoryMockListExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originKey string
+// ITokenRepositoryMockDeleteOrigins contains origins of expectations of the ITokenRepository.Delete
+type ITokenRepositoryMockDeleteExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originKeys string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1226,344 +1458,5355 @@ type ITokenRepositoryMockListExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmList *mITokenRepositoryMockList) Optional() *mITokenRepositoryMockList {
-	mmList.optional = true
-	return mmList
+func (mmDelete *mITokenRepositoryMockDelete) Optional() *mITokenRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
 }
 
-// Expect sets up expected params for ITokenRepository.List
-func (mmList *mITokenRepositoryMockList) Expect(ctx context.Context, key string) *mITokenRepositoryMockList {
-	if mmList.mock.funcList != nil {
-		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+// Expect sets up expected params for ITokenRepository.Delete
+func (mmDelete *mITokenRepositoryMockDelete) Expect(ctx context.Context, keys ...string) *mITokenRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
 	}
 
-	if mmList.defaultExpectation == nil {
-		mmList.defaultExpectation = &ITokenRepositoryMockListExpectation{}
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ITokenRepositoryMockDeleteExpectation{}
 	}
 
-	if mmList.defaultExpectation.paramPtrs != nil {
-		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by ExpectParams functions")
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by ExpectParams functions")
 	}
 
-	mmList.defaultExpectation.params = &ITokenRepositoryMockListParams{ctx, key}
-	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmList.expectations {
-		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
-			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
-		}
+	mmDelete.defaultExpectation.params = &ITokenRepositoryMockDeleteParams{ctx, keys}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
 	}
 
-	return mmList
+	return mmDelete
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.List
-func (mmList *mITokenRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockList {
-	if mmList.mock.funcList != nil {
-		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.Delete
+func (mmDelete *mITokenRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
 	}
 
-	if mmList.defaultExpectation == nil {
-		mmList.defaultExpectation = &ITokenRepositoryMockListExpectation{}
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ITokenRepositoryMockDeleteExpectation{}
 	}
 
-	if mmList.defaultExpectation.params != nil {
-		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Expect")
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Expect")
 	}
 
-	if mmList.defaultExpectation.paramPtrs == nil {
-		mmList.defaultExpectation.paramPtrs = &ITokenRepositoryMockListParamPtrs{}
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ITokenRepositoryMockDeleteParamPtrs{}
 	}
-	mmList.defaultExpectation.paramPtrs.ctx = &ctx
-	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmList
+	return mmDelete
 }
 
-// ExpectKeyParam2 sets up expected param key for ITokenRepository.List
-func (mmList *mITokenRepositoryMockList) ExpectKeyParam2(key string) *mITokenRepositoryMockList {
-	if mmList.mock.funcList != nil {
-		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+// ExpectKeysParam2 sets up expected param keys for ITokenRepository.Delete
+func (mmDelete *mITokenRepositoryMockDelete) ExpectKeysParam2(keys ...string) *mITokenRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
 	}
 
-	if mmList.defaultExpectation == nil {
-		mmList.defaultExpectation = &ITokenRepositoryMockListExpectation{}
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ITokenRepositoryMockDeleteExpectation{}
 	}
 
-	if mmList.defaultExpectation.params != nil {
-		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Expect")
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Expect")
 	}
 
-	if mmList.defaultExpectation.paramPtrs == nil {
-		mmList.defaultExpectation.paramPtrs = &ITokenRepositoryMockListParamPtrs{}
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ITokenRepositoryMockDeleteParamPtrs{}
 	}
-	mmList.defaultExpectation.paramPtrs.key = &key
-	mmList.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+	mmDelete.defaultExpectation.paramPtrs.keys = &keys
+	mmDelete.defaultExpectation.expectationOrigins.originKeys = minimock.CallerInfo(1)
 
-	return mmList
+	return mmDelete
 }
 
-// Inspect accepts an inspector function that has same arguments as the ITokenRepository.List
-func (mmList *mITokenRepositoryMockList) Inspect(f func(ctx context.Context, key string)) *mITokenRepositoryMockList {
-	if mmList.mock.inspectFuncList != nil {
-		mmList.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.List")
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.Delete
+func (mmDelete *mITokenRepositoryMockDelete) Inspect(f func(ctx context.Context, keys ...string)) *mITokenRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.Delete")
 	}
 
-	mmList.mock.inspectFuncList = f
+	mmDelete.mock.inspectFuncDelete = f
 
-	return mmList
+	return mmDelete
 }
 
-// Return sets up results that will be returned by ITokenRepository.List
-func (mmList *mITokenRepositoryMockList) Return(sa1 []string, err error) *ITokenRepositoryMock {
-	if mmList.mock.funcList != nil {
-		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+// Return sets up results that will be returned by ITokenRepository.Delete
+func (mmDelete *mITokenRepositoryMockDelete) Return(err error) *ITokenRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
 	}
 
-	if mmList.defaultExpectation == nil {
-		mmList.defaultExpectation = &ITokenRepositoryMockListExpectation{mock: mmList.mock}
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ITokenRepositoryMockDeleteExpectation{mock: mmDelete.mock}
 	}
-	mmList.defaultExpectation.results = &ITokenRepositoryMockListResults{sa1, err}
-	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmList.mock
+	mmDelete.defaultExpectation.results = &ITokenRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
 }
 
-// Set uses given function f to mock the ITokenRepository.List method
-func (mmList *mITokenRepositoryMockList) Set(f func(ctx context.Context, key string) (sa1 []string, err error)) *ITokenRepositoryMock {
-	if mmList.defaultExpectation != nil {
-		mmList.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.List method")
+// Set uses given function f to mock the ITokenRepository.Delete method
+func (mmDelete *mITokenRepositoryMockDelete) Set(f func(ctx context.Context, keys ...string) (err error)) *ITokenRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.Delete method")
 	}
 
-	if len(mmList.expectations) > 0 {
-		mmList.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.List method")
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.Delete method")
 	}
 
-	mmList.mock.funcList = f
-	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
-	return mmList.mock
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
 }
 
-// When sets expectation for the ITokenRepository.List which will trigger the result defined by the following
+// When sets expectation for the ITokenRepository.Delete which will trigger the result defined by the following
 // Then helper
-func (mmList *mITokenRepositoryMockList) When(ctx context.Context, key string) *ITokenRepositoryMockListExpectation {
-	if mmList.mock.funcList != nil {
-		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+func (mmDelete *mITokenRepositoryMockDelete) When(ctx context.Context, keys ...string) *ITokenRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ITokenRepositoryMock.Delete mock is already set by Set")
 	}
 
-	expectation := &ITokenRepositoryMockListExpectation{
-		mock:               mmList.mock,
-		params:             &ITokenRepositoryMockListParams{ctx, key},
-		expectationOrigins: ITokenRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ITokenRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &ITokenRepositoryMockDeleteParams{ctx, keys},
+		expectationOrigins: ITokenRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmList.expectations = append(mmList.expectations, expectation)
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ITokenRepository.List return parameters for the expectation previously defined by the When method
-func (e *ITokenRepositoryMockListExpectation) Then(sa1 []string, err error) *ITokenRepositoryMock {
-	e.results = &ITokenRepositoryMockListResults{sa1, err}
+// Then sets up ITokenRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockDeleteExpectation) Then(err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockDeleteResults{err}
 	return e.mock
 }
 
-// Times sets number of times ITokenRepository.List should be invoked
-func (mmList *mITokenRepositoryMockList) Times(n uint64) *mITokenRepositoryMockList {
+// Times sets number of times ITokenRepository.Delete should be invoked
+func (mmDelete *mITokenRepositoryMockDelete) Times(n uint64) *mITokenRepositoryMockDelete {
 	if n == 0 {
-		mmList.mock.t.Fatalf("Times of ITokenRepositoryMock.List mock can not be zero")
+		mmDelete.mock.t.Fatalf("Times of ITokenRepositoryMock.Delete mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
-	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmList
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
 }
 
-func (mmList *mITokenRepositoryMockList) invocationsDone() bool {
-	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+func (mmDelete *mITokenRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// List implements mm_repository.ITokenRepository
-func (mmList *ITokenRepositoryMock) List(ctx context.Context, key string) (sa1 []string, err error) {
-	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
-	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+// Delete implements mm_repository.ITokenRepository
+func (mmDelete *ITokenRepositoryMock) Delete(ctx context.Context, keys ...string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
 
-	mmList.t.Helper()
+	mmDelete.t.Helper()
 
-	if mmList.inspectFuncList != nil {
-		mmList.inspectFuncList(ctx, key)
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, keys...)
 	}
 
-	mm_params := ITokenRepositoryMockListParams{ctx, key}
+	mm_params := ITokenRepositoryMockDeleteParams{ctx, keys}
 
 	// Record call args
-	mmList.ListMock.mutex.Lock()
-	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
-	mmList.ListMock.mutex.Unlock()
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
 
-	for _, e := range mmList.ListMock.expectations {
+	for _, e := range mmDelete.DeleteMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmList.ListMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
-		mm_want := mmList.ListMock.defaultExpectation.params
-		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
 
-		mm_got := ITokenRepositoryMockListParams{ctx, key}
+		mm_got := ITokenRepositoryMockDeleteParams{ctx, keys}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmList.t.Errorf("ITokenRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDelete.t.Errorf("ITokenRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
-				mmList.t.Errorf("ITokenRepositoryMock.List got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmList.ListMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			if mm_want_ptrs.keys != nil && !minimock.Equal(*mm_want_ptrs.keys, mm_got.keys) {
+				mmDelete.t.Errorf("ITokenRepositoryMock.Delete got unexpected parameter keys, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originKeys, *mm_want_ptrs.keys, mm_got.keys, minimock.Diff(*mm_want_ptrs.keys, mm_got.keys))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmList.t.Errorf("ITokenRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDelete.t.Errorf("ITokenRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmList.ListMock.defaultExpectation.results
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
 		if mm_results == nil {
-			mmList.t.Fatal("No results are set for the ITokenRepositoryMock.List")
+			mmDelete.t.Fatal("No results are set for the ITokenRepositoryMock.Delete")
 		}
-		return (*mm_results).sa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmList.funcList != nil {
-		return mmList.funcList(ctx, key)
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, keys...)
 	}
-	mmList.t.Fatalf("Unexpected call to ITokenRepositoryMock.List. %v %v", ctx, key)
+	mmDelete.t.Fatalf("Unexpected call to ITokenRepositoryMock.Delete. %v %v", ctx, keys)
 	return
 }
 
-// ListAfterCounter returns a count of finished ITokenRepositoryMock.List invocations
-func (mmList *ITokenRepositoryMock) ListAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+// DeleteAfterCounter returns a count of finished ITokenRepositoryMock.Delete invocations
+func (mmDelete *ITokenRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of ITokenRepositoryMock.Delete invocations
+func (mmDelete *ITokenRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mITokenRepositoryMockDelete) Calls() []*ITokenRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mITokenRepositoryMockDeleteRefreshTokens struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockDeleteRefreshTokensExpectation
+	expectations       []*ITokenRepositoryMockDeleteRefreshTokensExpectation
+
+	callArgs []*ITokenRepositoryMockDeleteRefreshTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockDeleteRefreshTokensExpectation specifies expectation struct of the ITokenRepository.DeleteRefreshTokens
+type ITokenRepositoryMockDeleteRefreshTokensExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockDeleteRefreshTokensParams
+	paramPtrs          *ITokenRepositoryMockDeleteRefreshTokensParamPtrs
+	expectationOrigins ITokenRepositoryMockDeleteRefreshTokensExpectationOrigins
+	results            *ITokenRepositoryMockDeleteRefreshTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockDeleteRefreshTokensParams contains parameters of the ITokenRepository.DeleteRefreshTokens
+type ITokenRepositoryMockDeleteRefreshTokensParams struct {
+	ctx context.Context
+	ids []string
+}
+
+// ITokenRepositoryMockDeleteRefreshTokensParamPtrs contains pointers to parameters of the ITokenRepository.DeleteRefreshTokens
+type ITokenRepositoryMockDeleteRefreshTokensParamPtrs struct {
+	ctx *context.Context
+	ids *[]string
+}
+
+// ITokenRepositoryMockDeleteRefreshTokensResults contains results of the ITokenRepository.DeleteRefreshTokens
+type ITokenRepositoryMockDeleteRefreshTokensResults struct {
+	err error
+}
+
+// ITokenRepositoryMockDeleteRefreshTokensOrigins contains origins of expectations of the ITokenRepository.DeleteRefreshTokens
+type ITokenRepositoryMockDeleteRefreshTokensExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) Optional() *mITokenRepositoryMockDeleteRefreshTokens {
+	mmDeleteRefreshTokens.optional = true
+	return mmDeleteRefreshTokens
+}
+
+// Expect sets up expected params for ITokenRepository.DeleteRefreshTokens
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) Expect(ctx context.Context, ids ...string) *mITokenRepositoryMockDeleteRefreshTokens {
+	if mmDeleteRefreshTokens.mock.funcDeleteRefreshTokens != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("ITokenRepositoryMock.DeleteRefreshTokens mock is already set by Set")
+	}
+
+	if mmDeleteRefreshTokens.defaultExpectation == nil {
+		mmDeleteRefreshTokens.defaultExpectation = &ITokenRepositoryMockDeleteRefreshTokensExpectation{}
+	}
+
+	if mmDeleteRefreshTokens.defaultExpectation.paramPtrs != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("ITokenRepositoryMock.DeleteRefreshTokens mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRefreshTokens.defaultExpectation.params = &ITokenRepositoryMockDeleteRefreshTokensParams{ctx, ids}
+	mmDeleteRefreshTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRefreshTokens.expectations {
+		if minimock.Equal(e.params, mmDeleteRefreshTokens.defaultExpectation.params) {
+			mmDeleteRefreshTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRefreshTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRefreshTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.DeleteRefreshTokens
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockDeleteRefreshTokens {
+	if mmDeleteRefreshTokens.mock.funcDeleteRefreshTokens != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("ITokenRepositoryMock.DeleteRefreshTokens mock is already set by Set")
+	}
+
+	if mmDeleteRefreshTokens.defaultExpectation == nil {
+		mmDeleteRefreshTokens.defaultExpectation = &ITokenRepositoryMockDeleteRefreshTokensExpectation{}
+	}
+
+	if mmDeleteRefreshTokens.defaultExpectation.params != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("ITokenRepositoryMock.DeleteRefreshTokens mock is already set by Expect")
+	}
+
+	if mmDeleteRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmDeleteRefreshTokens.defaultExpectation.paramPtrs = &ITokenRepositoryMockDeleteRefreshTokensParamPtrs{}
+	}
+	mmDeleteRefreshTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRefreshTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRefreshTokens
+}
+
+// ExpectIdsParam2 sets up expected param ids for ITokenRepository.DeleteRefreshTokens
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) ExpectIdsParam2(ids ...string) *mITokenRepositoryMockDeleteRefreshTokens {
+	if mmDeleteRefreshTokens.mock.funcDeleteRefreshTokens != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("ITokenRepositoryMock.DeleteRefreshTokens mock is already set by Set")
+	}
+
+	if mmDeleteRefreshTokens.defaultExpectation == nil {
+		mmDeleteRefreshTokens.defaultExpectation = &ITokenRepositoryMockDeleteRefreshTokensExpectation{}
+	}
+
+	if mmDeleteRefreshTokens.defaultExpectation.params != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("ITokenRepositoryMock.DeleteRefreshTokens mock is already set by Expect")
+	}
+
+	if mmDeleteRefreshTokens.defaultExpectation.paramPtrs == nil {
+		mmDeleteRefreshTokens.defaultExpectation.paramPtrs = &ITokenRepositoryMockDeleteRefreshTokensParamPtrs{}
+	}
+	mmDeleteRefreshTokens.defaultExpectation.paramPtrs.ids = &ids
+	mmDeleteRefreshTokens.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmDeleteRefreshTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.DeleteRefreshTokens
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) Inspect(f func(ctx context.Context, ids ...string)) *mITokenRepositoryMockDeleteRefreshTokens {
+	if mmDeleteRefreshTokens.mock.inspectFuncDeleteRefreshTokens != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.DeleteRefreshTokens")
+	}
+
+	mmDeleteRefreshTokens.mock.inspectFuncDeleteRefreshTokens = f
+
+	return mmDeleteRefreshTokens
+}
+
+// Return sets up results that will be returned by ITokenRepository.DeleteRefreshTokens
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) Return(err error) *ITokenRepositoryMock {
+	if mmDeleteRefreshTokens.mock.funcDeleteRefreshTokens != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("ITokenRepositoryMock.DeleteRefreshTokens mock is already set by Set")
+	}
+
+	if mmDeleteRefreshTokens.defaultExpectation == nil {
+		mmDeleteRefreshTokens.defaultExpectation = &ITokenRepositoryMockDeleteRefreshTokensExpectation{mock: mmDeleteRefreshTokens.mock}
+	}
+	mmDeleteRefreshTokens.defaultExpectation.results = &ITokenRepositoryMockDeleteRefreshTokensResults{err}
+	mmDeleteRefreshTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRefreshTokens.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.DeleteRefreshTokens method
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) Set(f func(ctx context.Context, ids ...string) (err error)) *ITokenRepositoryMock {
+	if mmDeleteRefreshTokens.defaultExpectation != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.DeleteRefreshTokens method")
+	}
+
+	if len(mmDeleteRefreshTokens.expectations) > 0 {
+		mmDeleteRefreshTokens.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.DeleteRefreshTokens method")
+	}
+
+	mmDeleteRefreshTokens.mock.funcDeleteRefreshTokens = f
+	mmDeleteRefreshTokens.mock.funcDeleteRefreshTokensOrigin = minimock.CallerInfo(1)
+	return mmDeleteRefreshTokens.mock
+}
+
+// When sets expectation for the ITokenRepository.DeleteRefreshTokens which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) When(ctx context.Context, ids ...string) *ITokenRepositoryMockDeleteRefreshTokensExpectation {
+	if mmDeleteRefreshTokens.mock.funcDeleteRefreshTokens != nil {
+		mmDeleteRefreshTokens.mock.t.Fatalf("ITokenRepositoryMock.DeleteRefreshTokens mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockDeleteRefreshTokensExpectation{
+		mock:               mmDeleteRefreshTokens.mock,
+		params:             &ITokenRepositoryMockDeleteRefreshTokensParams{ctx, ids},
+		expectationOrigins: ITokenRepositoryMockDeleteRefreshTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRefreshTokens.expectations = append(mmDeleteRefreshTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.DeleteRefreshTokens return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockDeleteRefreshTokensExpectation) Then(err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockDeleteRefreshTokensResults{err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.DeleteRefreshTokens should be invoked
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) Times(n uint64) *mITokenRepositoryMockDeleteRefreshTokens {
+	if n == 0 {
+		mmDeleteRefreshTokens.mock.t.Fatalf("Times of ITokenRepositoryMock.DeleteRefreshTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRefreshTokens.expectedInvocations, n)
+	mmDeleteRefreshTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRefreshTokens
+}
+
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) invocationsDone() bool {
+	if len(mmDeleteRefreshTokens.expectations) == 0 && mmDeleteRefreshTokens.defaultExpectation == nil && mmDeleteRefreshTokens.mock.funcDeleteRefreshTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRefreshTokens.mock.afterDeleteRefreshTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRefreshTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRefreshTokens implements mm_repository.ITokenRepository
+func (mmDeleteRefreshTokens *ITokenRepositoryMock) DeleteRefreshTokens(ctx context.Context, ids ...string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRefreshTokens.beforeDeleteRefreshTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRefreshTokens.afterDeleteRefreshTokensCounter, 1)
+
+	mmDeleteRefreshTokens.t.Helper()
+
+	if mmDeleteRefreshTokens.inspectFuncDeleteRefreshTokens != nil {
+		mmDeleteRefreshTokens.inspectFuncDeleteRefreshTokens(ctx, ids...)
+	}
+
+	mm_params := ITokenRepositoryMockDeleteRefreshTokensParams{ctx, ids}
+
+	// Record call args
+	mmDeleteRefreshTokens.DeleteRefreshTokensMock.mutex.Lock()
+	mmDeleteRefreshTokens.DeleteRefreshTokensMock.callArgs = append(mmDeleteRefreshTokens.DeleteRefreshTokensMock.callArgs, &mm_params)
+	mmDeleteRefreshTokens.DeleteRefreshTokensMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRefreshTokens.DeleteRefreshTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRefreshTokens.DeleteRefreshTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRefreshTokens.DeleteRefreshTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRefreshTokens.DeleteRefreshTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRefreshTokens.DeleteRefreshTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockDeleteRefreshTokensParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRefreshTokens.t.Errorf("ITokenRepositoryMock.DeleteRefreshTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRefreshTokens.DeleteRefreshTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmDeleteRefreshTokens.t.Errorf("ITokenRepositoryMock.DeleteRefreshTokens got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRefreshTokens.DeleteRefreshTokensMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRefreshTokens.t.Errorf("ITokenRepositoryMock.DeleteRefreshTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRefreshTokens.DeleteRefreshTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRefreshTokens.DeleteRefreshTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRefreshTokens.t.Fatal("No results are set for the ITokenRepositoryMock.DeleteRefreshTokens")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRefreshTokens.funcDeleteRefreshTokens != nil {
+		return mmDeleteRefreshTokens.funcDeleteRefreshTokens(ctx, ids...)
+	}
+	mmDeleteRefreshTokens.t.Fatalf("Unexpected call to ITokenRepositoryMock.DeleteRefreshTokens. %v %v", ctx, ids)
+	return
+}
+
+// DeleteRefreshTokensAfterCounter returns a count of finished ITokenRepositoryMock.DeleteRefreshTokens invocations
+func (mmDeleteRefreshTokens *ITokenRepositoryMock) DeleteRefreshTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRefreshTokens.afterDeleteRefreshTokensCounter)
+}
+
+// DeleteRefreshTokensBeforeCounter returns a count of ITokenRepositoryMock.DeleteRefreshTokens invocations
+func (mmDeleteRefreshTokens *ITokenRepositoryMock) DeleteRefreshTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRefreshTokens.beforeDeleteRefreshTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.DeleteRefreshTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRefreshTokens *mITokenRepositoryMockDeleteRefreshTokens) Calls() []*ITokenRepositoryMockDeleteRefreshTokensParams {
+	mmDeleteRefreshTokens.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockDeleteRefreshTokensParams, len(mmDeleteRefreshTokens.callArgs))
+	copy(argCopy, mmDeleteRefreshTokens.callArgs)
+
+	mmDeleteRefreshTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRefreshTokensDone returns true if the count of the DeleteRefreshTokens invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockDeleteRefreshTokensDone() bool {
+	if m.DeleteRefreshTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRefreshTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRefreshTokensMock.invocationsDone()
+}
+
+// MinimockDeleteRefreshTokensInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockDeleteRefreshTokensInspect() {
+	for _, e := range m.DeleteRefreshTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.DeleteRefreshTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRefreshTokensCounter := mm_atomic.LoadUint64(&m.afterDeleteRefreshTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRefreshTokensMock.defaultExpectation != nil && afterDeleteRefreshTokensCounter < 1 {
+		if m.DeleteRefreshTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.DeleteRefreshTokens at\n%s", m.DeleteRefreshTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.DeleteRefreshTokens at\n%s with params: %#v", m.DeleteRefreshTokensMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRefreshTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRefreshTokens != nil && afterDeleteRefreshTokensCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.DeleteRefreshTokens at\n%s", m.funcDeleteRefreshTokensOrigin)
+	}
+
+	if !m.DeleteRefreshTokensMock.invocationsDone() && afterDeleteRefreshTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.DeleteRefreshTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRefreshTokensMock.expectedInvocations), m.DeleteRefreshTokensMock.expectedInvocationsOrigin, afterDeleteRefreshTokensCounter)
+	}
+}
+
+type mITokenRepositoryMockDeleteSessions struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockDeleteSessionsExpectation
+	expectations       []*ITokenRepositoryMockDeleteSessionsExpectation
+
+	callArgs []*ITokenRepositoryMockDeleteSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockDeleteSessionsExpectation specifies expectation struct of the ITokenRepository.DeleteSessions
+type ITokenRepositoryMockDeleteSessionsExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockDeleteSessionsParams
+	paramPtrs          *ITokenRepositoryMockDeleteSessionsParamPtrs
+	expectationOrigins ITokenRepositoryMockDeleteSessionsExpectationOrigins
+	results            *ITokenRepositoryMockDeleteSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockDeleteSessionsParams contains parameters of the ITokenRepository.DeleteSessions
+type ITokenRepositoryMockDeleteSessionsParams struct {
+	ctx   context.Context
+	email string
+	ids   []string
+}
+
+// ITokenRepositoryMockDeleteSessionsParamPtrs contains pointers to parameters of the ITokenRepository.DeleteSessions
+type ITokenRepositoryMockDeleteSessionsParamPtrs struct {
+	ctx   *context.Context
+	email *string
+	ids   *[]string
+}
+
+// ITokenRepositoryMockDeleteSessionsResults contains results of the ITokenRepository.DeleteSessions
+type ITokenRepositoryMockDeleteSessionsResults struct {
+	err error
+}
+
+// ITokenRepositoryMockDeleteSessionsOrigins contains origins of expectations of the ITokenRepository.DeleteSessions
+type ITokenRepositoryMockDeleteSessionsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+	originIds   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) Optional() *mITokenRepositoryMockDeleteSessions {
+	mmDeleteSessions.optional = true
+	return mmDeleteSessions
+}
+
+// Expect sets up expected params for ITokenRepository.DeleteSessions
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) Expect(ctx context.Context, email string, ids ...string) *mITokenRepositoryMockDeleteSessions {
+	if mmDeleteSessions.mock.funcDeleteSessions != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by Set")
+	}
+
+	if mmDeleteSessions.defaultExpectation == nil {
+		mmDeleteSessions.defaultExpectation = &ITokenRepositoryMockDeleteSessionsExpectation{}
+	}
+
+	if mmDeleteSessions.defaultExpectation.paramPtrs != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSessions.defaultExpectation.params = &ITokenRepositoryMockDeleteSessionsParams{ctx, email, ids}
+	mmDeleteSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSessions.expectations {
+		if minimock.Equal(e.params, mmDeleteSessions.defaultExpectation.params) {
+			mmDeleteSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.DeleteSessions
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockDeleteSessions {
+	if mmDeleteSessions.mock.funcDeleteSessions != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by Set")
+	}
+
+	if mmDeleteSessions.defaultExpectation == nil {
+		mmDeleteSessions.defaultExpectation = &ITokenRepositoryMockDeleteSessionsExpectation{}
+	}
+
+	if mmDeleteSessions.defaultExpectation.params != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by Expect")
+	}
+
+	if mmDeleteSessions.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessions.defaultExpectation.paramPtrs = &ITokenRepositoryMockDeleteSessionsParamPtrs{}
+	}
+	mmDeleteSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSessions
+}
+
+// ExpectEmailParam2 sets up expected param email for ITokenRepository.DeleteSessions
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) ExpectEmailParam2(email string) *mITokenRepositoryMockDeleteSessions {
+	if mmDeleteSessions.mock.funcDeleteSessions != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by Set")
+	}
+
+	if mmDeleteSessions.defaultExpectation == nil {
+		mmDeleteSessions.defaultExpectation = &ITokenRepositoryMockDeleteSessionsExpectation{}
+	}
+
+	if mmDeleteSessions.defaultExpectation.params != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by Expect")
+	}
+
+	if mmDeleteSessions.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessions.defaultExpectation.paramPtrs = &ITokenRepositoryMockDeleteSessionsParamPtrs{}
+	}
+	mmDeleteSessions.defaultExpectation.paramPtrs.email = &email
+	mmDeleteSessions.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmDeleteSessions
+}
+
+// ExpectIdsParam3 sets up expected param ids for ITokenRepository.DeleteSessions
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) ExpectIdsParam3(ids ...string) *mITokenRepositoryMockDeleteSessions {
+	if mmDeleteSessions.mock.funcDeleteSessions != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by Set")
+	}
+
+	if mmDeleteSessions.defaultExpectation == nil {
+		mmDeleteSessions.defaultExpectation = &ITokenRepositoryMockDeleteSessionsExpectation{}
+	}
+
+	if mmDeleteSessions.defaultExpectation.params != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by Expect")
+	}
+
+	if mmDeleteSessions.defaultExpectation.paramPtrs == nil {
+		mmDeleteSessions.defaultExpectation.paramPtrs = &ITokenRepositoryMockDeleteSessionsParamPtrs{}
+	}
+	mmDeleteSessions.defaultExpectation.paramPtrs.ids = &ids
+	mmDeleteSessions.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmDeleteSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.DeleteSessions
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) Inspect(f func(ctx context.Context, email string, ids ...string)) *mITokenRepositoryMockDeleteSessions {
+	if mmDeleteSessions.mock.inspectFuncDeleteSessions != nil {
+		mmDeleteSessions.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.DeleteSessions")
+	}
+
+	mmDeleteSessions.mock.inspectFuncDeleteSessions = f
+
+	return mmDeleteSessions
+}
+
+// Return sets up results that will be returned by ITokenRepository.DeleteSessions
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) Return(err error) *ITokenRepositoryMock {
+	if mmDeleteSessions.mock.funcDeleteSessions != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by Set")
+	}
+
+	if mmDeleteSessions.defaultExpectation == nil {
+		mmDeleteSessions.defaultExpectation = &ITokenRepositoryMockDeleteSessionsExpectation{mock: mmDeleteSessions.mock}
+	}
+	mmDeleteSessions.defaultExpectation.results = &ITokenRepositoryMockDeleteSessionsResults{err}
+	mmDeleteSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessions.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.DeleteSessions method
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) Set(f func(ctx context.Context, email string, ids ...string) (err error)) *ITokenRepositoryMock {
+	if mmDeleteSessions.defaultExpectation != nil {
+		mmDeleteSessions.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.DeleteSessions method")
+	}
+
+	if len(mmDeleteSessions.expectations) > 0 {
+		mmDeleteSessions.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.DeleteSessions method")
+	}
+
+	mmDeleteSessions.mock.funcDeleteSessions = f
+	mmDeleteSessions.mock.funcDeleteSessionsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessions.mock
+}
+
+// When sets expectation for the ITokenRepository.DeleteSessions which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) When(ctx context.Context, email string, ids ...string) *ITokenRepositoryMockDeleteSessionsExpectation {
+	if mmDeleteSessions.mock.funcDeleteSessions != nil {
+		mmDeleteSessions.mock.t.Fatalf("ITokenRepositoryMock.DeleteSessions mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockDeleteSessionsExpectation{
+		mock:               mmDeleteSessions.mock,
+		params:             &ITokenRepositoryMockDeleteSessionsParams{ctx, email, ids},
+		expectationOrigins: ITokenRepositoryMockDeleteSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSessions.expectations = append(mmDeleteSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.DeleteSessions return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockDeleteSessionsExpectation) Then(err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockDeleteSessionsResults{err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.DeleteSessions should be invoked
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) Times(n uint64) *mITokenRepositoryMockDeleteSessions {
+	if n == 0 {
+		mmDeleteSessions.mock.t.Fatalf("Times of ITokenRepositoryMock.DeleteSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSessions.expectedInvocations, n)
+	mmDeleteSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSessions
+}
+
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) invocationsDone() bool {
+	if len(mmDeleteSessions.expectations) == 0 && mmDeleteSessions.defaultExpectation == nil && mmDeleteSessions.mock.funcDeleteSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSessions.mock.afterDeleteSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSessions implements mm_repository.ITokenRepository
+func (mmDeleteSessions *ITokenRepositoryMock) DeleteSessions(ctx context.Context, email string, ids ...string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSessions.beforeDeleteSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSessions.afterDeleteSessionsCounter, 1)
+
+	mmDeleteSessions.t.Helper()
+
+	if mmDeleteSessions.inspectFuncDeleteSessions != nil {
+		mmDeleteSessions.inspectFuncDeleteSessions(ctx, email, ids...)
+	}
+
+	mm_params := ITokenRepositoryMockDeleteSessionsParams{ctx, email, ids}
+
+	// Record call args
+	mmDeleteSessions.DeleteSessionsMock.mutex.Lock()
+	mmDeleteSessions.DeleteSessionsMock.callArgs = append(mmDeleteSessions.DeleteSessionsMock.callArgs, &mm_params)
+	mmDeleteSessions.DeleteSessionsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSessions.DeleteSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSessions.DeleteSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSessions.DeleteSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSessions.DeleteSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSessions.DeleteSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockDeleteSessionsParams{ctx, email, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSessions.t.Errorf("ITokenRepositoryMock.DeleteSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessions.DeleteSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmDeleteSessions.t.Errorf("ITokenRepositoryMock.DeleteSessions got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessions.DeleteSessionsMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmDeleteSessions.t.Errorf("ITokenRepositoryMock.DeleteSessions got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSessions.DeleteSessionsMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSessions.t.Errorf("ITokenRepositoryMock.DeleteSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSessions.DeleteSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSessions.DeleteSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSessions.t.Fatal("No results are set for the ITokenRepositoryMock.DeleteSessions")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSessions.funcDeleteSessions != nil {
+		return mmDeleteSessions.funcDeleteSessions(ctx, email, ids...)
+	}
+	mmDeleteSessions.t.Fatalf("Unexpected call to ITokenRepositoryMock.DeleteSessions. %v %v %v", ctx, email, ids)
+	return
+}
+
+// DeleteSessionsAfterCounter returns a count of finished ITokenRepositoryMock.DeleteSessions invocations
+func (mmDeleteSessions *ITokenRepositoryMock) DeleteSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessions.afterDeleteSessionsCounter)
+}
+
+// DeleteSessionsBeforeCounter returns a count of ITokenRepositoryMock.DeleteSessions invocations
+func (mmDeleteSessions *ITokenRepositoryMock) DeleteSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSessions.beforeDeleteSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.DeleteSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSessions *mITokenRepositoryMockDeleteSessions) Calls() []*ITokenRepositoryMockDeleteSessionsParams {
+	mmDeleteSessions.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockDeleteSessionsParams, len(mmDeleteSessions.callArgs))
+	copy(argCopy, mmDeleteSessions.callArgs)
+
+	mmDeleteSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSessionsDone returns true if the count of the DeleteSessions invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockDeleteSessionsDone() bool {
+	if m.DeleteSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSessionsMock.invocationsDone()
+}
+
+// MinimockDeleteSessionsInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockDeleteSessionsInspect() {
+	for _, e := range m.DeleteSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.DeleteSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSessionsCounter := mm_atomic.LoadUint64(&m.afterDeleteSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSessionsMock.defaultExpectation != nil && afterDeleteSessionsCounter < 1 {
+		if m.DeleteSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.DeleteSessions at\n%s", m.DeleteSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.DeleteSessions at\n%s with params: %#v", m.DeleteSessionsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSessions != nil && afterDeleteSessionsCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.DeleteSessions at\n%s", m.funcDeleteSessionsOrigin)
+	}
+
+	if !m.DeleteSessionsMock.invocationsDone() && afterDeleteSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.DeleteSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSessionsMock.expectedInvocations), m.DeleteSessionsMock.expectedInvocationsOrigin, afterDeleteSessionsCounter)
+	}
+}
+
+type mITokenRepositoryMockFamilyTokens struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockFamilyTokensExpectation
+	expectations       []*ITokenRepositoryMockFamilyTokensExpectation
+
+	callArgs []*ITokenRepositoryMockFamilyTokensParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockFamilyTokensExpectation specifies expectation struct of the ITokenRepository.FamilyTokens
+type ITokenRepositoryMockFamilyTokensExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockFamilyTokensParams
+	paramPtrs          *ITokenRepositoryMockFamilyTokensParamPtrs
+	expectationOrigins ITokenRepositoryMockFamilyTokensExpectationOrigins
+	results            *ITokenRepositoryMockFamilyTokensResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockFamilyTokensParams contains parameters of the ITokenRepository.FamilyTokens
+type ITokenRepositoryMockFamilyTokensParams struct {
+	ctx      context.Context
+	familyID string
+}
+
+// ITokenRepositoryMockFamilyTokensParamPtrs contains pointers to parameters of the ITokenRepository.FamilyTokens
+type ITokenRepositoryMockFamilyTokensParamPtrs struct {
+	ctx      *context.Context
+	familyID *string
+}
+
+// ITokenRepositoryMockFamilyTokensResults contains results of the ITokenRepository.FamilyTokens
+type ITokenRepositoryMockFamilyTokensResults struct {
+	sa1 []string
+	err error
+}
+
+// ITokenRepositoryMockFamilyTokensOrigins contains origins of expectations of the ITokenRepository.FamilyTokens
+type ITokenRepositoryMockFamilyTokensExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originFamilyID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) Optional() *mITokenRepositoryMockFamilyTokens {
+	mmFamilyTokens.optional = true
+	return mmFamilyTokens
+}
+
+// Expect sets up expected params for ITokenRepository.FamilyTokens
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) Expect(ctx context.Context, familyID string) *mITokenRepositoryMockFamilyTokens {
+	if mmFamilyTokens.mock.funcFamilyTokens != nil {
+		mmFamilyTokens.mock.t.Fatalf("ITokenRepositoryMock.FamilyTokens mock is already set by Set")
+	}
+
+	if mmFamilyTokens.defaultExpectation == nil {
+		mmFamilyTokens.defaultExpectation = &ITokenRepositoryMockFamilyTokensExpectation{}
+	}
+
+	if mmFamilyTokens.defaultExpectation.paramPtrs != nil {
+		mmFamilyTokens.mock.t.Fatalf("ITokenRepositoryMock.FamilyTokens mock is already set by ExpectParams functions")
+	}
+
+	mmFamilyTokens.defaultExpectation.params = &ITokenRepositoryMockFamilyTokensParams{ctx, familyID}
+	mmFamilyTokens.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFamilyTokens.expectations {
+		if minimock.Equal(e.params, mmFamilyTokens.defaultExpectation.params) {
+			mmFamilyTokens.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFamilyTokens.defaultExpectation.params)
+		}
+	}
+
+	return mmFamilyTokens
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.FamilyTokens
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockFamilyTokens {
+	if mmFamilyTokens.mock.funcFamilyTokens != nil {
+		mmFamilyTokens.mock.t.Fatalf("ITokenRepositoryMock.FamilyTokens mock is already set by Set")
+	}
+
+	if mmFamilyTokens.defaultExpectation == nil {
+		mmFamilyTokens.defaultExpectation = &ITokenRepositoryMockFamilyTokensExpectation{}
+	}
+
+	if mmFamilyTokens.defaultExpectation.params != nil {
+		mmFamilyTokens.mock.t.Fatalf("ITokenRepositoryMock.FamilyTokens mock is already set by Expect")
+	}
+
+	if mmFamilyTokens.defaultExpectation.paramPtrs == nil {
+		mmFamilyTokens.defaultExpectation.paramPtrs = &ITokenRepositoryMockFamilyTokensParamPtrs{}
+	}
+	mmFamilyTokens.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFamilyTokens.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFamilyTokens
+}
+
+// ExpectFamilyIDParam2 sets up expected param familyID for ITokenRepository.FamilyTokens
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) ExpectFamilyIDParam2(familyID string) *mITokenRepositoryMockFamilyTokens {
+	if mmFamilyTokens.mock.funcFamilyTokens != nil {
+		mmFamilyTokens.mock.t.Fatalf("ITokenRepositoryMock.FamilyTokens mock is already set by Set")
+	}
+
+	if mmFamilyTokens.defaultExpectation == nil {
+		mmFamilyTokens.defaultExpectation = &ITokenRepositoryMockFamilyTokensExpectation{}
+	}
+
+	if mmFamilyTokens.defaultExpectation.params != nil {
+		mmFamilyTokens.mock.t.Fatalf("ITokenRepositoryMock.FamilyTokens mock is already set by Expect")
+	}
+
+	if mmFamilyTokens.defaultExpectation.paramPtrs == nil {
+		mmFamilyTokens.defaultExpectation.paramPtrs = &ITokenRepositoryMockFamilyTokensParamPtrs{}
+	}
+	mmFamilyTokens.defaultExpectation.paramPtrs.familyID = &familyID
+	mmFamilyTokens.defaultExpectation.expectationOrigins.originFamilyID = minimock.CallerInfo(1)
+
+	return mmFamilyTokens
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.FamilyTokens
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) Inspect(f func(ctx context.Context, familyID string)) *mITokenRepositoryMockFamilyTokens {
+	if mmFamilyTokens.mock.inspectFuncFamilyTokens != nil {
+		mmFamilyTokens.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.FamilyTokens")
+	}
+
+	mmFamilyTokens.mock.inspectFuncFamilyTokens = f
+
+	return mmFamilyTokens
+}
+
+// Return sets up results that will be returned by ITokenRepository.FamilyTokens
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) Return(sa1 []string, err error) *ITokenRepositoryMock {
+	if mmFamilyTokens.mock.funcFamilyTokens != nil {
+		mmFamilyTokens.mock.t.Fatalf("ITokenRepositoryMock.FamilyTokens mock is already set by Set")
+	}
+
+	if mmFamilyTokens.defaultExpectation == nil {
+		mmFamilyTokens.defaultExpectation = &ITokenRepositoryMockFamilyTokensExpectation{mock: mmFamilyTokens.mock}
+	}
+	mmFamilyTokens.defaultExpectation.results = &ITokenRepositoryMockFamilyTokensResults{sa1, err}
+	mmFamilyTokens.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFamilyTokens.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.FamilyTokens method
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) Set(f func(ctx context.Context, familyID string) (sa1 []string, err error)) *ITokenRepositoryMock {
+	if mmFamilyTokens.defaultExpectation != nil {
+		mmFamilyTokens.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.FamilyTokens method")
+	}
+
+	if len(mmFamilyTokens.expectations) > 0 {
+		mmFamilyTokens.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.FamilyTokens method")
+	}
+
+	mmFamilyTokens.mock.funcFamilyTokens = f
+	mmFamilyTokens.mock.funcFamilyTokensOrigin = minimock.CallerInfo(1)
+	return mmFamilyTokens.mock
+}
+
+// When sets expectation for the ITokenRepository.FamilyTokens which will trigger the result defined by the following
+// Then helper
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) When(ctx context.Context, familyID string) *ITokenRepositoryMockFamilyTokensExpectation {
+	if mmFamilyTokens.mock.funcFamilyTokens != nil {
+		mmFamilyTokens.mock.t.Fatalf("ITokenRepositoryMock.FamilyTokens mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockFamilyTokensExpectation{
+		mock:               mmFamilyTokens.mock,
+		params:             &ITokenRepositoryMockFamilyTokensParams{ctx, familyID},
+		expectationOrigins: ITokenRepositoryMockFamilyTokensExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFamilyTokens.expectations = append(mmFamilyTokens.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.FamilyTokens return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockFamilyTokensExpectation) Then(sa1 []string, err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockFamilyTokensResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.FamilyTokens should be invoked
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) Times(n uint64) *mITokenRepositoryMockFamilyTokens {
+	if n == 0 {
+		mmFamilyTokens.mock.t.Fatalf("Times of ITokenRepositoryMock.FamilyTokens mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFamilyTokens.expectedInvocations, n)
+	mmFamilyTokens.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFamilyTokens
+}
+
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) invocationsDone() bool {
+	if len(mmFamilyTokens.expectations) == 0 && mmFamilyTokens.defaultExpectation == nil && mmFamilyTokens.mock.funcFamilyTokens == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFamilyTokens.mock.afterFamilyTokensCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFamilyTokens.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FamilyTokens implements mm_repository.ITokenRepository
+func (mmFamilyTokens *ITokenRepositoryMock) FamilyTokens(ctx context.Context, familyID string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmFamilyTokens.beforeFamilyTokensCounter, 1)
+	defer mm_atomic.AddUint64(&mmFamilyTokens.afterFamilyTokensCounter, 1)
+
+	mmFamilyTokens.t.Helper()
+
+	if mmFamilyTokens.inspectFuncFamilyTokens != nil {
+		mmFamilyTokens.inspectFuncFamilyTokens(ctx, familyID)
+	}
+
+	mm_params := ITokenRepositoryMockFamilyTokensParams{ctx, familyID}
+
+	// Record call args
+	mmFamilyTokens.FamilyTokensMock.mutex.Lock()
+	mmFamilyTokens.FamilyTokensMock.callArgs = append(mmFamilyTokens.FamilyTokensMock.callArgs, &mm_params)
+	mmFamilyTokens.FamilyTokensMock.mutex.Unlock()
+
+	for _, e := range mmFamilyTokens.FamilyTokensMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmFamilyTokens.FamilyTokensMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFamilyTokens.FamilyTokensMock.defaultExpectation.Counter, 1)
+		mm_want := mmFamilyTokens.FamilyTokensMock.defaultExpectation.params
+		mm_want_ptrs := mmFamilyTokens.FamilyTokensMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockFamilyTokensParams{ctx, familyID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFamilyTokens.t.Errorf("ITokenRepositoryMock.FamilyTokens got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFamilyTokens.FamilyTokensMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.familyID != nil && !minimock.Equal(*mm_want_ptrs.familyID, mm_got.familyID) {
+				mmFamilyTokens.t.Errorf("ITokenRepositoryMock.FamilyTokens got unexpected parameter familyID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFamilyTokens.FamilyTokensMock.defaultExpectation.expectationOrigins.originFamilyID, *mm_want_ptrs.familyID, mm_got.familyID, minimock.Diff(*mm_want_ptrs.familyID, mm_got.familyID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFamilyTokens.t.Errorf("ITokenRepositoryMock.FamilyTokens got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFamilyTokens.FamilyTokensMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFamilyTokens.FamilyTokensMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFamilyTokens.t.Fatal("No results are set for the ITokenRepositoryMock.FamilyTokens")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmFamilyTokens.funcFamilyTokens != nil {
+		return mmFamilyTokens.funcFamilyTokens(ctx, familyID)
+	}
+	mmFamilyTokens.t.Fatalf("Unexpected call to ITokenRepositoryMock.FamilyTokens. %v %v", ctx, familyID)
+	return
+}
+
+// FamilyTokensAfterCounter returns a count of finished ITokenRepositoryMock.FamilyTokens invocations
+func (mmFamilyTokens *ITokenRepositoryMock) FamilyTokensAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFamilyTokens.afterFamilyTokensCounter)
+}
+
+// FamilyTokensBeforeCounter returns a count of ITokenRepositoryMock.FamilyTokens invocations
+func (mmFamilyTokens *ITokenRepositoryMock) FamilyTokensBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFamilyTokens.beforeFamilyTokensCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.FamilyTokens.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFamilyTokens *mITokenRepositoryMockFamilyTokens) Calls() []*ITokenRepositoryMockFamilyTokensParams {
+	mmFamilyTokens.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockFamilyTokensParams, len(mmFamilyTokens.callArgs))
+	copy(argCopy, mmFamilyTokens.callArgs)
+
+	mmFamilyTokens.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFamilyTokensDone returns true if the count of the FamilyTokens invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockFamilyTokensDone() bool {
+	if m.FamilyTokensMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FamilyTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FamilyTokensMock.invocationsDone()
+}
+
+// MinimockFamilyTokensInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockFamilyTokensInspect() {
+	for _, e := range m.FamilyTokensMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.FamilyTokens at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFamilyTokensCounter := mm_atomic.LoadUint64(&m.afterFamilyTokensCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FamilyTokensMock.defaultExpectation != nil && afterFamilyTokensCounter < 1 {
+		if m.FamilyTokensMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.FamilyTokens at\n%s", m.FamilyTokensMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.FamilyTokens at\n%s with params: %#v", m.FamilyTokensMock.defaultExpectation.expectationOrigins.origin, *m.FamilyTokensMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFamilyTokens != nil && afterFamilyTokensCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.FamilyTokens at\n%s", m.funcFamilyTokensOrigin)
+	}
+
+	if !m.FamilyTokensMock.invocationsDone() && afterFamilyTokensCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.FamilyTokens at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FamilyTokensMock.expectedInvocations), m.FamilyTokensMock.expectedInvocationsOrigin, afterFamilyTokensCounter)
+	}
+}
+
+type mITokenRepositoryMockGet struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockGetExpectation
+	expectations       []*ITokenRepositoryMockGetExpectation
+
+	callArgs []*ITokenRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockGetExpectation specifies expectation struct of the ITokenRepository.Get
+type ITokenRepositoryMockGetExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockGetParams
+	paramPtrs          *ITokenRepositoryMockGetParamPtrs
+	expectationOrigins ITokenRepositoryMockGetExpectationOrigins
+	results            *ITokenRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockGetParams contains parameters of the ITokenRepository.Get
+type ITokenRepositoryMockGetParams struct {
+	ctx context.Context
+	key string
+}
+
+// ITokenRepositoryMockGetParamPtrs contains pointers to parameters of the ITokenRepository.Get
+type ITokenRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// ITokenRepositoryMockGetResults contains results of the ITokenRepository.Get
+type ITokenRepositoryMockGetResults struct {
+	s1  string
+	err error
+}
+
+// ITokenRepositoryMockGetOrigins contains origins of expectations of the ITokenRepository.Get
+type ITokenRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mITokenRepositoryMockGet) Optional() *mITokenRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for ITokenRepository.Get
+func (mmGet *mITokenRepositoryMockGet) Expect(ctx context.Context, key string) *mITokenRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ITokenRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &ITokenRepositoryMockGetParams{ctx, key}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.Get
+func (mmGet *mITokenRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ITokenRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectKeyParam2 sets up expected param key for ITokenRepository.Get
+func (mmGet *mITokenRepositoryMockGet) ExpectKeyParam2(key string) *mITokenRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ITokenRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.key = &key
+	mmGet.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.Get
+func (mmGet *mITokenRepositoryMockGet) Inspect(f func(ctx context.Context, key string)) *mITokenRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by ITokenRepository.Get
+func (mmGet *mITokenRepositoryMockGet) Return(s1 string, err error) *ITokenRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &ITokenRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &ITokenRepositoryMockGetResults{s1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.Get method
+func (mmGet *mITokenRepositoryMockGet) Set(f func(ctx context.Context, key string) (s1 string, err error)) *ITokenRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the ITokenRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mITokenRepositoryMockGet) When(ctx context.Context, key string) *ITokenRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("ITokenRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &ITokenRepositoryMockGetParams{ctx, key},
+		expectationOrigins: ITokenRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.Get return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockGetExpectation) Then(s1 string, err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockGetResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.Get should be invoked
+func (mmGet *mITokenRepositoryMockGet) Times(n uint64) *mITokenRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of ITokenRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mITokenRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.ITokenRepository
+func (mmGet *ITokenRepositoryMock) Get(ctx context.Context, key string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, key)
+	}
+
+	mm_params := ITokenRepositoryMockGetParams{ctx, key}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockGetParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("ITokenRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGet.t.Errorf("ITokenRepositoryMock.Get got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("ITokenRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the ITokenRepositoryMock.Get")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, key)
+	}
+	mmGet.t.Fatalf("Unexpected call to ITokenRepositoryMock.Get. %v %v", ctx, key)
+	return
+}
+
+// GetAfterCounter returns a count of finished ITokenRepositoryMock.Get invocations
+func (mmGet *ITokenRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of ITokenRepositoryMock.Get invocations
+func (mmGet *ITokenRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mITokenRepositoryMockGet) Calls() []*ITokenRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mITokenRepositoryMockGetDel struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockGetDelExpectation
+	expectations       []*ITokenRepositoryMockGetDelExpectation
+
+	callArgs []*ITokenRepositoryMockGetDelParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockGetDelExpectation specifies expectation struct of the ITokenRepository.GetDel
+type ITokenRepositoryMockGetDelExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockGetDelParams
+	paramPtrs          *ITokenRepositoryMockGetDelParamPtrs
+	expectationOrigins ITokenRepositoryMockGetDelExpectationOrigins
+	results            *ITokenRepositoryMockGetDelResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockGetDelParams contains parameters of the ITokenRepository.GetDel
+type ITokenRepositoryMockGetDelParams struct {
+	ctx context.Context
+	key string
+}
+
+// ITokenRepositoryMockGetDelParamPtrs contains pointers to parameters of the ITokenRepository.GetDel
+type ITokenRepositoryMockGetDelParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// ITokenRepositoryMockGetDelResults contains results of the ITokenRepository.GetDel
+type ITokenRepositoryMockGetDelResults struct {
+	s1  string
+	err error
+}
+
+// ITokenRepositoryMockGetDelOrigins contains origins of expectations of the ITokenRepository.GetDel
+type ITokenRepositoryMockGetDelExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDel *mITokenRepositoryMockGetDel) Optional() *mITokenRepositoryMockGetDel {
+	mmGetDel.optional = true
+	return mmGetDel
+}
+
+// Expect sets up expected params for ITokenRepository.GetDel
+func (mmGetDel *mITokenRepositoryMockGetDel) Expect(ctx context.Context, key string) *mITokenRepositoryMockGetDel {
+	if mmGetDel.mock.funcGetDel != nil {
+		mmGetDel.mock.t.Fatalf("ITokenRepositoryMock.GetDel mock is already set by Set")
+	}
+
+	if mmGetDel.defaultExpectation == nil {
+		mmGetDel.defaultExpectation = &ITokenRepositoryMockGetDelExpectation{}
+	}
+
+	if mmGetDel.defaultExpectation.paramPtrs != nil {
+		mmGetDel.mock.t.Fatalf("ITokenRepositoryMock.GetDel mock is already set by ExpectParams functions")
+	}
+
+	mmGetDel.defaultExpectation.params = &ITokenRepositoryMockGetDelParams{ctx, key}
+	mmGetDel.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDel.expectations {
+		if minimock.Equal(e.params, mmGetDel.defaultExpectation.params) {
+			mmGetDel.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDel.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDel
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.GetDel
+func (mmGetDel *mITokenRepositoryMockGetDel) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockGetDel {
+	if mmGetDel.mock.funcGetDel != nil {
+		mmGetDel.mock.t.Fatalf("ITokenRepositoryMock.GetDel mock is already set by Set")
+	}
+
+	if mmGetDel.defaultExpectation == nil {
+		mmGetDel.defaultExpectation = &ITokenRepositoryMockGetDelExpectation{}
+	}
+
+	if mmGetDel.defaultExpectation.params != nil {
+		mmGetDel.mock.t.Fatalf("ITokenRepositoryMock.GetDel mock is already set by Expect")
+	}
+
+	if mmGetDel.defaultExpectation.paramPtrs == nil {
+		mmGetDel.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetDelParamPtrs{}
+	}
+	mmGetDel.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDel.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDel
+}
+
+// ExpectKeyParam2 sets up expected param key for ITokenRepository.GetDel
+func (mmGetDel *mITokenRepositoryMockGetDel) ExpectKeyParam2(key string) *mITokenRepositoryMockGetDel {
+	if mmGetDel.mock.funcGetDel != nil {
+		mmGetDel.mock.t.Fatalf("ITokenRepositoryMock.GetDel mock is already set by Set")
+	}
+
+	if mmGetDel.defaultExpectation == nil {
+		mmGetDel.defaultExpectation = &ITokenRepositoryMockGetDelExpectation{}
+	}
+
+	if mmGetDel.defaultExpectation.params != nil {
+		mmGetDel.mock.t.Fatalf("ITokenRepositoryMock.GetDel mock is already set by Expect")
+	}
+
+	if mmGetDel.defaultExpectation.paramPtrs == nil {
+		mmGetDel.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetDelParamPtrs{}
+	}
+	mmGetDel.defaultExpectation.paramPtrs.key = &key
+	mmGetDel.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmGetDel
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.GetDel
+func (mmGetDel *mITokenRepositoryMockGetDel) Inspect(f func(ctx context.Context, key string)) *mITokenRepositoryMockGetDel {
+	if mmGetDel.mock.inspectFuncGetDel != nil {
+		mmGetDel.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.GetDel")
+	}
+
+	mmGetDel.mock.inspectFuncGetDel = f
+
+	return mmGetDel
+}
+
+// Return sets up results that will be returned by ITokenRepository.GetDel
+func (mmGetDel *mITokenRepositoryMockGetDel) Return(s1 string, err error) *ITokenRepositoryMock {
+	if mmGetDel.mock.funcGetDel != nil {
+		mmGetDel.mock.t.Fatalf("ITokenRepositoryMock.GetDel mock is already set by Set")
+	}
+
+	if mmGetDel.defaultExpectation == nil {
+		mmGetDel.defaultExpectation = &ITokenRepositoryMockGetDelExpectation{mock: mmGetDel.mock}
+	}
+	mmGetDel.defaultExpectation.results = &ITokenRepositoryMockGetDelResults{s1, err}
+	mmGetDel.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDel.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.GetDel method
+func (mmGetDel *mITokenRepositoryMockGetDel) Set(f func(ctx context.Context, key string) (s1 string, err error)) *ITokenRepositoryMock {
+	if mmGetDel.defaultExpectation != nil {
+		mmGetDel.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.GetDel method")
+	}
+
+	if len(mmGetDel.expectations) > 0 {
+		mmGetDel.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.GetDel method")
+	}
+
+	mmGetDel.mock.funcGetDel = f
+	mmGetDel.mock.funcGetDelOrigin = minimock.CallerInfo(1)
+	return mmGetDel.mock
+}
+
+// When sets expectation for the ITokenRepository.GetDel which will trigger the result defined by the following
+// Then helper
+func (mmGetDel *mITokenRepositoryMockGetDel) When(ctx context.Context, key string) *ITokenRepositoryMockGetDelExpectation {
+	if mmGetDel.mock.funcGetDel != nil {
+		mmGetDel.mock.t.Fatalf("ITokenRepositoryMock.GetDel mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockGetDelExpectation{
+		mock:               mmGetDel.mock,
+		params:             &ITokenRepositoryMockGetDelParams{ctx, key},
+		expectationOrigins: ITokenRepositoryMockGetDelExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDel.expectations = append(mmGetDel.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.GetDel return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockGetDelExpectation) Then(s1 string, err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockGetDelResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.GetDel should be invoked
+func (mmGetDel *mITokenRepositoryMockGetDel) Times(n uint64) *mITokenRepositoryMockGetDel {
+	if n == 0 {
+		mmGetDel.mock.t.Fatalf("Times of ITokenRepositoryMock.GetDel mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDel.expectedInvocations, n)
+	mmGetDel.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDel
+}
+
+func (mmGetDel *mITokenRepositoryMockGetDel) invocationsDone() bool {
+	if len(mmGetDel.expectations) == 0 && mmGetDel.defaultExpectation == nil && mmGetDel.mock.funcGetDel == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDel.mock.afterGetDelCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDel.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDel implements mm_repository.ITokenRepository
+func (mmGetDel *ITokenRepositoryMock) GetDel(ctx context.Context, key string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetDel.beforeGetDelCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDel.afterGetDelCounter, 1)
+
+	mmGetDel.t.Helper()
+
+	if mmGetDel.inspectFuncGetDel != nil {
+		mmGetDel.inspectFuncGetDel(ctx, key)
+	}
+
+	mm_params := ITokenRepositoryMockGetDelParams{ctx, key}
+
+	// Record call args
+	mmGetDel.GetDelMock.mutex.Lock()
+	mmGetDel.GetDelMock.callArgs = append(mmGetDel.GetDelMock.callArgs, &mm_params)
+	mmGetDel.GetDelMock.mutex.Unlock()
+
+	for _, e := range mmGetDel.GetDelMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetDel.GetDelMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDel.GetDelMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDel.GetDelMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDel.GetDelMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockGetDelParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDel.t.Errorf("ITokenRepositoryMock.GetDel got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDel.GetDelMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmGetDel.t.Errorf("ITokenRepositoryMock.GetDel got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDel.GetDelMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDel.t.Errorf("ITokenRepositoryMock.GetDel got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDel.GetDelMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDel.GetDelMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDel.t.Fatal("No results are set for the ITokenRepositoryMock.GetDel")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetDel.funcGetDel != nil {
+		return mmGetDel.funcGetDel(ctx, key)
+	}
+	mmGetDel.t.Fatalf("Unexpected call to ITokenRepositoryMock.GetDel. %v %v", ctx, key)
+	return
+}
+
+// GetDelAfterCounter returns a count of finished ITokenRepositoryMock.GetDel invocations
+func (mmGetDel *ITokenRepositoryMock) GetDelAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDel.afterGetDelCounter)
+}
+
+// GetDelBeforeCounter returns a count of ITokenRepositoryMock.GetDel invocations
+func (mmGetDel *ITokenRepositoryMock) GetDelBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDel.beforeGetDelCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.GetDel.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDel *mITokenRepositoryMockGetDel) Calls() []*ITokenRepositoryMockGetDelParams {
+	mmGetDel.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockGetDelParams, len(mmGetDel.callArgs))
+	copy(argCopy, mmGetDel.callArgs)
+
+	mmGetDel.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDelDone returns true if the count of the GetDel invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockGetDelDone() bool {
+	if m.GetDelMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDelMock.invocationsDone()
+}
+
+// MinimockGetDelInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockGetDelInspect() {
+	for _, e := range m.GetDelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.GetDel at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDelCounter := mm_atomic.LoadUint64(&m.afterGetDelCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDelMock.defaultExpectation != nil && afterGetDelCounter < 1 {
+		if m.GetDelMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.GetDel at\n%s", m.GetDelMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.GetDel at\n%s with params: %#v", m.GetDelMock.defaultExpectation.expectationOrigins.origin, *m.GetDelMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDel != nil && afterGetDelCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.GetDel at\n%s", m.funcGetDelOrigin)
+	}
+
+	if !m.GetDelMock.invocationsDone() && afterGetDelCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.GetDel at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDelMock.expectedInvocations), m.GetDelMock.expectedInvocationsOrigin, afterGetDelCounter)
+	}
+}
+
+type mITokenRepositoryMockGetRefreshToken struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockGetRefreshTokenExpectation
+	expectations       []*ITokenRepositoryMockGetRefreshTokenExpectation
+
+	callArgs []*ITokenRepositoryMockGetRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockGetRefreshTokenExpectation specifies expectation struct of the ITokenRepository.GetRefreshToken
+type ITokenRepositoryMockGetRefreshTokenExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockGetRefreshTokenParams
+	paramPtrs          *ITokenRepositoryMockGetRefreshTokenParamPtrs
+	expectationOrigins ITokenRepositoryMockGetRefreshTokenExpectationOrigins
+	results            *ITokenRepositoryMockGetRefreshTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockGetRefreshTokenParams contains parameters of the ITokenRepository.GetRefreshToken
+type ITokenRepositoryMockGetRefreshTokenParams struct {
+	ctx context.Context
+	id  string
+}
+
+// ITokenRepositoryMockGetRefreshTokenParamPtrs contains pointers to parameters of the ITokenRepository.GetRefreshToken
+type ITokenRepositoryMockGetRefreshTokenParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// ITokenRepositoryMockGetRefreshTokenResults contains results of the ITokenRepository.GetRefreshToken
+type ITokenRepositoryMockGetRefreshTokenResults struct {
+	r1  domain.RefreshToken
+	err error
+}
+
+// ITokenRepositoryMockGetRefreshTokenOrigins contains origins of expectations of the ITokenRepository.GetRefreshToken
+type ITokenRepositoryMockGetRefreshTokenExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) Optional() *mITokenRepositoryMockGetRefreshToken {
+	mmGetRefreshToken.optional = true
+	return mmGetRefreshToken
+}
+
+// Expect sets up expected params for ITokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) Expect(ctx context.Context, id string) *mITokenRepositoryMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &ITokenRepositoryMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmGetRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.GetRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmGetRefreshToken.defaultExpectation.params = &ITokenRepositoryMockGetRefreshTokenParams{ctx, id}
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRefreshToken.expectations {
+		if minimock.Equal(e.params, mmGetRefreshToken.defaultExpectation.params) {
+			mmGetRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &ITokenRepositoryMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRefreshToken
+}
+
+// ExpectIdParam2 sets up expected param id for ITokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) ExpectIdParam2(id string) *mITokenRepositoryMockGetRefreshToken {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &ITokenRepositoryMockGetRefreshTokenExpectation{}
+	}
+
+	if mmGetRefreshToken.defaultExpectation.params != nil {
+		mmGetRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.GetRefreshToken mock is already set by Expect")
+	}
+
+	if mmGetRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmGetRefreshToken.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetRefreshTokenParamPtrs{}
+	}
+	mmGetRefreshToken.defaultExpectation.paramPtrs.id = &id
+	mmGetRefreshToken.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) Inspect(f func(ctx context.Context, id string)) *mITokenRepositoryMockGetRefreshToken {
+	if mmGetRefreshToken.mock.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.GetRefreshToken")
+	}
+
+	mmGetRefreshToken.mock.inspectFuncGetRefreshToken = f
+
+	return mmGetRefreshToken
+}
+
+// Return sets up results that will be returned by ITokenRepository.GetRefreshToken
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) Return(r1 domain.RefreshToken, err error) *ITokenRepositoryMock {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	if mmGetRefreshToken.defaultExpectation == nil {
+		mmGetRefreshToken.defaultExpectation = &ITokenRepositoryMockGetRefreshTokenExpectation{mock: mmGetRefreshToken.mock}
+	}
+	mmGetRefreshToken.defaultExpectation.results = &ITokenRepositoryMockGetRefreshTokenResults{r1, err}
+	mmGetRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.GetRefreshToken method
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) Set(f func(ctx context.Context, id string) (r1 domain.RefreshToken, err error)) *ITokenRepositoryMock {
+	if mmGetRefreshToken.defaultExpectation != nil {
+		mmGetRefreshToken.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.GetRefreshToken method")
+	}
+
+	if len(mmGetRefreshToken.expectations) > 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.GetRefreshToken method")
+	}
+
+	mmGetRefreshToken.mock.funcGetRefreshToken = f
+	mmGetRefreshToken.mock.funcGetRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken.mock
+}
+
+// When sets expectation for the ITokenRepository.GetRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) When(ctx context.Context, id string) *ITokenRepositoryMockGetRefreshTokenExpectation {
+	if mmGetRefreshToken.mock.funcGetRefreshToken != nil {
+		mmGetRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.GetRefreshToken mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockGetRefreshTokenExpectation{
+		mock:               mmGetRefreshToken.mock,
+		params:             &ITokenRepositoryMockGetRefreshTokenParams{ctx, id},
+		expectationOrigins: ITokenRepositoryMockGetRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRefreshToken.expectations = append(mmGetRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.GetRefreshToken return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockGetRefreshTokenExpectation) Then(r1 domain.RefreshToken, err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockGetRefreshTokenResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.GetRefreshToken should be invoked
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) Times(n uint64) *mITokenRepositoryMockGetRefreshToken {
+	if n == 0 {
+		mmGetRefreshToken.mock.t.Fatalf("Times of ITokenRepositoryMock.GetRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRefreshToken.expectedInvocations, n)
+	mmGetRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRefreshToken
+}
+
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) invocationsDone() bool {
+	if len(mmGetRefreshToken.expectations) == 0 && mmGetRefreshToken.defaultExpectation == nil && mmGetRefreshToken.mock.funcGetRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.mock.afterGetRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRefreshToken implements mm_repository.ITokenRepository
+func (mmGetRefreshToken *ITokenRepositoryMock) GetRefreshToken(ctx context.Context, id string) (r1 domain.RefreshToken, err error) {
+	mm_atomic.AddUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter, 1)
+
+	mmGetRefreshToken.t.Helper()
+
+	if mmGetRefreshToken.inspectFuncGetRefreshToken != nil {
+		mmGetRefreshToken.inspectFuncGetRefreshToken(ctx, id)
+	}
+
+	mm_params := ITokenRepositoryMockGetRefreshTokenParams{ctx, id}
+
+	// Record call args
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Lock()
+	mmGetRefreshToken.GetRefreshTokenMock.callArgs = append(mmGetRefreshToken.GetRefreshTokenMock.callArgs, &mm_params)
+	mmGetRefreshToken.GetRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmGetRefreshToken.GetRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockGetRefreshTokenParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRefreshToken.t.Errorf("ITokenRepositoryMock.GetRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetRefreshToken.t.Errorf("ITokenRepositoryMock.GetRefreshToken got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRefreshToken.t.Errorf("ITokenRepositoryMock.GetRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRefreshToken.GetRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRefreshToken.t.Fatal("No results are set for the ITokenRepositoryMock.GetRefreshToken")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetRefreshToken.funcGetRefreshToken != nil {
+		return mmGetRefreshToken.funcGetRefreshToken(ctx, id)
+	}
+	mmGetRefreshToken.t.Fatalf("Unexpected call to ITokenRepositoryMock.GetRefreshToken. %v %v", ctx, id)
+	return
+}
+
+// GetRefreshTokenAfterCounter returns a count of finished ITokenRepositoryMock.GetRefreshToken invocations
+func (mmGetRefreshToken *ITokenRepositoryMock) GetRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.afterGetRefreshTokenCounter)
+}
+
+// GetRefreshTokenBeforeCounter returns a count of ITokenRepositoryMock.GetRefreshToken invocations
+func (mmGetRefreshToken *ITokenRepositoryMock) GetRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefreshToken.beforeGetRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.GetRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRefreshToken *mITokenRepositoryMockGetRefreshToken) Calls() []*ITokenRepositoryMockGetRefreshTokenParams {
+	mmGetRefreshToken.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockGetRefreshTokenParams, len(mmGetRefreshToken.callArgs))
+	copy(argCopy, mmGetRefreshToken.callArgs)
+
+	mmGetRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRefreshTokenDone returns true if the count of the GetRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockGetRefreshTokenDone() bool {
+	if m.GetRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRefreshTokenMock.invocationsDone()
+}
+
+// MinimockGetRefreshTokenInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockGetRefreshTokenInspect() {
+	for _, e := range m.GetRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.GetRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterGetRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRefreshTokenMock.defaultExpectation != nil && afterGetRefreshTokenCounter < 1 {
+		if m.GetRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.GetRefreshToken at\n%s", m.GetRefreshTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.GetRefreshToken at\n%s with params: %#v", m.GetRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.GetRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRefreshToken != nil && afterGetRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.GetRefreshToken at\n%s", m.funcGetRefreshTokenOrigin)
+	}
+
+	if !m.GetRefreshTokenMock.invocationsDone() && afterGetRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.GetRefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRefreshTokenMock.expectedInvocations), m.GetRefreshTokenMock.expectedInvocationsOrigin, afterGetRefreshTokenCounter)
+	}
+}
+
+type mITokenRepositoryMockGetSession struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockGetSessionExpectation
+	expectations       []*ITokenRepositoryMockGetSessionExpectation
+
+	callArgs []*ITokenRepositoryMockGetSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockGetSessionExpectation specifies expectation struct of the ITokenRepository.GetSession
+type ITokenRepositoryMockGetSessionExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockGetSessionParams
+	paramPtrs          *ITokenRepositoryMockGetSessionParamPtrs
+	expectationOrigins ITokenRepositoryMockGetSessionExpectationOrigins
+	results            *ITokenRepositoryMockGetSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockGetSessionParams contains parameters of the ITokenRepository.GetSession
+type ITokenRepositoryMockGetSessionParams struct {
+	ctx context.Context
+	id  string
+}
+
+// ITokenRepositoryMockGetSessionParamPtrs contains pointers to parameters of the ITokenRepository.GetSession
+type ITokenRepositoryMockGetSessionParamPtrs struct {
+	ctx *context.Context
+	id  *string
+}
+
+// ITokenRepositoryMockGetSessionResults contains results of the ITokenRepository.GetSession
+type ITokenRepositoryMockGetSessionResults struct {
+	s1  domain.Session
+	err error
+}
+
+// ITokenRepositoryMockGetSessionOrigins contains origins of expectations of the ITokenRepository.GetSession
+type ITokenRepositoryMockGetSessionExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSession *mITokenRepositoryMockGetSession) Optional() *mITokenRepositoryMockGetSession {
+	mmGetSession.optional = true
+	return mmGetSession
+}
+
+// Expect sets up expected params for ITokenRepository.GetSession
+func (mmGetSession *mITokenRepositoryMockGetSession) Expect(ctx context.Context, id string) *mITokenRepositoryMockGetSession {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("ITokenRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	if mmGetSession.defaultExpectation == nil {
+		mmGetSession.defaultExpectation = &ITokenRepositoryMockGetSessionExpectation{}
+	}
+
+	if mmGetSession.defaultExpectation.paramPtrs != nil {
+		mmGetSession.mock.t.Fatalf("ITokenRepositoryMock.GetSession mock is already set by ExpectParams functions")
+	}
+
+	mmGetSession.defaultExpectation.params = &ITokenRepositoryMockGetSessionParams{ctx, id}
+	mmGetSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSession.expectations {
+		if minimock.Equal(e.params, mmGetSession.defaultExpectation.params) {
+			mmGetSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSession.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.GetSession
+func (mmGetSession *mITokenRepositoryMockGetSession) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockGetSession {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("ITokenRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	if mmGetSession.defaultExpectation == nil {
+		mmGetSession.defaultExpectation = &ITokenRepositoryMockGetSessionExpectation{}
+	}
+
+	if mmGetSession.defaultExpectation.params != nil {
+		mmGetSession.mock.t.Fatalf("ITokenRepositoryMock.GetSession mock is already set by Expect")
+	}
+
+	if mmGetSession.defaultExpectation.paramPtrs == nil {
+		mmGetSession.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetSessionParamPtrs{}
+	}
+	mmGetSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSession
+}
+
+// ExpectIdParam2 sets up expected param id for ITokenRepository.GetSession
+func (mmGetSession *mITokenRepositoryMockGetSession) ExpectIdParam2(id string) *mITokenRepositoryMockGetSession {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("ITokenRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	if mmGetSession.defaultExpectation == nil {
+		mmGetSession.defaultExpectation = &ITokenRepositoryMockGetSessionExpectation{}
+	}
+
+	if mmGetSession.defaultExpectation.params != nil {
+		mmGetSession.mock.t.Fatalf("ITokenRepositoryMock.GetSession mock is already set by Expect")
+	}
+
+	if mmGetSession.defaultExpectation.paramPtrs == nil {
+		mmGetSession.defaultExpectation.paramPtrs = &ITokenRepositoryMockGetSessionParamPtrs{}
+	}
+	mmGetSession.defaultExpectation.paramPtrs.id = &id
+	mmGetSession.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.GetSession
+func (mmGetSession *mITokenRepositoryMockGetSession) Inspect(f func(ctx context.Context, id string)) *mITokenRepositoryMockGetSession {
+	if mmGetSession.mock.inspectFuncGetSession != nil {
+		mmGetSession.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.GetSession")
+	}
+
+	mmGetSession.mock.inspectFuncGetSession = f
+
+	return mmGetSession
+}
+
+// Return sets up results that will be returned by ITokenRepository.GetSession
+func (mmGetSession *mITokenRepositoryMockGetSession) Return(s1 domain.Session, err error) *ITokenRepositoryMock {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("ITokenRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	if mmGetSession.defaultExpectation == nil {
+		mmGetSession.defaultExpectation = &ITokenRepositoryMockGetSessionExpectation{mock: mmGetSession.mock}
+	}
+	mmGetSession.defaultExpectation.results = &ITokenRepositoryMockGetSessionResults{s1, err}
+	mmGetSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSession.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.GetSession method
+func (mmGetSession *mITokenRepositoryMockGetSession) Set(f func(ctx context.Context, id string) (s1 domain.Session, err error)) *ITokenRepositoryMock {
+	if mmGetSession.defaultExpectation != nil {
+		mmGetSession.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.GetSession method")
+	}
+
+	if len(mmGetSession.expectations) > 0 {
+		mmGetSession.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.GetSession method")
+	}
+
+	mmGetSession.mock.funcGetSession = f
+	mmGetSession.mock.funcGetSessionOrigin = minimock.CallerInfo(1)
+	return mmGetSession.mock
+}
+
+// When sets expectation for the ITokenRepository.GetSession which will trigger the result defined by the following
+// Then helper
+func (mmGetSession *mITokenRepositoryMockGetSession) When(ctx context.Context, id string) *ITokenRepositoryMockGetSessionExpectation {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("ITokenRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockGetSessionExpectation{
+		mock:               mmGetSession.mock,
+		params:             &ITokenRepositoryMockGetSessionParams{ctx, id},
+		expectationOrigins: ITokenRepositoryMockGetSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSession.expectations = append(mmGetSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.GetSession return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockGetSessionExpectation) Then(s1 domain.Session, err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockGetSessionResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.GetSession should be invoked
+func (mmGetSession *mITokenRepositoryMockGetSession) Times(n uint64) *mITokenRepositoryMockGetSession {
+	if n == 0 {
+		mmGetSession.mock.t.Fatalf("Times of ITokenRepositoryMock.GetSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSession.expectedInvocations, n)
+	mmGetSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSession
+}
+
+func (mmGetSession *mITokenRepositoryMockGetSession) invocationsDone() bool {
+	if len(mmGetSession.expectations) == 0 && mmGetSession.defaultExpectation == nil && mmGetSession.mock.funcGetSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSession.mock.afterGetSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSession implements mm_repository.ITokenRepository
+func (mmGetSession *ITokenRepositoryMock) GetSession(ctx context.Context, id string) (s1 domain.Session, err error) {
+	mm_atomic.AddUint64(&mmGetSession.beforeGetSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSession.afterGetSessionCounter, 1)
+
+	mmGetSession.t.Helper()
+
+	if mmGetSession.inspectFuncGetSession != nil {
+		mmGetSession.inspectFuncGetSession(ctx, id)
+	}
+
+	mm_params := ITokenRepositoryMockGetSessionParams{ctx, id}
+
+	// Record call args
+	mmGetSession.GetSessionMock.mutex.Lock()
+	mmGetSession.GetSessionMock.callArgs = append(mmGetSession.GetSessionMock.callArgs, &mm_params)
+	mmGetSession.GetSessionMock.mutex.Unlock()
+
+	for _, e := range mmGetSession.GetSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetSession.GetSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSession.GetSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSession.GetSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSession.GetSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockGetSessionParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSession.t.Errorf("ITokenRepositoryMock.GetSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSession.GetSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetSession.t.Errorf("ITokenRepositoryMock.GetSession got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSession.GetSessionMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSession.t.Errorf("ITokenRepositoryMock.GetSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSession.GetSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSession.GetSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSession.t.Fatal("No results are set for the ITokenRepositoryMock.GetSession")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetSession.funcGetSession != nil {
+		return mmGetSession.funcGetSession(ctx, id)
+	}
+	mmGetSession.t.Fatalf("Unexpected call to ITokenRepositoryMock.GetSession. %v %v", ctx, id)
+	return
+}
+
+// GetSessionAfterCounter returns a count of finished ITokenRepositoryMock.GetSession invocations
+func (mmGetSession *ITokenRepositoryMock) GetSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSession.afterGetSessionCounter)
+}
+
+// GetSessionBeforeCounter returns a count of ITokenRepositoryMock.GetSession invocations
+func (mmGetSession *ITokenRepositoryMock) GetSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSession.beforeGetSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.GetSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSession *mITokenRepositoryMockGetSession) Calls() []*ITokenRepositoryMockGetSessionParams {
+	mmGetSession.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockGetSessionParams, len(mmGetSession.callArgs))
+	copy(argCopy, mmGetSession.callArgs)
+
+	mmGetSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSessionDone returns true if the count of the GetSession invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockGetSessionDone() bool {
+	if m.GetSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSessionMock.invocationsDone()
+}
+
+// MinimockGetSessionInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockGetSessionInspect() {
+	for _, e := range m.GetSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.GetSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSessionCounter := mm_atomic.LoadUint64(&m.afterGetSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSessionMock.defaultExpectation != nil && afterGetSessionCounter < 1 {
+		if m.GetSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.GetSession at\n%s", m.GetSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.GetSession at\n%s with params: %#v", m.GetSessionMock.defaultExpectation.expectationOrigins.origin, *m.GetSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSession != nil && afterGetSessionCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.GetSession at\n%s", m.funcGetSessionOrigin)
+	}
+
+	if !m.GetSessionMock.invocationsDone() && afterGetSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.GetSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSessionMock.expectedInvocations), m.GetSessionMock.expectedInvocationsOrigin, afterGetSessionCounter)
+	}
+}
+
+type mITokenRepositoryMockIncr struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockIncrExpectation
+	expectations       []*ITokenRepositoryMockIncrExpectation
+
+	callArgs []*ITokenRepositoryMockIncrParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockIncrExpectation specifies expectation struct of the ITokenRepository.Incr
+type ITokenRepositoryMockIncrExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockIncrParams
+	paramPtrs          *ITokenRepositoryMockIncrParamPtrs
+	expectationOrigins ITokenRepositoryMockIncrExpectationOrigins
+	results            *ITokenRepositoryMockIncrResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockIncrParams contains parameters of the ITokenRepository.Incr
+type ITokenRepositoryMockIncrParams struct {
+	ctx        context.Context
+	key        string
+	expiration time.Duration
+}
+
+// ITokenRepositoryMockIncrParamPtrs contains pointers to parameters of the ITokenRepository.Incr
+type ITokenRepositoryMockIncrParamPtrs struct {
+	ctx        *context.Context
+	key        *string
+	expiration *time.Duration
+}
+
+// ITokenRepositoryMockIncrResults contains results of the ITokenRepository.Incr
+type ITokenRepositoryMockIncrResults struct {
+	i1  int64
+	err error
+}
+
+// ITokenRepositoryMockIncrOrigins contains origins of expectations of the ITokenRepository.Incr
+type ITokenRepositoryMockIncrExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originKey        string
+	originExpiration string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIncr *mITokenRepositoryMockIncr) Optional() *mITokenRepositoryMockIncr {
+	mmIncr.optional = true
+	return mmIncr
+}
+
+// Expect sets up expected params for ITokenRepository.Incr
+func (mmIncr *mITokenRepositoryMockIncr) Expect(ctx context.Context, key string, expiration time.Duration) *mITokenRepositoryMockIncr {
+	if mmIncr.mock.funcIncr != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by Set")
+	}
+
+	if mmIncr.defaultExpectation == nil {
+		mmIncr.defaultExpectation = &ITokenRepositoryMockIncrExpectation{}
+	}
+
+	if mmIncr.defaultExpectation.paramPtrs != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by ExpectParams functions")
+	}
+
+	mmIncr.defaultExpectation.params = &ITokenRepositoryMockIncrParams{ctx, key, expiration}
+	mmIncr.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIncr.expectations {
+		if minimock.Equal(e.params, mmIncr.defaultExpectation.params) {
+			mmIncr.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIncr.defaultExpectation.params)
+		}
+	}
+
+	return mmIncr
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.Incr
+func (mmIncr *mITokenRepositoryMockIncr) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockIncr {
+	if mmIncr.mock.funcIncr != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by Set")
+	}
+
+	if mmIncr.defaultExpectation == nil {
+		mmIncr.defaultExpectation = &ITokenRepositoryMockIncrExpectation{}
+	}
+
+	if mmIncr.defaultExpectation.params != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by Expect")
+	}
+
+	if mmIncr.defaultExpectation.paramPtrs == nil {
+		mmIncr.defaultExpectation.paramPtrs = &ITokenRepositoryMockIncrParamPtrs{}
+	}
+	mmIncr.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIncr.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIncr
+}
+
+// ExpectKeyParam2 sets up expected param key for ITokenRepository.Incr
+func (mmIncr *mITokenRepositoryMockIncr) ExpectKeyParam2(key string) *mITokenRepositoryMockIncr {
+	if mmIncr.mock.funcIncr != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by Set")
+	}
+
+	if mmIncr.defaultExpectation == nil {
+		mmIncr.defaultExpectation = &ITokenRepositoryMockIncrExpectation{}
+	}
+
+	if mmIncr.defaultExpectation.params != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by Expect")
+	}
+
+	if mmIncr.defaultExpectation.paramPtrs == nil {
+		mmIncr.defaultExpectation.paramPtrs = &ITokenRepositoryMockIncrParamPtrs{}
+	}
+	mmIncr.defaultExpectation.paramPtrs.key = &key
+	mmIncr.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmIncr
+}
+
+// ExpectExpirationParam3 sets up expected param expiration for ITokenRepository.Incr
+func (mmIncr *mITokenRepositoryMockIncr) ExpectExpirationParam3(expiration time.Duration) *mITokenRepositoryMockIncr {
+	if mmIncr.mock.funcIncr != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by Set")
+	}
+
+	if mmIncr.defaultExpectation == nil {
+		mmIncr.defaultExpectation = &ITokenRepositoryMockIncrExpectation{}
+	}
+
+	if mmIncr.defaultExpectation.params != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by Expect")
+	}
+
+	if mmIncr.defaultExpectation.paramPtrs == nil {
+		mmIncr.defaultExpectation.paramPtrs = &ITokenRepositoryMockIncrParamPtrs{}
+	}
+	mmIncr.defaultExpectation.paramPtrs.expiration = &expiration
+	mmIncr.defaultExpectation.expectationOrigins.originExpiration = minimock.CallerInfo(1)
+
+	return mmIncr
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.Incr
+func (mmIncr *mITokenRepositoryMockIncr) Inspect(f func(ctx context.Context, key string, expiration time.Duration)) *mITokenRepositoryMockIncr {
+	if mmIncr.mock.inspectFuncIncr != nil {
+		mmIncr.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.Incr")
+	}
+
+	mmIncr.mock.inspectFuncIncr = f
+
+	return mmIncr
+}
+
+// Return sets up results that will be returned by ITokenRepository.Incr
+func (mmIncr *mITokenRepositoryMockIncr) Return(i1 int64, err error) *ITokenRepositoryMock {
+	if mmIncr.mock.funcIncr != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by Set")
+	}
+
+	if mmIncr.defaultExpectation == nil {
+		mmIncr.defaultExpectation = &ITokenRepositoryMockIncrExpectation{mock: mmIncr.mock}
+	}
+	mmIncr.defaultExpectation.results = &ITokenRepositoryMockIncrResults{i1, err}
+	mmIncr.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIncr.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.Incr method
+func (mmIncr *mITokenRepositoryMockIncr) Set(f func(ctx context.Context, key string, expiration time.Duration) (i1 int64, err error)) *ITokenRepositoryMock {
+	if mmIncr.defaultExpectation != nil {
+		mmIncr.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.Incr method")
+	}
+
+	if len(mmIncr.expectations) > 0 {
+		mmIncr.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.Incr method")
+	}
+
+	mmIncr.mock.funcIncr = f
+	mmIncr.mock.funcIncrOrigin = minimock.CallerInfo(1)
+	return mmIncr.mock
+}
+
+// When sets expectation for the ITokenRepository.Incr which will trigger the result defined by the following
+// Then helper
+func (mmIncr *mITokenRepositoryMockIncr) When(ctx context.Context, key string, expiration time.Duration) *ITokenRepositoryMockIncrExpectation {
+	if mmIncr.mock.funcIncr != nil {
+		mmIncr.mock.t.Fatalf("ITokenRepositoryMock.Incr mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockIncrExpectation{
+		mock:               mmIncr.mock,
+		params:             &ITokenRepositoryMockIncrParams{ctx, key, expiration},
+		expectationOrigins: ITokenRepositoryMockIncrExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIncr.expectations = append(mmIncr.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.Incr return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockIncrExpectation) Then(i1 int64, err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockIncrResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.Incr should be invoked
+func (mmIncr *mITokenRepositoryMockIncr) Times(n uint64) *mITokenRepositoryMockIncr {
+	if n == 0 {
+		mmIncr.mock.t.Fatalf("Times of ITokenRepositoryMock.Incr mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIncr.expectedInvocations, n)
+	mmIncr.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIncr
+}
+
+func (mmIncr *mITokenRepositoryMockIncr) invocationsDone() bool {
+	if len(mmIncr.expectations) == 0 && mmIncr.defaultExpectation == nil && mmIncr.mock.funcIncr == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIncr.mock.afterIncrCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIncr.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Incr implements mm_repository.ITokenRepository
+func (mmIncr *ITokenRepositoryMock) Incr(ctx context.Context, key string, expiration time.Duration) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmIncr.beforeIncrCounter, 1)
+	defer mm_atomic.AddUint64(&mmIncr.afterIncrCounter, 1)
+
+	mmIncr.t.Helper()
+
+	if mmIncr.inspectFuncIncr != nil {
+		mmIncr.inspectFuncIncr(ctx, key, expiration)
+	}
+
+	mm_params := ITokenRepositoryMockIncrParams{ctx, key, expiration}
+
+	// Record call args
+	mmIncr.IncrMock.mutex.Lock()
+	mmIncr.IncrMock.callArgs = append(mmIncr.IncrMock.callArgs, &mm_params)
+	mmIncr.IncrMock.mutex.Unlock()
+
+	for _, e := range mmIncr.IncrMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmIncr.IncrMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIncr.IncrMock.defaultExpectation.Counter, 1)
+		mm_want := mmIncr.IncrMock.defaultExpectation.params
+		mm_want_ptrs := mmIncr.IncrMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockIncrParams{ctx, key, expiration}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIncr.t.Errorf("ITokenRepositoryMock.Incr got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncr.IncrMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmIncr.t.Errorf("ITokenRepositoryMock.Incr got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncr.IncrMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.expiration != nil && !minimock.Equal(*mm_want_ptrs.expiration, mm_got.expiration) {
+				mmIncr.t.Errorf("ITokenRepositoryMock.Incr got unexpected parameter expiration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIncr.IncrMock.defaultExpectation.expectationOrigins.originExpiration, *mm_want_ptrs.expiration, mm_got.expiration, minimock.Diff(*mm_want_ptrs.expiration, mm_got.expiration))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIncr.t.Errorf("ITokenRepositoryMock.Incr got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIncr.IncrMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIncr.IncrMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIncr.t.Fatal("No results are set for the ITokenRepositoryMock.Incr")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmIncr.funcIncr != nil {
+		return mmIncr.funcIncr(ctx, key, expiration)
+	}
+	mmIncr.t.Fatalf("Unexpected call to ITokenRepositoryMock.Incr. %v %v %v", ctx, key, expiration)
+	return
+}
+
+// IncrAfterCounter returns a count of finished ITokenRepositoryMock.Incr invocations
+func (mmIncr *ITokenRepositoryMock) IncrAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncr.afterIncrCounter)
+}
+
+// IncrBeforeCounter returns a count of ITokenRepositoryMock.Incr invocations
+func (mmIncr *ITokenRepositoryMock) IncrBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIncr.beforeIncrCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.Incr.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIncr *mITokenRepositoryMockIncr) Calls() []*ITokenRepositoryMockIncrParams {
+	mmIncr.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockIncrParams, len(mmIncr.callArgs))
+	copy(argCopy, mmIncr.callArgs)
+
+	mmIncr.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIncrDone returns true if the count of the Incr invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockIncrDone() bool {
+	if m.IncrMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IncrMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IncrMock.invocationsDone()
+}
+
+// MinimockIncrInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockIncrInspect() {
+	for _, e := range m.IncrMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Incr at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIncrCounter := mm_atomic.LoadUint64(&m.afterIncrCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IncrMock.defaultExpectation != nil && afterIncrCounter < 1 {
+		if m.IncrMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Incr at\n%s", m.IncrMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Incr at\n%s with params: %#v", m.IncrMock.defaultExpectation.expectationOrigins.origin, *m.IncrMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIncr != nil && afterIncrCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.Incr at\n%s", m.funcIncrOrigin)
+	}
+
+	if !m.IncrMock.invocationsDone() && afterIncrCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.Incr at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IncrMock.expectedInvocations), m.IncrMock.expectedInvocationsOrigin, afterIncrCounter)
+	}
+}
+
+type mITokenRepositoryMockList struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockListExpectation
+	expectations       []*ITokenRepositoryMockListExpectation
+
+	callArgs []*ITokenRepositoryMockListParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockListExpectation specifies expectation struct of the ITokenRepository.List
+type ITokenRepositoryMockListExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockListParams
+	paramPtrs          *ITokenRepositoryMockListParamPtrs
+	expectationOrigins ITokenRepositoryMockListExpectationOrigins
+	results            *ITokenRepositoryMockListResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockListParams contains parameters of the ITokenRepository.List
+type ITokenRepositoryMockListParams struct {
+	ctx context.Context
+	key string
+}
+
+// ITokenRepositoryMockListParamPtrs contains pointers to parameters of the ITokenRepository.List
+type ITokenRepositoryMockListParamPtrs struct {
+	ctx *context.Context
+	key *string
+}
+
+// ITokenRepositoryMockListResults contains results of the ITokenRepository.List
+type ITokenRepositoryMockListResults struct {
+	sa1 []string
+	err error
+}
+
+// ITokenRepositoryMockListOrigins contains origins of expectations of the ITokenRepository.List
+type ITokenRepositoryMockListExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmList *mITokenRepositoryMockList) Optional() *mITokenRepositoryMockList {
+	mmList.optional = true
+	return mmList
+}
+
+// Expect sets up expected params for ITokenRepository.List
+func (mmList *mITokenRepositoryMockList) Expect(ctx context.Context, key string) *mITokenRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ITokenRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by ExpectParams functions")
+	}
+
+	mmList.defaultExpectation.params = &ITokenRepositoryMockListParams{ctx, key}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
+		}
+	}
+
+	return mmList
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.List
+func (mmList *mITokenRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ITokenRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ITokenRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// ExpectKeyParam2 sets up expected param key for ITokenRepository.List
+func (mmList *mITokenRepositoryMockList) ExpectKeyParam2(key string) *mITokenRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ITokenRepositoryMockListExpectation{}
+	}
+
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Expect")
+	}
+
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &ITokenRepositoryMockListParamPtrs{}
+	}
+	mmList.defaultExpectation.paramPtrs.key = &key
+	mmList.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmList
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.List
+func (mmList *mITokenRepositoryMockList) Inspect(f func(ctx context.Context, key string)) *mITokenRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.List")
+	}
+
+	mmList.mock.inspectFuncList = f
+
+	return mmList
+}
+
+// Return sets up results that will be returned by ITokenRepository.List
+func (mmList *mITokenRepositoryMockList) Return(sa1 []string, err error) *ITokenRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &ITokenRepositoryMockListExpectation{mock: mmList.mock}
+	}
+	mmList.defaultExpectation.results = &ITokenRepositoryMockListResults{sa1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.List method
+func (mmList *mITokenRepositoryMockList) Set(f func(ctx context.Context, key string) (sa1 []string, err error)) *ITokenRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.List method")
+	}
+
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.List method")
+	}
+
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
+}
+
+// When sets expectation for the ITokenRepository.List which will trigger the result defined by the following
+// Then helper
+func (mmList *mITokenRepositoryMockList) When(ctx context.Context, key string) *ITokenRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("ITokenRepositoryMock.List mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &ITokenRepositoryMockListParams{ctx, key},
+		expectationOrigins: ITokenRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmList.expectations = append(mmList.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.List return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockListExpectation) Then(sa1 []string, err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockListResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.List should be invoked
+func (mmList *mITokenRepositoryMockList) Times(n uint64) *mITokenRepositoryMockList {
+	if n == 0 {
+		mmList.mock.t.Fatalf("Times of ITokenRepositoryMock.List mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
+}
+
+func (mmList *mITokenRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// List implements mm_repository.ITokenRepository
+func (mmList *ITokenRepositoryMock) List(ctx context.Context, key string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
+
+	mmList.t.Helper()
+
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, key)
+	}
+
+	mm_params := ITokenRepositoryMockListParams{ctx, key}
+
+	// Record call args
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
+
+	for _, e := range mmList.ListMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockListParams{ctx, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmList.t.Errorf("ITokenRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmList.t.Errorf("ITokenRepositoryMock.List got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmList.t.Errorf("ITokenRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmList.ListMock.defaultExpectation.results
+		if mm_results == nil {
+			mmList.t.Fatal("No results are set for the ITokenRepositoryMock.List")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, key)
+	}
+	mmList.t.Fatalf("Unexpected call to ITokenRepositoryMock.List. %v %v", ctx, key)
+	return
+}
+
+// ListAfterCounter returns a count of finished ITokenRepositoryMock.List invocations
+func (mmList *ITokenRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
+}
+
+// ListBeforeCounter returns a count of ITokenRepositoryMock.List invocations
+func (mmList *ITokenRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.List.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmList *mITokenRepositoryMockList) Calls() []*ITokenRepositoryMockListParams {
+	mmList.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
+
+	mmList.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDone returns true if the count of the List invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMock.invocationsDone()
+}
+
+// MinimockListInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.List at\n%s", m.funcListOrigin)
+	}
+
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	}
+}
+
+type mITokenRepositoryMockListSessions struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockListSessionsExpectation
+	expectations       []*ITokenRepositoryMockListSessionsExpectation
+
+	callArgs []*ITokenRepositoryMockListSessionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockListSessionsExpectation specifies expectation struct of the ITokenRepository.ListSessions
+type ITokenRepositoryMockListSessionsExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockListSessionsParams
+	paramPtrs          *ITokenRepositoryMockListSessionsParamPtrs
+	expectationOrigins ITokenRepositoryMockListSessionsExpectationOrigins
+	results            *ITokenRepositoryMockListSessionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockListSessionsParams contains parameters of the ITokenRepository.ListSessions
+type ITokenRepositoryMockListSessionsParams struct {
+	ctx   context.Context
+	email string
+}
+
+// ITokenRepositoryMockListSessionsParamPtrs contains pointers to parameters of the ITokenRepository.ListSessions
+type ITokenRepositoryMockListSessionsParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// ITokenRepositoryMockListSessionsResults contains results of the ITokenRepository.ListSessions
+type ITokenRepositoryMockListSessionsResults struct {
+	sa1 []domain.Session
+	err error
+}
+
+// ITokenRepositoryMockListSessionsOrigins contains origins of expectations of the ITokenRepository.ListSessions
+type ITokenRepositoryMockListSessionsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSessions *mITokenRepositoryMockListSessions) Optional() *mITokenRepositoryMockListSessions {
+	mmListSessions.optional = true
+	return mmListSessions
+}
+
+// Expect sets up expected params for ITokenRepository.ListSessions
+func (mmListSessions *mITokenRepositoryMockListSessions) Expect(ctx context.Context, email string) *mITokenRepositoryMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ITokenRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &ITokenRepositoryMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs != nil {
+		mmListSessions.mock.t.Fatalf("ITokenRepositoryMock.ListSessions mock is already set by ExpectParams functions")
+	}
+
+	mmListSessions.defaultExpectation.params = &ITokenRepositoryMockListSessionsParams{ctx, email}
+	mmListSessions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSessions.expectations {
+		if minimock.Equal(e.params, mmListSessions.defaultExpectation.params) {
+			mmListSessions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSessions.defaultExpectation.params)
+		}
+	}
+
+	return mmListSessions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.ListSessions
+func (mmListSessions *mITokenRepositoryMockListSessions) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ITokenRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &ITokenRepositoryMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("ITokenRepositoryMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &ITokenRepositoryMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSessions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// ExpectEmailParam2 sets up expected param email for ITokenRepository.ListSessions
+func (mmListSessions *mITokenRepositoryMockListSessions) ExpectEmailParam2(email string) *mITokenRepositoryMockListSessions {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ITokenRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &ITokenRepositoryMockListSessionsExpectation{}
+	}
+
+	if mmListSessions.defaultExpectation.params != nil {
+		mmListSessions.mock.t.Fatalf("ITokenRepositoryMock.ListSessions mock is already set by Expect")
+	}
+
+	if mmListSessions.defaultExpectation.paramPtrs == nil {
+		mmListSessions.defaultExpectation.paramPtrs = &ITokenRepositoryMockListSessionsParamPtrs{}
+	}
+	mmListSessions.defaultExpectation.paramPtrs.email = &email
+	mmListSessions.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmListSessions
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.ListSessions
+func (mmListSessions *mITokenRepositoryMockListSessions) Inspect(f func(ctx context.Context, email string)) *mITokenRepositoryMockListSessions {
+	if mmListSessions.mock.inspectFuncListSessions != nil {
+		mmListSessions.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.ListSessions")
+	}
+
+	mmListSessions.mock.inspectFuncListSessions = f
+
+	return mmListSessions
+}
+
+// Return sets up results that will be returned by ITokenRepository.ListSessions
+func (mmListSessions *mITokenRepositoryMockListSessions) Return(sa1 []domain.Session, err error) *ITokenRepositoryMock {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ITokenRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	if mmListSessions.defaultExpectation == nil {
+		mmListSessions.defaultExpectation = &ITokenRepositoryMockListSessionsExpectation{mock: mmListSessions.mock}
+	}
+	mmListSessions.defaultExpectation.results = &ITokenRepositoryMockListSessionsResults{sa1, err}
+	mmListSessions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.ListSessions method
+func (mmListSessions *mITokenRepositoryMockListSessions) Set(f func(ctx context.Context, email string) (sa1 []domain.Session, err error)) *ITokenRepositoryMock {
+	if mmListSessions.defaultExpectation != nil {
+		mmListSessions.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.ListSessions method")
+	}
+
+	if len(mmListSessions.expectations) > 0 {
+		mmListSessions.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.ListSessions method")
+	}
+
+	mmListSessions.mock.funcListSessions = f
+	mmListSessions.mock.funcListSessionsOrigin = minimock.CallerInfo(1)
+	return mmListSessions.mock
+}
+
+// When sets expectation for the ITokenRepository.ListSessions which will trigger the result defined by the following
+// Then helper
+func (mmListSessions *mITokenRepositoryMockListSessions) When(ctx context.Context, email string) *ITokenRepositoryMockListSessionsExpectation {
+	if mmListSessions.mock.funcListSessions != nil {
+		mmListSessions.mock.t.Fatalf("ITokenRepositoryMock.ListSessions mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockListSessionsExpectation{
+		mock:               mmListSessions.mock,
+		params:             &ITokenRepositoryMockListSessionsParams{ctx, email},
+		expectationOrigins: ITokenRepositoryMockListSessionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSessions.expectations = append(mmListSessions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.ListSessions return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockListSessionsExpectation) Then(sa1 []domain.Session, err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockListSessionsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.ListSessions should be invoked
+func (mmListSessions *mITokenRepositoryMockListSessions) Times(n uint64) *mITokenRepositoryMockListSessions {
+	if n == 0 {
+		mmListSessions.mock.t.Fatalf("Times of ITokenRepositoryMock.ListSessions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSessions.expectedInvocations, n)
+	mmListSessions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSessions
+}
+
+func (mmListSessions *mITokenRepositoryMockListSessions) invocationsDone() bool {
+	if len(mmListSessions.expectations) == 0 && mmListSessions.defaultExpectation == nil && mmListSessions.mock.funcListSessions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSessions.mock.afterListSessionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSessions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSessions implements mm_repository.ITokenRepository
+func (mmListSessions *ITokenRepositoryMock) ListSessions(ctx context.Context, email string) (sa1 []domain.Session, err error) {
+	mm_atomic.AddUint64(&mmListSessions.beforeListSessionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSessions.afterListSessionsCounter, 1)
+
+	mmListSessions.t.Helper()
+
+	if mmListSessions.inspectFuncListSessions != nil {
+		mmListSessions.inspectFuncListSessions(ctx, email)
+	}
+
+	mm_params := ITokenRepositoryMockListSessionsParams{ctx, email}
+
+	// Record call args
+	mmListSessions.ListSessionsMock.mutex.Lock()
+	mmListSessions.ListSessionsMock.callArgs = append(mmListSessions.ListSessionsMock.callArgs, &mm_params)
+	mmListSessions.ListSessionsMock.mutex.Unlock()
+
+	for _, e := range mmListSessions.ListSessionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListSessions.ListSessionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSessions.ListSessionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSessions.ListSessionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListSessions.ListSessionsMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockListSessionsParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSessions.t.Errorf("ITokenRepositoryMock.ListSessions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmListSessions.t.Errorf("ITokenRepositoryMock.ListSessions got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSessions.t.Errorf("ITokenRepositoryMock.ListSessions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSessions.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSessions.ListSessionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSessions.t.Fatal("No results are set for the ITokenRepositoryMock.ListSessions")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListSessions.funcListSessions != nil {
+		return mmListSessions.funcListSessions(ctx, email)
+	}
+	mmListSessions.t.Fatalf("Unexpected call to ITokenRepositoryMock.ListSessions. %v %v", ctx, email)
+	return
+}
+
+// ListSessionsAfterCounter returns a count of finished ITokenRepositoryMock.ListSessions invocations
+func (mmListSessions *ITokenRepositoryMock) ListSessionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.afterListSessionsCounter)
+}
+
+// ListSessionsBeforeCounter returns a count of ITokenRepositoryMock.ListSessions invocations
+func (mmListSessions *ITokenRepositoryMock) ListSessionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSessions.beforeListSessionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.ListSessions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSessions *mITokenRepositoryMockListSessions) Calls() []*ITokenRepositoryMockListSessionsParams {
+	mmListSessions.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockListSessionsParams, len(mmListSessions.callArgs))
+	copy(argCopy, mmListSessions.callArgs)
+
+	mmListSessions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSessionsDone returns true if the count of the ListSessions invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockListSessionsDone() bool {
+	if m.ListSessionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSessionsMock.invocationsDone()
+}
+
+// MinimockListSessionsInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockListSessionsInspect() {
+	for _, e := range m.ListSessionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.ListSessions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSessionsCounter := mm_atomic.LoadUint64(&m.afterListSessionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSessionsMock.defaultExpectation != nil && afterListSessionsCounter < 1 {
+		if m.ListSessionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.ListSessions at\n%s", m.ListSessionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.ListSessions at\n%s with params: %#v", m.ListSessionsMock.defaultExpectation.expectationOrigins.origin, *m.ListSessionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSessions != nil && afterListSessionsCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.ListSessions at\n%s", m.funcListSessionsOrigin)
+	}
+
+	if !m.ListSessionsMock.invocationsDone() && afterListSessionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.ListSessions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSessionsMock.expectedInvocations), m.ListSessionsMock.expectedInvocationsOrigin, afterListSessionsCounter)
+	}
+}
+
+type mITokenRepositoryMockMarkRefreshTokenRotated struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockMarkRefreshTokenRotatedExpectation
+	expectations       []*ITokenRepositoryMockMarkRefreshTokenRotatedExpectation
+
+	callArgs []*ITokenRepositoryMockMarkRefreshTokenRotatedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockMarkRefreshTokenRotatedExpectation specifies expectation struct of the ITokenRepository.MarkRefreshTokenRotated
+type ITokenRepositoryMockMarkRefreshTokenRotatedExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockMarkRefreshTokenRotatedParams
+	paramPtrs          *ITokenRepositoryMockMarkRefreshTokenRotatedParamPtrs
+	expectationOrigins ITokenRepositoryMockMarkRefreshTokenRotatedExpectationOrigins
+	results            *ITokenRepositoryMockMarkRefreshTokenRotatedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockMarkRefreshTokenRotatedParams contains parameters of the ITokenRepository.MarkRefreshTokenRotated
+type ITokenRepositoryMockMarkRefreshTokenRotatedParams struct {
+	ctx        context.Context
+	token      domain.RefreshToken
+	expiration time.Duration
+}
+
+// ITokenRepositoryMockMarkRefreshTokenRotatedParamPtrs contains pointers to parameters of the ITokenRepository.MarkRefreshTokenRotated
+type ITokenRepositoryMockMarkRefreshTokenRotatedParamPtrs struct {
+	ctx        *context.Context
+	token      *domain.RefreshToken
+	expiration *time.Duration
+}
+
+// ITokenRepositoryMockMarkRefreshTokenRotatedResults contains results of the ITokenRepository.MarkRefreshTokenRotated
+type ITokenRepositoryMockMarkRefreshTokenRotatedResults struct {
+	err error
+}
+
+// ITokenRepositoryMockMarkRefreshTokenRotatedOrigins contains origins of expectations of the ITokenRepository.MarkRefreshTokenRotated
+type ITokenRepositoryMockMarkRefreshTokenRotatedExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originToken      string
+	originExpiration string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) Optional() *mITokenRepositoryMockMarkRefreshTokenRotated {
+	mmMarkRefreshTokenRotated.optional = true
+	return mmMarkRefreshTokenRotated
+}
+
+// Expect sets up expected params for ITokenRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) Expect(ctx context.Context, token domain.RefreshToken, expiration time.Duration) *mITokenRepositoryMockMarkRefreshTokenRotated {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation = &ITokenRepositoryMockMarkRefreshTokenRotatedExpectation{}
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRefreshTokenRotated.defaultExpectation.params = &ITokenRepositoryMockMarkRefreshTokenRotatedParams{ctx, token, expiration}
+	mmMarkRefreshTokenRotated.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRefreshTokenRotated.expectations {
+		if minimock.Equal(e.params, mmMarkRefreshTokenRotated.defaultExpectation.params) {
+			mmMarkRefreshTokenRotated.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRefreshTokenRotated.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRefreshTokenRotated
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockMarkRefreshTokenRotated {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation = &ITokenRepositoryMockMarkRefreshTokenRotatedExpectation{}
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.params != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by Expect")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs = &ITokenRepositoryMockMarkRefreshTokenRotatedParamPtrs{}
+	}
+	mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRefreshTokenRotated.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRefreshTokenRotated
+}
+
+// ExpectTokenParam2 sets up expected param token for ITokenRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) ExpectTokenParam2(token domain.RefreshToken) *mITokenRepositoryMockMarkRefreshTokenRotated {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation = &ITokenRepositoryMockMarkRefreshTokenRotatedExpectation{}
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.params != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by Expect")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs = &ITokenRepositoryMockMarkRefreshTokenRotatedParamPtrs{}
+	}
+	mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs.token = &token
+	mmMarkRefreshTokenRotated.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmMarkRefreshTokenRotated
+}
+
+// ExpectExpirationParam3 sets up expected param expiration for ITokenRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) ExpectExpirationParam3(expiration time.Duration) *mITokenRepositoryMockMarkRefreshTokenRotated {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation = &ITokenRepositoryMockMarkRefreshTokenRotatedExpectation{}
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.params != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by Expect")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs = &ITokenRepositoryMockMarkRefreshTokenRotatedParamPtrs{}
+	}
+	mmMarkRefreshTokenRotated.defaultExpectation.paramPtrs.expiration = &expiration
+	mmMarkRefreshTokenRotated.defaultExpectation.expectationOrigins.originExpiration = minimock.CallerInfo(1)
+
+	return mmMarkRefreshTokenRotated
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) Inspect(f func(ctx context.Context, token domain.RefreshToken, expiration time.Duration)) *mITokenRepositoryMockMarkRefreshTokenRotated {
+	if mmMarkRefreshTokenRotated.mock.inspectFuncMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.MarkRefreshTokenRotated")
+	}
+
+	mmMarkRefreshTokenRotated.mock.inspectFuncMarkRefreshTokenRotated = f
+
+	return mmMarkRefreshTokenRotated
+}
+
+// Return sets up results that will be returned by ITokenRepository.MarkRefreshTokenRotated
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) Return(err error) *ITokenRepositoryMock {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	if mmMarkRefreshTokenRotated.defaultExpectation == nil {
+		mmMarkRefreshTokenRotated.defaultExpectation = &ITokenRepositoryMockMarkRefreshTokenRotatedExpectation{mock: mmMarkRefreshTokenRotated.mock}
+	}
+	mmMarkRefreshTokenRotated.defaultExpectation.results = &ITokenRepositoryMockMarkRefreshTokenRotatedResults{err}
+	mmMarkRefreshTokenRotated.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRefreshTokenRotated.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.MarkRefreshTokenRotated method
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) Set(f func(ctx context.Context, token domain.RefreshToken, expiration time.Duration) (err error)) *ITokenRepositoryMock {
+	if mmMarkRefreshTokenRotated.defaultExpectation != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.MarkRefreshTokenRotated method")
+	}
+
+	if len(mmMarkRefreshTokenRotated.expectations) > 0 {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.MarkRefreshTokenRotated method")
+	}
+
+	mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated = f
+	mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotatedOrigin = minimock.CallerInfo(1)
+	return mmMarkRefreshTokenRotated.mock
+}
+
+// When sets expectation for the ITokenRepository.MarkRefreshTokenRotated which will trigger the result defined by the following
+// Then helper
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) When(ctx context.Context, token domain.RefreshToken, expiration time.Duration) *ITokenRepositoryMockMarkRefreshTokenRotatedExpectation {
+	if mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("ITokenRepositoryMock.MarkRefreshTokenRotated mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockMarkRefreshTokenRotatedExpectation{
+		mock:               mmMarkRefreshTokenRotated.mock,
+		params:             &ITokenRepositoryMockMarkRefreshTokenRotatedParams{ctx, token, expiration},
+		expectationOrigins: ITokenRepositoryMockMarkRefreshTokenRotatedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRefreshTokenRotated.expectations = append(mmMarkRefreshTokenRotated.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.MarkRefreshTokenRotated return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockMarkRefreshTokenRotatedExpectation) Then(err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockMarkRefreshTokenRotatedResults{err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.MarkRefreshTokenRotated should be invoked
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) Times(n uint64) *mITokenRepositoryMockMarkRefreshTokenRotated {
+	if n == 0 {
+		mmMarkRefreshTokenRotated.mock.t.Fatalf("Times of ITokenRepositoryMock.MarkRefreshTokenRotated mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRefreshTokenRotated.expectedInvocations, n)
+	mmMarkRefreshTokenRotated.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRefreshTokenRotated
+}
+
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) invocationsDone() bool {
+	if len(mmMarkRefreshTokenRotated.expectations) == 0 && mmMarkRefreshTokenRotated.defaultExpectation == nil && mmMarkRefreshTokenRotated.mock.funcMarkRefreshTokenRotated == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRefreshTokenRotated.mock.afterMarkRefreshTokenRotatedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRefreshTokenRotated.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRefreshTokenRotated implements mm_repository.ITokenRepository
+func (mmMarkRefreshTokenRotated *ITokenRepositoryMock) MarkRefreshTokenRotated(ctx context.Context, token domain.RefreshToken, expiration time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmMarkRefreshTokenRotated.beforeMarkRefreshTokenRotatedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRefreshTokenRotated.afterMarkRefreshTokenRotatedCounter, 1)
+
+	mmMarkRefreshTokenRotated.t.Helper()
+
+	if mmMarkRefreshTokenRotated.inspectFuncMarkRefreshTokenRotated != nil {
+		mmMarkRefreshTokenRotated.inspectFuncMarkRefreshTokenRotated(ctx, token, expiration)
+	}
+
+	mm_params := ITokenRepositoryMockMarkRefreshTokenRotatedParams{ctx, token, expiration}
+
+	// Record call args
+	mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.mutex.Lock()
+	mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.callArgs = append(mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.callArgs, &mm_params)
+	mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.mutex.Unlock()
+
+	for _, e := range mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockMarkRefreshTokenRotatedParams{ctx, token, expiration}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRefreshTokenRotated.t.Errorf("ITokenRepositoryMock.MarkRefreshTokenRotated got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmMarkRefreshTokenRotated.t.Errorf("ITokenRepositoryMock.MarkRefreshTokenRotated got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.expiration != nil && !minimock.Equal(*mm_want_ptrs.expiration, mm_got.expiration) {
+				mmMarkRefreshTokenRotated.t.Errorf("ITokenRepositoryMock.MarkRefreshTokenRotated got unexpected parameter expiration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.expectationOrigins.originExpiration, *mm_want_ptrs.expiration, mm_got.expiration, minimock.Diff(*mm_want_ptrs.expiration, mm_got.expiration))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRefreshTokenRotated.t.Errorf("ITokenRepositoryMock.MarkRefreshTokenRotated got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRefreshTokenRotated.MarkRefreshTokenRotatedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRefreshTokenRotated.t.Fatal("No results are set for the ITokenRepositoryMock.MarkRefreshTokenRotated")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRefreshTokenRotated.funcMarkRefreshTokenRotated != nil {
+		return mmMarkRefreshTokenRotated.funcMarkRefreshTokenRotated(ctx, token, expiration)
+	}
+	mmMarkRefreshTokenRotated.t.Fatalf("Unexpected call to ITokenRepositoryMock.MarkRefreshTokenRotated. %v %v %v", ctx, token, expiration)
+	return
+}
+
+// MarkRefreshTokenRotatedAfterCounter returns a count of finished ITokenRepositoryMock.MarkRefreshTokenRotated invocations
+func (mmMarkRefreshTokenRotated *ITokenRepositoryMock) MarkRefreshTokenRotatedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRefreshTokenRotated.afterMarkRefreshTokenRotatedCounter)
+}
+
+// MarkRefreshTokenRotatedBeforeCounter returns a count of ITokenRepositoryMock.MarkRefreshTokenRotated invocations
+func (mmMarkRefreshTokenRotated *ITokenRepositoryMock) MarkRefreshTokenRotatedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRefreshTokenRotated.beforeMarkRefreshTokenRotatedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.MarkRefreshTokenRotated.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRefreshTokenRotated *mITokenRepositoryMockMarkRefreshTokenRotated) Calls() []*ITokenRepositoryMockMarkRefreshTokenRotatedParams {
+	mmMarkRefreshTokenRotated.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockMarkRefreshTokenRotatedParams, len(mmMarkRefreshTokenRotated.callArgs))
+	copy(argCopy, mmMarkRefreshTokenRotated.callArgs)
+
+	mmMarkRefreshTokenRotated.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkRefreshTokenRotatedDone returns true if the count of the MarkRefreshTokenRotated invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockMarkRefreshTokenRotatedDone() bool {
+	if m.MarkRefreshTokenRotatedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkRefreshTokenRotatedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkRefreshTokenRotatedMock.invocationsDone()
+}
+
+// MinimockMarkRefreshTokenRotatedInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockMarkRefreshTokenRotatedInspect() {
+	for _, e := range m.MarkRefreshTokenRotatedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.MarkRefreshTokenRotated at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkRefreshTokenRotatedCounter := mm_atomic.LoadUint64(&m.afterMarkRefreshTokenRotatedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkRefreshTokenRotatedMock.defaultExpectation != nil && afterMarkRefreshTokenRotatedCounter < 1 {
+		if m.MarkRefreshTokenRotatedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.MarkRefreshTokenRotated at\n%s", m.MarkRefreshTokenRotatedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.MarkRefreshTokenRotated at\n%s with params: %#v", m.MarkRefreshTokenRotatedMock.defaultExpectation.expectationOrigins.origin, *m.MarkRefreshTokenRotatedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRefreshTokenRotated != nil && afterMarkRefreshTokenRotatedCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.MarkRefreshTokenRotated at\n%s", m.funcMarkRefreshTokenRotatedOrigin)
+	}
+
+	if !m.MarkRefreshTokenRotatedMock.invocationsDone() && afterMarkRefreshTokenRotatedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.MarkRefreshTokenRotated at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkRefreshTokenRotatedMock.expectedInvocations), m.MarkRefreshTokenRotatedMock.expectedInvocationsOrigin, afterMarkRefreshTokenRotatedCounter)
+	}
+}
+
+type mITokenRepositoryMockMigrateRefreshToken struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockMigrateRefreshTokenExpectation
+	expectations       []*ITokenRepositoryMockMigrateRefreshTokenExpectation
+
+	callArgs []*ITokenRepositoryMockMigrateRefreshTokenParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockMigrateRefreshTokenExpectation specifies expectation struct of the ITokenRepository.MigrateRefreshToken
+type ITokenRepositoryMockMigrateRefreshTokenExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockMigrateRefreshTokenParams
+	paramPtrs          *ITokenRepositoryMockMigrateRefreshTokenParamPtrs
+	expectationOrigins ITokenRepositoryMockMigrateRefreshTokenExpectationOrigins
+	results            *ITokenRepositoryMockMigrateRefreshTokenResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockMigrateRefreshTokenParams contains parameters of the ITokenRepository.MigrateRefreshToken
+type ITokenRepositoryMockMigrateRefreshTokenParams struct {
+	ctx        context.Context
+	token      string
+	id         string
+	expiration time.Duration
+}
+
+// ITokenRepositoryMockMigrateRefreshTokenParamPtrs contains pointers to parameters of the ITokenRepository.MigrateRefreshToken
+type ITokenRepositoryMockMigrateRefreshTokenParamPtrs struct {
+	ctx        *context.Context
+	token      *string
+	id         *string
+	expiration *time.Duration
+}
+
+// ITokenRepositoryMockMigrateRefreshTokenResults contains results of the ITokenRepository.MigrateRefreshToken
+type ITokenRepositoryMockMigrateRefreshTokenResults struct {
+	err error
+}
+
+// ITokenRepositoryMockMigrateRefreshTokenOrigins contains origins of expectations of the ITokenRepository.MigrateRefreshToken
+type ITokenRepositoryMockMigrateRefreshTokenExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originToken      string
+	originId         string
+	originExpiration string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) Optional() *mITokenRepositoryMockMigrateRefreshToken {
+	mmMigrateRefreshToken.optional = true
+	return mmMigrateRefreshToken
+}
+
+// Expect sets up expected params for ITokenRepository.MigrateRefreshToken
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) Expect(ctx context.Context, token string, id string, expiration time.Duration) *mITokenRepositoryMockMigrateRefreshToken {
+	if mmMigrateRefreshToken.mock.funcMigrateRefreshToken != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Set")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation == nil {
+		mmMigrateRefreshToken.defaultExpectation = &ITokenRepositoryMockMigrateRefreshTokenExpectation{}
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation.paramPtrs != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by ExpectParams functions")
+	}
+
+	mmMigrateRefreshToken.defaultExpectation.params = &ITokenRepositoryMockMigrateRefreshTokenParams{ctx, token, id, expiration}
+	mmMigrateRefreshToken.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMigrateRefreshToken.expectations {
+		if minimock.Equal(e.params, mmMigrateRefreshToken.defaultExpectation.params) {
+			mmMigrateRefreshToken.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMigrateRefreshToken.defaultExpectation.params)
+		}
+	}
+
+	return mmMigrateRefreshToken
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.MigrateRefreshToken
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockMigrateRefreshToken {
+	if mmMigrateRefreshToken.mock.funcMigrateRefreshToken != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Set")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation == nil {
+		mmMigrateRefreshToken.defaultExpectation = &ITokenRepositoryMockMigrateRefreshTokenExpectation{}
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation.params != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Expect")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmMigrateRefreshToken.defaultExpectation.paramPtrs = &ITokenRepositoryMockMigrateRefreshTokenParamPtrs{}
+	}
+	mmMigrateRefreshToken.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMigrateRefreshToken.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMigrateRefreshToken
+}
+
+// ExpectTokenParam2 sets up expected param token for ITokenRepository.MigrateRefreshToken
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) ExpectTokenParam2(token string) *mITokenRepositoryMockMigrateRefreshToken {
+	if mmMigrateRefreshToken.mock.funcMigrateRefreshToken != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Set")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation == nil {
+		mmMigrateRefreshToken.defaultExpectation = &ITokenRepositoryMockMigrateRefreshTokenExpectation{}
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation.params != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Expect")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmMigrateRefreshToken.defaultExpectation.paramPtrs = &ITokenRepositoryMockMigrateRefreshTokenParamPtrs{}
+	}
+	mmMigrateRefreshToken.defaultExpectation.paramPtrs.token = &token
+	mmMigrateRefreshToken.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmMigrateRefreshToken
+}
+
+// ExpectIdParam3 sets up expected param id for ITokenRepository.MigrateRefreshToken
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) ExpectIdParam3(id string) *mITokenRepositoryMockMigrateRefreshToken {
+	if mmMigrateRefreshToken.mock.funcMigrateRefreshToken != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Set")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation == nil {
+		mmMigrateRefreshToken.defaultExpectation = &ITokenRepositoryMockMigrateRefreshTokenExpectation{}
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation.params != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Expect")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmMigrateRefreshToken.defaultExpectation.paramPtrs = &ITokenRepositoryMockMigrateRefreshTokenParamPtrs{}
+	}
+	mmMigrateRefreshToken.defaultExpectation.paramPtrs.id = &id
+	mmMigrateRefreshToken.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMigrateRefreshToken
+}
+
+// ExpectExpirationParam4 sets up expected param expiration for ITokenRepository.MigrateRefreshToken
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) ExpectExpirationParam4(expiration time.Duration) *mITokenRepositoryMockMigrateRefreshToken {
+	if mmMigrateRefreshToken.mock.funcMigrateRefreshToken != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Set")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation == nil {
+		mmMigrateRefreshToken.defaultExpectation = &ITokenRepositoryMockMigrateRefreshTokenExpectation{}
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation.params != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Expect")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation.paramPtrs == nil {
+		mmMigrateRefreshToken.defaultExpectation.paramPtrs = &ITokenRepositoryMockMigrateRefreshTokenParamPtrs{}
+	}
+	mmMigrateRefreshToken.defaultExpectation.paramPtrs.expiration = &expiration
+	mmMigrateRefreshToken.defaultExpectation.expectationOrigins.originExpiration = minimock.CallerInfo(1)
+
+	return mmMigrateRefreshToken
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.MigrateRefreshToken
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) Inspect(f func(ctx context.Context, token string, id string, expiration time.Duration)) *mITokenRepositoryMockMigrateRefreshToken {
+	if mmMigrateRefreshToken.mock.inspectFuncMigrateRefreshToken != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.MigrateRefreshToken")
+	}
+
+	mmMigrateRefreshToken.mock.inspectFuncMigrateRefreshToken = f
+
+	return mmMigrateRefreshToken
+}
+
+// Return sets up results that will be returned by ITokenRepository.MigrateRefreshToken
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) Return(err error) *ITokenRepositoryMock {
+	if mmMigrateRefreshToken.mock.funcMigrateRefreshToken != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Set")
+	}
+
+	if mmMigrateRefreshToken.defaultExpectation == nil {
+		mmMigrateRefreshToken.defaultExpectation = &ITokenRepositoryMockMigrateRefreshTokenExpectation{mock: mmMigrateRefreshToken.mock}
+	}
+	mmMigrateRefreshToken.defaultExpectation.results = &ITokenRepositoryMockMigrateRefreshTokenResults{err}
+	mmMigrateRefreshToken.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMigrateRefreshToken.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.MigrateRefreshToken method
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) Set(f func(ctx context.Context, token string, id string, expiration time.Duration) (err error)) *ITokenRepositoryMock {
+	if mmMigrateRefreshToken.defaultExpectation != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.MigrateRefreshToken method")
+	}
+
+	if len(mmMigrateRefreshToken.expectations) > 0 {
+		mmMigrateRefreshToken.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.MigrateRefreshToken method")
+	}
+
+	mmMigrateRefreshToken.mock.funcMigrateRefreshToken = f
+	mmMigrateRefreshToken.mock.funcMigrateRefreshTokenOrigin = minimock.CallerInfo(1)
+	return mmMigrateRefreshToken.mock
+}
+
+// When sets expectation for the ITokenRepository.MigrateRefreshToken which will trigger the result defined by the following
+// Then helper
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) When(ctx context.Context, token string, id string, expiration time.Duration) *ITokenRepositoryMockMigrateRefreshTokenExpectation {
+	if mmMigrateRefreshToken.mock.funcMigrateRefreshToken != nil {
+		mmMigrateRefreshToken.mock.t.Fatalf("ITokenRepositoryMock.MigrateRefreshToken mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockMigrateRefreshTokenExpectation{
+		mock:               mmMigrateRefreshToken.mock,
+		params:             &ITokenRepositoryMockMigrateRefreshTokenParams{ctx, token, id, expiration},
+		expectationOrigins: ITokenRepositoryMockMigrateRefreshTokenExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMigrateRefreshToken.expectations = append(mmMigrateRefreshToken.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.MigrateRefreshToken return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockMigrateRefreshTokenExpectation) Then(err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockMigrateRefreshTokenResults{err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.MigrateRefreshToken should be invoked
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) Times(n uint64) *mITokenRepositoryMockMigrateRefreshToken {
+	if n == 0 {
+		mmMigrateRefreshToken.mock.t.Fatalf("Times of ITokenRepositoryMock.MigrateRefreshToken mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMigrateRefreshToken.expectedInvocations, n)
+	mmMigrateRefreshToken.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMigrateRefreshToken
+}
+
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) invocationsDone() bool {
+	if len(mmMigrateRefreshToken.expectations) == 0 && mmMigrateRefreshToken.defaultExpectation == nil && mmMigrateRefreshToken.mock.funcMigrateRefreshToken == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMigrateRefreshToken.mock.afterMigrateRefreshTokenCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMigrateRefreshToken.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MigrateRefreshToken implements mm_repository.ITokenRepository
+func (mmMigrateRefreshToken *ITokenRepositoryMock) MigrateRefreshToken(ctx context.Context, token string, id string, expiration time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmMigrateRefreshToken.beforeMigrateRefreshTokenCounter, 1)
+	defer mm_atomic.AddUint64(&mmMigrateRefreshToken.afterMigrateRefreshTokenCounter, 1)
+
+	mmMigrateRefreshToken.t.Helper()
+
+	if mmMigrateRefreshToken.inspectFuncMigrateRefreshToken != nil {
+		mmMigrateRefreshToken.inspectFuncMigrateRefreshToken(ctx, token, id, expiration)
+	}
+
+	mm_params := ITokenRepositoryMockMigrateRefreshTokenParams{ctx, token, id, expiration}
+
+	// Record call args
+	mmMigrateRefreshToken.MigrateRefreshTokenMock.mutex.Lock()
+	mmMigrateRefreshToken.MigrateRefreshTokenMock.callArgs = append(mmMigrateRefreshToken.MigrateRefreshTokenMock.callArgs, &mm_params)
+	mmMigrateRefreshToken.MigrateRefreshTokenMock.mutex.Unlock()
+
+	for _, e := range mmMigrateRefreshToken.MigrateRefreshTokenMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation.Counter, 1)
+		mm_want := mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation.params
+		mm_want_ptrs := mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockMigrateRefreshTokenParams{ctx, token, id, expiration}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMigrateRefreshToken.t.Errorf("ITokenRepositoryMock.MigrateRefreshToken got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmMigrateRefreshToken.t.Errorf("ITokenRepositoryMock.MigrateRefreshToken got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMigrateRefreshToken.t.Errorf("ITokenRepositoryMock.MigrateRefreshToken got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.expiration != nil && !minimock.Equal(*mm_want_ptrs.expiration, mm_got.expiration) {
+				mmMigrateRefreshToken.t.Errorf("ITokenRepositoryMock.MigrateRefreshToken got unexpected parameter expiration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation.expectationOrigins.originExpiration, *mm_want_ptrs.expiration, mm_got.expiration, minimock.Diff(*mm_want_ptrs.expiration, mm_got.expiration))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMigrateRefreshToken.t.Errorf("ITokenRepositoryMock.MigrateRefreshToken got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMigrateRefreshToken.MigrateRefreshTokenMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMigrateRefreshToken.t.Fatal("No results are set for the ITokenRepositoryMock.MigrateRefreshToken")
+		}
+		return (*mm_results).err
+	}
+	if mmMigrateRefreshToken.funcMigrateRefreshToken != nil {
+		return mmMigrateRefreshToken.funcMigrateRefreshToken(ctx, token, id, expiration)
+	}
+	mmMigrateRefreshToken.t.Fatalf("Unexpected call to ITokenRepositoryMock.MigrateRefreshToken. %v %v %v %v", ctx, token, id, expiration)
+	return
+}
+
+// MigrateRefreshTokenAfterCounter returns a count of finished ITokenRepositoryMock.MigrateRefreshToken invocations
+func (mmMigrateRefreshToken *ITokenRepositoryMock) MigrateRefreshTokenAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMigrateRefreshToken.afterMigrateRefreshTokenCounter)
+}
+
+// MigrateRefreshTokenBeforeCounter returns a count of ITokenRepositoryMock.MigrateRefreshToken invocations
+func (mmMigrateRefreshToken *ITokenRepositoryMock) MigrateRefreshTokenBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMigrateRefreshToken.beforeMigrateRefreshTokenCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.MigrateRefreshToken.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMigrateRefreshToken *mITokenRepositoryMockMigrateRefreshToken) Calls() []*ITokenRepositoryMockMigrateRefreshTokenParams {
+	mmMigrateRefreshToken.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockMigrateRefreshTokenParams, len(mmMigrateRefreshToken.callArgs))
+	copy(argCopy, mmMigrateRefreshToken.callArgs)
+
+	mmMigrateRefreshToken.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMigrateRefreshTokenDone returns true if the count of the MigrateRefreshToken invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockMigrateRefreshTokenDone() bool {
+	if m.MigrateRefreshTokenMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MigrateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MigrateRefreshTokenMock.invocationsDone()
+}
+
+// MinimockMigrateRefreshTokenInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockMigrateRefreshTokenInspect() {
+	for _, e := range m.MigrateRefreshTokenMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.MigrateRefreshToken at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMigrateRefreshTokenCounter := mm_atomic.LoadUint64(&m.afterMigrateRefreshTokenCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MigrateRefreshTokenMock.defaultExpectation != nil && afterMigrateRefreshTokenCounter < 1 {
+		if m.MigrateRefreshTokenMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.MigrateRefreshToken at\n%s", m.MigrateRefreshTokenMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.MigrateRefreshToken at\n%s with params: %#v", m.MigrateRefreshTokenMock.defaultExpectation.expectationOrigins.origin, *m.MigrateRefreshTokenMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMigrateRefreshToken != nil && afterMigrateRefreshTokenCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.MigrateRefreshToken at\n%s", m.funcMigrateRefreshTokenOrigin)
+	}
+
+	if !m.MigrateRefreshTokenMock.invocationsDone() && afterMigrateRefreshTokenCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.MigrateRefreshToken at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MigrateRefreshTokenMock.expectedInvocations), m.MigrateRefreshTokenMock.expectedInvocationsOrigin, afterMigrateRefreshTokenCounter)
+	}
+}
+
+type mITokenRepositoryMockPush struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockPushExpectation
+	expectations       []*ITokenRepositoryMockPushExpectation
+
+	callArgs []*ITokenRepositoryMockPushParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockPushExpectation specifies expectation struct of the ITokenRepository.Push
+type ITokenRepositoryMockPushExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockPushParams
+	paramPtrs          *ITokenRepositoryMockPushParamPtrs
+	expectationOrigins ITokenRepositoryMockPushExpectationOrigins
+	results            *ITokenRepositoryMockPushResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockPushParams contains parameters of the ITokenRepository.Push
+type ITokenRepositoryMockPushParams struct {
+	ctx    context.Context
+	key    string
+	values []string
+}
+
+// ITokenRepositoryMockPushParamPtrs contains pointers to parameters of the ITokenRepository.Push
+type ITokenRepositoryMockPushParamPtrs struct {
+	ctx    *context.Context
+	key    *string
+	values *[]string
+}
+
+// ITokenRepositoryMockPushResults contains results of the ITokenRepository.Push
+type ITokenRepositoryMockPushResults struct {
+	err error
+}
+
+// ITokenRepositoryMockPushOrigins contains origins of expectations of the ITokenRepository.Push
+type ITokenRepositoryMockPushExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originKey    string
+	originValues string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPush *mITokenRepositoryMockPush) Optional() *mITokenRepositoryMockPush {
+	mmPush.optional = true
+	return mmPush
+}
+
+// Expect sets up expected params for ITokenRepository.Push
+func (mmPush *mITokenRepositoryMockPush) Expect(ctx context.Context, key string, values ...string) *mITokenRepositoryMockPush {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+	}
+
+	if mmPush.defaultExpectation == nil {
+		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{}
+	}
+
+	if mmPush.defaultExpectation.paramPtrs != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by ExpectParams functions")
+	}
+
+	mmPush.defaultExpectation.params = &ITokenRepositoryMockPushParams{ctx, key, values}
+	mmPush.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPush.expectations {
+		if minimock.Equal(e.params, mmPush.defaultExpectation.params) {
+			mmPush.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPush.defaultExpectation.params)
+		}
+	}
+
+	return mmPush
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.Push
+func (mmPush *mITokenRepositoryMockPush) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockPush {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+	}
+
+	if mmPush.defaultExpectation == nil {
+		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{}
+	}
+
+	if mmPush.defaultExpectation.params != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Expect")
+	}
+
+	if mmPush.defaultExpectation.paramPtrs == nil {
+		mmPush.defaultExpectation.paramPtrs = &ITokenRepositoryMockPushParamPtrs{}
+	}
+	mmPush.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPush.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPush
+}
+
+// ExpectKeyParam2 sets up expected param key for ITokenRepository.Push
+func (mmPush *mITokenRepositoryMockPush) ExpectKeyParam2(key string) *mITokenRepositoryMockPush {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+	}
+
+	if mmPush.defaultExpectation == nil {
+		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{}
+	}
+
+	if mmPush.defaultExpectation.params != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Expect")
+	}
+
+	if mmPush.defaultExpectation.paramPtrs == nil {
+		mmPush.defaultExpectation.paramPtrs = &ITokenRepositoryMockPushParamPtrs{}
+	}
+	mmPush.defaultExpectation.paramPtrs.key = &key
+	mmPush.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmPush
+}
+
+// ExpectValuesParam3 sets up expected param values for ITokenRepository.Push
+func (mmPush *mITokenRepositoryMockPush) ExpectValuesParam3(values ...string) *mITokenRepositoryMockPush {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+	}
+
+	if mmPush.defaultExpectation == nil {
+		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{}
+	}
+
+	if mmPush.defaultExpectation.params != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Expect")
+	}
+
+	if mmPush.defaultExpectation.paramPtrs == nil {
+		mmPush.defaultExpectation.paramPtrs = &ITokenRepositoryMockPushParamPtrs{}
+	}
+	mmPush.defaultExpectation.paramPtrs.values = &values
+	mmPush.defaultExpectation.expectationOrigins.originValues = minimock.CallerInfo(1)
+
+	return mmPush
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.Push
+func (mmPush *mITokenRepositoryMockPush) Inspect(f func(ctx context.Context, key string, values ...string)) *mITokenRepositoryMockPush {
+	if mmPush.mock.inspectFuncPush != nil {
+		mmPush.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.Push")
+	}
+
+	mmPush.mock.inspectFuncPush = f
+
+	return mmPush
+}
+
+// Return sets up results that will be returned by ITokenRepository.Push
+func (mmPush *mITokenRepositoryMockPush) Return(err error) *ITokenRepositoryMock {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+	}
+
+	if mmPush.defaultExpectation == nil {
+		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{mock: mmPush.mock}
+	}
+	mmPush.defaultExpectation.results = &ITokenRepositoryMockPushResults{err}
+	mmPush.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPush.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.Push method
+func (mmPush *mITokenRepositoryMockPush) Set(f func(ctx context.Context, key string, values ...string) (err error)) *ITokenRepositoryMock {
+	if mmPush.defaultExpectation != nil {
+		mmPush.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.Push method")
+	}
+
+	if len(mmPush.expectations) > 0 {
+		mmPush.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.Push method")
+	}
+
+	mmPush.mock.funcPush = f
+	mmPush.mock.funcPushOrigin = minimock.CallerInfo(1)
+	return mmPush.mock
+}
+
+// When sets expectation for the ITokenRepository.Push which will trigger the result defined by the following
+// Then helper
+func (mmPush *mITokenRepositoryMockPush) When(ctx context.Context, key string, values ...string) *ITokenRepositoryMockPushExpectation {
+	if mmPush.mock.funcPush != nil {
+		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockPushExpectation{
+		mock:               mmPush.mock,
+		params:             &ITokenRepositoryMockPushParams{ctx, key, values},
+		expectationOrigins: ITokenRepositoryMockPushExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPush.expectations = append(mmPush.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.Push return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockPushExpectation) Then(err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockPushResults{err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.Push should be invoked
+func (mmPush *mITokenRepositoryMockPush) Times(n uint64) *mITokenRepositoryMockPush {
+	if n == 0 {
+		mmPush.mock.t.Fatalf("Times of ITokenRepositoryMock.Push mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPush.expectedInvocations, n)
+	mmPush.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPush
+}
+
+func (mmPush *mITokenRepositoryMockPush) invocationsDone() bool {
+	if len(mmPush.expectations) == 0 && mmPush.defaultExpectation == nil && mmPush.mock.funcPush == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPush.mock.afterPushCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPush.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Push implements mm_repository.ITokenRepository
+func (mmPush *ITokenRepositoryMock) Push(ctx context.Context, key string, values ...string) (err error) {
+	mm_atomic.AddUint64(&mmPush.beforePushCounter, 1)
+	defer mm_atomic.AddUint64(&mmPush.afterPushCounter, 1)
+
+	mmPush.t.Helper()
+
+	if mmPush.inspectFuncPush != nil {
+		mmPush.inspectFuncPush(ctx, key, values...)
+	}
+
+	mm_params := ITokenRepositoryMockPushParams{ctx, key, values}
+
+	// Record call args
+	mmPush.PushMock.mutex.Lock()
+	mmPush.PushMock.callArgs = append(mmPush.PushMock.callArgs, &mm_params)
+	mmPush.PushMock.mutex.Unlock()
+
+	for _, e := range mmPush.PushMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPush.PushMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPush.PushMock.defaultExpectation.Counter, 1)
+		mm_want := mmPush.PushMock.defaultExpectation.params
+		mm_want_ptrs := mmPush.PushMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockPushParams{ctx, key, values}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPush.t.Errorf("ITokenRepositoryMock.Push got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPush.PushMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmPush.t.Errorf("ITokenRepositoryMock.Push got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPush.PushMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.values != nil && !minimock.Equal(*mm_want_ptrs.values, mm_got.values) {
+				mmPush.t.Errorf("ITokenRepositoryMock.Push got unexpected parameter values, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPush.PushMock.defaultExpectation.expectationOrigins.originValues, *mm_want_ptrs.values, mm_got.values, minimock.Diff(*mm_want_ptrs.values, mm_got.values))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPush.t.Errorf("ITokenRepositoryMock.Push got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPush.PushMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPush.PushMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPush.t.Fatal("No results are set for the ITokenRepositoryMock.Push")
+		}
+		return (*mm_results).err
+	}
+	if mmPush.funcPush != nil {
+		return mmPush.funcPush(ctx, key, values...)
+	}
+	mmPush.t.Fatalf("Unexpected call to ITokenRepositoryMock.Push. %v %v %v", ctx, key, values)
+	return
+}
+
+// PushAfterCounter returns a count of finished ITokenRepositoryMock.Push invocations
+func (mmPush *ITokenRepositoryMock) PushAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPush.afterPushCounter)
+}
+
+// PushBeforeCounter returns a count of ITokenRepositoryMock.Push invocations
+func (mmPush *ITokenRepositoryMock) PushBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPush.beforePushCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.Push.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPush *mITokenRepositoryMockPush) Calls() []*ITokenRepositoryMockPushParams {
+	mmPush.mutex.RLock()
+
+	argCopy := make([]*ITokenRepositoryMockPushParams, len(mmPush.callArgs))
+	copy(argCopy, mmPush.callArgs)
+
+	mmPush.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPushDone returns true if the count of the Push invocations corresponds
+// the number of defined expectations
+func (m *ITokenRepositoryMock) MinimockPushDone() bool {
+	if m.PushMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PushMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PushMock.invocationsDone()
+}
+
+// MinimockPushInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockPushInspect() {
+	for _, e := range m.PushMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Push at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPushCounter := mm_atomic.LoadUint64(&m.afterPushCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PushMock.defaultExpectation != nil && afterPushCounter < 1 {
+		if m.PushMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Push at\n%s", m.PushMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.Push at\n%s with params: %#v", m.PushMock.defaultExpectation.expectationOrigins.origin, *m.PushMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPush != nil && afterPushCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.Push at\n%s", m.funcPushOrigin)
+	}
+
+	if !m.PushMock.invocationsDone() && afterPushCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.Push at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PushMock.expectedInvocations), m.PushMock.expectedInvocationsOrigin, afterPushCounter)
+	}
+}
+
+type mITokenRepositoryMockSaveSession struct {
+	optional           bool
+	mock               *ITokenRepositoryMock
+	defaultExpectation *ITokenRepositoryMockSaveSessionExpectation
+	expectations       []*ITokenRepositoryMockSaveSessionExpectation
+
+	callArgs []*ITokenRepositoryMockSaveSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITokenRepositoryMockSaveSessionExpectation specifies expectation struct of the ITokenRepository.SaveSession
+type ITokenRepositoryMockSaveSessionExpectation struct {
+	mock               *ITokenRepositoryMock
+	params             *ITokenRepositoryMockSaveSessionParams
+	paramPtrs          *ITokenRepositoryMockSaveSessionParamPtrs
+	expectationOrigins ITokenRepositoryMockSaveSessionExpectationOrigins
+	results            *ITokenRepositoryMockSaveSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITokenRepositoryMockSaveSessionParams contains parameters of the ITokenRepository.SaveSession
+type ITokenRepositoryMockSaveSessionParams struct {
+	ctx        context.Context
+	session    domain.Session
+	expiration time.Duration
+}
+
+// ITokenRepositoryMockSaveSessionParamPtrs contains pointers to parameters of the ITokenRepository.SaveSession
+type ITokenRepositoryMockSaveSessionParamPtrs struct {
+	ctx        *context.Context
+	session    *domain.Session
+	expiration *time.Duration
+}
+
+// ITokenRepositoryMockSaveSessionResults contains results of the ITokenRepository.SaveSession
+type ITokenRepositoryMockSaveSessionResults struct {
+	err error
+}
+
+// ITokenRepositoryMockSaveSessionOrigins contains origins of expectations of the ITokenRepository.SaveSession
+type ITokenRepositoryMockSaveSessionExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originSession    string
+	originExpiration string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveSession *mITokenRepositoryMockSaveSession) Optional() *mITokenRepositoryMockSaveSession {
+	mmSaveSession.optional = true
+	return mmSaveSession
+}
+
+// Expect sets up expected params for ITokenRepository.SaveSession
+func (mmSaveSession *mITokenRepositoryMockSaveSession) Expect(ctx context.Context, session domain.Session, expiration time.Duration) *mITokenRepositoryMockSaveSession {
+	if mmSaveSession.mock.funcSaveSession != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by Set")
+	}
+
+	if mmSaveSession.defaultExpectation == nil {
+		mmSaveSession.defaultExpectation = &ITokenRepositoryMockSaveSessionExpectation{}
+	}
+
+	if mmSaveSession.defaultExpectation.paramPtrs != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by ExpectParams functions")
+	}
+
+	mmSaveSession.defaultExpectation.params = &ITokenRepositoryMockSaveSessionParams{ctx, session, expiration}
+	mmSaveSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveSession.expectations {
+		if minimock.Equal(e.params, mmSaveSession.defaultExpectation.params) {
+			mmSaveSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveSession.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.SaveSession
+func (mmSaveSession *mITokenRepositoryMockSaveSession) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockSaveSession {
+	if mmSaveSession.mock.funcSaveSession != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by Set")
+	}
+
+	if mmSaveSession.defaultExpectation == nil {
+		mmSaveSession.defaultExpectation = &ITokenRepositoryMockSaveSessionExpectation{}
+	}
+
+	if mmSaveSession.defaultExpectation.params != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by Expect")
+	}
+
+	if mmSaveSession.defaultExpectation.paramPtrs == nil {
+		mmSaveSession.defaultExpectation.paramPtrs = &ITokenRepositoryMockSaveSessionParamPtrs{}
+	}
+	mmSaveSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveSession
+}
+
+// ExpectSessionParam2 sets up expected param session for ITokenRepository.SaveSession
+func (mmSaveSession *mITokenRepositoryMockSaveSession) ExpectSessionParam2(session domain.Session) *mITokenRepositoryMockSaveSession {
+	if mmSaveSession.mock.funcSaveSession != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by Set")
+	}
+
+	if mmSaveSession.defaultExpectation == nil {
+		mmSaveSession.defaultExpectation = &ITokenRepositoryMockSaveSessionExpectation{}
+	}
+
+	if mmSaveSession.defaultExpectation.params != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by Expect")
+	}
+
+	if mmSaveSession.defaultExpectation.paramPtrs == nil {
+		mmSaveSession.defaultExpectation.paramPtrs = &ITokenRepositoryMockSaveSessionParamPtrs{}
+	}
+	mmSaveSession.defaultExpectation.paramPtrs.session = &session
+	mmSaveSession.defaultExpectation.expectationOrigins.originSession = minimock.CallerInfo(1)
+
+	return mmSaveSession
+}
+
+// ExpectExpirationParam3 sets up expected param expiration for ITokenRepository.SaveSession
+func (mmSaveSession *mITokenRepositoryMockSaveSession) ExpectExpirationParam3(expiration time.Duration) *mITokenRepositoryMockSaveSession {
+	if mmSaveSession.mock.funcSaveSession != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by Set")
+	}
+
+	if mmSaveSession.defaultExpectation == nil {
+		mmSaveSession.defaultExpectation = &ITokenRepositoryMockSaveSessionExpectation{}
+	}
+
+	if mmSaveSession.defaultExpectation.params != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by Expect")
+	}
+
+	if mmSaveSession.defaultExpectation.paramPtrs == nil {
+		mmSaveSession.defaultExpectation.paramPtrs = &ITokenRepositoryMockSaveSessionParamPtrs{}
+	}
+	mmSaveSession.defaultExpectation.paramPtrs.expiration = &expiration
+	mmSaveSession.defaultExpectation.expectationOrigins.originExpiration = minimock.CallerInfo(1)
+
+	return mmSaveSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.SaveSession
+func (mmSaveSession *mITokenRepositoryMockSaveSession) Inspect(f func(ctx context.Context, session domain.Session, expiration time.Duration)) *mITokenRepositoryMockSaveSession {
+	if mmSaveSession.mock.inspectFuncSaveSession != nil {
+		mmSaveSession.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.SaveSession")
+	}
+
+	mmSaveSession.mock.inspectFuncSaveSession = f
+
+	return mmSaveSession
+}
+
+// Return sets up results that will be returned by ITokenRepository.SaveSession
+func (mmSaveSession *mITokenRepositoryMockSaveSession) Return(err error) *ITokenRepositoryMock {
+	if mmSaveSession.mock.funcSaveSession != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by Set")
+	}
+
+	if mmSaveSession.defaultExpectation == nil {
+		mmSaveSession.defaultExpectation = &ITokenRepositoryMockSaveSessionExpectation{mock: mmSaveSession.mock}
+	}
+	mmSaveSession.defaultExpectation.results = &ITokenRepositoryMockSaveSessionResults{err}
+	mmSaveSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveSession.mock
+}
+
+// Set uses given function f to mock the ITokenRepository.SaveSession method
+func (mmSaveSession *mITokenRepositoryMockSaveSession) Set(f func(ctx context.Context, session domain.Session, expiration time.Duration) (err error)) *ITokenRepositoryMock {
+	if mmSaveSession.defaultExpectation != nil {
+		mmSaveSession.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.SaveSession method")
+	}
+
+	if len(mmSaveSession.expectations) > 0 {
+		mmSaveSession.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.SaveSession method")
+	}
+
+	mmSaveSession.mock.funcSaveSession = f
+	mmSaveSession.mock.funcSaveSessionOrigin = minimock.CallerInfo(1)
+	return mmSaveSession.mock
+}
+
+// When sets expectation for the ITokenRepository.SaveSession which will trigger the result defined by the following
+// Then helper
+func (mmSaveSession *mITokenRepositoryMockSaveSession) When(ctx context.Context, session domain.Session, expiration time.Duration) *ITokenRepositoryMockSaveSessionExpectation {
+	if mmSaveSession.mock.funcSaveSession != nil {
+		mmSaveSession.mock.t.Fatalf("ITokenRepositoryMock.SaveSession mock is already set by Set")
+	}
+
+	expectation := &ITokenRepositoryMockSaveSessionExpectation{
+		mock:               mmSaveSession.mock,
+		params:             &ITokenRepositoryMockSaveSessionParams{ctx, session, expiration},
+		expectationOrigins: ITokenRepositoryMockSaveSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveSession.expectations = append(mmSaveSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITokenRepository.SaveSession return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockSaveSessionExpectation) Then(err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockSaveSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ITokenRepository.SaveSession should be invoked
+func (mmSaveSession *mITokenRepositoryMockSaveSession) Times(n uint64) *mITokenRepositoryMockSaveSession {
+	if n == 0 {
+		mmSaveSession.mock.t.Fatalf("Times of ITokenRepositoryMock.SaveSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveSession.expectedInvocations, n)
+	mmSaveSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveSession
+}
+
+func (mmSaveSession *mITokenRepositoryMockSaveSession) invocationsDone() bool {
+	if len(mmSaveSession.expectations) == 0 && mmSaveSession.defaultExpectation == nil && mmSaveSession.mock.funcSaveSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveSession.mock.afterSaveSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveSession implements mm_repository.ITokenRepository
+func (mmSaveSession *ITokenRepositoryMock) SaveSession(ctx context.Context, session domain.Session, expiration time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmSaveSession.beforeSaveSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveSession.afterSaveSessionCounter, 1)
+
+	mmSaveSession.t.Helper()
+
+	if mmSaveSession.inspectFuncSaveSession != nil {
+		mmSaveSession.inspectFuncSaveSession(ctx, session, expiration)
+	}
+
+	mm_params := ITokenRepositoryMockSaveSessionParams{ctx, session, expiration}
+
+	// Record call args
+	mmSaveSession.SaveSessionMock.mutex.Lock()
+	mmSaveSession.SaveSessionMock.callArgs = append(mmSaveSession.SaveSessionMock.callArgs, &mm_params)
+	mmSaveSession.SaveSessionMock.mutex.Unlock()
+
+	for _, e := range mmSaveSession.SaveSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveSession.SaveSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveSession.SaveSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveSession.SaveSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveSession.SaveSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := ITokenRepositoryMockSaveSessionParams{ctx, session, expiration}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveSession.t.Errorf("ITokenRepositoryMock.SaveSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveSession.SaveSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
+				mmSaveSession.t.Errorf("ITokenRepositoryMock.SaveSession got unexpected parameter session, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveSession.SaveSessionMock.defaultExpectation.expectationOrigins.originSession, *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
+			}
+
+			if mm_want_ptrs.expiration != nil && !minimock.Equal(*mm_want_ptrs.expiration, mm_got.expiration) {
+				mmSaveSession.t.Errorf("ITokenRepositoryMock.SaveSession got unexpected parameter expiration, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveSession.SaveSessionMock.defaultExpectation.expectationOrigins.originExpiration, *mm_want_ptrs.expiration, mm_got.expiration, minimock.Diff(*mm_want_ptrs.expiration, mm_got.expiration))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveSession.t.Errorf("ITokenRepositoryMock.SaveSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveSession.SaveSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveSession.SaveSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveSession.t.Fatal("No results are set for the ITokenRepositoryMock.SaveSession")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveSession.funcSaveSession != nil {
+		return mmSaveSession.funcSaveSession(ctx, session, expiration)
+	}
+	mmSaveSession.t.Fatalf("Unexpected call to ITokenRepositoryMock.SaveSession. %v %v %v", ctx, session, expiration)
+	return
+}
+
+// SaveSessionAfterCounter returns a count of finished ITokenRepositoryMock.SaveSession invocations
+func (mmSaveSession *ITokenRepositoryMock) SaveSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveSession.afterSaveSessionCounter)
 }
 
-// ListBeforeCounter returns a count of ITokenRepositoryMock.List invocations
-func (mmList *ITokenRepositoryMock) ListBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
+// SaveSessionBeforeCounter returns a count of ITokenRepositoryMock.SaveSession invocations
+func (mmSaveSession *ITokenRepositoryMock) SaveSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveSession.beforeSaveSessionCounter)
 }
 
-// Calls returns a list of arguments used in each call to ITokenRepositoryMock.List.
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.SaveSession.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmList *mITokenRepositoryMockList) Calls() []*ITokenRepositoryMockListParams {
-	mmList.mutex.RLock()
+func (mmSaveSession *mITokenRepositoryMockSaveSession) Calls() []*ITokenRepositoryMockSaveSessionParams {
+	mmSaveSession.mutex.RLock()
 
-	argCopy := make([]*ITokenRepositoryMockListParams, len(mmList.callArgs))
-	copy(argCopy, mmList.callArgs)
+	argCopy := make([]*ITokenRepositoryMockSaveSessionParams, len(mmSaveSession.callArgs))
+	copy(argCopy, mmSaveSession.callArgs)
 
-	mmList.mutex.RUnlock()
+	mmSaveSession.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListDone returns true if the count of the List invocations corresponds
+// MinimockSaveSessionDone returns true if the count of the SaveSession invocations corresponds
 // the number of defined expectations
-func (m *ITokenRepositoryMock) MinimockListDone() bool {
-	if m.ListMock.optional {
+func (m *ITokenRepositoryMock) MinimockSaveSessionDone() bool {
+	if m.SaveSessionMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListMock.expectations {
+	for _, e := range m.SaveSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListMock.invocationsDone()
+	return m.SaveSessionMock.invocationsDone()
 }
 
-// MinimockListInspect logs each unmet expectation
-func (m *ITokenRepositoryMock) MinimockListInspect() {
-	for _, e := range m.ListMock.expectations {
+// MinimockSaveSessionInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockSaveSessionInspect() {
+	for _, e := range m.SaveSessionMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ITokenRepositoryMock.SaveSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
+	afterSaveSessionCounter := mm_atomic.LoadUint64(&m.afterSaveSessionCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
-		if m.ListMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
+	if m.SaveSessionMock.defaultExpectation != nil && afterSaveSessionCounter < 1 {
+		if m.SaveSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.SaveSession at\n%s", m.SaveSessionMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ITokenRepositoryMock.SaveSession at\n%s with params: %#v", m.SaveSessionMock.defaultExpectation.expectationOrigins.origin, *m.SaveSessionMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcList != nil && afterListCounter < 1 {
-		m.t.Errorf("Expected call to ITokenRepositoryMock.List at\n%s", m.funcListOrigin)
+	if m.funcSaveSession != nil && afterSaveSessionCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.SaveSession at\n%s", m.funcSaveSessionOrigin)
 	}
 
-	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
-		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.List at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
+	if !m.SaveSessionMock.invocationsDone() && afterSaveSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.SaveSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveSessionMock.expectedInvocations), m.SaveSessionMock.expectedInvocationsOrigin, afterSaveSessionCounter)
 	}
 }
 
-type mITokenRepositoryMockPush struct {
+type mITokenRepositoryMockTTL struct {
 	optional           bool
 	mock               *ITokenRepositoryMock
-	defaultExpectation *ITokenRepositoryMockPushExpectation
-	expectations       []*ITokenRepositoryMockPushExpectation
+	defaultExpectation *ITokenRepositoryMockTTLExpectation
+	expectations       []*ITokenRepositoryMockTTLExpectation
 
-	callArgs []*ITokenRepositoryMockPushParams
+	callArgs []*ITokenRepositoryMockTTLParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ITokenRepositoryMockPushExpectation specifies expectation struct of the ITokenRepository.Push
-type ITokenRepositoryMockPushExpectation struct {
+// ITokenRepositoryMockTTLExpectation specifies expectation struct of the ITokenRepository.TTL
+type ITokenRepositoryMockTTLExpectation struct {
 	mock               *ITokenRepositoryMock
-	params             *ITokenRepositoryMockPushParams
-	paramPtrs          *ITokenRepositoryMockPushParamPtrs
-	expectationOrigins ITokenRepositoryMockPushExpectationOrigins
-	results            *ITokenRepositoryMockPushResults
+	params             *ITokenRepositoryMockTTLParams
+	paramPtrs          *ITokenRepositoryMockTTLParamPtrs
+	expectationOrigins ITokenRepositoryMockTTLExpectationOrigins
+	results            *ITokenRepositoryMockTTLResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ITokenRepositoryMockPushParams contains parameters of the ITokenRepository.Push
-type ITokenRepositoryMockPushParams struct {
-	ctx    context.Context
-	key    string
-	values []string
+// ITokenRepositoryMockTTLParams contains parameters of the ITokenRepository.TTL
+type ITokenRepositoryMockTTLParams struct {
+	ctx context.Context
+	key string
 }
 
-// ITokenRepositoryMockPushParamPtrs contains pointers to parameters of the ITokenRepository.Push
-type ITokenRepositoryMockPushParamPtrs struct {
-	ctx    *context.Context
-	key    *string
-	values *[]string
+// ITokenRepositoryMockTTLParamPtrs contains pointers to parameters of the ITokenRepository.TTL
+type ITokenRepositoryMockTTLParamPtrs struct {
+	ctx *context.Context
+	key *string
 }
 
-// ITokenRepositoryMockPushResults contains results of the ITokenRepository.Push
-type ITokenRepositoryMockPushResults struct {
+// ITokenRepositoryMockTTLResults contains results of the ITokenRepository.TTL
+type ITokenRepositoryMockTTLResults struct {
+	d1  time.Duration
 	err error
 }
 
-// ITokenRepositoryMockPushOrigins contains origins of expectations of the ITokenRepository.Push
-type ITokenRepositoryMockPushExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originKey    string
-	originValues string
+// ITokenRepositoryMockTTLOrigins contains origins of expectations of the ITokenRepository.TTL
+type ITokenRepositoryMockTTLExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originKey string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1571,320 +6814,292 @@ type ITokenRepositoryMockPushExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmPush *mITokenRepositoryMockPush) Optional() *mITokenRepositoryMockPush {
-	mmPush.optional = true
-	return mmPush
+func (mmTTL *mITokenRepositoryMockTTL) Optional() *mITokenRepositoryMockTTL {
+	mmTTL.optional = true
+	return mmTTL
 }
 
-// Expect sets up expected params for ITokenRepository.Push
-func (mmPush *mITokenRepositoryMockPush) Expect(ctx context.Context, key string, values ...string) *mITokenRepositoryMockPush {
-	if mmPush.mock.funcPush != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+// Expect sets up expected params for ITokenRepository.TTL
+func (mmTTL *mITokenRepositoryMockTTL) Expect(ctx context.Context, key string) *mITokenRepositoryMockTTL {
+	if mmTTL.mock.funcTTL != nil {
+		mmTTL.mock.t.Fatalf("ITokenRepositoryMock.TTL mock is already set by Set")
 	}
 
-	if mmPush.defaultExpectation == nil {
-		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{}
+	if mmTTL.defaultExpectation == nil {
+		mmTTL.defaultExpectation = &ITokenRepositoryMockTTLExpectation{}
 	}
 
-	if mmPush.defaultExpectation.paramPtrs != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by ExpectParams functions")
+	if mmTTL.defaultExpectation.paramPtrs != nil {
+		mmTTL.mock.t.Fatalf("ITokenRepositoryMock.TTL mock is already set by ExpectParams functions")
 	}
 
-	mmPush.defaultExpectation.params = &ITokenRepositoryMockPushParams{ctx, key, values}
-	mmPush.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmPush.expectations {
-		if minimock.Equal(e.params, mmPush.defaultExpectation.params) {
-			mmPush.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPush.defaultExpectation.params)
+	mmTTL.defaultExpectation.params = &ITokenRepositoryMockTTLParams{ctx, key}
+	mmTTL.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTTL.expectations {
+		if minimock.Equal(e.params, mmTTL.defaultExpectation.params) {
+			mmTTL.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTTL.defaultExpectation.params)
 		}
 	}
 
-	return mmPush
-}
-
-// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.Push
-func (mmPush *mITokenRepositoryMockPush) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockPush {
-	if mmPush.mock.funcPush != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
-	}
-
-	if mmPush.defaultExpectation == nil {
-		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{}
-	}
-
-	if mmPush.defaultExpectation.params != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Expect")
-	}
-
-	if mmPush.defaultExpectation.paramPtrs == nil {
-		mmPush.defaultExpectation.paramPtrs = &ITokenRepositoryMockPushParamPtrs{}
-	}
-	mmPush.defaultExpectation.paramPtrs.ctx = &ctx
-	mmPush.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmPush
+	return mmTTL
 }
 
-// ExpectKeyParam2 sets up expected param key for ITokenRepository.Push
-func (mmPush *mITokenRepositoryMockPush) ExpectKeyParam2(key string) *mITokenRepositoryMockPush {
-	if mmPush.mock.funcPush != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ITokenRepository.TTL
+func (mmTTL *mITokenRepositoryMockTTL) ExpectCtxParam1(ctx context.Context) *mITokenRepositoryMockTTL {
+	if mmTTL.mock.funcTTL != nil {
+		mmTTL.mock.t.Fatalf("ITokenRepositoryMock.TTL mock is already set by Set")
 	}
 
-	if mmPush.defaultExpectation == nil {
-		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{}
+	if mmTTL.defaultExpectation == nil {
+		mmTTL.defaultExpectation = &ITokenRepositoryMockTTLExpectation{}
 	}
 
-	if mmPush.defaultExpectation.params != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Expect")
+	if mmTTL.defaultExpectation.params != nil {
+		mmTTL.mock.t.Fatalf("ITokenRepositoryMock.TTL mock is already set by Expect")
 	}
 
-	if mmPush.defaultExpectation.paramPtrs == nil {
-		mmPush.defaultExpectation.paramPtrs = &ITokenRepositoryMockPushParamPtrs{}
+	if mmTTL.defaultExpectation.paramPtrs == nil {
+		mmTTL.defaultExpectation.paramPtrs = &ITokenRepositoryMockTTLParamPtrs{}
 	}
-	mmPush.defaultExpectation.paramPtrs.key = &key
-	mmPush.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+	mmTTL.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTTL.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmPush
+	return mmTTL
 }
 
-// ExpectValuesParam3 sets up expected param values for ITokenRepository.Push
-func (mmPush *mITokenRepositoryMockPush) ExpectValuesParam3(values ...string) *mITokenRepositoryMockPush {
-	if mmPush.mock.funcPush != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+// ExpectKeyParam2 sets up expected param key for ITokenRepository.TTL
+func (mmTTL *mITokenRepositoryMockTTL) ExpectKeyParam2(key string) *mITokenRepositoryMockTTL {
+	if mmTTL.mock.funcTTL != nil {
+		mmTTL.mock.t.Fatalf("ITokenRepositoryMock.TTL mock is already set by Set")
 	}
 
-	if mmPush.defaultExpectation == nil {
-		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{}
+	if mmTTL.defaultExpectation == nil {
+		mmTTL.defaultExpectation = &ITokenRepositoryMockTTLExpectation{}
 	}
 
-	if mmPush.defaultExpectation.params != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Expect")
+	if mmTTL.defaultExpectation.params != nil {
+		mmTTL.mock.t.Fatalf("ITokenRepositoryMock.TTL mock is already set by Expect")
 	}
 
-	if mmPush.defaultExpectation.paramPtrs == nil {
-		mmPush.defaultExpectation.paramPtrs = &ITokenRepositoryMockPushParamPtrs{}
+	if mmTTL.defaultExpectation.paramPtrs == nil {
+		mmTTL.defaultExpectation.paramPtrs = &ITokenRepositoryMockTTLParamPtrs{}
 	}
-	mmPush.defaultExpectation.paramPtrs.values = &values
-	mmPush.defaultExpectation.expectationOrigins.originValues = minimock.CallerInfo(1)
+	mmTTL.defaultExpectation.paramPtrs.key = &key
+	mmTTL.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
 
-	return mmPush
+	return mmTTL
 }
 
-// Inspect accepts an inspector function that has same arguments as the ITokenRepository.Push
-func (mmPush *mITokenRepositoryMockPush) Inspect(f func(ctx context.Context, key string, values ...string)) *mITokenRepositoryMockPush {
-	if mmPush.mock.inspectFuncPush != nil {
-		mmPush.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.Push")
+// Inspect accepts an inspector function that has same arguments as the ITokenRepository.TTL
+func (mmTTL *mITokenRepositoryMockTTL) Inspect(f func(ctx context.Context, key string)) *mITokenRepositoryMockTTL {
+	if mmTTL.mock.inspectFuncTTL != nil {
+		mmTTL.mock.t.Fatalf("Inspect function is already set for ITokenRepositoryMock.TTL")
 	}
 
-	mmPush.mock.inspectFuncPush = f
+	mmTTL.mock.inspectFuncTTL = f
 
-	return mmPush
+	return mmTTL
 }
 
-// Return sets up results that will be returned by ITokenRepository.Push
-func (mmPush *mITokenRepositoryMockPush) Return(err error) *ITokenRepositoryMock {
-	if mmPush.mock.funcPush != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+// Return sets up results that will be returned by ITokenRepository.TTL
+func (mmTTL *mITokenRepositoryMockTTL) Return(d1 time.Duration, err error) *ITokenRepositoryMock {
+	if mmTTL.mock.funcTTL != nil {
+		mmTTL.mock.t.Fatalf("ITokenRepositoryMock.TTL mock is already set by Set")
 	}
 
-	if mmPush.defaultExpectation == nil {
-		mmPush.defaultExpectation = &ITokenRepositoryMockPushExpectation{mock: mmPush.mock}
+	if mmTTL.defaultExpectation == nil {
+		mmTTL.defaultExpectation = &ITokenRepositoryMockTTLExpectation{mock: mmTTL.mock}
 	}
-	mmPush.defaultExpectation.results = &ITokenRepositoryMockPushResults{err}
-	mmPush.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmPush.mock
+	mmTTL.defaultExpectation.results = &ITokenRepositoryMockTTLResults{d1, err}
+	mmTTL.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTTL.mock
 }
 
-// Set uses given function f to mock the ITokenRepository.Push method
-func (mmPush *mITokenRepositoryMockPush) Set(f func(ctx context.Context, key string, values ...string) (err error)) *ITokenRepositoryMock {
-	if mmPush.defaultExpectation != nil {
-		mmPush.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.Push method")
+// Set uses given function f to mock the ITokenRepository.TTL method
+func (mmTTL *mITokenRepositoryMockTTL) Set(f func(ctx context.Context, key string) (d1 time.Duration, err error)) *ITokenRepositoryMock {
+	if mmTTL.defaultExpectation != nil {
+		mmTTL.mock.t.Fatalf("Default expectation is already set for the ITokenRepository.TTL method")
 	}
 
-	if len(mmPush.expectations) > 0 {
-		mmPush.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.Push method")
+	if len(mmTTL.expectations) > 0 {
+		mmTTL.mock.t.Fatalf("Some expectations are already set for the ITokenRepository.TTL method")
 	}
 
-	mmPush.mock.funcPush = f
-	mmPush.mock.funcPushOrigin = minimock.CallerInfo(1)
-	return mmPush.mock
+	mmTTL.mock.funcTTL = f
+	mmTTL.mock.funcTTLOrigin = minimock.CallerInfo(1)
+	return mmTTL.mock
 }
 
-// When sets expectation for the ITokenRepository.Push which will trigger the result defined by the following
+// When sets expectation for the ITokenRepository.TTL which will trigger the result defined by the following
 // Then helper
-func (mmPush *mITokenRepositoryMockPush) When(ctx context.Context, key string, values ...string) *ITokenRepositoryMockPushExpectation {
-	if mmPush.mock.funcPush != nil {
-		mmPush.mock.t.Fatalf("ITokenRepositoryMock.Push mock is already set by Set")
+func (mmTTL *mITokenRepositoryMockTTL) When(ctx context.Context, key string) *ITokenRepositoryMockTTLExpectation {
+	if mmTTL.mock.funcTTL != nil {
+		mmTTL.mock.t.Fatalf("ITokenRepositoryMock.TTL mock is already set by Set")
 	}
 
-	expectation := &ITokenRepositoryMockPushExpectation{
-		mock:               mmPush.mock,
-		params:             &ITokenRepositoryMockPushParams{ctx, key, values},
-		expectationOrigins: ITokenRepositoryMockPushExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ITokenRepositoryMockTTLExpectation{
+		mock:               mmTTL.mock,
+		params:             &ITokenRepositoryMockTTLParams{ctx, key},
+		expectationOrigins: ITokenRepositoryMockTTLExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmPush.expectations = append(mmPush.expectations, expectation)
+	mmTTL.expectations = append(mmTTL.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ITokenRepository.Push return parameters for the expectation previously defined by the When method
-func (e *ITokenRepositoryMockPushExpectation) Then(err error) *ITokenRepositoryMock {
-	e.results = &ITokenRepositoryMockPushResults{err}
+// Then sets up ITokenRepository.TTL return parameters for the expectation previously defined by the When method
+func (e *ITokenRepositoryMockTTLExpectation) Then(d1 time.Duration, err error) *ITokenRepositoryMock {
+	e.results = &ITokenRepositoryMockTTLResults{d1, err}
 	return e.mock
 }
 
-// Times sets number of times ITokenRepository.Push should be invoked
-func (mmPush *mITokenRepositoryMockPush) Times(n uint64) *mITokenRepositoryMockPush {
+// Times sets number of times ITokenRepository.TTL should be invoked
+func (mmTTL *mITokenRepositoryMockTTL) Times(n uint64) *mITokenRepositoryMockTTL {
 	if n == 0 {
-		mmPush.mock.t.Fatalf("Times of ITokenRepositoryMock.Push mock can not be zero")
+		mmTTL.mock.t.Fatalf("Times of ITokenRepositoryMock.TTL mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmPush.expectedInvocations, n)
-	mmPush.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmPush
+	mm_atomic.StoreUint64(&mmTTL.expectedInvocations, n)
+	mmTTL.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTTL
 }
 
-func (mmPush *mITokenRepositoryMockPush) invocationsDone() bool {
-	if len(mmPush.expectations) == 0 && mmPush.defaultExpectation == nil && mmPush.mock.funcPush == nil {
+func (mmTTL *mITokenRepositoryMockTTL) invocationsDone() bool {
+	if len(mmTTL.expectations) == 0 && mmTTL.defaultExpectation == nil && mmTTL.mock.funcTTL == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmPush.mock.afterPushCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmPush.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmTTL.mock.afterTTLCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTTL.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Push implements mm_repository.ITokenRepository
-func (mmPush *ITokenRepositoryMock) Push(ctx context.Context, key string, values ...string) (err error) {
-	mm_atomic.AddUint64(&mmPush.beforePushCounter, 1)
-	defer mm_atomic.AddUint64(&mmPush.afterPushCounter, 1)
+// TTL implements mm_repository.ITokenRepository
+func (mmTTL *ITokenRepositoryMock) TTL(ctx context.Context, key string) (d1 time.Duration, err error) {
+	mm_atomic.AddUint64(&mmTTL.beforeTTLCounter, 1)
+	defer mm_atomic.AddUint64(&mmTTL.afterTTLCounter, 1)
 
-	mmPush.t.Helper()
+	mmTTL.t.Helper()
 
-	if mmPush.inspectFuncPush != nil {
-		mmPush.inspectFuncPush(ctx, key, values...)
+	if mmTTL.inspectFuncTTL != nil {
+		mmTTL.inspectFuncTTL(ctx, key)
 	}
 
-	mm_params := ITokenRepositoryMockPushParams{ctx, key, values}
+	mm_params := ITokenRepositoryMockTTLParams{ctx, key}
 
 	// Record call args
-	mmPush.PushMock.mutex.Lock()
-	mmPush.PushMock.callArgs = append(mmPush.PushMock.callArgs, &mm_params)
-	mmPush.PushMock.mutex.Unlock()
+	mmTTL.TTLMock.mutex.Lock()
+	mmTTL.TTLMock.callArgs = append(mmTTL.TTLMock.callArgs, &mm_params)
+	mmTTL.TTLMock.mutex.Unlock()
 
-	for _, e := range mmPush.PushMock.expectations {
+	for _, e := range mmTTL.TTLMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.d1, e.results.err
 		}
 	}
 
-	if mmPush.PushMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmPush.PushMock.defaultExpectation.Counter, 1)
-		mm_want := mmPush.PushMock.defaultExpectation.params
-		mm_want_ptrs := mmPush.PushMock.defaultExpectation.paramPtrs
+	if mmTTL.TTLMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTTL.TTLMock.defaultExpectation.Counter, 1)
+		mm_want := mmTTL.TTLMock.defaultExpectation.params
+		mm_want_ptrs := mmTTL.TTLMock.defaultExpectation.paramPtrs
 
-		mm_got := ITokenRepositoryMockPushParams{ctx, key, values}
+		mm_got := ITokenRepositoryMockTTLParams{ctx, key}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmPush.t.Errorf("ITokenRepositoryMock.Push got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmPush.PushMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmTTL.t.Errorf("ITokenRepositoryMock.TTL got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTTL.TTLMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
-				mmPush.t.Errorf("ITokenRepositoryMock.Push got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmPush.PushMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
-			}
-
-			if mm_want_ptrs.values != nil && !minimock.Equal(*mm_want_ptrs.values, mm_got.values) {
-				mmPush.t.Errorf("ITokenRepositoryMock.Push got unexpected parameter values, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmPush.PushMock.defaultExpectation.expectationOrigins.originValues, *mm_want_ptrs.values, mm_got.values, minimock.Diff(*mm_want_ptrs.values, mm_got.values))
+				mmTTL.t.Errorf("ITokenRepositoryMock.TTL got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTTL.TTLMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmPush.t.Errorf("ITokenRepositoryMock.Push got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmPush.PushMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmTTL.t.Errorf("ITokenRepositoryMock.TTL got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTTL.TTLMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmPush.PushMock.defaultExpectation.results
+		mm_results := mmTTL.TTLMock.defaultExpectation.results
 		if mm_results == nil {
-			mmPush.t.Fatal("No results are set for the ITokenRepositoryMock.Push")
+			mmTTL.t.Fatal("No results are set for the ITokenRepositoryMock.TTL")
 		}
-		return (*mm_results).err
+		return (*mm_results).d1, (*mm_results).err
 	}
-	if mmPush.funcPush != nil {
-		return mmPush.funcPush(ctx, key, values...)
+	if mmTTL.funcTTL != nil {
+		return mmTTL.funcTTL(ctx, key)
 	}
-	mmPush.t.Fatalf("Unexpected call to ITokenRepositoryMock.Push. %v %v %v", ctx, key, values)
+	mmTTL.t.Fatalf("Unexpected call to ITokenRepositoryMock.TTL. %v %v", ctx, key)
 	return
 }
 
-// PushAfterCounter returns a count of finished ITokenRepositoryMock.Push invocations
-func (mmPush *ITokenRepositoryMock) PushAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPush.afterPushCounter)
+// TTLAfterCounter returns a count of finished ITokenRepositoryMock.TTL invocations
+func (mmTTL *ITokenRepositoryMock) TTLAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTTL.afterTTLCounter)
 }
 
-// PushBeforeCounter returns a count of ITokenRepositoryMock.Push invocations
-func (mmPush *ITokenRepositoryMock) PushBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmPush.beforePushCounter)
+// TTLBeforeCounter returns a count of ITokenRepositoryMock.TTL invocations
+func (mmTTL *ITokenRepositoryMock) TTLBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTTL.beforeTTLCounter)
 }
 
-// Calls returns a list of arguments used in each call to ITokenRepositoryMock.Push.
+// Calls returns a list of arguments used in each call to ITokenRepositoryMock.TTL.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmPush *mITokenRepositoryMockPush) Calls() []*ITokenRepositoryMockPushParams {
-	mmPush.mutex.RLock()
+func (mmTTL *mITokenRepositoryMockTTL) Calls() []*ITokenRepositoryMockTTLParams {
+	mmTTL.mutex.RLock()
 
-	argCopy := make([]*ITokenRepositoryMockPushParams, len(mmPush.callArgs))
-	copy(argCopy, mmPush.callArgs)
+	argCopy := make([]*ITokenRepositoryMockTTLParams, len(mmTTL.callArgs))
+	copy(argCopy, mmTTL.callArgs)
 
-	mmPush.mutex.RUnlock()
+	mmTTL.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockPushDone returns true if the count of the Push invocations corresponds
+// MinimockTTLDone returns true if the count of the TTL invocations corresponds
 // the number of defined expectations
-func (m *ITokenRepositoryMock) MinimockPushDone() bool {
-	if m.PushMock.optional {
+func (m *ITokenRepositoryMock) MinimockTTLDone() bool {
+	if m.TTLMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.PushMock.expectations {
+	for _, e := range m.TTLMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.PushMock.invocationsDone()
+	return m.TTLMock.invocationsDone()
 }
 
-// MinimockPushInspect logs each unmet expectation
-func (m *ITokenRepositoryMock) MinimockPushInspect() {
-	for _, e := range m.PushMock.expectations {
+// MinimockTTLInspect logs each unmet expectation
+func (m *ITokenRepositoryMock) MinimockTTLInspect() {
+	for _, e := range m.TTLMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.Push at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ITokenRepositoryMock.TTL at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterPushCounter := mm_atomic.LoadUint64(&m.afterPushCounter)
+	afterTTLCounter := mm_atomic.LoadUint64(&m.afterTTLCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.PushMock.defaultExpectation != nil && afterPushCounter < 1 {
-		if m.PushMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.Push at\n%s", m.PushMock.defaultExpectation.returnOrigin)
+	if m.TTLMock.defaultExpectation != nil && afterTTLCounter < 1 {
+		if m.TTLMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITokenRepositoryMock.TTL at\n%s", m.TTLMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ITokenRepositoryMock.Push at\n%s with params: %#v", m.PushMock.defaultExpectation.expectationOrigins.origin, *m.PushMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ITokenRepositoryMock.TTL at\n%s with params: %#v", m.TTLMock.defaultExpectation.expectationOrigins.origin, *m.TTLMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcPush != nil && afterPushCounter < 1 {
-		m.t.Errorf("Expected call to ITokenRepositoryMock.Push at\n%s", m.funcPushOrigin)
+	if m.funcTTL != nil && afterTTLCounter < 1 {
+		m.t.Errorf("Expected call to ITokenRepositoryMock.TTL at\n%s", m.funcTTLOrigin)
 	}
 
-	if !m.PushMock.invocationsDone() && afterPushCounter > 0 {
-		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.Push at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.PushMock.expectedInvocations), m.PushMock.expectedInvocationsOrigin, afterPushCounter)
+	if !m.TTLMock.invocationsDone() && afterTTLCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITokenRepositoryMock.TTL at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TTLMock.expectedInvocations), m.TTLMock.expectedInvocationsOrigin, afterTTLCounter)
 	}
 }
 
@@ -1894,13 +7109,41 @@ func (m *ITokenRepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddInspect()
 
+			m.MinimockAddNXInspect()
+
+			m.MinimockAddRefreshTokenInspect()
+
 			m.MinimockDeleteInspect()
 
+			m.MinimockDeleteRefreshTokensInspect()
+
+			m.MinimockDeleteSessionsInspect()
+
+			m.MinimockFamilyTokensInspect()
+
 			m.MinimockGetInspect()
 
+			m.MinimockGetDelInspect()
+
+			m.MinimockGetRefreshTokenInspect()
+
+			m.MinimockGetSessionInspect()
+
+			m.MinimockIncrInspect()
+
 			m.MinimockListInspect()
 
+			m.MinimockListSessionsInspect()
+
+			m.MinimockMarkRefreshTokenRotatedInspect()
+
+			m.MinimockMigrateRefreshTokenInspect()
+
 			m.MinimockPushInspect()
+
+			m.MinimockSaveSessionInspect()
+
+			m.MinimockTTLInspect()
 		}
 	})
 }
@@ -1925,8 +7168,22 @@ func (m *ITokenRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddDone() &&
+		m.MinimockAddNXDone() &&
+		m.MinimockAddRefreshTokenDone() &&
 		m.MinimockDeleteDone() &&
+		m.MinimockDeleteRefreshTokensDone() &&
+		m.MinimockDeleteSessionsDone() &&
+		m.MinimockFamilyTokensDone() &&
 		m.MinimockGetDone() &&
+		m.MinimockGetDelDone() &&
+		m.MinimockGetRefreshTokenDone() &&
+		m.MinimockGetSessionDone() &&
+		m.MinimockIncrDone() &&
 		m.MinimockListDone() &&
-		m.MinimockPushDone()
+		m.MinimockListSessionsDone() &&
+		m.MinimockMarkRefreshTokenRotatedDone() &&
+		m.MinimockMigrateRefreshTokenDone() &&
+		m.MinimockPushDone() &&
+		m.MinimockSaveSessionDone() &&
+		m.MinimockTTLDone()
 }
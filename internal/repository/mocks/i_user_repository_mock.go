@@ -8,9 +8,11 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
 	"github.com/maisiq/go-auth-service/internal/domain"
 )
 
@@ -40,6 +42,20 @@ type IUserRepositoryMock struct {
 	beforeGetByEmailCounter uint64
 	GetByEmailMock          mIUserRepositoryMockGetByEmail
 
+	funcGetByID          func(ctx context.Context, id uuid.UUID) (u1 domain.User, err error)
+	funcGetByIDOrigin    string
+	inspectFuncGetByID   func(ctx context.Context, id uuid.UUID)
+	afterGetByIDCounter  uint64
+	beforeGetByIDCounter uint64
+	GetByIDMock          mIUserRepositoryMockGetByID
+
+	funcList          func(ctx context.Context, filter domain.UserFilter) (ua1 []domain.User, i1 int, err error)
+	funcListOrigin    string
+	inspectFuncList   func(ctx context.Context, filter domain.UserFilter)
+	afterListCounter  uint64
+	beforeListCounter uint64
+	ListMock          mIUserRepositoryMockList
+
 	funcLogs          func(ctx context.Context, email string) (ua1 []domain.UserLog, err error)
 	funcLogsOrigin    string
 	inspectFuncLogs   func(ctx context.Context, email string)
@@ -47,12 +63,33 @@ type IUserRepositoryMock struct {
 	beforeLogsCounter uint64
 	LogsMock          mIUserRepositoryMockLogs
 
-	funcUpdate          func(ctx context.Context, user domain.User) (err error)
-	funcUpdateOrigin    string
-	inspectFuncUpdate   func(ctx context.Context, user domain.User)
-	afterUpdateCounter  uint64
-	beforeUpdateCounter uint64
-	UpdateMock          mIUserRepositoryMockUpdate
+	funcMarkVerified          func(ctx context.Context, id uuid.UUID, verifiedAt time.Time) (err error)
+	funcMarkVerifiedOrigin    string
+	inspectFuncMarkVerified   func(ctx context.Context, id uuid.UUID, verifiedAt time.Time)
+	afterMarkVerifiedCounter  uint64
+	beforeMarkVerifiedCounter uint64
+	MarkVerifiedMock          mIUserRepositoryMockMarkVerified
+
+	funcUpdatePassword          func(ctx context.Context, id uuid.UUID, hashedPassword string) (err error)
+	funcUpdatePasswordOrigin    string
+	inspectFuncUpdatePassword   func(ctx context.Context, id uuid.UUID, hashedPassword string)
+	afterUpdatePasswordCounter  uint64
+	beforeUpdatePasswordCounter uint64
+	UpdatePasswordMock          mIUserRepositoryMockUpdatePassword
+
+	funcUpdateRole          func(ctx context.Context, id uuid.UUID, role domain.Role) (err error)
+	funcUpdateRoleOrigin    string
+	inspectFuncUpdateRole   func(ctx context.Context, id uuid.UUID, role domain.Role)
+	afterUpdateRoleCounter  uint64
+	beforeUpdateRoleCounter uint64
+	UpdateRoleMock          mIUserRepositoryMockUpdateRole
+
+	funcUpdateStatus          func(ctx context.Context, user domain.User) (err error)
+	funcUpdateStatusOrigin    string
+	inspectFuncUpdateStatus   func(ctx context.Context, user domain.User)
+	afterUpdateStatusCounter  uint64
+	beforeUpdateStatusCounter uint64
+	UpdateStatusMock          mIUserRepositoryMockUpdateStatus
 }
 
 // NewIUserRepositoryMock returns a mock for mm_repository.IUserRepository
@@ -72,11 +109,26 @@ func NewIUserRepositoryMock(t minimock.Tester) *IUserRepositoryMock {
 	m.GetByEmailMock = mIUserRepositoryMockGetByEmail{mock: m}
 	m.GetByEmailMock.callArgs = []*IUserRepositoryMockGetByEmailParams{}
 
+	m.GetByIDMock = mIUserRepositoryMockGetByID{mock: m}
+	m.GetByIDMock.callArgs = []*IUserRepositoryMockGetByIDParams{}
+
+	m.ListMock = mIUserRepositoryMockList{mock: m}
+	m.ListMock.callArgs = []*IUserRepositoryMockListParams{}
+
 	m.LogsMock = mIUserRepositoryMockLogs{mock: m}
 	m.LogsMock.callArgs = []*IUserRepositoryMockLogsParams{}
 
-	m.UpdateMock = mIUserRepositoryMockUpdate{mock: m}
-	m.UpdateMock.callArgs = []*IUserRepositoryMockUpdateParams{}
+	m.MarkVerifiedMock = mIUserRepositoryMockMarkVerified{mock: m}
+	m.MarkVerifiedMock.callArgs = []*IUserRepositoryMockMarkVerifiedParams{}
+
+	m.UpdatePasswordMock = mIUserRepositoryMockUpdatePassword{mock: m}
+	m.UpdatePasswordMock.callArgs = []*IUserRepositoryMockUpdatePasswordParams{}
+
+	m.UpdateRoleMock = mIUserRepositoryMockUpdateRole{mock: m}
+	m.UpdateRoleMock.callArgs = []*IUserRepositoryMockUpdateRoleParams{}
+
+	m.UpdateStatusMock = mIUserRepositoryMockUpdateStatus{mock: m}
+	m.UpdateStatusMock.callArgs = []*IUserRepositoryMockUpdateStatusParams{}
 
 	t.Cleanup(m.MinimockFinish)
 
@@ -1110,53 +1162,53 @@ func (m *IUserRepositoryMock) MinimockGetByEmailInspect() {
 	}
 }
 
-type mIUserRepositoryMockLogs struct {
+type mIUserRepositoryMockGetByID struct {
 	optional           bool
 	mock               *IUserRepositoryMock
-	defaultExpectation *IUserRepositoryMockLogsExpectation
-	expectations       []*IUserRepositoryMockLogsExpectation
+	defaultExpectation *IUserRepositoryMockGetByIDExpectation
+	expectations       []*IUserRepositoryMockGetByIDExpectation
 
-	callArgs []*IUserRepositoryMockLogsParams
+	callArgs []*IUserRepositoryMockGetByIDParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// IUserRepositoryMockLogsExpectation specifies expectation struct of the IUserRepository.Logs
-type IUserRepositoryMockLogsExpectation struct {
+// IUserRepositoryMockGetByIDExpectation specifies expectation struct of the IUserRepository.GetByID
+type IUserRepositoryMockGetByIDExpectation struct {
 	mock               *IUserRepositoryMock
-	params             *IUserRepositoryMockLogsParams
-	paramPtrs          *IUserRepositoryMockLogsParamPtrs
-	expectationOrigins IUserRepositoryMockLogsExpectationOrigins
-	results            *IUserRepositoryMockLogsResults
+	params             *IUserRepositoryMockGetByIDParams
+	paramPtrs          *IUserRepositoryMockGetByIDParamPtrs
+	expectationOrigins IUserRepositoryMockGetByIDExpectationOrigins
+	results            *IUserRepositoryMockGetByIDResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// IUserRepositoryMockLogsParams contains parameters of the IUserRepository.Logs
-type IUserRepositoryMockLogsParams struct {
-	ctx   context.Context
-	email string
+// IUserRepositoryMockGetByIDParams contains parameters of the IUserRepository.GetByID
+type IUserRepositoryMockGetByIDParams struct {
+	ctx context.Context
+	id  uuid.UUID
 }
 
-// IUserRepositoryMockLogsParamPtrs contains pointers to parameters of the IUserRepository.Logs
-type IUserRepositoryMockLogsParamPtrs struct {
-	ctx   *context.Context
-	email *string
+// IUserRepositoryMockGetByIDParamPtrs contains pointers to parameters of the IUserRepository.GetByID
+type IUserRepositoryMockGetByIDParamPtrs struct {
+	ctx *context.Context
+	id  *uuid.UUID
 }
 
-// IUserRepositoryMockLogsResults contains results of the IUserRepository.Logs
-type IUserRepositoryMockLogsResults struct {
-	ua1 []domain.UserLog
+// IUserRepositoryMockGetByIDResults contains results of the IUserRepository.GetByID
+type IUserRepositoryMockGetByIDResults struct {
+	u1  domain.User
 	err error
 }
 
-// IUserRepositoryMockLogsOrigins contains origins of expectations of the IUserRepository.Logs
-type IUserRepositoryMockLogsExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originEmail string
+// IUserRepositoryMockGetByIDOrigins contains origins of expectations of the IUserRepository.GetByID
+type IUserRepositoryMockGetByIDExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1164,341 +1216,343 @@ type IUserRepositoryMockLogsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmLogs *mIUserRepositoryMockLogs) Optional() *mIUserRepositoryMockLogs {
-	mmLogs.optional = true
-	return mmLogs
+func (mmGetByID *mIUserRepositoryMockGetByID) Optional() *mIUserRepositoryMockGetByID {
+	mmGetByID.optional = true
+	return mmGetByID
 }
 
-// Expect sets up expected params for IUserRepository.Logs
-func (mmLogs *mIUserRepositoryMockLogs) Expect(ctx context.Context, email string) *mIUserRepositoryMockLogs {
-	if mmLogs.mock.funcLogs != nil {
-		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+// Expect sets up expected params for IUserRepository.GetByID
+func (mmGetByID *mIUserRepositoryMockGetByID) Expect(ctx context.Context, id uuid.UUID) *mIUserRepositoryMockGetByID {
+	if mmGetByID.mock.funcGetByID != nil {
+		mmGetByID.mock.t.Fatalf("IUserRepositoryMock.GetByID mock is already set by Set")
 	}
 
-	if mmLogs.defaultExpectation == nil {
-		mmLogs.defaultExpectation = &IUserRepositoryMockLogsExpectation{}
+	if mmGetByID.defaultExpectation == nil {
+		mmGetByID.defaultExpectation = &IUserRepositoryMockGetByIDExpectation{}
 	}
 
-	if mmLogs.defaultExpectation.paramPtrs != nil {
-		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by ExpectParams functions")
+	if mmGetByID.defaultExpectation.paramPtrs != nil {
+		mmGetByID.mock.t.Fatalf("IUserRepositoryMock.GetByID mock is already set by ExpectParams functions")
 	}
 
-	mmLogs.defaultExpectation.params = &IUserRepositoryMockLogsParams{ctx, email}
-	mmLogs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmLogs.expectations {
-		if minimock.Equal(e.params, mmLogs.defaultExpectation.params) {
-			mmLogs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogs.defaultExpectation.params)
+	mmGetByID.defaultExpectation.params = &IUserRepositoryMockGetByIDParams{ctx, id}
+	mmGetByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetByID.expectations {
+		if minimock.Equal(e.params, mmGetByID.defaultExpectation.params) {
+			mmGetByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetByID.defaultExpectation.params)
 		}
 	}
 
-	return mmLogs
+	return mmGetByID
 }
 
-// ExpectCtxParam1 sets up expected param ctx for IUserRepository.Logs
-func (mmLogs *mIUserRepositoryMockLogs) ExpectCtxParam1(ctx context.Context) *mIUserRepositoryMockLogs {
-	if mmLogs.mock.funcLogs != nil {
-		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for IUserRepository.GetByID
+func (mmGetByID *mIUserRepositoryMockGetByID) ExpectCtxParam1(ctx context.Context) *mIUserRepositoryMockGetByID {
+	if mmGetByID.mock.funcGetByID != nil {
+		mmGetByID.mock.t.Fatalf("IUserRepositoryMock.GetByID mock is already set by Set")
 	}
 
-	if mmLogs.defaultExpectation == nil {
-		mmLogs.defaultExpectation = &IUserRepositoryMockLogsExpectation{}
+	if mmGetByID.defaultExpectation == nil {
+		mmGetByID.defaultExpectation = &IUserRepositoryMockGetByIDExpectation{}
 	}
 
-	if mmLogs.defaultExpectation.params != nil {
-		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Expect")
+	if mmGetByID.defaultExpectation.params != nil {
+		mmGetByID.mock.t.Fatalf("IUserRepositoryMock.GetByID mock is already set by Expect")
 	}
 
-	if mmLogs.defaultExpectation.paramPtrs == nil {
-		mmLogs.defaultExpectation.paramPtrs = &IUserRepositoryMockLogsParamPtrs{}
+	if mmGetByID.defaultExpectation.paramPtrs == nil {
+		mmGetByID.defaultExpectation.paramPtrs = &IUserRepositoryMockGetByIDParamPtrs{}
 	}
-	mmLogs.defaultExpectation.paramPtrs.ctx = &ctx
-	mmLogs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmGetByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmLogs
+	return mmGetByID
 }
 
-// ExpectEmailParam2 sets up expected param email for IUserRepository.Logs
-func (mmLogs *mIUserRepositoryMockLogs) ExpectEmailParam2(email string) *mIUserRepositoryMockLogs {
-	if mmLogs.mock.funcLogs != nil {
-		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+// ExpectIdParam2 sets up expected param id for IUserRepository.GetByID
+func (mmGetByID *mIUserRepositoryMockGetByID) ExpectIdParam2(id uuid.UUID) *mIUserRepositoryMockGetByID {
+	if mmGetByID.mock.funcGetByID != nil {
+		mmGetByID.mock.t.Fatalf("IUserRepositoryMock.GetByID mock is already set by Set")
 	}
 
-	if mmLogs.defaultExpectation == nil {
-		mmLogs.defaultExpectation = &IUserRepositoryMockLogsExpectation{}
+	if mmGetByID.defaultExpectation == nil {
+		mmGetByID.defaultExpectation = &IUserRepositoryMockGetByIDExpectation{}
 	}
 
-	if mmLogs.defaultExpectation.params != nil {
-		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Expect")
+	if mmGetByID.defaultExpectation.params != nil {
+		mmGetByID.mock.t.Fatalf("IUserRepositoryMock.GetByID mock is already set by Expect")
 	}
 
-	if mmLogs.defaultExpectation.paramPtrs == nil {
-		mmLogs.defaultExpectation.paramPtrs = &IUserRepositoryMockLogsParamPtrs{}
+	if mmGetByID.defaultExpectation.paramPtrs == nil {
+		mmGetByID.defaultExpectation.paramPtrs = &IUserRepositoryMockGetByIDParamPtrs{}
 	}
-	mmLogs.defaultExpectation.paramPtrs.email = &email
-	mmLogs.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+	mmGetByID.defaultExpectation.paramPtrs.id = &id
+	mmGetByID.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
 
-	return mmLogs
+	return mmGetByID
 }
 
-// Inspect accepts an inspector function that has same arguments as the IUserRepository.Logs
-func (mmLogs *mIUserRepositoryMockLogs) Inspect(f func(ctx context.Context, email string)) *mIUserRepositoryMockLogs {
-	if mmLogs.mock.inspectFuncLogs != nil {
-		mmLogs.mock.t.Fatalf("Inspect function is already set for IUserRepositoryMock.Logs")
+// Inspect accepts an inspector function that has same arguments as the IUserRepository.GetByID
+func (mmGetByID *mIUserRepositoryMockGetByID) Inspect(f func(ctx context.Context, id uuid.UUID)) *mIUserRepositoryMockGetByID {
+	if mmGetByID.mock.inspectFuncGetByID != nil {
+		mmGetByID.mock.t.Fatalf("Inspect function is already set for IUserRepositoryMock.GetByID")
 	}
 
-	mmLogs.mock.inspectFuncLogs = f
+	mmGetByID.mock.inspectFuncGetByID = f
 
-	return mmLogs
+	return mmGetByID
 }
 
-// Return sets up results that will be returned by IUserRepository.Logs
-func (mmLogs *mIUserRepositoryMockLogs) Return(ua1 []domain.UserLog, err error) *IUserRepositoryMock {
-	if mmLogs.mock.funcLogs != nil {
-		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+// Return sets up results that will be returned by IUserRepository.GetByID
+func (mmGetByID *mIUserRepositoryMockGetByID) Return(u1 domain.User, err error) *IUserRepositoryMock {
+	if mmGetByID.mock.funcGetByID != nil {
+		mmGetByID.mock.t.Fatalf("IUserRepositoryMock.GetByID mock is already set by Set")
 	}
 
-	if mmLogs.defaultExpectation == nil {
-		mmLogs.defaultExpectation = &IUserRepositoryMockLogsExpectation{mock: mmLogs.mock}
+	if mmGetByID.defaultExpectation == nil {
+		mmGetByID.defaultExpectation = &IUserRepositoryMockGetByIDExpectation{mock: mmGetByID.mock}
 	}
-	mmLogs.defaultExpectation.results = &IUserRepositoryMockLogsResults{ua1, err}
-	mmLogs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmLogs.mock
+	mmGetByID.defaultExpectation.results = &IUserRepositoryMockGetByIDResults{u1, err}
+	mmGetByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetByID.mock
 }
 
-// Set uses given function f to mock the IUserRepository.Logs method
-func (mmLogs *mIUserRepositoryMockLogs) Set(f func(ctx context.Context, email string) (ua1 []domain.UserLog, err error)) *IUserRepositoryMock {
-	if mmLogs.defaultExpectation != nil {
-		mmLogs.mock.t.Fatalf("Default expectation is already set for the IUserRepository.Logs method")
+// Set uses given function f to mock the IUserRepository.GetByID method
+func (mmGetByID *mIUserRepositoryMockGetByID) Set(f func(ctx context.Context, id uuid.UUID) (u1 domain.User, err error)) *IUserRepositoryMock {
+	if mmGetByID.defaultExpectation != nil {
+		mmGetByID.mock.t.Fatalf("Default expectation is already set for the IUserRepository.GetByID method")
 	}
 
-	if len(mmLogs.expectations) > 0 {
-		mmLogs.mock.t.Fatalf("Some expectations are already set for the IUserRepository.Logs method")
+	if len(mmGetByID.expectations) > 0 {
+		mmGetByID.mock.t.Fatalf("Some expectations are already set for the IUserRepository.GetByID method")
 	}
 
-	mmLogs.mock.funcLogs = f
-	mmLogs.mock.funcLogsOrigin = minimock.CallerInfo(1)
-	return mmLogs.mock
+	mmGetByID.mock.funcGetByID = f
+	mmGetByID.mock.funcGetByIDOrigin = minimock.CallerInfo(1)
+	return mmGetByID.mock
 }
 
-// When sets expectation for the IUserRepository.Logs which will trigger the result defined by the following
+// When sets expectation for the IUserRepository.GetByID which will trigger the result defined by the following
 // Then helper
-func (mmLogs *mIUserRepositoryMockLogs) When(ctx context.Context, email string) *IUserRepositoryMockLogsExpectation {
-	if mmLogs.mock.funcLogs != nil {
-		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+func (mmGetByID *mIUserRepositoryMockGetByID) When(ctx context.Context, id uuid.UUID) *IUserRepositoryMockGetByIDExpectation {
+	if mmGetByID.mock.funcGetByID != nil {
+		mmGetByID.mock.t.Fatalf("IUserRepositoryMock.GetByID mock is already set by Set")
 	}
 
-	expectation := &IUserRepositoryMockLogsExpectation{
-		mock:               mmLogs.mock,
-		params:             &IUserRepositoryMockLogsParams{ctx, email},
-		expectationOrigins: IUserRepositoryMockLogsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &IUserRepositoryMockGetByIDExpectation{
+		mock:               mmGetByID.mock,
+		params:             &IUserRepositoryMockGetByIDParams{ctx, id},
+		expectationOrigins: IUserRepositoryMockGetByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmLogs.expectations = append(mmLogs.expectations, expectation)
+	mmGetByID.expectations = append(mmGetByID.expectations, expectation)
 	return expectation
 }
 
-// Then sets up IUserRepository.Logs return parameters for the expectation previously defined by the When method
-func (e *IUserRepositoryMockLogsExpectation) Then(ua1 []domain.UserLog, err error) *IUserRepositoryMock {
-	e.results = &IUserRepositoryMockLogsResults{ua1, err}
+// Then sets up IUserRepository.GetByID return parameters for the expectation previously defined by the When method
+func (e *IUserRepositoryMockGetByIDExpectation) Then(u1 domain.User, err error) *IUserRepositoryMock {
+	e.results = &IUserRepositoryMockGetByIDResults{u1, err}
 	return e.mock
 }
 
-// Times sets number of times IUserRepository.Logs should be invoked
-func (mmLogs *mIUserRepositoryMockLogs) Times(n uint64) *mIUserRepositoryMockLogs {
+// Times sets number of times IUserRepository.GetByID should be invoked
+func (mmGetByID *mIUserRepositoryMockGetByID) Times(n uint64) *mIUserRepositoryMockGetByID {
 	if n == 0 {
-		mmLogs.mock.t.Fatalf("Times of IUserRepositoryMock.Logs mock can not be zero")
+		mmGetByID.mock.t.Fatalf("Times of IUserRepositoryMock.GetByID mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmLogs.expectedInvocations, n)
-	mmLogs.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmLogs
+	mm_atomic.StoreUint64(&mmGetByID.expectedInvocations, n)
+	mmGetByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetByID
 }
 
-func (mmLogs *mIUserRepositoryMockLogs) invocationsDone() bool {
-	if len(mmLogs.expectations) == 0 && mmLogs.defaultExpectation == nil && mmLogs.mock.funcLogs == nil {
+func (mmGetByID *mIUserRepositoryMockGetByID) invocationsDone() bool {
+	if len(mmGetByID.expectations) == 0 && mmGetByID.defaultExpectation == nil && mmGetByID.mock.funcGetByID == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmLogs.mock.afterLogsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmLogs.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmGetByID.mock.afterGetByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetByID.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Logs implements mm_repository.IUserRepository
-func (mmLogs *IUserRepositoryMock) Logs(ctx context.Context, email string) (ua1 []domain.UserLog, err error) {
-	mm_atomic.AddUint64(&mmLogs.beforeLogsCounter, 1)
-	defer mm_atomic.AddUint64(&mmLogs.afterLogsCounter, 1)
+// GetByID implements mm_repository.IUserRepository
+func (mmGetByID *IUserRepositoryMock) GetByID(ctx context.Context, id uuid.UUID) (u1 domain.User, err error) {
+	mm_atomic.AddUint64(&mmGetByID.beforeGetByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetByID.afterGetByIDCounter, 1)
 
-	mmLogs.t.Helper()
+	mmGetByID.t.Helper()
 
-	if mmLogs.inspectFuncLogs != nil {
-		mmLogs.inspectFuncLogs(ctx, email)
+	if mmGetByID.inspectFuncGetByID != nil {
+		mmGetByID.inspectFuncGetByID(ctx, id)
 	}
 
-	mm_params := IUserRepositoryMockLogsParams{ctx, email}
+	mm_params := IUserRepositoryMockGetByIDParams{ctx, id}
 
 	// Record call args
-	mmLogs.LogsMock.mutex.Lock()
-	mmLogs.LogsMock.callArgs = append(mmLogs.LogsMock.callArgs, &mm_params)
-	mmLogs.LogsMock.mutex.Unlock()
+	mmGetByID.GetByIDMock.mutex.Lock()
+	mmGetByID.GetByIDMock.callArgs = append(mmGetByID.GetByIDMock.callArgs, &mm_params)
+	mmGetByID.GetByIDMock.mutex.Unlock()
 
-	for _, e := range mmLogs.LogsMock.expectations {
+	for _, e := range mmGetByID.GetByIDMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ua1, e.results.err
+			return e.results.u1, e.results.err
 		}
 	}
 
-	if mmLogs.LogsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmLogs.LogsMock.defaultExpectation.Counter, 1)
-		mm_want := mmLogs.LogsMock.defaultExpectation.params
-		mm_want_ptrs := mmLogs.LogsMock.defaultExpectation.paramPtrs
+	if mmGetByID.GetByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetByID.GetByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetByID.GetByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetByID.GetByIDMock.defaultExpectation.paramPtrs
 
-		mm_got := IUserRepositoryMockLogsParams{ctx, email}
+		mm_got := IUserRepositoryMockGetByIDParams{ctx, id}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmLogs.t.Errorf("IUserRepositoryMock.Logs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogs.LogsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmGetByID.t.Errorf("IUserRepositoryMock.GetByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByID.GetByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
-				mmLogs.t.Errorf("IUserRepositoryMock.Logs got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmLogs.LogsMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetByID.t.Errorf("IUserRepositoryMock.GetByID got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetByID.GetByIDMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmLogs.t.Errorf("IUserRepositoryMock.Logs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmLogs.LogsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmGetByID.t.Errorf("IUserRepositoryMock.GetByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetByID.GetByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmLogs.LogsMock.defaultExpectation.results
+		mm_results := mmGetByID.GetByIDMock.defaultExpectation.results
 		if mm_results == nil {
-			mmLogs.t.Fatal("No results are set for the IUserRepositoryMock.Logs")
+			mmGetByID.t.Fatal("No results are set for the IUserRepositoryMock.GetByID")
 		}
-		return (*mm_results).ua1, (*mm_results).err
+		return (*mm_results).u1, (*mm_results).err
 	}
-	if mmLogs.funcLogs != nil {
-		return mmLogs.funcLogs(ctx, email)
+	if mmGetByID.funcGetByID != nil {
+		return mmGetByID.funcGetByID(ctx, id)
 	}
-	mmLogs.t.Fatalf("Unexpected call to IUserRepositoryMock.Logs. %v %v", ctx, email)
+	mmGetByID.t.Fatalf("Unexpected call to IUserRepositoryMock.GetByID. %v %v", ctx, id)
 	return
 }
 
-// LogsAfterCounter returns a count of finished IUserRepositoryMock.Logs invocations
-func (mmLogs *IUserRepositoryMock) LogsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogs.afterLogsCounter)
+// GetByIDAfterCounter returns a count of finished IUserRepositoryMock.GetByID invocations
+func (mmGetByID *IUserRepositoryMock) GetByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByID.afterGetByIDCounter)
 }
 
-// LogsBeforeCounter returns a count of IUserRepositoryMock.Logs invocations
-func (mmLogs *IUserRepositoryMock) LogsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmLogs.beforeLogsCounter)
+// GetByIDBeforeCounter returns a count of IUserRepositoryMock.GetByID invocations
+func (mmGetByID *IUserRepositoryMock) GetByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetByID.beforeGetByIDCounter)
 }
 
-// Calls returns a list of arguments used in each call to IUserRepositoryMock.Logs.
+// Calls returns a list of arguments used in each call to IUserRepositoryMock.GetByID.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmLogs *mIUserRepositoryMockLogs) Calls() []*IUserRepositoryMockLogsParams {
-	mmLogs.mutex.RLock()
+func (mmGetByID *mIUserRepositoryMockGetByID) Calls() []*IUserRepositoryMockGetByIDParams {
+	mmGetByID.mutex.RLock()
 
-	argCopy := make([]*IUserRepositoryMockLogsParams, len(mmLogs.callArgs))
-	copy(argCopy, mmLogs.callArgs)
+	argCopy := make([]*IUserRepositoryMockGetByIDParams, len(mmGetByID.callArgs))
+	copy(argCopy, mmGetByID.callArgs)
 
-	mmLogs.mutex.RUnlock()
+	mmGetByID.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockLogsDone returns true if the count of the Logs invocations corresponds
+// MinimockGetByIDDone returns true if the count of the GetByID invocations corresponds
 // the number of defined expectations
-func (m *IUserRepositoryMock) MinimockLogsDone() bool {
-	if m.LogsMock.optional {
+func (m *IUserRepositoryMock) MinimockGetByIDDone() bool {
+	if m.GetByIDMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.LogsMock.expectations {
+	for _, e := range m.GetByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.LogsMock.invocationsDone()
+	return m.GetByIDMock.invocationsDone()
 }
 
-// MinimockLogsInspect logs each unmet expectation
-func (m *IUserRepositoryMock) MinimockLogsInspect() {
-	for _, e := range m.LogsMock.expectations {
+// MinimockGetByIDInspect logs each unmet expectation
+func (m *IUserRepositoryMock) MinimockGetByIDInspect() {
+	for _, e := range m.GetByIDMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to IUserRepositoryMock.Logs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to IUserRepositoryMock.GetByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterLogsCounter := mm_atomic.LoadUint64(&m.afterLogsCounter)
+	afterGetByIDCounter := mm_atomic.LoadUint64(&m.afterGetByIDCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.LogsMock.defaultExpectation != nil && afterLogsCounter < 1 {
-		if m.LogsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to IUserRepositoryMock.Logs at\n%s", m.LogsMock.defaultExpectation.returnOrigin)
+	if m.GetByIDMock.defaultExpectation != nil && afterGetByIDCounter < 1 {
+		if m.GetByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IUserRepositoryMock.GetByID at\n%s", m.GetByIDMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to IUserRepositoryMock.Logs at\n%s with params: %#v", m.LogsMock.defaultExpectation.expectationOrigins.origin, *m.LogsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to IUserRepositoryMock.GetByID at\n%s with params: %#v", m.GetByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetByIDMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcLogs != nil && afterLogsCounter < 1 {
-		m.t.Errorf("Expected call to IUserRepositoryMock.Logs at\n%s", m.funcLogsOrigin)
+	if m.funcGetByID != nil && afterGetByIDCounter < 1 {
+		m.t.Errorf("Expected call to IUserRepositoryMock.GetByID at\n%s", m.funcGetByIDOrigin)
 	}
 
-	if !m.LogsMock.invocationsDone() && afterLogsCounter > 0 {
-		m.t.Errorf("Expected %d calls to IUserRepositoryMock.Logs at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.LogsMock.expectedInvocations), m.LogsMock.expectedInvocationsOrigin, afterLogsCounter)
+	if !m.GetByIDMock.invocationsDone() && afterGetByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to IUserRepositoryMock.GetByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetByIDMock.expectedInvocations), m.GetByIDMock.expectedInvocationsOrigin, afterGetByIDCounter)
 	}
 }
 
-type mIUserRepositoryMockUpdate struct {
+type mIUserRepositoryMockList struct {
 	optional           bool
 	mock               *IUserRepositoryMock
-	defaultExpectation *IUserRepositoryMockUpdateExpectation
-	expectations       []*IUserRepositoryMockUpdateExpectation
+	defaultExpectation *IUserRepositoryMockListExpectation
+	expectations       []*IUserRepositoryMockListExpectation
 
-	callArgs []*IUserRepositoryMockUpdateParams
+	callArgs []*IUserRepositoryMockListParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// IUserRepositoryMockUpdateExpectation specifies expectation struct of the IUserRepository.Update
-type IUserRepositoryMockUpdateExpectation struct {
+// IUserRepositoryMockListExpectation specifies expectation struct of the IUserRepository.List
+type IUserRepositoryMockListExpectation struct {
 	mock               *IUserRepositoryMock
-	params             *IUserRepositoryMockUpdateParams
-	paramPtrs          *IUserRepositoryMockUpdateParamPtrs
-	expectationOrigins IUserRepositoryMockUpdateExpectationOrigins
-	results            *IUserRepositoryMockUpdateResults
+	params             *IUserRepositoryMockListParams
+	paramPtrs          *IUserRepositoryMockListParamPtrs
+	expectationOrigins IUserRepositoryMockListExpectationOrigins
+	results            *IUserRepositoryMockListResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// IUserRepositoryMockUpdateParams contains parameters of the IUserRepository.Update
-type IUserRepositoryMockUpdateParams struct {
-	ctx  context.Context
-	user domain.User
+// IUserRepositoryMockListParams contains parameters of the IUserRepository.List
+type IUserRepositoryMockListParams struct {
+	ctx    context.Context
+	filter domain.UserFilter
 }
 
-// IUserRepositoryMockUpdateParamPtrs contains pointers to parameters of the IUserRepository.Update
-type IUserRepositoryMockUpdateParamPtrs struct {
-	ctx  *context.Context
-	user *domain.User
+// IUserRepositoryMockListParamPtrs contains pointers to parameters of the IUserRepository.List
+type IUserRepositoryMockListParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.UserFilter
 }
 
-// IUserRepositoryMockUpdateResults contains results of the IUserRepository.Update
-type IUserRepositoryMockUpdateResults struct {
+// IUserRepositoryMockListResults contains results of the IUserRepository.List
+type IUserRepositoryMockListResults struct {
+	ua1 []domain.User
+	i1  int
 	err error
 }
 
-// IUserRepositoryMockUpdateOrigins contains origins of expectations of the IUserRepository.Update
-type IUserRepositoryMockUpdateExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originUser string
+// IUserRepositoryMockListOrigins contains origins of expectations of the IUserRepository.List
+type IUserRepositoryMockListExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1506,308 +1560,2122 @@ type IUserRepositoryMockUpdateExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdate *mIUserRepositoryMockUpdate) Optional() *mIUserRepositoryMockUpdate {
-	mmUpdate.optional = true
-	return mmUpdate
+func (mmList *mIUserRepositoryMockList) Optional() *mIUserRepositoryMockList {
+	mmList.optional = true
+	return mmList
 }
 
-// Expect sets up expected params for IUserRepository.Update
-func (mmUpdate *mIUserRepositoryMockUpdate) Expect(ctx context.Context, user domain.User) *mIUserRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("IUserRepositoryMock.Update mock is already set by Set")
+// Expect sets up expected params for IUserRepository.List
+func (mmList *mIUserRepositoryMockList) Expect(ctx context.Context, filter domain.UserFilter) *mIUserRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("IUserRepositoryMock.List mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &IUserRepositoryMockUpdateExpectation{}
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &IUserRepositoryMockListExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs != nil {
-		mmUpdate.mock.t.Fatalf("IUserRepositoryMock.Update mock is already set by ExpectParams functions")
+	if mmList.defaultExpectation.paramPtrs != nil {
+		mmList.mock.t.Fatalf("IUserRepositoryMock.List mock is already set by ExpectParams functions")
 	}
 
-	mmUpdate.defaultExpectation.params = &IUserRepositoryMockUpdateParams{ctx, user}
-	mmUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdate.expectations {
-		if minimock.Equal(e.params, mmUpdate.defaultExpectation.params) {
-			mmUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdate.defaultExpectation.params)
+	mmList.defaultExpectation.params = &IUserRepositoryMockListParams{ctx, filter}
+	mmList.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmList.expectations {
+		if minimock.Equal(e.params, mmList.defaultExpectation.params) {
+			mmList.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmList.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdate
+	return mmList
 }
 
-// ExpectCtxParam1 sets up expected param ctx for IUserRepository.Update
-func (mmUpdate *mIUserRepositoryMockUpdate) ExpectCtxParam1(ctx context.Context) *mIUserRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("IUserRepositoryMock.Update mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for IUserRepository.List
+func (mmList *mIUserRepositoryMockList) ExpectCtxParam1(ctx context.Context) *mIUserRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("IUserRepositoryMock.List mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &IUserRepositoryMockUpdateExpectation{}
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &IUserRepositoryMockListExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.params != nil {
-		mmUpdate.mock.t.Fatalf("IUserRepositoryMock.Update mock is already set by Expect")
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("IUserRepositoryMock.List mock is already set by Expect")
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs == nil {
-		mmUpdate.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdateParamPtrs{}
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &IUserRepositoryMockListParamPtrs{}
 	}
-	mmUpdate.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmList.defaultExpectation.paramPtrs.ctx = &ctx
+	mmList.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdate
+	return mmList
 }
 
-// ExpectUserParam2 sets up expected param user for IUserRepository.Update
-func (mmUpdate *mIUserRepositoryMockUpdate) ExpectUserParam2(user domain.User) *mIUserRepositoryMockUpdate {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("IUserRepositoryMock.Update mock is already set by Set")
+// ExpectFilterParam2 sets up expected param filter for IUserRepository.List
+func (mmList *mIUserRepositoryMockList) ExpectFilterParam2(filter domain.UserFilter) *mIUserRepositoryMockList {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("IUserRepositoryMock.List mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &IUserRepositoryMockUpdateExpectation{}
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &IUserRepositoryMockListExpectation{}
 	}
 
-	if mmUpdate.defaultExpectation.params != nil {
-		mmUpdate.mock.t.Fatalf("IUserRepositoryMock.Update mock is already set by Expect")
+	if mmList.defaultExpectation.params != nil {
+		mmList.mock.t.Fatalf("IUserRepositoryMock.List mock is already set by Expect")
 	}
 
-	if mmUpdate.defaultExpectation.paramPtrs == nil {
-		mmUpdate.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdateParamPtrs{}
+	if mmList.defaultExpectation.paramPtrs == nil {
+		mmList.defaultExpectation.paramPtrs = &IUserRepositoryMockListParamPtrs{}
 	}
-	mmUpdate.defaultExpectation.paramPtrs.user = &user
-	mmUpdate.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+	mmList.defaultExpectation.paramPtrs.filter = &filter
+	mmList.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
 
-	return mmUpdate
+	return mmList
 }
 
-// Inspect accepts an inspector function that has same arguments as the IUserRepository.Update
-func (mmUpdate *mIUserRepositoryMockUpdate) Inspect(f func(ctx context.Context, user domain.User)) *mIUserRepositoryMockUpdate {
-	if mmUpdate.mock.inspectFuncUpdate != nil {
-		mmUpdate.mock.t.Fatalf("Inspect function is already set for IUserRepositoryMock.Update")
+// Inspect accepts an inspector function that has same arguments as the IUserRepository.List
+func (mmList *mIUserRepositoryMockList) Inspect(f func(ctx context.Context, filter domain.UserFilter)) *mIUserRepositoryMockList {
+	if mmList.mock.inspectFuncList != nil {
+		mmList.mock.t.Fatalf("Inspect function is already set for IUserRepositoryMock.List")
 	}
 
-	mmUpdate.mock.inspectFuncUpdate = f
+	mmList.mock.inspectFuncList = f
 
-	return mmUpdate
+	return mmList
 }
 
-// Return sets up results that will be returned by IUserRepository.Update
-func (mmUpdate *mIUserRepositoryMockUpdate) Return(err error) *IUserRepositoryMock {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("IUserRepositoryMock.Update mock is already set by Set")
+// Return sets up results that will be returned by IUserRepository.List
+func (mmList *mIUserRepositoryMockList) Return(ua1 []domain.User, i1 int, err error) *IUserRepositoryMock {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("IUserRepositoryMock.List mock is already set by Set")
 	}
 
-	if mmUpdate.defaultExpectation == nil {
-		mmUpdate.defaultExpectation = &IUserRepositoryMockUpdateExpectation{mock: mmUpdate.mock}
+	if mmList.defaultExpectation == nil {
+		mmList.defaultExpectation = &IUserRepositoryMockListExpectation{mock: mmList.mock}
 	}
-	mmUpdate.defaultExpectation.results = &IUserRepositoryMockUpdateResults{err}
-	mmUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUpdate.mock
+	mmList.defaultExpectation.results = &IUserRepositoryMockListResults{ua1, i1, err}
+	mmList.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmList.mock
 }
 
-// Set uses given function f to mock the IUserRepository.Update method
-func (mmUpdate *mIUserRepositoryMockUpdate) Set(f func(ctx context.Context, user domain.User) (err error)) *IUserRepositoryMock {
-	if mmUpdate.defaultExpectation != nil {
-		mmUpdate.mock.t.Fatalf("Default expectation is already set for the IUserRepository.Update method")
+// Set uses given function f to mock the IUserRepository.List method
+func (mmList *mIUserRepositoryMockList) Set(f func(ctx context.Context, filter domain.UserFilter) (ua1 []domain.User, i1 int, err error)) *IUserRepositoryMock {
+	if mmList.defaultExpectation != nil {
+		mmList.mock.t.Fatalf("Default expectation is already set for the IUserRepository.List method")
 	}
 
-	if len(mmUpdate.expectations) > 0 {
-		mmUpdate.mock.t.Fatalf("Some expectations are already set for the IUserRepository.Update method")
+	if len(mmList.expectations) > 0 {
+		mmList.mock.t.Fatalf("Some expectations are already set for the IUserRepository.List method")
 	}
 
-	mmUpdate.mock.funcUpdate = f
-	mmUpdate.mock.funcUpdateOrigin = minimock.CallerInfo(1)
-	return mmUpdate.mock
+	mmList.mock.funcList = f
+	mmList.mock.funcListOrigin = minimock.CallerInfo(1)
+	return mmList.mock
 }
 
-// When sets expectation for the IUserRepository.Update which will trigger the result defined by the following
+// When sets expectation for the IUserRepository.List which will trigger the result defined by the following
 // Then helper
-func (mmUpdate *mIUserRepositoryMockUpdate) When(ctx context.Context, user domain.User) *IUserRepositoryMockUpdateExpectation {
-	if mmUpdate.mock.funcUpdate != nil {
-		mmUpdate.mock.t.Fatalf("IUserRepositoryMock.Update mock is already set by Set")
+func (mmList *mIUserRepositoryMockList) When(ctx context.Context, filter domain.UserFilter) *IUserRepositoryMockListExpectation {
+	if mmList.mock.funcList != nil {
+		mmList.mock.t.Fatalf("IUserRepositoryMock.List mock is already set by Set")
 	}
 
-	expectation := &IUserRepositoryMockUpdateExpectation{
-		mock:               mmUpdate.mock,
-		params:             &IUserRepositoryMockUpdateParams{ctx, user},
-		expectationOrigins: IUserRepositoryMockUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &IUserRepositoryMockListExpectation{
+		mock:               mmList.mock,
+		params:             &IUserRepositoryMockListParams{ctx, filter},
+		expectationOrigins: IUserRepositoryMockListExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmUpdate.expectations = append(mmUpdate.expectations, expectation)
+	mmList.expectations = append(mmList.expectations, expectation)
 	return expectation
 }
 
-// Then sets up IUserRepository.Update return parameters for the expectation previously defined by the When method
-func (e *IUserRepositoryMockUpdateExpectation) Then(err error) *IUserRepositoryMock {
-	e.results = &IUserRepositoryMockUpdateResults{err}
+// Then sets up IUserRepository.List return parameters for the expectation previously defined by the When method
+func (e *IUserRepositoryMockListExpectation) Then(ua1 []domain.User, i1 int, err error) *IUserRepositoryMock {
+	e.results = &IUserRepositoryMockListResults{ua1, i1, err}
 	return e.mock
 }
 
-// Times sets number of times IUserRepository.Update should be invoked
-func (mmUpdate *mIUserRepositoryMockUpdate) Times(n uint64) *mIUserRepositoryMockUpdate {
+// Times sets number of times IUserRepository.List should be invoked
+func (mmList *mIUserRepositoryMockList) Times(n uint64) *mIUserRepositoryMockList {
 	if n == 0 {
-		mmUpdate.mock.t.Fatalf("Times of IUserRepositoryMock.Update mock can not be zero")
+		mmList.mock.t.Fatalf("Times of IUserRepositoryMock.List mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdate.expectedInvocations, n)
-	mmUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmUpdate
+	mm_atomic.StoreUint64(&mmList.expectedInvocations, n)
+	mmList.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmList
 }
 
-func (mmUpdate *mIUserRepositoryMockUpdate) invocationsDone() bool {
-	if len(mmUpdate.expectations) == 0 && mmUpdate.defaultExpectation == nil && mmUpdate.mock.funcUpdate == nil {
+func (mmList *mIUserRepositoryMockList) invocationsDone() bool {
+	if len(mmList.expectations) == 0 && mmList.defaultExpectation == nil && mmList.mock.funcList == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdate.mock.afterUpdateCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdate.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmList.mock.afterListCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmList.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Update implements mm_repository.IUserRepository
-func (mmUpdate *IUserRepositoryMock) Update(ctx context.Context, user domain.User) (err error) {
-	mm_atomic.AddUint64(&mmUpdate.beforeUpdateCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdate.afterUpdateCounter, 1)
+// List implements mm_repository.IUserRepository
+func (mmList *IUserRepositoryMock) List(ctx context.Context, filter domain.UserFilter) (ua1 []domain.User, i1 int, err error) {
+	mm_atomic.AddUint64(&mmList.beforeListCounter, 1)
+	defer mm_atomic.AddUint64(&mmList.afterListCounter, 1)
 
-	mmUpdate.t.Helper()
+	mmList.t.Helper()
 
-	if mmUpdate.inspectFuncUpdate != nil {
-		mmUpdate.inspectFuncUpdate(ctx, user)
+	if mmList.inspectFuncList != nil {
+		mmList.inspectFuncList(ctx, filter)
 	}
 
-	mm_params := IUserRepositoryMockUpdateParams{ctx, user}
+	mm_params := IUserRepositoryMockListParams{ctx, filter}
 
 	// Record call args
-	mmUpdate.UpdateMock.mutex.Lock()
-	mmUpdate.UpdateMock.callArgs = append(mmUpdate.UpdateMock.callArgs, &mm_params)
-	mmUpdate.UpdateMock.mutex.Unlock()
+	mmList.ListMock.mutex.Lock()
+	mmList.ListMock.callArgs = append(mmList.ListMock.callArgs, &mm_params)
+	mmList.ListMock.mutex.Unlock()
 
-	for _, e := range mmUpdate.UpdateMock.expectations {
+	for _, e := range mmList.ListMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ua1, e.results.i1, e.results.err
 		}
 	}
 
-	if mmUpdate.UpdateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdate.UpdateMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdate.UpdateMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdate.UpdateMock.defaultExpectation.paramPtrs
+	if mmList.ListMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmList.ListMock.defaultExpectation.Counter, 1)
+		mm_want := mmList.ListMock.defaultExpectation.params
+		mm_want_ptrs := mmList.ListMock.defaultExpectation.paramPtrs
 
-		mm_got := IUserRepositoryMockUpdateParams{ctx, user}
+		mm_got := IUserRepositoryMockListParams{ctx, filter}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdate.t.Errorf("IUserRepositoryMock.Update got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmList.t.Errorf("IUserRepositoryMock.List got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
-				mmUpdate.t.Errorf("IUserRepositoryMock.Update got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmList.t.Errorf("IUserRepositoryMock.List got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmList.ListMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdate.t.Errorf("IUserRepositoryMock.Update got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmUpdate.UpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmList.t.Errorf("IUserRepositoryMock.List got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmList.ListMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdate.UpdateMock.defaultExpectation.results
+		mm_results := mmList.ListMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdate.t.Fatal("No results are set for the IUserRepositoryMock.Update")
+			mmList.t.Fatal("No results are set for the IUserRepositoryMock.List")
 		}
-		return (*mm_results).err
+		return (*mm_results).ua1, (*mm_results).i1, (*mm_results).err
 	}
-	if mmUpdate.funcUpdate != nil {
-		return mmUpdate.funcUpdate(ctx, user)
+	if mmList.funcList != nil {
+		return mmList.funcList(ctx, filter)
 	}
-	mmUpdate.t.Fatalf("Unexpected call to IUserRepositoryMock.Update. %v %v", ctx, user)
+	mmList.t.Fatalf("Unexpected call to IUserRepositoryMock.List. %v %v", ctx, filter)
 	return
 }
 
-// UpdateAfterCounter returns a count of finished IUserRepositoryMock.Update invocations
-func (mmUpdate *IUserRepositoryMock) UpdateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdate.afterUpdateCounter)
+// ListAfterCounter returns a count of finished IUserRepositoryMock.List invocations
+func (mmList *IUserRepositoryMock) ListAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.afterListCounter)
 }
 
-// UpdateBeforeCounter returns a count of IUserRepositoryMock.Update invocations
-func (mmUpdate *IUserRepositoryMock) UpdateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdate.beforeUpdateCounter)
+// ListBeforeCounter returns a count of IUserRepositoryMock.List invocations
+func (mmList *IUserRepositoryMock) ListBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmList.beforeListCounter)
 }
 
-// Calls returns a list of arguments used in each call to IUserRepositoryMock.Update.
+// Calls returns a list of arguments used in each call to IUserRepositoryMock.List.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdate *mIUserRepositoryMockUpdate) Calls() []*IUserRepositoryMockUpdateParams {
-	mmUpdate.mutex.RLock()
+func (mmList *mIUserRepositoryMockList) Calls() []*IUserRepositoryMockListParams {
+	mmList.mutex.RLock()
 
-	argCopy := make([]*IUserRepositoryMockUpdateParams, len(mmUpdate.callArgs))
-	copy(argCopy, mmUpdate.callArgs)
+	argCopy := make([]*IUserRepositoryMockListParams, len(mmList.callArgs))
+	copy(argCopy, mmList.callArgs)
 
-	mmUpdate.mutex.RUnlock()
+	mmList.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateDone returns true if the count of the Update invocations corresponds
+// MinimockListDone returns true if the count of the List invocations corresponds
 // the number of defined expectations
-func (m *IUserRepositoryMock) MinimockUpdateDone() bool {
-	if m.UpdateMock.optional {
+func (m *IUserRepositoryMock) MinimockListDone() bool {
+	if m.ListMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateMock.expectations {
+	for _, e := range m.ListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateMock.invocationsDone()
+	return m.ListMock.invocationsDone()
 }
 
-// MinimockUpdateInspect logs each unmet expectation
-func (m *IUserRepositoryMock) MinimockUpdateInspect() {
-	for _, e := range m.UpdateMock.expectations {
+// MinimockListInspect logs each unmet expectation
+func (m *IUserRepositoryMock) MinimockListInspect() {
+	for _, e := range m.ListMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to IUserRepositoryMock.Update at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to IUserRepositoryMock.List at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterUpdateCounter := mm_atomic.LoadUint64(&m.afterUpdateCounter)
+	afterListCounter := mm_atomic.LoadUint64(&m.afterListCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateMock.defaultExpectation != nil && afterUpdateCounter < 1 {
-		if m.UpdateMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to IUserRepositoryMock.Update at\n%s", m.UpdateMock.defaultExpectation.returnOrigin)
+	if m.ListMock.defaultExpectation != nil && afterListCounter < 1 {
+		if m.ListMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IUserRepositoryMock.List at\n%s", m.ListMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to IUserRepositoryMock.Update at\n%s with params: %#v", m.UpdateMock.defaultExpectation.expectationOrigins.origin, *m.UpdateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to IUserRepositoryMock.List at\n%s with params: %#v", m.ListMock.defaultExpectation.expectationOrigins.origin, *m.ListMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdate != nil && afterUpdateCounter < 1 {
-		m.t.Errorf("Expected call to IUserRepositoryMock.Update at\n%s", m.funcUpdateOrigin)
+	if m.funcList != nil && afterListCounter < 1 {
+		m.t.Errorf("Expected call to IUserRepositoryMock.List at\n%s", m.funcListOrigin)
 	}
 
-	if !m.UpdateMock.invocationsDone() && afterUpdateCounter > 0 {
-		m.t.Errorf("Expected %d calls to IUserRepositoryMock.Update at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateMock.expectedInvocations), m.UpdateMock.expectedInvocationsOrigin, afterUpdateCounter)
+	if !m.ListMock.invocationsDone() && afterListCounter > 0 {
+		m.t.Errorf("Expected %d calls to IUserRepositoryMock.List at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMock.expectedInvocations), m.ListMock.expectedInvocationsOrigin, afterListCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *IUserRepositoryMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockAddInspect()
+type mIUserRepositoryMockLogs struct {
+	optional           bool
+	mock               *IUserRepositoryMock
+	defaultExpectation *IUserRepositoryMockLogsExpectation
+	expectations       []*IUserRepositoryMockLogsExpectation
 
-			m.MinimockAddLogInspect()
+	callArgs []*IUserRepositoryMockLogsParams
+	mutex    sync.RWMutex
 
-			m.MinimockGetByEmailInspect()
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
 
-			m.MinimockLogsInspect()
+// IUserRepositoryMockLogsExpectation specifies expectation struct of the IUserRepository.Logs
+type IUserRepositoryMockLogsExpectation struct {
+	mock               *IUserRepositoryMock
+	params             *IUserRepositoryMockLogsParams
+	paramPtrs          *IUserRepositoryMockLogsParamPtrs
+	expectationOrigins IUserRepositoryMockLogsExpectationOrigins
+	results            *IUserRepositoryMockLogsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IUserRepositoryMockLogsParams contains parameters of the IUserRepository.Logs
+type IUserRepositoryMockLogsParams struct {
+	ctx   context.Context
+	email string
+}
+
+// IUserRepositoryMockLogsParamPtrs contains pointers to parameters of the IUserRepository.Logs
+type IUserRepositoryMockLogsParamPtrs struct {
+	ctx   *context.Context
+	email *string
+}
+
+// IUserRepositoryMockLogsResults contains results of the IUserRepository.Logs
+type IUserRepositoryMockLogsResults struct {
+	ua1 []domain.UserLog
+	err error
+}
+
+// IUserRepositoryMockLogsOrigins contains origins of expectations of the IUserRepository.Logs
+type IUserRepositoryMockLogsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originEmail string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLogs *mIUserRepositoryMockLogs) Optional() *mIUserRepositoryMockLogs {
+	mmLogs.optional = true
+	return mmLogs
+}
+
+// Expect sets up expected params for IUserRepository.Logs
+func (mmLogs *mIUserRepositoryMockLogs) Expect(ctx context.Context, email string) *mIUserRepositoryMockLogs {
+	if mmLogs.mock.funcLogs != nil {
+		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+	}
+
+	if mmLogs.defaultExpectation == nil {
+		mmLogs.defaultExpectation = &IUserRepositoryMockLogsExpectation{}
+	}
+
+	if mmLogs.defaultExpectation.paramPtrs != nil {
+		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by ExpectParams functions")
+	}
+
+	mmLogs.defaultExpectation.params = &IUserRepositoryMockLogsParams{ctx, email}
+	mmLogs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLogs.expectations {
+		if minimock.Equal(e.params, mmLogs.defaultExpectation.params) {
+			mmLogs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLogs.defaultExpectation.params)
+		}
+	}
+
+	return mmLogs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IUserRepository.Logs
+func (mmLogs *mIUserRepositoryMockLogs) ExpectCtxParam1(ctx context.Context) *mIUserRepositoryMockLogs {
+	if mmLogs.mock.funcLogs != nil {
+		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+	}
+
+	if mmLogs.defaultExpectation == nil {
+		mmLogs.defaultExpectation = &IUserRepositoryMockLogsExpectation{}
+	}
+
+	if mmLogs.defaultExpectation.params != nil {
+		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Expect")
+	}
+
+	if mmLogs.defaultExpectation.paramPtrs == nil {
+		mmLogs.defaultExpectation.paramPtrs = &IUserRepositoryMockLogsParamPtrs{}
+	}
+	mmLogs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLogs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLogs
+}
+
+// ExpectEmailParam2 sets up expected param email for IUserRepository.Logs
+func (mmLogs *mIUserRepositoryMockLogs) ExpectEmailParam2(email string) *mIUserRepositoryMockLogs {
+	if mmLogs.mock.funcLogs != nil {
+		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+	}
+
+	if mmLogs.defaultExpectation == nil {
+		mmLogs.defaultExpectation = &IUserRepositoryMockLogsExpectation{}
+	}
+
+	if mmLogs.defaultExpectation.params != nil {
+		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Expect")
+	}
+
+	if mmLogs.defaultExpectation.paramPtrs == nil {
+		mmLogs.defaultExpectation.paramPtrs = &IUserRepositoryMockLogsParamPtrs{}
+	}
+	mmLogs.defaultExpectation.paramPtrs.email = &email
+	mmLogs.defaultExpectation.expectationOrigins.originEmail = minimock.CallerInfo(1)
+
+	return mmLogs
+}
+
+// Inspect accepts an inspector function that has same arguments as the IUserRepository.Logs
+func (mmLogs *mIUserRepositoryMockLogs) Inspect(f func(ctx context.Context, email string)) *mIUserRepositoryMockLogs {
+	if mmLogs.mock.inspectFuncLogs != nil {
+		mmLogs.mock.t.Fatalf("Inspect function is already set for IUserRepositoryMock.Logs")
+	}
+
+	mmLogs.mock.inspectFuncLogs = f
+
+	return mmLogs
+}
+
+// Return sets up results that will be returned by IUserRepository.Logs
+func (mmLogs *mIUserRepositoryMockLogs) Return(ua1 []domain.UserLog, err error) *IUserRepositoryMock {
+	if mmLogs.mock.funcLogs != nil {
+		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+	}
+
+	if mmLogs.defaultExpectation == nil {
+		mmLogs.defaultExpectation = &IUserRepositoryMockLogsExpectation{mock: mmLogs.mock}
+	}
+	mmLogs.defaultExpectation.results = &IUserRepositoryMockLogsResults{ua1, err}
+	mmLogs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLogs.mock
+}
+
+// Set uses given function f to mock the IUserRepository.Logs method
+func (mmLogs *mIUserRepositoryMockLogs) Set(f func(ctx context.Context, email string) (ua1 []domain.UserLog, err error)) *IUserRepositoryMock {
+	if mmLogs.defaultExpectation != nil {
+		mmLogs.mock.t.Fatalf("Default expectation is already set for the IUserRepository.Logs method")
+	}
+
+	if len(mmLogs.expectations) > 0 {
+		mmLogs.mock.t.Fatalf("Some expectations are already set for the IUserRepository.Logs method")
+	}
+
+	mmLogs.mock.funcLogs = f
+	mmLogs.mock.funcLogsOrigin = minimock.CallerInfo(1)
+	return mmLogs.mock
+}
+
+// When sets expectation for the IUserRepository.Logs which will trigger the result defined by the following
+// Then helper
+func (mmLogs *mIUserRepositoryMockLogs) When(ctx context.Context, email string) *IUserRepositoryMockLogsExpectation {
+	if mmLogs.mock.funcLogs != nil {
+		mmLogs.mock.t.Fatalf("IUserRepositoryMock.Logs mock is already set by Set")
+	}
+
+	expectation := &IUserRepositoryMockLogsExpectation{
+		mock:               mmLogs.mock,
+		params:             &IUserRepositoryMockLogsParams{ctx, email},
+		expectationOrigins: IUserRepositoryMockLogsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLogs.expectations = append(mmLogs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IUserRepository.Logs return parameters for the expectation previously defined by the When method
+func (e *IUserRepositoryMockLogsExpectation) Then(ua1 []domain.UserLog, err error) *IUserRepositoryMock {
+	e.results = &IUserRepositoryMockLogsResults{ua1, err}
+	return e.mock
+}
+
+// Times sets number of times IUserRepository.Logs should be invoked
+func (mmLogs *mIUserRepositoryMockLogs) Times(n uint64) *mIUserRepositoryMockLogs {
+	if n == 0 {
+		mmLogs.mock.t.Fatalf("Times of IUserRepositoryMock.Logs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLogs.expectedInvocations, n)
+	mmLogs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLogs
+}
+
+func (mmLogs *mIUserRepositoryMockLogs) invocationsDone() bool {
+	if len(mmLogs.expectations) == 0 && mmLogs.defaultExpectation == nil && mmLogs.mock.funcLogs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLogs.mock.afterLogsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLogs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Logs implements mm_repository.IUserRepository
+func (mmLogs *IUserRepositoryMock) Logs(ctx context.Context, email string) (ua1 []domain.UserLog, err error) {
+	mm_atomic.AddUint64(&mmLogs.beforeLogsCounter, 1)
+	defer mm_atomic.AddUint64(&mmLogs.afterLogsCounter, 1)
+
+	mmLogs.t.Helper()
+
+	if mmLogs.inspectFuncLogs != nil {
+		mmLogs.inspectFuncLogs(ctx, email)
+	}
+
+	mm_params := IUserRepositoryMockLogsParams{ctx, email}
+
+	// Record call args
+	mmLogs.LogsMock.mutex.Lock()
+	mmLogs.LogsMock.callArgs = append(mmLogs.LogsMock.callArgs, &mm_params)
+	mmLogs.LogsMock.mutex.Unlock()
+
+	for _, e := range mmLogs.LogsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmLogs.LogsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLogs.LogsMock.defaultExpectation.Counter, 1)
+		mm_want := mmLogs.LogsMock.defaultExpectation.params
+		mm_want_ptrs := mmLogs.LogsMock.defaultExpectation.paramPtrs
+
+		mm_got := IUserRepositoryMockLogsParams{ctx, email}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLogs.t.Errorf("IUserRepositoryMock.Logs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogs.LogsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.email != nil && !minimock.Equal(*mm_want_ptrs.email, mm_got.email) {
+				mmLogs.t.Errorf("IUserRepositoryMock.Logs got unexpected parameter email, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLogs.LogsMock.defaultExpectation.expectationOrigins.originEmail, *mm_want_ptrs.email, mm_got.email, minimock.Diff(*mm_want_ptrs.email, mm_got.email))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLogs.t.Errorf("IUserRepositoryMock.Logs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLogs.LogsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLogs.LogsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLogs.t.Fatal("No results are set for the IUserRepositoryMock.Logs")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmLogs.funcLogs != nil {
+		return mmLogs.funcLogs(ctx, email)
+	}
+	mmLogs.t.Fatalf("Unexpected call to IUserRepositoryMock.Logs. %v %v", ctx, email)
+	return
+}
+
+// LogsAfterCounter returns a count of finished IUserRepositoryMock.Logs invocations
+func (mmLogs *IUserRepositoryMock) LogsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogs.afterLogsCounter)
+}
+
+// LogsBeforeCounter returns a count of IUserRepositoryMock.Logs invocations
+func (mmLogs *IUserRepositoryMock) LogsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLogs.beforeLogsCounter)
+}
+
+// Calls returns a list of arguments used in each call to IUserRepositoryMock.Logs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLogs *mIUserRepositoryMockLogs) Calls() []*IUserRepositoryMockLogsParams {
+	mmLogs.mutex.RLock()
+
+	argCopy := make([]*IUserRepositoryMockLogsParams, len(mmLogs.callArgs))
+	copy(argCopy, mmLogs.callArgs)
+
+	mmLogs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLogsDone returns true if the count of the Logs invocations corresponds
+// the number of defined expectations
+func (m *IUserRepositoryMock) MinimockLogsDone() bool {
+	if m.LogsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LogsMock.invocationsDone()
+}
+
+// MinimockLogsInspect logs each unmet expectation
+func (m *IUserRepositoryMock) MinimockLogsInspect() {
+	for _, e := range m.LogsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IUserRepositoryMock.Logs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLogsCounter := mm_atomic.LoadUint64(&m.afterLogsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LogsMock.defaultExpectation != nil && afterLogsCounter < 1 {
+		if m.LogsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IUserRepositoryMock.Logs at\n%s", m.LogsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IUserRepositoryMock.Logs at\n%s with params: %#v", m.LogsMock.defaultExpectation.expectationOrigins.origin, *m.LogsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLogs != nil && afterLogsCounter < 1 {
+		m.t.Errorf("Expected call to IUserRepositoryMock.Logs at\n%s", m.funcLogsOrigin)
+	}
+
+	if !m.LogsMock.invocationsDone() && afterLogsCounter > 0 {
+		m.t.Errorf("Expected %d calls to IUserRepositoryMock.Logs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LogsMock.expectedInvocations), m.LogsMock.expectedInvocationsOrigin, afterLogsCounter)
+	}
+}
+
+type mIUserRepositoryMockMarkVerified struct {
+	optional           bool
+	mock               *IUserRepositoryMock
+	defaultExpectation *IUserRepositoryMockMarkVerifiedExpectation
+	expectations       []*IUserRepositoryMockMarkVerifiedExpectation
+
+	callArgs []*IUserRepositoryMockMarkVerifiedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IUserRepositoryMockMarkVerifiedExpectation specifies expectation struct of the IUserRepository.MarkVerified
+type IUserRepositoryMockMarkVerifiedExpectation struct {
+	mock               *IUserRepositoryMock
+	params             *IUserRepositoryMockMarkVerifiedParams
+	paramPtrs          *IUserRepositoryMockMarkVerifiedParamPtrs
+	expectationOrigins IUserRepositoryMockMarkVerifiedExpectationOrigins
+	results            *IUserRepositoryMockMarkVerifiedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IUserRepositoryMockMarkVerifiedParams contains parameters of the IUserRepository.MarkVerified
+type IUserRepositoryMockMarkVerifiedParams struct {
+	ctx        context.Context
+	id         uuid.UUID
+	verifiedAt time.Time
+}
+
+// IUserRepositoryMockMarkVerifiedParamPtrs contains pointers to parameters of the IUserRepository.MarkVerified
+type IUserRepositoryMockMarkVerifiedParamPtrs struct {
+	ctx        *context.Context
+	id         *uuid.UUID
+	verifiedAt *time.Time
+}
+
+// IUserRepositoryMockMarkVerifiedResults contains results of the IUserRepository.MarkVerified
+type IUserRepositoryMockMarkVerifiedResults struct {
+	err error
+}
+
+// IUserRepositoryMockMarkVerifiedOrigins contains origins of expectations of the IUserRepository.MarkVerified
+type IUserRepositoryMockMarkVerifiedExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originId         string
+	originVerifiedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) Optional() *mIUserRepositoryMockMarkVerified {
+	mmMarkVerified.optional = true
+	return mmMarkVerified
+}
+
+// Expect sets up expected params for IUserRepository.MarkVerified
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) Expect(ctx context.Context, id uuid.UUID, verifiedAt time.Time) *mIUserRepositoryMockMarkVerified {
+	if mmMarkVerified.mock.funcMarkVerified != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by Set")
+	}
+
+	if mmMarkVerified.defaultExpectation == nil {
+		mmMarkVerified.defaultExpectation = &IUserRepositoryMockMarkVerifiedExpectation{}
+	}
+
+	if mmMarkVerified.defaultExpectation.paramPtrs != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by ExpectParams functions")
+	}
+
+	mmMarkVerified.defaultExpectation.params = &IUserRepositoryMockMarkVerifiedParams{ctx, id, verifiedAt}
+	mmMarkVerified.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkVerified.expectations {
+		if minimock.Equal(e.params, mmMarkVerified.defaultExpectation.params) {
+			mmMarkVerified.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkVerified.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkVerified
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IUserRepository.MarkVerified
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) ExpectCtxParam1(ctx context.Context) *mIUserRepositoryMockMarkVerified {
+	if mmMarkVerified.mock.funcMarkVerified != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by Set")
+	}
+
+	if mmMarkVerified.defaultExpectation == nil {
+		mmMarkVerified.defaultExpectation = &IUserRepositoryMockMarkVerifiedExpectation{}
+	}
+
+	if mmMarkVerified.defaultExpectation.params != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by Expect")
+	}
+
+	if mmMarkVerified.defaultExpectation.paramPtrs == nil {
+		mmMarkVerified.defaultExpectation.paramPtrs = &IUserRepositoryMockMarkVerifiedParamPtrs{}
+	}
+	mmMarkVerified.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkVerified.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkVerified
+}
+
+// ExpectIdParam2 sets up expected param id for IUserRepository.MarkVerified
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) ExpectIdParam2(id uuid.UUID) *mIUserRepositoryMockMarkVerified {
+	if mmMarkVerified.mock.funcMarkVerified != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by Set")
+	}
+
+	if mmMarkVerified.defaultExpectation == nil {
+		mmMarkVerified.defaultExpectation = &IUserRepositoryMockMarkVerifiedExpectation{}
+	}
+
+	if mmMarkVerified.defaultExpectation.params != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by Expect")
+	}
+
+	if mmMarkVerified.defaultExpectation.paramPtrs == nil {
+		mmMarkVerified.defaultExpectation.paramPtrs = &IUserRepositoryMockMarkVerifiedParamPtrs{}
+	}
+	mmMarkVerified.defaultExpectation.paramPtrs.id = &id
+	mmMarkVerified.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmMarkVerified
+}
+
+// ExpectVerifiedAtParam3 sets up expected param verifiedAt for IUserRepository.MarkVerified
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) ExpectVerifiedAtParam3(verifiedAt time.Time) *mIUserRepositoryMockMarkVerified {
+	if mmMarkVerified.mock.funcMarkVerified != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by Set")
+	}
+
+	if mmMarkVerified.defaultExpectation == nil {
+		mmMarkVerified.defaultExpectation = &IUserRepositoryMockMarkVerifiedExpectation{}
+	}
+
+	if mmMarkVerified.defaultExpectation.params != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by Expect")
+	}
+
+	if mmMarkVerified.defaultExpectation.paramPtrs == nil {
+		mmMarkVerified.defaultExpectation.paramPtrs = &IUserRepositoryMockMarkVerifiedParamPtrs{}
+	}
+	mmMarkVerified.defaultExpectation.paramPtrs.verifiedAt = &verifiedAt
+	mmMarkVerified.defaultExpectation.expectationOrigins.originVerifiedAt = minimock.CallerInfo(1)
+
+	return mmMarkVerified
+}
+
+// Inspect accepts an inspector function that has same arguments as the IUserRepository.MarkVerified
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) Inspect(f func(ctx context.Context, id uuid.UUID, verifiedAt time.Time)) *mIUserRepositoryMockMarkVerified {
+	if mmMarkVerified.mock.inspectFuncMarkVerified != nil {
+		mmMarkVerified.mock.t.Fatalf("Inspect function is already set for IUserRepositoryMock.MarkVerified")
+	}
+
+	mmMarkVerified.mock.inspectFuncMarkVerified = f
+
+	return mmMarkVerified
+}
+
+// Return sets up results that will be returned by IUserRepository.MarkVerified
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) Return(err error) *IUserRepositoryMock {
+	if mmMarkVerified.mock.funcMarkVerified != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by Set")
+	}
+
+	if mmMarkVerified.defaultExpectation == nil {
+		mmMarkVerified.defaultExpectation = &IUserRepositoryMockMarkVerifiedExpectation{mock: mmMarkVerified.mock}
+	}
+	mmMarkVerified.defaultExpectation.results = &IUserRepositoryMockMarkVerifiedResults{err}
+	mmMarkVerified.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkVerified.mock
+}
+
+// Set uses given function f to mock the IUserRepository.MarkVerified method
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) Set(f func(ctx context.Context, id uuid.UUID, verifiedAt time.Time) (err error)) *IUserRepositoryMock {
+	if mmMarkVerified.defaultExpectation != nil {
+		mmMarkVerified.mock.t.Fatalf("Default expectation is already set for the IUserRepository.MarkVerified method")
+	}
+
+	if len(mmMarkVerified.expectations) > 0 {
+		mmMarkVerified.mock.t.Fatalf("Some expectations are already set for the IUserRepository.MarkVerified method")
+	}
+
+	mmMarkVerified.mock.funcMarkVerified = f
+	mmMarkVerified.mock.funcMarkVerifiedOrigin = minimock.CallerInfo(1)
+	return mmMarkVerified.mock
+}
+
+// When sets expectation for the IUserRepository.MarkVerified which will trigger the result defined by the following
+// Then helper
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) When(ctx context.Context, id uuid.UUID, verifiedAt time.Time) *IUserRepositoryMockMarkVerifiedExpectation {
+	if mmMarkVerified.mock.funcMarkVerified != nil {
+		mmMarkVerified.mock.t.Fatalf("IUserRepositoryMock.MarkVerified mock is already set by Set")
+	}
+
+	expectation := &IUserRepositoryMockMarkVerifiedExpectation{
+		mock:               mmMarkVerified.mock,
+		params:             &IUserRepositoryMockMarkVerifiedParams{ctx, id, verifiedAt},
+		expectationOrigins: IUserRepositoryMockMarkVerifiedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkVerified.expectations = append(mmMarkVerified.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IUserRepository.MarkVerified return parameters for the expectation previously defined by the When method
+func (e *IUserRepositoryMockMarkVerifiedExpectation) Then(err error) *IUserRepositoryMock {
+	e.results = &IUserRepositoryMockMarkVerifiedResults{err}
+	return e.mock
+}
+
+// Times sets number of times IUserRepository.MarkVerified should be invoked
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) Times(n uint64) *mIUserRepositoryMockMarkVerified {
+	if n == 0 {
+		mmMarkVerified.mock.t.Fatalf("Times of IUserRepositoryMock.MarkVerified mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkVerified.expectedInvocations, n)
+	mmMarkVerified.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkVerified
+}
+
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) invocationsDone() bool {
+	if len(mmMarkVerified.expectations) == 0 && mmMarkVerified.defaultExpectation == nil && mmMarkVerified.mock.funcMarkVerified == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkVerified.mock.afterMarkVerifiedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkVerified.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkVerified implements mm_repository.IUserRepository
+func (mmMarkVerified *IUserRepositoryMock) MarkVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkVerified.beforeMarkVerifiedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkVerified.afterMarkVerifiedCounter, 1)
+
+	mmMarkVerified.t.Helper()
+
+	if mmMarkVerified.inspectFuncMarkVerified != nil {
+		mmMarkVerified.inspectFuncMarkVerified(ctx, id, verifiedAt)
+	}
+
+	mm_params := IUserRepositoryMockMarkVerifiedParams{ctx, id, verifiedAt}
+
+	// Record call args
+	mmMarkVerified.MarkVerifiedMock.mutex.Lock()
+	mmMarkVerified.MarkVerifiedMock.callArgs = append(mmMarkVerified.MarkVerifiedMock.callArgs, &mm_params)
+	mmMarkVerified.MarkVerifiedMock.mutex.Unlock()
+
+	for _, e := range mmMarkVerified.MarkVerifiedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkVerified.MarkVerifiedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkVerified.MarkVerifiedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkVerified.MarkVerifiedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkVerified.MarkVerifiedMock.defaultExpectation.paramPtrs
+
+		mm_got := IUserRepositoryMockMarkVerifiedParams{ctx, id, verifiedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkVerified.t.Errorf("IUserRepositoryMock.MarkVerified got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkVerified.MarkVerifiedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmMarkVerified.t.Errorf("IUserRepositoryMock.MarkVerified got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkVerified.MarkVerifiedMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.verifiedAt != nil && !minimock.Equal(*mm_want_ptrs.verifiedAt, mm_got.verifiedAt) {
+				mmMarkVerified.t.Errorf("IUserRepositoryMock.MarkVerified got unexpected parameter verifiedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkVerified.MarkVerifiedMock.defaultExpectation.expectationOrigins.originVerifiedAt, *mm_want_ptrs.verifiedAt, mm_got.verifiedAt, minimock.Diff(*mm_want_ptrs.verifiedAt, mm_got.verifiedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkVerified.t.Errorf("IUserRepositoryMock.MarkVerified got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkVerified.MarkVerifiedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkVerified.MarkVerifiedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkVerified.t.Fatal("No results are set for the IUserRepositoryMock.MarkVerified")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkVerified.funcMarkVerified != nil {
+		return mmMarkVerified.funcMarkVerified(ctx, id, verifiedAt)
+	}
+	mmMarkVerified.t.Fatalf("Unexpected call to IUserRepositoryMock.MarkVerified. %v %v %v", ctx, id, verifiedAt)
+	return
+}
+
+// MarkVerifiedAfterCounter returns a count of finished IUserRepositoryMock.MarkVerified invocations
+func (mmMarkVerified *IUserRepositoryMock) MarkVerifiedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkVerified.afterMarkVerifiedCounter)
+}
+
+// MarkVerifiedBeforeCounter returns a count of IUserRepositoryMock.MarkVerified invocations
+func (mmMarkVerified *IUserRepositoryMock) MarkVerifiedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkVerified.beforeMarkVerifiedCounter)
+}
+
+// Calls returns a list of arguments used in each call to IUserRepositoryMock.MarkVerified.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkVerified *mIUserRepositoryMockMarkVerified) Calls() []*IUserRepositoryMockMarkVerifiedParams {
+	mmMarkVerified.mutex.RLock()
+
+	argCopy := make([]*IUserRepositoryMockMarkVerifiedParams, len(mmMarkVerified.callArgs))
+	copy(argCopy, mmMarkVerified.callArgs)
+
+	mmMarkVerified.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkVerifiedDone returns true if the count of the MarkVerified invocations corresponds
+// the number of defined expectations
+func (m *IUserRepositoryMock) MinimockMarkVerifiedDone() bool {
+	if m.MarkVerifiedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkVerifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkVerifiedMock.invocationsDone()
+}
+
+// MinimockMarkVerifiedInspect logs each unmet expectation
+func (m *IUserRepositoryMock) MinimockMarkVerifiedInspect() {
+	for _, e := range m.MarkVerifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IUserRepositoryMock.MarkVerified at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkVerifiedCounter := mm_atomic.LoadUint64(&m.afterMarkVerifiedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkVerifiedMock.defaultExpectation != nil && afterMarkVerifiedCounter < 1 {
+		if m.MarkVerifiedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IUserRepositoryMock.MarkVerified at\n%s", m.MarkVerifiedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IUserRepositoryMock.MarkVerified at\n%s with params: %#v", m.MarkVerifiedMock.defaultExpectation.expectationOrigins.origin, *m.MarkVerifiedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkVerified != nil && afterMarkVerifiedCounter < 1 {
+		m.t.Errorf("Expected call to IUserRepositoryMock.MarkVerified at\n%s", m.funcMarkVerifiedOrigin)
+	}
+
+	if !m.MarkVerifiedMock.invocationsDone() && afterMarkVerifiedCounter > 0 {
+		m.t.Errorf("Expected %d calls to IUserRepositoryMock.MarkVerified at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkVerifiedMock.expectedInvocations), m.MarkVerifiedMock.expectedInvocationsOrigin, afterMarkVerifiedCounter)
+	}
+}
+
+type mIUserRepositoryMockUpdatePassword struct {
+	optional           bool
+	mock               *IUserRepositoryMock
+	defaultExpectation *IUserRepositoryMockUpdatePasswordExpectation
+	expectations       []*IUserRepositoryMockUpdatePasswordExpectation
+
+	callArgs []*IUserRepositoryMockUpdatePasswordParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IUserRepositoryMockUpdatePasswordExpectation specifies expectation struct of the IUserRepository.UpdatePassword
+type IUserRepositoryMockUpdatePasswordExpectation struct {
+	mock               *IUserRepositoryMock
+	params             *IUserRepositoryMockUpdatePasswordParams
+	paramPtrs          *IUserRepositoryMockUpdatePasswordParamPtrs
+	expectationOrigins IUserRepositoryMockUpdatePasswordExpectationOrigins
+	results            *IUserRepositoryMockUpdatePasswordResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IUserRepositoryMockUpdatePasswordParams contains parameters of the IUserRepository.UpdatePassword
+type IUserRepositoryMockUpdatePasswordParams struct {
+	ctx            context.Context
+	id             uuid.UUID
+	hashedPassword string
+}
+
+// IUserRepositoryMockUpdatePasswordParamPtrs contains pointers to parameters of the IUserRepository.UpdatePassword
+type IUserRepositoryMockUpdatePasswordParamPtrs struct {
+	ctx            *context.Context
+	id             *uuid.UUID
+	hashedPassword *string
+}
+
+// IUserRepositoryMockUpdatePasswordResults contains results of the IUserRepository.UpdatePassword
+type IUserRepositoryMockUpdatePasswordResults struct {
+	err error
+}
+
+// IUserRepositoryMockUpdatePasswordOrigins contains origins of expectations of the IUserRepository.UpdatePassword
+type IUserRepositoryMockUpdatePasswordExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originId             string
+	originHashedPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) Optional() *mIUserRepositoryMockUpdatePassword {
+	mmUpdatePassword.optional = true
+	return mmUpdatePassword
+}
+
+// Expect sets up expected params for IUserRepository.UpdatePassword
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) Expect(ctx context.Context, id uuid.UUID, hashedPassword string) *mIUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &IUserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by ExpectParams functions")
+	}
+
+	mmUpdatePassword.defaultExpectation.params = &IUserRepositoryMockUpdatePasswordParams{ctx, id, hashedPassword}
+	mmUpdatePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePassword.expectations {
+		if minimock.Equal(e.params, mmUpdatePassword.defaultExpectation.params) {
+			mmUpdatePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePassword.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePassword
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IUserRepository.UpdatePassword
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) ExpectCtxParam1(ctx context.Context) *mIUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &IUserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// ExpectIdParam2 sets up expected param id for IUserRepository.UpdatePassword
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) ExpectIdParam2(id uuid.UUID) *mIUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &IUserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.id = &id
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// ExpectHashedPasswordParam3 sets up expected param hashedPassword for IUserRepository.UpdatePassword
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) ExpectHashedPasswordParam3(hashedPassword string) *mIUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &IUserRepositoryMockUpdatePasswordExpectation{}
+	}
+
+	if mmUpdatePassword.defaultExpectation.params != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by Expect")
+	}
+
+	if mmUpdatePassword.defaultExpectation.paramPtrs == nil {
+		mmUpdatePassword.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdatePasswordParamPtrs{}
+	}
+	mmUpdatePassword.defaultExpectation.paramPtrs.hashedPassword = &hashedPassword
+	mmUpdatePassword.defaultExpectation.expectationOrigins.originHashedPassword = minimock.CallerInfo(1)
+
+	return mmUpdatePassword
+}
+
+// Inspect accepts an inspector function that has same arguments as the IUserRepository.UpdatePassword
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) Inspect(f func(ctx context.Context, id uuid.UUID, hashedPassword string)) *mIUserRepositoryMockUpdatePassword {
+	if mmUpdatePassword.mock.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("Inspect function is already set for IUserRepositoryMock.UpdatePassword")
+	}
+
+	mmUpdatePassword.mock.inspectFuncUpdatePassword = f
+
+	return mmUpdatePassword
+}
+
+// Return sets up results that will be returned by IUserRepository.UpdatePassword
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) Return(err error) *IUserRepositoryMock {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	if mmUpdatePassword.defaultExpectation == nil {
+		mmUpdatePassword.defaultExpectation = &IUserRepositoryMockUpdatePasswordExpectation{mock: mmUpdatePassword.mock}
+	}
+	mmUpdatePassword.defaultExpectation.results = &IUserRepositoryMockUpdatePasswordResults{err}
+	mmUpdatePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword.mock
+}
+
+// Set uses given function f to mock the IUserRepository.UpdatePassword method
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) Set(f func(ctx context.Context, id uuid.UUID, hashedPassword string) (err error)) *IUserRepositoryMock {
+	if mmUpdatePassword.defaultExpectation != nil {
+		mmUpdatePassword.mock.t.Fatalf("Default expectation is already set for the IUserRepository.UpdatePassword method")
+	}
+
+	if len(mmUpdatePassword.expectations) > 0 {
+		mmUpdatePassword.mock.t.Fatalf("Some expectations are already set for the IUserRepository.UpdatePassword method")
+	}
+
+	mmUpdatePassword.mock.funcUpdatePassword = f
+	mmUpdatePassword.mock.funcUpdatePasswordOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword.mock
+}
+
+// When sets expectation for the IUserRepository.UpdatePassword which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) When(ctx context.Context, id uuid.UUID, hashedPassword string) *IUserRepositoryMockUpdatePasswordExpectation {
+	if mmUpdatePassword.mock.funcUpdatePassword != nil {
+		mmUpdatePassword.mock.t.Fatalf("IUserRepositoryMock.UpdatePassword mock is already set by Set")
+	}
+
+	expectation := &IUserRepositoryMockUpdatePasswordExpectation{
+		mock:               mmUpdatePassword.mock,
+		params:             &IUserRepositoryMockUpdatePasswordParams{ctx, id, hashedPassword},
+		expectationOrigins: IUserRepositoryMockUpdatePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdatePassword.expectations = append(mmUpdatePassword.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IUserRepository.UpdatePassword return parameters for the expectation previously defined by the When method
+func (e *IUserRepositoryMockUpdatePasswordExpectation) Then(err error) *IUserRepositoryMock {
+	e.results = &IUserRepositoryMockUpdatePasswordResults{err}
+	return e.mock
+}
+
+// Times sets number of times IUserRepository.UpdatePassword should be invoked
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) Times(n uint64) *mIUserRepositoryMockUpdatePassword {
+	if n == 0 {
+		mmUpdatePassword.mock.t.Fatalf("Times of IUserRepositoryMock.UpdatePassword mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdatePassword.expectedInvocations, n)
+	mmUpdatePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePassword
+}
+
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) invocationsDone() bool {
+	if len(mmUpdatePassword.expectations) == 0 && mmUpdatePassword.defaultExpectation == nil && mmUpdatePassword.mock.funcUpdatePassword == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.mock.afterUpdatePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePassword.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdatePassword implements mm_repository.IUserRepository
+func (mmUpdatePassword *IUserRepositoryMock) UpdatePassword(ctx context.Context, id uuid.UUID, hashedPassword string) (err error) {
+	mm_atomic.AddUint64(&mmUpdatePassword.beforeUpdatePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePassword.afterUpdatePasswordCounter, 1)
+
+	mmUpdatePassword.t.Helper()
+
+	if mmUpdatePassword.inspectFuncUpdatePassword != nil {
+		mmUpdatePassword.inspectFuncUpdatePassword(ctx, id, hashedPassword)
+	}
+
+	mm_params := IUserRepositoryMockUpdatePasswordParams{ctx, id, hashedPassword}
+
+	// Record call args
+	mmUpdatePassword.UpdatePasswordMock.mutex.Lock()
+	mmUpdatePassword.UpdatePasswordMock.callArgs = append(mmUpdatePassword.UpdatePasswordMock.callArgs, &mm_params)
+	mmUpdatePassword.UpdatePasswordMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePassword.UpdatePasswordMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdatePassword.UpdatePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePassword.UpdatePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.paramPtrs
+
+		mm_got := IUserRepositoryMockUpdatePasswordParams{ctx, id, hashedPassword}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdatePassword.t.Errorf("IUserRepositoryMock.UpdatePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdatePassword.t.Errorf("IUserRepositoryMock.UpdatePassword got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.hashedPassword != nil && !minimock.Equal(*mm_want_ptrs.hashedPassword, mm_got.hashedPassword) {
+				mmUpdatePassword.t.Errorf("IUserRepositoryMock.UpdatePassword got unexpected parameter hashedPassword, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.originHashedPassword, *mm_want_ptrs.hashedPassword, mm_got.hashedPassword, minimock.Diff(*mm_want_ptrs.hashedPassword, mm_got.hashedPassword))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePassword.t.Errorf("IUserRepositoryMock.UpdatePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePassword.UpdatePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePassword.UpdatePasswordMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePassword.t.Fatal("No results are set for the IUserRepositoryMock.UpdatePassword")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdatePassword.funcUpdatePassword != nil {
+		return mmUpdatePassword.funcUpdatePassword(ctx, id, hashedPassword)
+	}
+	mmUpdatePassword.t.Fatalf("Unexpected call to IUserRepositoryMock.UpdatePassword. %v %v %v", ctx, id, hashedPassword)
+	return
+}
+
+// UpdatePasswordAfterCounter returns a count of finished IUserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *IUserRepositoryMock) UpdatePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.afterUpdatePasswordCounter)
+}
+
+// UpdatePasswordBeforeCounter returns a count of IUserRepositoryMock.UpdatePassword invocations
+func (mmUpdatePassword *IUserRepositoryMock) UpdatePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePassword.beforeUpdatePasswordCounter)
+}
+
+// Calls returns a list of arguments used in each call to IUserRepositoryMock.UpdatePassword.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePassword *mIUserRepositoryMockUpdatePassword) Calls() []*IUserRepositoryMockUpdatePasswordParams {
+	mmUpdatePassword.mutex.RLock()
+
+	argCopy := make([]*IUserRepositoryMockUpdatePasswordParams, len(mmUpdatePassword.callArgs))
+	copy(argCopy, mmUpdatePassword.callArgs)
+
+	mmUpdatePassword.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePasswordDone returns true if the count of the UpdatePassword invocations corresponds
+// the number of defined expectations
+func (m *IUserRepositoryMock) MinimockUpdatePasswordDone() bool {
+	if m.UpdatePasswordMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdatePasswordMock.invocationsDone()
+}
+
+// MinimockUpdatePasswordInspect logs each unmet expectation
+func (m *IUserRepositoryMock) MinimockUpdatePasswordInspect() {
+	for _, e := range m.UpdatePasswordMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IUserRepositoryMock.UpdatePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdatePasswordCounter := mm_atomic.LoadUint64(&m.afterUpdatePasswordCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePasswordMock.defaultExpectation != nil && afterUpdatePasswordCounter < 1 {
+		if m.UpdatePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IUserRepositoryMock.UpdatePassword at\n%s", m.UpdatePasswordMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IUserRepositoryMock.UpdatePassword at\n%s with params: %#v", m.UpdatePasswordMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePasswordMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePassword != nil && afterUpdatePasswordCounter < 1 {
+		m.t.Errorf("Expected call to IUserRepositoryMock.UpdatePassword at\n%s", m.funcUpdatePasswordOrigin)
+	}
+
+	if !m.UpdatePasswordMock.invocationsDone() && afterUpdatePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to IUserRepositoryMock.UpdatePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePasswordMock.expectedInvocations), m.UpdatePasswordMock.expectedInvocationsOrigin, afterUpdatePasswordCounter)
+	}
+}
+
+type mIUserRepositoryMockUpdateRole struct {
+	optional           bool
+	mock               *IUserRepositoryMock
+	defaultExpectation *IUserRepositoryMockUpdateRoleExpectation
+	expectations       []*IUserRepositoryMockUpdateRoleExpectation
+
+	callArgs []*IUserRepositoryMockUpdateRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IUserRepositoryMockUpdateRoleExpectation specifies expectation struct of the IUserRepository.UpdateRole
+type IUserRepositoryMockUpdateRoleExpectation struct {
+	mock               *IUserRepositoryMock
+	params             *IUserRepositoryMockUpdateRoleParams
+	paramPtrs          *IUserRepositoryMockUpdateRoleParamPtrs
+	expectationOrigins IUserRepositoryMockUpdateRoleExpectationOrigins
+	results            *IUserRepositoryMockUpdateRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IUserRepositoryMockUpdateRoleParams contains parameters of the IUserRepository.UpdateRole
+type IUserRepositoryMockUpdateRoleParams struct {
+	ctx  context.Context
+	id   uuid.UUID
+	role domain.Role
+}
+
+// IUserRepositoryMockUpdateRoleParamPtrs contains pointers to parameters of the IUserRepository.UpdateRole
+type IUserRepositoryMockUpdateRoleParamPtrs struct {
+	ctx  *context.Context
+	id   *uuid.UUID
+	role *domain.Role
+}
+
+// IUserRepositoryMockUpdateRoleResults contains results of the IUserRepository.UpdateRole
+type IUserRepositoryMockUpdateRoleResults struct {
+	err error
+}
+
+// IUserRepositoryMockUpdateRoleOrigins contains origins of expectations of the IUserRepository.UpdateRole
+type IUserRepositoryMockUpdateRoleExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originId   string
+	originRole string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) Optional() *mIUserRepositoryMockUpdateRole {
+	mmUpdateRole.optional = true
+	return mmUpdateRole
+}
+
+// Expect sets up expected params for IUserRepository.UpdateRole
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) Expect(ctx context.Context, id uuid.UUID, role domain.Role) *mIUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &IUserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateRole.defaultExpectation.params = &IUserRepositoryMockUpdateRoleParams{ctx, id, role}
+	mmUpdateRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateRole.expectations {
+		if minimock.Equal(e.params, mmUpdateRole.defaultExpectation.params) {
+			mmUpdateRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateRole.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IUserRepository.UpdateRole
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) ExpectCtxParam1(ctx context.Context) *mIUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &IUserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateRole
+}
+
+// ExpectIdParam2 sets up expected param id for IUserRepository.UpdateRole
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) ExpectIdParam2(id uuid.UUID) *mIUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &IUserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.id = &id
+	mmUpdateRole.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateRole
+}
+
+// ExpectRoleParam3 sets up expected param role for IUserRepository.UpdateRole
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) ExpectRoleParam3(role domain.Role) *mIUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &IUserRepositoryMockUpdateRoleExpectation{}
+	}
+
+	if mmUpdateRole.defaultExpectation.params != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by Expect")
+	}
+
+	if mmUpdateRole.defaultExpectation.paramPtrs == nil {
+		mmUpdateRole.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdateRoleParamPtrs{}
+	}
+	mmUpdateRole.defaultExpectation.paramPtrs.role = &role
+	mmUpdateRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmUpdateRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the IUserRepository.UpdateRole
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) Inspect(f func(ctx context.Context, id uuid.UUID, role domain.Role)) *mIUserRepositoryMockUpdateRole {
+	if mmUpdateRole.mock.inspectFuncUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("Inspect function is already set for IUserRepositoryMock.UpdateRole")
+	}
+
+	mmUpdateRole.mock.inspectFuncUpdateRole = f
+
+	return mmUpdateRole
+}
+
+// Return sets up results that will be returned by IUserRepository.UpdateRole
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) Return(err error) *IUserRepositoryMock {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	if mmUpdateRole.defaultExpectation == nil {
+		mmUpdateRole.defaultExpectation = &IUserRepositoryMockUpdateRoleExpectation{mock: mmUpdateRole.mock}
+	}
+	mmUpdateRole.defaultExpectation.results = &IUserRepositoryMockUpdateRoleResults{err}
+	mmUpdateRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateRole.mock
+}
+
+// Set uses given function f to mock the IUserRepository.UpdateRole method
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) Set(f func(ctx context.Context, id uuid.UUID, role domain.Role) (err error)) *IUserRepositoryMock {
+	if mmUpdateRole.defaultExpectation != nil {
+		mmUpdateRole.mock.t.Fatalf("Default expectation is already set for the IUserRepository.UpdateRole method")
+	}
+
+	if len(mmUpdateRole.expectations) > 0 {
+		mmUpdateRole.mock.t.Fatalf("Some expectations are already set for the IUserRepository.UpdateRole method")
+	}
+
+	mmUpdateRole.mock.funcUpdateRole = f
+	mmUpdateRole.mock.funcUpdateRoleOrigin = minimock.CallerInfo(1)
+	return mmUpdateRole.mock
+}
+
+// When sets expectation for the IUserRepository.UpdateRole which will trigger the result defined by the following
+// Then helper
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) When(ctx context.Context, id uuid.UUID, role domain.Role) *IUserRepositoryMockUpdateRoleExpectation {
+	if mmUpdateRole.mock.funcUpdateRole != nil {
+		mmUpdateRole.mock.t.Fatalf("IUserRepositoryMock.UpdateRole mock is already set by Set")
+	}
+
+	expectation := &IUserRepositoryMockUpdateRoleExpectation{
+		mock:               mmUpdateRole.mock,
+		params:             &IUserRepositoryMockUpdateRoleParams{ctx, id, role},
+		expectationOrigins: IUserRepositoryMockUpdateRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateRole.expectations = append(mmUpdateRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IUserRepository.UpdateRole return parameters for the expectation previously defined by the When method
+func (e *IUserRepositoryMockUpdateRoleExpectation) Then(err error) *IUserRepositoryMock {
+	e.results = &IUserRepositoryMockUpdateRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times IUserRepository.UpdateRole should be invoked
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) Times(n uint64) *mIUserRepositoryMockUpdateRole {
+	if n == 0 {
+		mmUpdateRole.mock.t.Fatalf("Times of IUserRepositoryMock.UpdateRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateRole.expectedInvocations, n)
+	mmUpdateRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateRole
+}
+
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) invocationsDone() bool {
+	if len(mmUpdateRole.expectations) == 0 && mmUpdateRole.defaultExpectation == nil && mmUpdateRole.mock.funcUpdateRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateRole.mock.afterUpdateRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateRole implements mm_repository.IUserRepository
+func (mmUpdateRole *IUserRepositoryMock) UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) (err error) {
+	mm_atomic.AddUint64(&mmUpdateRole.beforeUpdateRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateRole.afterUpdateRoleCounter, 1)
+
+	mmUpdateRole.t.Helper()
+
+	if mmUpdateRole.inspectFuncUpdateRole != nil {
+		mmUpdateRole.inspectFuncUpdateRole(ctx, id, role)
+	}
+
+	mm_params := IUserRepositoryMockUpdateRoleParams{ctx, id, role}
+
+	// Record call args
+	mmUpdateRole.UpdateRoleMock.mutex.Lock()
+	mmUpdateRole.UpdateRoleMock.callArgs = append(mmUpdateRole.UpdateRoleMock.callArgs, &mm_params)
+	mmUpdateRole.UpdateRoleMock.mutex.Unlock()
+
+	for _, e := range mmUpdateRole.UpdateRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateRole.UpdateRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateRole.UpdateRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateRole.UpdateRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateRole.UpdateRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := IUserRepositoryMockUpdateRoleParams{ctx, id, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateRole.t.Errorf("IUserRepositoryMock.UpdateRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRole.UpdateRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateRole.t.Errorf("IUserRepositoryMock.UpdateRole got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRole.UpdateRoleMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmUpdateRole.t.Errorf("IUserRepositoryMock.UpdateRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateRole.UpdateRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateRole.t.Errorf("IUserRepositoryMock.UpdateRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateRole.UpdateRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateRole.UpdateRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateRole.t.Fatal("No results are set for the IUserRepositoryMock.UpdateRole")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateRole.funcUpdateRole != nil {
+		return mmUpdateRole.funcUpdateRole(ctx, id, role)
+	}
+	mmUpdateRole.t.Fatalf("Unexpected call to IUserRepositoryMock.UpdateRole. %v %v %v", ctx, id, role)
+	return
+}
+
+// UpdateRoleAfterCounter returns a count of finished IUserRepositoryMock.UpdateRole invocations
+func (mmUpdateRole *IUserRepositoryMock) UpdateRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRole.afterUpdateRoleCounter)
+}
+
+// UpdateRoleBeforeCounter returns a count of IUserRepositoryMock.UpdateRole invocations
+func (mmUpdateRole *IUserRepositoryMock) UpdateRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateRole.beforeUpdateRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to IUserRepositoryMock.UpdateRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateRole *mIUserRepositoryMockUpdateRole) Calls() []*IUserRepositoryMockUpdateRoleParams {
+	mmUpdateRole.mutex.RLock()
+
+	argCopy := make([]*IUserRepositoryMockUpdateRoleParams, len(mmUpdateRole.callArgs))
+	copy(argCopy, mmUpdateRole.callArgs)
+
+	mmUpdateRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateRoleDone returns true if the count of the UpdateRole invocations corresponds
+// the number of defined expectations
+func (m *IUserRepositoryMock) MinimockUpdateRoleDone() bool {
+	if m.UpdateRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateRoleMock.invocationsDone()
+}
+
+// MinimockUpdateRoleInspect logs each unmet expectation
+func (m *IUserRepositoryMock) MinimockUpdateRoleInspect() {
+	for _, e := range m.UpdateRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IUserRepositoryMock.UpdateRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateRoleCounter := mm_atomic.LoadUint64(&m.afterUpdateRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateRoleMock.defaultExpectation != nil && afterUpdateRoleCounter < 1 {
+		if m.UpdateRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IUserRepositoryMock.UpdateRole at\n%s", m.UpdateRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IUserRepositoryMock.UpdateRole at\n%s with params: %#v", m.UpdateRoleMock.defaultExpectation.expectationOrigins.origin, *m.UpdateRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateRole != nil && afterUpdateRoleCounter < 1 {
+		m.t.Errorf("Expected call to IUserRepositoryMock.UpdateRole at\n%s", m.funcUpdateRoleOrigin)
+	}
+
+	if !m.UpdateRoleMock.invocationsDone() && afterUpdateRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to IUserRepositoryMock.UpdateRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateRoleMock.expectedInvocations), m.UpdateRoleMock.expectedInvocationsOrigin, afterUpdateRoleCounter)
+	}
+}
+
+type mIUserRepositoryMockUpdateStatus struct {
+	optional           bool
+	mock               *IUserRepositoryMock
+	defaultExpectation *IUserRepositoryMockUpdateStatusExpectation
+	expectations       []*IUserRepositoryMockUpdateStatusExpectation
+
+	callArgs []*IUserRepositoryMockUpdateStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IUserRepositoryMockUpdateStatusExpectation specifies expectation struct of the IUserRepository.UpdateStatus
+type IUserRepositoryMockUpdateStatusExpectation struct {
+	mock               *IUserRepositoryMock
+	params             *IUserRepositoryMockUpdateStatusParams
+	paramPtrs          *IUserRepositoryMockUpdateStatusParamPtrs
+	expectationOrigins IUserRepositoryMockUpdateStatusExpectationOrigins
+	results            *IUserRepositoryMockUpdateStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IUserRepositoryMockUpdateStatusParams contains parameters of the IUserRepository.UpdateStatus
+type IUserRepositoryMockUpdateStatusParams struct {
+	ctx  context.Context
+	user domain.User
+}
+
+// IUserRepositoryMockUpdateStatusParamPtrs contains pointers to parameters of the IUserRepository.UpdateStatus
+type IUserRepositoryMockUpdateStatusParamPtrs struct {
+	ctx  *context.Context
+	user *domain.User
+}
+
+// IUserRepositoryMockUpdateStatusResults contains results of the IUserRepository.UpdateStatus
+type IUserRepositoryMockUpdateStatusResults struct {
+	err error
+}
+
+// IUserRepositoryMockUpdateStatusOrigins contains origins of expectations of the IUserRepository.UpdateStatus
+type IUserRepositoryMockUpdateStatusExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originUser string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) Optional() *mIUserRepositoryMockUpdateStatus {
+	mmUpdateStatus.optional = true
+	return mmUpdateStatus
+}
+
+// Expect sets up expected params for IUserRepository.UpdateStatus
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) Expect(ctx context.Context, user domain.User) *mIUserRepositoryMockUpdateStatus {
+	if mmUpdateStatus.mock.funcUpdateStatus != nil {
+		mmUpdateStatus.mock.t.Fatalf("IUserRepositoryMock.UpdateStatus mock is already set by Set")
+	}
+
+	if mmUpdateStatus.defaultExpectation == nil {
+		mmUpdateStatus.defaultExpectation = &IUserRepositoryMockUpdateStatusExpectation{}
+	}
+
+	if mmUpdateStatus.defaultExpectation.paramPtrs != nil {
+		mmUpdateStatus.mock.t.Fatalf("IUserRepositoryMock.UpdateStatus mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateStatus.defaultExpectation.params = &IUserRepositoryMockUpdateStatusParams{ctx, user}
+	mmUpdateStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateStatus.expectations {
+		if minimock.Equal(e.params, mmUpdateStatus.defaultExpectation.params) {
+			mmUpdateStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IUserRepository.UpdateStatus
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) ExpectCtxParam1(ctx context.Context) *mIUserRepositoryMockUpdateStatus {
+	if mmUpdateStatus.mock.funcUpdateStatus != nil {
+		mmUpdateStatus.mock.t.Fatalf("IUserRepositoryMock.UpdateStatus mock is already set by Set")
+	}
+
+	if mmUpdateStatus.defaultExpectation == nil {
+		mmUpdateStatus.defaultExpectation = &IUserRepositoryMockUpdateStatusExpectation{}
+	}
+
+	if mmUpdateStatus.defaultExpectation.params != nil {
+		mmUpdateStatus.mock.t.Fatalf("IUserRepositoryMock.UpdateStatus mock is already set by Expect")
+	}
+
+	if mmUpdateStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateStatus.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdateStatusParamPtrs{}
+	}
+	mmUpdateStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateStatus
+}
+
+// ExpectUserParam2 sets up expected param user for IUserRepository.UpdateStatus
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) ExpectUserParam2(user domain.User) *mIUserRepositoryMockUpdateStatus {
+	if mmUpdateStatus.mock.funcUpdateStatus != nil {
+		mmUpdateStatus.mock.t.Fatalf("IUserRepositoryMock.UpdateStatus mock is already set by Set")
+	}
+
+	if mmUpdateStatus.defaultExpectation == nil {
+		mmUpdateStatus.defaultExpectation = &IUserRepositoryMockUpdateStatusExpectation{}
+	}
+
+	if mmUpdateStatus.defaultExpectation.params != nil {
+		mmUpdateStatus.mock.t.Fatalf("IUserRepositoryMock.UpdateStatus mock is already set by Expect")
+	}
+
+	if mmUpdateStatus.defaultExpectation.paramPtrs == nil {
+		mmUpdateStatus.defaultExpectation.paramPtrs = &IUserRepositoryMockUpdateStatusParamPtrs{}
+	}
+	mmUpdateStatus.defaultExpectation.paramPtrs.user = &user
+	mmUpdateStatus.defaultExpectation.expectationOrigins.originUser = minimock.CallerInfo(1)
+
+	return mmUpdateStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the IUserRepository.UpdateStatus
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) Inspect(f func(ctx context.Context, user domain.User)) *mIUserRepositoryMockUpdateStatus {
+	if mmUpdateStatus.mock.inspectFuncUpdateStatus != nil {
+		mmUpdateStatus.mock.t.Fatalf("Inspect function is already set for IUserRepositoryMock.UpdateStatus")
+	}
+
+	mmUpdateStatus.mock.inspectFuncUpdateStatus = f
+
+	return mmUpdateStatus
+}
+
+// Return sets up results that will be returned by IUserRepository.UpdateStatus
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) Return(err error) *IUserRepositoryMock {
+	if mmUpdateStatus.mock.funcUpdateStatus != nil {
+		mmUpdateStatus.mock.t.Fatalf("IUserRepositoryMock.UpdateStatus mock is already set by Set")
+	}
+
+	if mmUpdateStatus.defaultExpectation == nil {
+		mmUpdateStatus.defaultExpectation = &IUserRepositoryMockUpdateStatusExpectation{mock: mmUpdateStatus.mock}
+	}
+	mmUpdateStatus.defaultExpectation.results = &IUserRepositoryMockUpdateStatusResults{err}
+	mmUpdateStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateStatus.mock
+}
+
+// Set uses given function f to mock the IUserRepository.UpdateStatus method
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) Set(f func(ctx context.Context, user domain.User) (err error)) *IUserRepositoryMock {
+	if mmUpdateStatus.defaultExpectation != nil {
+		mmUpdateStatus.mock.t.Fatalf("Default expectation is already set for the IUserRepository.UpdateStatus method")
+	}
+
+	if len(mmUpdateStatus.expectations) > 0 {
+		mmUpdateStatus.mock.t.Fatalf("Some expectations are already set for the IUserRepository.UpdateStatus method")
+	}
+
+	mmUpdateStatus.mock.funcUpdateStatus = f
+	mmUpdateStatus.mock.funcUpdateStatusOrigin = minimock.CallerInfo(1)
+	return mmUpdateStatus.mock
+}
+
+// When sets expectation for the IUserRepository.UpdateStatus which will trigger the result defined by the following
+// Then helper
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) When(ctx context.Context, user domain.User) *IUserRepositoryMockUpdateStatusExpectation {
+	if mmUpdateStatus.mock.funcUpdateStatus != nil {
+		mmUpdateStatus.mock.t.Fatalf("IUserRepositoryMock.UpdateStatus mock is already set by Set")
+	}
+
+	expectation := &IUserRepositoryMockUpdateStatusExpectation{
+		mock:               mmUpdateStatus.mock,
+		params:             &IUserRepositoryMockUpdateStatusParams{ctx, user},
+		expectationOrigins: IUserRepositoryMockUpdateStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateStatus.expectations = append(mmUpdateStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IUserRepository.UpdateStatus return parameters for the expectation previously defined by the When method
+func (e *IUserRepositoryMockUpdateStatusExpectation) Then(err error) *IUserRepositoryMock {
+	e.results = &IUserRepositoryMockUpdateStatusResults{err}
+	return e.mock
+}
+
+// Times sets number of times IUserRepository.UpdateStatus should be invoked
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) Times(n uint64) *mIUserRepositoryMockUpdateStatus {
+	if n == 0 {
+		mmUpdateStatus.mock.t.Fatalf("Times of IUserRepositoryMock.UpdateStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateStatus.expectedInvocations, n)
+	mmUpdateStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateStatus
+}
+
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) invocationsDone() bool {
+	if len(mmUpdateStatus.expectations) == 0 && mmUpdateStatus.defaultExpectation == nil && mmUpdateStatus.mock.funcUpdateStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateStatus.mock.afterUpdateStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateStatus implements mm_repository.IUserRepository
+func (mmUpdateStatus *IUserRepositoryMock) UpdateStatus(ctx context.Context, user domain.User) (err error) {
+	mm_atomic.AddUint64(&mmUpdateStatus.beforeUpdateStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateStatus.afterUpdateStatusCounter, 1)
+
+	mmUpdateStatus.t.Helper()
+
+	if mmUpdateStatus.inspectFuncUpdateStatus != nil {
+		mmUpdateStatus.inspectFuncUpdateStatus(ctx, user)
+	}
+
+	mm_params := IUserRepositoryMockUpdateStatusParams{ctx, user}
+
+	// Record call args
+	mmUpdateStatus.UpdateStatusMock.mutex.Lock()
+	mmUpdateStatus.UpdateStatusMock.callArgs = append(mmUpdateStatus.UpdateStatusMock.callArgs, &mm_params)
+	mmUpdateStatus.UpdateStatusMock.mutex.Unlock()
+
+	for _, e := range mmUpdateStatus.UpdateStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateStatus.UpdateStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateStatus.UpdateStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateStatus.UpdateStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateStatus.UpdateStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := IUserRepositoryMockUpdateStatusParams{ctx, user}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateStatus.t.Errorf("IUserRepositoryMock.UpdateStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStatus.UpdateStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.user != nil && !minimock.Equal(*mm_want_ptrs.user, mm_got.user) {
+				mmUpdateStatus.t.Errorf("IUserRepositoryMock.UpdateStatus got unexpected parameter user, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStatus.UpdateStatusMock.defaultExpectation.expectationOrigins.originUser, *mm_want_ptrs.user, mm_got.user, minimock.Diff(*mm_want_ptrs.user, mm_got.user))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateStatus.t.Errorf("IUserRepositoryMock.UpdateStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateStatus.UpdateStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateStatus.UpdateStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateStatus.t.Fatal("No results are set for the IUserRepositoryMock.UpdateStatus")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateStatus.funcUpdateStatus != nil {
+		return mmUpdateStatus.funcUpdateStatus(ctx, user)
+	}
+	mmUpdateStatus.t.Fatalf("Unexpected call to IUserRepositoryMock.UpdateStatus. %v %v", ctx, user)
+	return
+}
+
+// UpdateStatusAfterCounter returns a count of finished IUserRepositoryMock.UpdateStatus invocations
+func (mmUpdateStatus *IUserRepositoryMock) UpdateStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateStatus.afterUpdateStatusCounter)
+}
+
+// UpdateStatusBeforeCounter returns a count of IUserRepositoryMock.UpdateStatus invocations
+func (mmUpdateStatus *IUserRepositoryMock) UpdateStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateStatus.beforeUpdateStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to IUserRepositoryMock.UpdateStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateStatus *mIUserRepositoryMockUpdateStatus) Calls() []*IUserRepositoryMockUpdateStatusParams {
+	mmUpdateStatus.mutex.RLock()
+
+	argCopy := make([]*IUserRepositoryMockUpdateStatusParams, len(mmUpdateStatus.callArgs))
+	copy(argCopy, mmUpdateStatus.callArgs)
+
+	mmUpdateStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateStatusDone returns true if the count of the UpdateStatus invocations corresponds
+// the number of defined expectations
+func (m *IUserRepositoryMock) MinimockUpdateStatusDone() bool {
+	if m.UpdateStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateStatusMock.invocationsDone()
+}
+
+// MinimockUpdateStatusInspect logs each unmet expectation
+func (m *IUserRepositoryMock) MinimockUpdateStatusInspect() {
+	for _, e := range m.UpdateStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IUserRepositoryMock.UpdateStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateStatusCounter := mm_atomic.LoadUint64(&m.afterUpdateStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateStatusMock.defaultExpectation != nil && afterUpdateStatusCounter < 1 {
+		if m.UpdateStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IUserRepositoryMock.UpdateStatus at\n%s", m.UpdateStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IUserRepositoryMock.UpdateStatus at\n%s with params: %#v", m.UpdateStatusMock.defaultExpectation.expectationOrigins.origin, *m.UpdateStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateStatus != nil && afterUpdateStatusCounter < 1 {
+		m.t.Errorf("Expected call to IUserRepositoryMock.UpdateStatus at\n%s", m.funcUpdateStatusOrigin)
+	}
+
+	if !m.UpdateStatusMock.invocationsDone() && afterUpdateStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to IUserRepositoryMock.UpdateStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateStatusMock.expectedInvocations), m.UpdateStatusMock.expectedInvocationsOrigin, afterUpdateStatusCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IUserRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddInspect()
+
+			m.MinimockAddLogInspect()
+
+			m.MinimockGetByEmailInspect()
+
+			m.MinimockGetByIDInspect()
+
+			m.MinimockListInspect()
+
+			m.MinimockLogsInspect()
+
+			m.MinimockMarkVerifiedInspect()
+
+			m.MinimockUpdatePasswordInspect()
+
+			m.MinimockUpdateRoleInspect()
 
-			m.MinimockUpdateInspect()
+			m.MinimockUpdateStatusInspect()
 		}
 	})
 }
@@ -1834,6 +3702,11 @@ func (m *IUserRepositoryMock) minimockDone() bool {
 		m.MinimockAddDone() &&
 		m.MinimockAddLogDone() &&
 		m.MinimockGetByEmailDone() &&
+		m.MinimockGetByIDDone() &&
+		m.MinimockListDone() &&
 		m.MinimockLogsDone() &&
-		m.MinimockUpdateDone()
+		m.MinimockMarkVerifiedDone() &&
+		m.MinimockUpdatePasswordDone() &&
+		m.MinimockUpdateRoleDone() &&
+		m.MinimockUpdateStatusDone()
 }
@@ -0,0 +1,1934 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-auth-service/internal/repository.IWebAuthnRepository -o i_web_authn_repository_mock.go -n IWebAuthnRepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+// IWebAuthnRepositoryMock implements mm_repository.IWebAuthnRepository
+type IWebAuthnRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAdd          func(ctx context.Context, cred domain.WebAuthnCredential) (err error)
+	funcAddOrigin    string
+	inspectFuncAdd   func(ctx context.Context, cred domain.WebAuthnCredential)
+	afterAddCounter  uint64
+	beforeAddCounter uint64
+	AddMock          mIWebAuthnRepositoryMockAdd
+
+	funcDelete          func(ctx context.Context, userID uuid.UUID, id []byte) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, userID uuid.UUID, id []byte)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mIWebAuthnRepositoryMockDelete
+
+	funcGet          func(ctx context.Context, id []byte) (w1 domain.WebAuthnCredential, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, id []byte)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mIWebAuthnRepositoryMockGet
+
+	funcListByUser          func(ctx context.Context, userID uuid.UUID) (wa1 []domain.WebAuthnCredential, err error)
+	funcListByUserOrigin    string
+	inspectFuncListByUser   func(ctx context.Context, userID uuid.UUID)
+	afterListByUserCounter  uint64
+	beforeListByUserCounter uint64
+	ListByUserMock          mIWebAuthnRepositoryMockListByUser
+
+	funcUpdateSignCount          func(ctx context.Context, id []byte, signCount uint32, usedAt time.Time) (err error)
+	funcUpdateSignCountOrigin    string
+	inspectFuncUpdateSignCount   func(ctx context.Context, id []byte, signCount uint32, usedAt time.Time)
+	afterUpdateSignCountCounter  uint64
+	beforeUpdateSignCountCounter uint64
+	UpdateSignCountMock          mIWebAuthnRepositoryMockUpdateSignCount
+}
+
+// NewIWebAuthnRepositoryMock returns a mock for mm_repository.IWebAuthnRepository
+func NewIWebAuthnRepositoryMock(t minimock.Tester) *IWebAuthnRepositoryMock {
+	m := &IWebAuthnRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddMock = mIWebAuthnRepositoryMockAdd{mock: m}
+	m.AddMock.callArgs = []*IWebAuthnRepositoryMockAddParams{}
+
+	m.DeleteMock = mIWebAuthnRepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*IWebAuthnRepositoryMockDeleteParams{}
+
+	m.GetMock = mIWebAuthnRepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*IWebAuthnRepositoryMockGetParams{}
+
+	m.ListByUserMock = mIWebAuthnRepositoryMockListByUser{mock: m}
+	m.ListByUserMock.callArgs = []*IWebAuthnRepositoryMockListByUserParams{}
+
+	m.UpdateSignCountMock = mIWebAuthnRepositoryMockUpdateSignCount{mock: m}
+	m.UpdateSignCountMock.callArgs = []*IWebAuthnRepositoryMockUpdateSignCountParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIWebAuthnRepositoryMockAdd struct {
+	optional           bool
+	mock               *IWebAuthnRepositoryMock
+	defaultExpectation *IWebAuthnRepositoryMockAddExpectation
+	expectations       []*IWebAuthnRepositoryMockAddExpectation
+
+	callArgs []*IWebAuthnRepositoryMockAddParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IWebAuthnRepositoryMockAddExpectation specifies expectation struct of the IWebAuthnRepository.Add
+type IWebAuthnRepositoryMockAddExpectation struct {
+	mock               *IWebAuthnRepositoryMock
+	params             *IWebAuthnRepositoryMockAddParams
+	paramPtrs          *IWebAuthnRepositoryMockAddParamPtrs
+	expectationOrigins IWebAuthnRepositoryMockAddExpectationOrigins
+	results            *IWebAuthnRepositoryMockAddResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IWebAuthnRepositoryMockAddParams contains parameters of the IWebAuthnRepository.Add
+type IWebAuthnRepositoryMockAddParams struct {
+	ctx  context.Context
+	cred domain.WebAuthnCredential
+}
+
+// IWebAuthnRepositoryMockAddParamPtrs contains pointers to parameters of the IWebAuthnRepository.Add
+type IWebAuthnRepositoryMockAddParamPtrs struct {
+	ctx  *context.Context
+	cred *domain.WebAuthnCredential
+}
+
+// IWebAuthnRepositoryMockAddResults contains results of the IWebAuthnRepository.Add
+type IWebAuthnRepositoryMockAddResults struct {
+	err error
+}
+
+// IWebAuthnRepositoryMockAddOrigins contains origins of expectations of the IWebAuthnRepository.Add
+type IWebAuthnRepositoryMockAddExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originCred string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAdd *mIWebAuthnRepositoryMockAdd) Optional() *mIWebAuthnRepositoryMockAdd {
+	mmAdd.optional = true
+	return mmAdd
+}
+
+// Expect sets up expected params for IWebAuthnRepository.Add
+func (mmAdd *mIWebAuthnRepositoryMockAdd) Expect(ctx context.Context, cred domain.WebAuthnCredential) *mIWebAuthnRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IWebAuthnRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IWebAuthnRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs != nil {
+		mmAdd.mock.t.Fatalf("IWebAuthnRepositoryMock.Add mock is already set by ExpectParams functions")
+	}
+
+	mmAdd.defaultExpectation.params = &IWebAuthnRepositoryMockAddParams{ctx, cred}
+	mmAdd.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdd.expectations {
+		if minimock.Equal(e.params, mmAdd.defaultExpectation.params) {
+			mmAdd.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdd.defaultExpectation.params)
+		}
+	}
+
+	return mmAdd
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IWebAuthnRepository.Add
+func (mmAdd *mIWebAuthnRepositoryMockAdd) ExpectCtxParam1(ctx context.Context) *mIWebAuthnRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IWebAuthnRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IWebAuthnRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("IWebAuthnRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdd.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// ExpectCredParam2 sets up expected param cred for IWebAuthnRepository.Add
+func (mmAdd *mIWebAuthnRepositoryMockAdd) ExpectCredParam2(cred domain.WebAuthnCredential) *mIWebAuthnRepositoryMockAdd {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IWebAuthnRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IWebAuthnRepositoryMockAddExpectation{}
+	}
+
+	if mmAdd.defaultExpectation.params != nil {
+		mmAdd.mock.t.Fatalf("IWebAuthnRepositoryMock.Add mock is already set by Expect")
+	}
+
+	if mmAdd.defaultExpectation.paramPtrs == nil {
+		mmAdd.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockAddParamPtrs{}
+	}
+	mmAdd.defaultExpectation.paramPtrs.cred = &cred
+	mmAdd.defaultExpectation.expectationOrigins.originCred = minimock.CallerInfo(1)
+
+	return mmAdd
+}
+
+// Inspect accepts an inspector function that has same arguments as the IWebAuthnRepository.Add
+func (mmAdd *mIWebAuthnRepositoryMockAdd) Inspect(f func(ctx context.Context, cred domain.WebAuthnCredential)) *mIWebAuthnRepositoryMockAdd {
+	if mmAdd.mock.inspectFuncAdd != nil {
+		mmAdd.mock.t.Fatalf("Inspect function is already set for IWebAuthnRepositoryMock.Add")
+	}
+
+	mmAdd.mock.inspectFuncAdd = f
+
+	return mmAdd
+}
+
+// Return sets up results that will be returned by IWebAuthnRepository.Add
+func (mmAdd *mIWebAuthnRepositoryMockAdd) Return(err error) *IWebAuthnRepositoryMock {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IWebAuthnRepositoryMock.Add mock is already set by Set")
+	}
+
+	if mmAdd.defaultExpectation == nil {
+		mmAdd.defaultExpectation = &IWebAuthnRepositoryMockAddExpectation{mock: mmAdd.mock}
+	}
+	mmAdd.defaultExpectation.results = &IWebAuthnRepositoryMockAddResults{err}
+	mmAdd.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// Set uses given function f to mock the IWebAuthnRepository.Add method
+func (mmAdd *mIWebAuthnRepositoryMockAdd) Set(f func(ctx context.Context, cred domain.WebAuthnCredential) (err error)) *IWebAuthnRepositoryMock {
+	if mmAdd.defaultExpectation != nil {
+		mmAdd.mock.t.Fatalf("Default expectation is already set for the IWebAuthnRepository.Add method")
+	}
+
+	if len(mmAdd.expectations) > 0 {
+		mmAdd.mock.t.Fatalf("Some expectations are already set for the IWebAuthnRepository.Add method")
+	}
+
+	mmAdd.mock.funcAdd = f
+	mmAdd.mock.funcAddOrigin = minimock.CallerInfo(1)
+	return mmAdd.mock
+}
+
+// When sets expectation for the IWebAuthnRepository.Add which will trigger the result defined by the following
+// Then helper
+func (mmAdd *mIWebAuthnRepositoryMockAdd) When(ctx context.Context, cred domain.WebAuthnCredential) *IWebAuthnRepositoryMockAddExpectation {
+	if mmAdd.mock.funcAdd != nil {
+		mmAdd.mock.t.Fatalf("IWebAuthnRepositoryMock.Add mock is already set by Set")
+	}
+
+	expectation := &IWebAuthnRepositoryMockAddExpectation{
+		mock:               mmAdd.mock,
+		params:             &IWebAuthnRepositoryMockAddParams{ctx, cred},
+		expectationOrigins: IWebAuthnRepositoryMockAddExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAdd.expectations = append(mmAdd.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IWebAuthnRepository.Add return parameters for the expectation previously defined by the When method
+func (e *IWebAuthnRepositoryMockAddExpectation) Then(err error) *IWebAuthnRepositoryMock {
+	e.results = &IWebAuthnRepositoryMockAddResults{err}
+	return e.mock
+}
+
+// Times sets number of times IWebAuthnRepository.Add should be invoked
+func (mmAdd *mIWebAuthnRepositoryMockAdd) Times(n uint64) *mIWebAuthnRepositoryMockAdd {
+	if n == 0 {
+		mmAdd.mock.t.Fatalf("Times of IWebAuthnRepositoryMock.Add mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdd.expectedInvocations, n)
+	mmAdd.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdd
+}
+
+func (mmAdd *mIWebAuthnRepositoryMockAdd) invocationsDone() bool {
+	if len(mmAdd.expectations) == 0 && mmAdd.defaultExpectation == nil && mmAdd.mock.funcAdd == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdd.mock.afterAddCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdd.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Add implements mm_repository.IWebAuthnRepository
+func (mmAdd *IWebAuthnRepositoryMock) Add(ctx context.Context, cred domain.WebAuthnCredential) (err error) {
+	mm_atomic.AddUint64(&mmAdd.beforeAddCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdd.afterAddCounter, 1)
+
+	mmAdd.t.Helper()
+
+	if mmAdd.inspectFuncAdd != nil {
+		mmAdd.inspectFuncAdd(ctx, cred)
+	}
+
+	mm_params := IWebAuthnRepositoryMockAddParams{ctx, cred}
+
+	// Record call args
+	mmAdd.AddMock.mutex.Lock()
+	mmAdd.AddMock.callArgs = append(mmAdd.AddMock.callArgs, &mm_params)
+	mmAdd.AddMock.mutex.Unlock()
+
+	for _, e := range mmAdd.AddMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAdd.AddMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdd.AddMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdd.AddMock.defaultExpectation.params
+		mm_want_ptrs := mmAdd.AddMock.defaultExpectation.paramPtrs
+
+		mm_got := IWebAuthnRepositoryMockAddParams{ctx, cred}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdd.t.Errorf("IWebAuthnRepositoryMock.Add got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cred != nil && !minimock.Equal(*mm_want_ptrs.cred, mm_got.cred) {
+				mmAdd.t.Errorf("IWebAuthnRepositoryMock.Add got unexpected parameter cred, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdd.AddMock.defaultExpectation.expectationOrigins.originCred, *mm_want_ptrs.cred, mm_got.cred, minimock.Diff(*mm_want_ptrs.cred, mm_got.cred))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdd.t.Errorf("IWebAuthnRepositoryMock.Add got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdd.AddMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdd.AddMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdd.t.Fatal("No results are set for the IWebAuthnRepositoryMock.Add")
+		}
+		return (*mm_results).err
+	}
+	if mmAdd.funcAdd != nil {
+		return mmAdd.funcAdd(ctx, cred)
+	}
+	mmAdd.t.Fatalf("Unexpected call to IWebAuthnRepositoryMock.Add. %v %v", ctx, cred)
+	return
+}
+
+// AddAfterCounter returns a count of finished IWebAuthnRepositoryMock.Add invocations
+func (mmAdd *IWebAuthnRepositoryMock) AddAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.afterAddCounter)
+}
+
+// AddBeforeCounter returns a count of IWebAuthnRepositoryMock.Add invocations
+func (mmAdd *IWebAuthnRepositoryMock) AddBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdd.beforeAddCounter)
+}
+
+// Calls returns a list of arguments used in each call to IWebAuthnRepositoryMock.Add.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdd *mIWebAuthnRepositoryMockAdd) Calls() []*IWebAuthnRepositoryMockAddParams {
+	mmAdd.mutex.RLock()
+
+	argCopy := make([]*IWebAuthnRepositoryMockAddParams, len(mmAdd.callArgs))
+	copy(argCopy, mmAdd.callArgs)
+
+	mmAdd.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddDone returns true if the count of the Add invocations corresponds
+// the number of defined expectations
+func (m *IWebAuthnRepositoryMock) MinimockAddDone() bool {
+	if m.AddMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMock.invocationsDone()
+}
+
+// MinimockAddInspect logs each unmet expectation
+func (m *IWebAuthnRepositoryMock) MinimockAddInspect() {
+	for _, e := range m.AddMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Add at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddCounter := mm_atomic.LoadUint64(&m.afterAddCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMock.defaultExpectation != nil && afterAddCounter < 1 {
+		if m.AddMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Add at\n%s", m.AddMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Add at\n%s with params: %#v", m.AddMock.defaultExpectation.expectationOrigins.origin, *m.AddMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdd != nil && afterAddCounter < 1 {
+		m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Add at\n%s", m.funcAddOrigin)
+	}
+
+	if !m.AddMock.invocationsDone() && afterAddCounter > 0 {
+		m.t.Errorf("Expected %d calls to IWebAuthnRepositoryMock.Add at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMock.expectedInvocations), m.AddMock.expectedInvocationsOrigin, afterAddCounter)
+	}
+}
+
+type mIWebAuthnRepositoryMockDelete struct {
+	optional           bool
+	mock               *IWebAuthnRepositoryMock
+	defaultExpectation *IWebAuthnRepositoryMockDeleteExpectation
+	expectations       []*IWebAuthnRepositoryMockDeleteExpectation
+
+	callArgs []*IWebAuthnRepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IWebAuthnRepositoryMockDeleteExpectation specifies expectation struct of the IWebAuthnRepository.Delete
+type IWebAuthnRepositoryMockDeleteExpectation struct {
+	mock               *IWebAuthnRepositoryMock
+	params             *IWebAuthnRepositoryMockDeleteParams
+	paramPtrs          *IWebAuthnRepositoryMockDeleteParamPtrs
+	expectationOrigins IWebAuthnRepositoryMockDeleteExpectationOrigins
+	results            *IWebAuthnRepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IWebAuthnRepositoryMockDeleteParams contains parameters of the IWebAuthnRepository.Delete
+type IWebAuthnRepositoryMockDeleteParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+	id     []byte
+}
+
+// IWebAuthnRepositoryMockDeleteParamPtrs contains pointers to parameters of the IWebAuthnRepository.Delete
+type IWebAuthnRepositoryMockDeleteParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+	id     *[]byte
+}
+
+// IWebAuthnRepositoryMockDeleteResults contains results of the IWebAuthnRepository.Delete
+type IWebAuthnRepositoryMockDeleteResults struct {
+	err error
+}
+
+// IWebAuthnRepositoryMockDeleteOrigins contains origins of expectations of the IWebAuthnRepository.Delete
+type IWebAuthnRepositoryMockDeleteExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originId     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mIWebAuthnRepositoryMockDelete) Optional() *mIWebAuthnRepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for IWebAuthnRepository.Delete
+func (mmDelete *mIWebAuthnRepositoryMockDelete) Expect(ctx context.Context, userID uuid.UUID, id []byte) *mIWebAuthnRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IWebAuthnRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &IWebAuthnRepositoryMockDeleteParams{ctx, userID, id}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IWebAuthnRepository.Delete
+func (mmDelete *mIWebAuthnRepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mIWebAuthnRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IWebAuthnRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IWebAuthnRepository.Delete
+func (mmDelete *mIWebAuthnRepositoryMockDelete) ExpectUserIDParam2(userID uuid.UUID) *mIWebAuthnRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IWebAuthnRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.userID = &userID
+	mmDelete.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam3 sets up expected param id for IWebAuthnRepository.Delete
+func (mmDelete *mIWebAuthnRepositoryMockDelete) ExpectIdParam3(id []byte) *mIWebAuthnRepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IWebAuthnRepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the IWebAuthnRepository.Delete
+func (mmDelete *mIWebAuthnRepositoryMockDelete) Inspect(f func(ctx context.Context, userID uuid.UUID, id []byte)) *mIWebAuthnRepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for IWebAuthnRepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by IWebAuthnRepository.Delete
+func (mmDelete *mIWebAuthnRepositoryMockDelete) Return(err error) *IWebAuthnRepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IWebAuthnRepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &IWebAuthnRepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the IWebAuthnRepository.Delete method
+func (mmDelete *mIWebAuthnRepositoryMockDelete) Set(f func(ctx context.Context, userID uuid.UUID, id []byte) (err error)) *IWebAuthnRepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the IWebAuthnRepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the IWebAuthnRepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the IWebAuthnRepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mIWebAuthnRepositoryMockDelete) When(ctx context.Context, userID uuid.UUID, id []byte) *IWebAuthnRepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IWebAuthnRepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &IWebAuthnRepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &IWebAuthnRepositoryMockDeleteParams{ctx, userID, id},
+		expectationOrigins: IWebAuthnRepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IWebAuthnRepository.Delete return parameters for the expectation previously defined by the When method
+func (e *IWebAuthnRepositoryMockDeleteExpectation) Then(err error) *IWebAuthnRepositoryMock {
+	e.results = &IWebAuthnRepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times IWebAuthnRepository.Delete should be invoked
+func (mmDelete *mIWebAuthnRepositoryMockDelete) Times(n uint64) *mIWebAuthnRepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of IWebAuthnRepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mIWebAuthnRepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.IWebAuthnRepository
+func (mmDelete *IWebAuthnRepositoryMock) Delete(ctx context.Context, userID uuid.UUID, id []byte) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, userID, id)
+	}
+
+	mm_params := IWebAuthnRepositoryMockDeleteParams{ctx, userID, id}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := IWebAuthnRepositoryMockDeleteParams{ctx, userID, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("IWebAuthnRepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDelete.t.Errorf("IWebAuthnRepositoryMock.Delete got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("IWebAuthnRepositoryMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("IWebAuthnRepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the IWebAuthnRepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, userID, id)
+	}
+	mmDelete.t.Fatalf("Unexpected call to IWebAuthnRepositoryMock.Delete. %v %v %v", ctx, userID, id)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished IWebAuthnRepositoryMock.Delete invocations
+func (mmDelete *IWebAuthnRepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of IWebAuthnRepositoryMock.Delete invocations
+func (mmDelete *IWebAuthnRepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to IWebAuthnRepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mIWebAuthnRepositoryMockDelete) Calls() []*IWebAuthnRepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*IWebAuthnRepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *IWebAuthnRepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *IWebAuthnRepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to IWebAuthnRepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mIWebAuthnRepositoryMockGet struct {
+	optional           bool
+	mock               *IWebAuthnRepositoryMock
+	defaultExpectation *IWebAuthnRepositoryMockGetExpectation
+	expectations       []*IWebAuthnRepositoryMockGetExpectation
+
+	callArgs []*IWebAuthnRepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IWebAuthnRepositoryMockGetExpectation specifies expectation struct of the IWebAuthnRepository.Get
+type IWebAuthnRepositoryMockGetExpectation struct {
+	mock               *IWebAuthnRepositoryMock
+	params             *IWebAuthnRepositoryMockGetParams
+	paramPtrs          *IWebAuthnRepositoryMockGetParamPtrs
+	expectationOrigins IWebAuthnRepositoryMockGetExpectationOrigins
+	results            *IWebAuthnRepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IWebAuthnRepositoryMockGetParams contains parameters of the IWebAuthnRepository.Get
+type IWebAuthnRepositoryMockGetParams struct {
+	ctx context.Context
+	id  []byte
+}
+
+// IWebAuthnRepositoryMockGetParamPtrs contains pointers to parameters of the IWebAuthnRepository.Get
+type IWebAuthnRepositoryMockGetParamPtrs struct {
+	ctx *context.Context
+	id  *[]byte
+}
+
+// IWebAuthnRepositoryMockGetResults contains results of the IWebAuthnRepository.Get
+type IWebAuthnRepositoryMockGetResults struct {
+	w1  domain.WebAuthnCredential
+	err error
+}
+
+// IWebAuthnRepositoryMockGetOrigins contains origins of expectations of the IWebAuthnRepository.Get
+type IWebAuthnRepositoryMockGetExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mIWebAuthnRepositoryMockGet) Optional() *mIWebAuthnRepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for IWebAuthnRepository.Get
+func (mmGet *mIWebAuthnRepositoryMockGet) Expect(ctx context.Context, id []byte) *mIWebAuthnRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IWebAuthnRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IWebAuthnRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("IWebAuthnRepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &IWebAuthnRepositoryMockGetParams{ctx, id}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IWebAuthnRepository.Get
+func (mmGet *mIWebAuthnRepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mIWebAuthnRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IWebAuthnRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IWebAuthnRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IWebAuthnRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectIdParam2 sets up expected param id for IWebAuthnRepository.Get
+func (mmGet *mIWebAuthnRepositoryMockGet) ExpectIdParam2(id []byte) *mIWebAuthnRepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IWebAuthnRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IWebAuthnRepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IWebAuthnRepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.id = &id
+	mmGet.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the IWebAuthnRepository.Get
+func (mmGet *mIWebAuthnRepositoryMockGet) Inspect(f func(ctx context.Context, id []byte)) *mIWebAuthnRepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for IWebAuthnRepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by IWebAuthnRepository.Get
+func (mmGet *mIWebAuthnRepositoryMockGet) Return(w1 domain.WebAuthnCredential, err error) *IWebAuthnRepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IWebAuthnRepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IWebAuthnRepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &IWebAuthnRepositoryMockGetResults{w1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the IWebAuthnRepository.Get method
+func (mmGet *mIWebAuthnRepositoryMockGet) Set(f func(ctx context.Context, id []byte) (w1 domain.WebAuthnCredential, err error)) *IWebAuthnRepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the IWebAuthnRepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the IWebAuthnRepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the IWebAuthnRepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mIWebAuthnRepositoryMockGet) When(ctx context.Context, id []byte) *IWebAuthnRepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IWebAuthnRepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &IWebAuthnRepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &IWebAuthnRepositoryMockGetParams{ctx, id},
+		expectationOrigins: IWebAuthnRepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IWebAuthnRepository.Get return parameters for the expectation previously defined by the When method
+func (e *IWebAuthnRepositoryMockGetExpectation) Then(w1 domain.WebAuthnCredential, err error) *IWebAuthnRepositoryMock {
+	e.results = &IWebAuthnRepositoryMockGetResults{w1, err}
+	return e.mock
+}
+
+// Times sets number of times IWebAuthnRepository.Get should be invoked
+func (mmGet *mIWebAuthnRepositoryMockGet) Times(n uint64) *mIWebAuthnRepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of IWebAuthnRepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mIWebAuthnRepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.IWebAuthnRepository
+func (mmGet *IWebAuthnRepositoryMock) Get(ctx context.Context, id []byte) (w1 domain.WebAuthnCredential, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, id)
+	}
+
+	mm_params := IWebAuthnRepositoryMockGetParams{ctx, id}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := IWebAuthnRepositoryMockGetParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("IWebAuthnRepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGet.t.Errorf("IWebAuthnRepositoryMock.Get got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("IWebAuthnRepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the IWebAuthnRepositoryMock.Get")
+		}
+		return (*mm_results).w1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, id)
+	}
+	mmGet.t.Fatalf("Unexpected call to IWebAuthnRepositoryMock.Get. %v %v", ctx, id)
+	return
+}
+
+// GetAfterCounter returns a count of finished IWebAuthnRepositoryMock.Get invocations
+func (mmGet *IWebAuthnRepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of IWebAuthnRepositoryMock.Get invocations
+func (mmGet *IWebAuthnRepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to IWebAuthnRepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mIWebAuthnRepositoryMockGet) Calls() []*IWebAuthnRepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*IWebAuthnRepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *IWebAuthnRepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *IWebAuthnRepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to IWebAuthnRepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to IWebAuthnRepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mIWebAuthnRepositoryMockListByUser struct {
+	optional           bool
+	mock               *IWebAuthnRepositoryMock
+	defaultExpectation *IWebAuthnRepositoryMockListByUserExpectation
+	expectations       []*IWebAuthnRepositoryMockListByUserExpectation
+
+	callArgs []*IWebAuthnRepositoryMockListByUserParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IWebAuthnRepositoryMockListByUserExpectation specifies expectation struct of the IWebAuthnRepository.ListByUser
+type IWebAuthnRepositoryMockListByUserExpectation struct {
+	mock               *IWebAuthnRepositoryMock
+	params             *IWebAuthnRepositoryMockListByUserParams
+	paramPtrs          *IWebAuthnRepositoryMockListByUserParamPtrs
+	expectationOrigins IWebAuthnRepositoryMockListByUserExpectationOrigins
+	results            *IWebAuthnRepositoryMockListByUserResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IWebAuthnRepositoryMockListByUserParams contains parameters of the IWebAuthnRepository.ListByUser
+type IWebAuthnRepositoryMockListByUserParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// IWebAuthnRepositoryMockListByUserParamPtrs contains pointers to parameters of the IWebAuthnRepository.ListByUser
+type IWebAuthnRepositoryMockListByUserParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// IWebAuthnRepositoryMockListByUserResults contains results of the IWebAuthnRepository.ListByUser
+type IWebAuthnRepositoryMockListByUserResults struct {
+	wa1 []domain.WebAuthnCredential
+	err error
+}
+
+// IWebAuthnRepositoryMockListByUserOrigins contains origins of expectations of the IWebAuthnRepository.ListByUser
+type IWebAuthnRepositoryMockListByUserExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) Optional() *mIWebAuthnRepositoryMockListByUser {
+	mmListByUser.optional = true
+	return mmListByUser
+}
+
+// Expect sets up expected params for IWebAuthnRepository.ListByUser
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) Expect(ctx context.Context, userID uuid.UUID) *mIWebAuthnRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IWebAuthnRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &IWebAuthnRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs != nil {
+		mmListByUser.mock.t.Fatalf("IWebAuthnRepositoryMock.ListByUser mock is already set by ExpectParams functions")
+	}
+
+	mmListByUser.defaultExpectation.params = &IWebAuthnRepositoryMockListByUserParams{ctx, userID}
+	mmListByUser.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListByUser.expectations {
+		if minimock.Equal(e.params, mmListByUser.defaultExpectation.params) {
+			mmListByUser.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListByUser.defaultExpectation.params)
+		}
+	}
+
+	return mmListByUser
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IWebAuthnRepository.ListByUser
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) ExpectCtxParam1(ctx context.Context) *mIWebAuthnRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IWebAuthnRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &IWebAuthnRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("IWebAuthnRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListByUser.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IWebAuthnRepository.ListByUser
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) ExpectUserIDParam2(userID uuid.UUID) *mIWebAuthnRepositoryMockListByUser {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IWebAuthnRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &IWebAuthnRepositoryMockListByUserExpectation{}
+	}
+
+	if mmListByUser.defaultExpectation.params != nil {
+		mmListByUser.mock.t.Fatalf("IWebAuthnRepositoryMock.ListByUser mock is already set by Expect")
+	}
+
+	if mmListByUser.defaultExpectation.paramPtrs == nil {
+		mmListByUser.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockListByUserParamPtrs{}
+	}
+	mmListByUser.defaultExpectation.paramPtrs.userID = &userID
+	mmListByUser.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListByUser
+}
+
+// Inspect accepts an inspector function that has same arguments as the IWebAuthnRepository.ListByUser
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mIWebAuthnRepositoryMockListByUser {
+	if mmListByUser.mock.inspectFuncListByUser != nil {
+		mmListByUser.mock.t.Fatalf("Inspect function is already set for IWebAuthnRepositoryMock.ListByUser")
+	}
+
+	mmListByUser.mock.inspectFuncListByUser = f
+
+	return mmListByUser
+}
+
+// Return sets up results that will be returned by IWebAuthnRepository.ListByUser
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) Return(wa1 []domain.WebAuthnCredential, err error) *IWebAuthnRepositoryMock {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IWebAuthnRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	if mmListByUser.defaultExpectation == nil {
+		mmListByUser.defaultExpectation = &IWebAuthnRepositoryMockListByUserExpectation{mock: mmListByUser.mock}
+	}
+	mmListByUser.defaultExpectation.results = &IWebAuthnRepositoryMockListByUserResults{wa1, err}
+	mmListByUser.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListByUser.mock
+}
+
+// Set uses given function f to mock the IWebAuthnRepository.ListByUser method
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) Set(f func(ctx context.Context, userID uuid.UUID) (wa1 []domain.WebAuthnCredential, err error)) *IWebAuthnRepositoryMock {
+	if mmListByUser.defaultExpectation != nil {
+		mmListByUser.mock.t.Fatalf("Default expectation is already set for the IWebAuthnRepository.ListByUser method")
+	}
+
+	if len(mmListByUser.expectations) > 0 {
+		mmListByUser.mock.t.Fatalf("Some expectations are already set for the IWebAuthnRepository.ListByUser method")
+	}
+
+	mmListByUser.mock.funcListByUser = f
+	mmListByUser.mock.funcListByUserOrigin = minimock.CallerInfo(1)
+	return mmListByUser.mock
+}
+
+// When sets expectation for the IWebAuthnRepository.ListByUser which will trigger the result defined by the following
+// Then helper
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) When(ctx context.Context, userID uuid.UUID) *IWebAuthnRepositoryMockListByUserExpectation {
+	if mmListByUser.mock.funcListByUser != nil {
+		mmListByUser.mock.t.Fatalf("IWebAuthnRepositoryMock.ListByUser mock is already set by Set")
+	}
+
+	expectation := &IWebAuthnRepositoryMockListByUserExpectation{
+		mock:               mmListByUser.mock,
+		params:             &IWebAuthnRepositoryMockListByUserParams{ctx, userID},
+		expectationOrigins: IWebAuthnRepositoryMockListByUserExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListByUser.expectations = append(mmListByUser.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IWebAuthnRepository.ListByUser return parameters for the expectation previously defined by the When method
+func (e *IWebAuthnRepositoryMockListByUserExpectation) Then(wa1 []domain.WebAuthnCredential, err error) *IWebAuthnRepositoryMock {
+	e.results = &IWebAuthnRepositoryMockListByUserResults{wa1, err}
+	return e.mock
+}
+
+// Times sets number of times IWebAuthnRepository.ListByUser should be invoked
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) Times(n uint64) *mIWebAuthnRepositoryMockListByUser {
+	if n == 0 {
+		mmListByUser.mock.t.Fatalf("Times of IWebAuthnRepositoryMock.ListByUser mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListByUser.expectedInvocations, n)
+	mmListByUser.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListByUser
+}
+
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) invocationsDone() bool {
+	if len(mmListByUser.expectations) == 0 && mmListByUser.defaultExpectation == nil && mmListByUser.mock.funcListByUser == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListByUser.mock.afterListByUserCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListByUser.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListByUser implements mm_repository.IWebAuthnRepository
+func (mmListByUser *IWebAuthnRepositoryMock) ListByUser(ctx context.Context, userID uuid.UUID) (wa1 []domain.WebAuthnCredential, err error) {
+	mm_atomic.AddUint64(&mmListByUser.beforeListByUserCounter, 1)
+	defer mm_atomic.AddUint64(&mmListByUser.afterListByUserCounter, 1)
+
+	mmListByUser.t.Helper()
+
+	if mmListByUser.inspectFuncListByUser != nil {
+		mmListByUser.inspectFuncListByUser(ctx, userID)
+	}
+
+	mm_params := IWebAuthnRepositoryMockListByUserParams{ctx, userID}
+
+	// Record call args
+	mmListByUser.ListByUserMock.mutex.Lock()
+	mmListByUser.ListByUserMock.callArgs = append(mmListByUser.ListByUserMock.callArgs, &mm_params)
+	mmListByUser.ListByUserMock.mutex.Unlock()
+
+	for _, e := range mmListByUser.ListByUserMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.wa1, e.results.err
+		}
+	}
+
+	if mmListByUser.ListByUserMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListByUser.ListByUserMock.defaultExpectation.Counter, 1)
+		mm_want := mmListByUser.ListByUserMock.defaultExpectation.params
+		mm_want_ptrs := mmListByUser.ListByUserMock.defaultExpectation.paramPtrs
+
+		mm_got := IWebAuthnRepositoryMockListByUserParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListByUser.t.Errorf("IWebAuthnRepositoryMock.ListByUser got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListByUser.t.Errorf("IWebAuthnRepositoryMock.ListByUser got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListByUser.t.Errorf("IWebAuthnRepositoryMock.ListByUser got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListByUser.ListByUserMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListByUser.ListByUserMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListByUser.t.Fatal("No results are set for the IWebAuthnRepositoryMock.ListByUser")
+		}
+		return (*mm_results).wa1, (*mm_results).err
+	}
+	if mmListByUser.funcListByUser != nil {
+		return mmListByUser.funcListByUser(ctx, userID)
+	}
+	mmListByUser.t.Fatalf("Unexpected call to IWebAuthnRepositoryMock.ListByUser. %v %v", ctx, userID)
+	return
+}
+
+// ListByUserAfterCounter returns a count of finished IWebAuthnRepositoryMock.ListByUser invocations
+func (mmListByUser *IWebAuthnRepositoryMock) ListByUserAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.afterListByUserCounter)
+}
+
+// ListByUserBeforeCounter returns a count of IWebAuthnRepositoryMock.ListByUser invocations
+func (mmListByUser *IWebAuthnRepositoryMock) ListByUserBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListByUser.beforeListByUserCounter)
+}
+
+// Calls returns a list of arguments used in each call to IWebAuthnRepositoryMock.ListByUser.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListByUser *mIWebAuthnRepositoryMockListByUser) Calls() []*IWebAuthnRepositoryMockListByUserParams {
+	mmListByUser.mutex.RLock()
+
+	argCopy := make([]*IWebAuthnRepositoryMockListByUserParams, len(mmListByUser.callArgs))
+	copy(argCopy, mmListByUser.callArgs)
+
+	mmListByUser.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListByUserDone returns true if the count of the ListByUser invocations corresponds
+// the number of defined expectations
+func (m *IWebAuthnRepositoryMock) MinimockListByUserDone() bool {
+	if m.ListByUserMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListByUserMock.invocationsDone()
+}
+
+// MinimockListByUserInspect logs each unmet expectation
+func (m *IWebAuthnRepositoryMock) MinimockListByUserInspect() {
+	for _, e := range m.ListByUserMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.ListByUser at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListByUserCounter := mm_atomic.LoadUint64(&m.afterListByUserCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListByUserMock.defaultExpectation != nil && afterListByUserCounter < 1 {
+		if m.ListByUserMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.ListByUser at\n%s", m.ListByUserMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.ListByUser at\n%s with params: %#v", m.ListByUserMock.defaultExpectation.expectationOrigins.origin, *m.ListByUserMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListByUser != nil && afterListByUserCounter < 1 {
+		m.t.Errorf("Expected call to IWebAuthnRepositoryMock.ListByUser at\n%s", m.funcListByUserOrigin)
+	}
+
+	if !m.ListByUserMock.invocationsDone() && afterListByUserCounter > 0 {
+		m.t.Errorf("Expected %d calls to IWebAuthnRepositoryMock.ListByUser at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListByUserMock.expectedInvocations), m.ListByUserMock.expectedInvocationsOrigin, afterListByUserCounter)
+	}
+}
+
+type mIWebAuthnRepositoryMockUpdateSignCount struct {
+	optional           bool
+	mock               *IWebAuthnRepositoryMock
+	defaultExpectation *IWebAuthnRepositoryMockUpdateSignCountExpectation
+	expectations       []*IWebAuthnRepositoryMockUpdateSignCountExpectation
+
+	callArgs []*IWebAuthnRepositoryMockUpdateSignCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IWebAuthnRepositoryMockUpdateSignCountExpectation specifies expectation struct of the IWebAuthnRepository.UpdateSignCount
+type IWebAuthnRepositoryMockUpdateSignCountExpectation struct {
+	mock               *IWebAuthnRepositoryMock
+	params             *IWebAuthnRepositoryMockUpdateSignCountParams
+	paramPtrs          *IWebAuthnRepositoryMockUpdateSignCountParamPtrs
+	expectationOrigins IWebAuthnRepositoryMockUpdateSignCountExpectationOrigins
+	results            *IWebAuthnRepositoryMockUpdateSignCountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IWebAuthnRepositoryMockUpdateSignCountParams contains parameters of the IWebAuthnRepository.UpdateSignCount
+type IWebAuthnRepositoryMockUpdateSignCountParams struct {
+	ctx       context.Context
+	id        []byte
+	signCount uint32
+	usedAt    time.Time
+}
+
+// IWebAuthnRepositoryMockUpdateSignCountParamPtrs contains pointers to parameters of the IWebAuthnRepository.UpdateSignCount
+type IWebAuthnRepositoryMockUpdateSignCountParamPtrs struct {
+	ctx       *context.Context
+	id        *[]byte
+	signCount *uint32
+	usedAt    *time.Time
+}
+
+// IWebAuthnRepositoryMockUpdateSignCountResults contains results of the IWebAuthnRepository.UpdateSignCount
+type IWebAuthnRepositoryMockUpdateSignCountResults struct {
+	err error
+}
+
+// IWebAuthnRepositoryMockUpdateSignCountOrigins contains origins of expectations of the IWebAuthnRepository.UpdateSignCount
+type IWebAuthnRepositoryMockUpdateSignCountExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originId        string
+	originSignCount string
+	originUsedAt    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) Optional() *mIWebAuthnRepositoryMockUpdateSignCount {
+	mmUpdateSignCount.optional = true
+	return mmUpdateSignCount
+}
+
+// Expect sets up expected params for IWebAuthnRepository.UpdateSignCount
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) Expect(ctx context.Context, id []byte, signCount uint32, usedAt time.Time) *mIWebAuthnRepositoryMockUpdateSignCount {
+	if mmUpdateSignCount.mock.funcUpdateSignCount != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Set")
+	}
+
+	if mmUpdateSignCount.defaultExpectation == nil {
+		mmUpdateSignCount.defaultExpectation = &IWebAuthnRepositoryMockUpdateSignCountExpectation{}
+	}
+
+	if mmUpdateSignCount.defaultExpectation.paramPtrs != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateSignCount.defaultExpectation.params = &IWebAuthnRepositoryMockUpdateSignCountParams{ctx, id, signCount, usedAt}
+	mmUpdateSignCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateSignCount.expectations {
+		if minimock.Equal(e.params, mmUpdateSignCount.defaultExpectation.params) {
+			mmUpdateSignCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSignCount.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateSignCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IWebAuthnRepository.UpdateSignCount
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) ExpectCtxParam1(ctx context.Context) *mIWebAuthnRepositoryMockUpdateSignCount {
+	if mmUpdateSignCount.mock.funcUpdateSignCount != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Set")
+	}
+
+	if mmUpdateSignCount.defaultExpectation == nil {
+		mmUpdateSignCount.defaultExpectation = &IWebAuthnRepositoryMockUpdateSignCountExpectation{}
+	}
+
+	if mmUpdateSignCount.defaultExpectation.params != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Expect")
+	}
+
+	if mmUpdateSignCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateSignCount.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockUpdateSignCountParamPtrs{}
+	}
+	mmUpdateSignCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateSignCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateSignCount
+}
+
+// ExpectIdParam2 sets up expected param id for IWebAuthnRepository.UpdateSignCount
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) ExpectIdParam2(id []byte) *mIWebAuthnRepositoryMockUpdateSignCount {
+	if mmUpdateSignCount.mock.funcUpdateSignCount != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Set")
+	}
+
+	if mmUpdateSignCount.defaultExpectation == nil {
+		mmUpdateSignCount.defaultExpectation = &IWebAuthnRepositoryMockUpdateSignCountExpectation{}
+	}
+
+	if mmUpdateSignCount.defaultExpectation.params != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Expect")
+	}
+
+	if mmUpdateSignCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateSignCount.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockUpdateSignCountParamPtrs{}
+	}
+	mmUpdateSignCount.defaultExpectation.paramPtrs.id = &id
+	mmUpdateSignCount.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUpdateSignCount
+}
+
+// ExpectSignCountParam3 sets up expected param signCount for IWebAuthnRepository.UpdateSignCount
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) ExpectSignCountParam3(signCount uint32) *mIWebAuthnRepositoryMockUpdateSignCount {
+	if mmUpdateSignCount.mock.funcUpdateSignCount != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Set")
+	}
+
+	if mmUpdateSignCount.defaultExpectation == nil {
+		mmUpdateSignCount.defaultExpectation = &IWebAuthnRepositoryMockUpdateSignCountExpectation{}
+	}
+
+	if mmUpdateSignCount.defaultExpectation.params != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Expect")
+	}
+
+	if mmUpdateSignCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateSignCount.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockUpdateSignCountParamPtrs{}
+	}
+	mmUpdateSignCount.defaultExpectation.paramPtrs.signCount = &signCount
+	mmUpdateSignCount.defaultExpectation.expectationOrigins.originSignCount = minimock.CallerInfo(1)
+
+	return mmUpdateSignCount
+}
+
+// ExpectUsedAtParam4 sets up expected param usedAt for IWebAuthnRepository.UpdateSignCount
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) ExpectUsedAtParam4(usedAt time.Time) *mIWebAuthnRepositoryMockUpdateSignCount {
+	if mmUpdateSignCount.mock.funcUpdateSignCount != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Set")
+	}
+
+	if mmUpdateSignCount.defaultExpectation == nil {
+		mmUpdateSignCount.defaultExpectation = &IWebAuthnRepositoryMockUpdateSignCountExpectation{}
+	}
+
+	if mmUpdateSignCount.defaultExpectation.params != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Expect")
+	}
+
+	if mmUpdateSignCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateSignCount.defaultExpectation.paramPtrs = &IWebAuthnRepositoryMockUpdateSignCountParamPtrs{}
+	}
+	mmUpdateSignCount.defaultExpectation.paramPtrs.usedAt = &usedAt
+	mmUpdateSignCount.defaultExpectation.expectationOrigins.originUsedAt = minimock.CallerInfo(1)
+
+	return mmUpdateSignCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the IWebAuthnRepository.UpdateSignCount
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) Inspect(f func(ctx context.Context, id []byte, signCount uint32, usedAt time.Time)) *mIWebAuthnRepositoryMockUpdateSignCount {
+	if mmUpdateSignCount.mock.inspectFuncUpdateSignCount != nil {
+		mmUpdateSignCount.mock.t.Fatalf("Inspect function is already set for IWebAuthnRepositoryMock.UpdateSignCount")
+	}
+
+	mmUpdateSignCount.mock.inspectFuncUpdateSignCount = f
+
+	return mmUpdateSignCount
+}
+
+// Return sets up results that will be returned by IWebAuthnRepository.UpdateSignCount
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) Return(err error) *IWebAuthnRepositoryMock {
+	if mmUpdateSignCount.mock.funcUpdateSignCount != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Set")
+	}
+
+	if mmUpdateSignCount.defaultExpectation == nil {
+		mmUpdateSignCount.defaultExpectation = &IWebAuthnRepositoryMockUpdateSignCountExpectation{mock: mmUpdateSignCount.mock}
+	}
+	mmUpdateSignCount.defaultExpectation.results = &IWebAuthnRepositoryMockUpdateSignCountResults{err}
+	mmUpdateSignCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateSignCount.mock
+}
+
+// Set uses given function f to mock the IWebAuthnRepository.UpdateSignCount method
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) Set(f func(ctx context.Context, id []byte, signCount uint32, usedAt time.Time) (err error)) *IWebAuthnRepositoryMock {
+	if mmUpdateSignCount.defaultExpectation != nil {
+		mmUpdateSignCount.mock.t.Fatalf("Default expectation is already set for the IWebAuthnRepository.UpdateSignCount method")
+	}
+
+	if len(mmUpdateSignCount.expectations) > 0 {
+		mmUpdateSignCount.mock.t.Fatalf("Some expectations are already set for the IWebAuthnRepository.UpdateSignCount method")
+	}
+
+	mmUpdateSignCount.mock.funcUpdateSignCount = f
+	mmUpdateSignCount.mock.funcUpdateSignCountOrigin = minimock.CallerInfo(1)
+	return mmUpdateSignCount.mock
+}
+
+// When sets expectation for the IWebAuthnRepository.UpdateSignCount which will trigger the result defined by the following
+// Then helper
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) When(ctx context.Context, id []byte, signCount uint32, usedAt time.Time) *IWebAuthnRepositoryMockUpdateSignCountExpectation {
+	if mmUpdateSignCount.mock.funcUpdateSignCount != nil {
+		mmUpdateSignCount.mock.t.Fatalf("IWebAuthnRepositoryMock.UpdateSignCount mock is already set by Set")
+	}
+
+	expectation := &IWebAuthnRepositoryMockUpdateSignCountExpectation{
+		mock:               mmUpdateSignCount.mock,
+		params:             &IWebAuthnRepositoryMockUpdateSignCountParams{ctx, id, signCount, usedAt},
+		expectationOrigins: IWebAuthnRepositoryMockUpdateSignCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateSignCount.expectations = append(mmUpdateSignCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IWebAuthnRepository.UpdateSignCount return parameters for the expectation previously defined by the When method
+func (e *IWebAuthnRepositoryMockUpdateSignCountExpectation) Then(err error) *IWebAuthnRepositoryMock {
+	e.results = &IWebAuthnRepositoryMockUpdateSignCountResults{err}
+	return e.mock
+}
+
+// Times sets number of times IWebAuthnRepository.UpdateSignCount should be invoked
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) Times(n uint64) *mIWebAuthnRepositoryMockUpdateSignCount {
+	if n == 0 {
+		mmUpdateSignCount.mock.t.Fatalf("Times of IWebAuthnRepositoryMock.UpdateSignCount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateSignCount.expectedInvocations, n)
+	mmUpdateSignCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSignCount
+}
+
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) invocationsDone() bool {
+	if len(mmUpdateSignCount.expectations) == 0 && mmUpdateSignCount.defaultExpectation == nil && mmUpdateSignCount.mock.funcUpdateSignCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateSignCount.mock.afterUpdateSignCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateSignCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateSignCount implements mm_repository.IWebAuthnRepository
+func (mmUpdateSignCount *IWebAuthnRepositoryMock) UpdateSignCount(ctx context.Context, id []byte, signCount uint32, usedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmUpdateSignCount.beforeUpdateSignCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSignCount.afterUpdateSignCountCounter, 1)
+
+	mmUpdateSignCount.t.Helper()
+
+	if mmUpdateSignCount.inspectFuncUpdateSignCount != nil {
+		mmUpdateSignCount.inspectFuncUpdateSignCount(ctx, id, signCount, usedAt)
+	}
+
+	mm_params := IWebAuthnRepositoryMockUpdateSignCountParams{ctx, id, signCount, usedAt}
+
+	// Record call args
+	mmUpdateSignCount.UpdateSignCountMock.mutex.Lock()
+	mmUpdateSignCount.UpdateSignCountMock.callArgs = append(mmUpdateSignCount.UpdateSignCountMock.callArgs, &mm_params)
+	mmUpdateSignCount.UpdateSignCountMock.mutex.Unlock()
+
+	for _, e := range mmUpdateSignCount.UpdateSignCountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateSignCount.UpdateSignCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSignCount.UpdateSignCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSignCount.UpdateSignCountMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateSignCount.UpdateSignCountMock.defaultExpectation.paramPtrs
+
+		mm_got := IWebAuthnRepositoryMockUpdateSignCountParams{ctx, id, signCount, usedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateSignCount.t.Errorf("IWebAuthnRepositoryMock.UpdateSignCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSignCount.UpdateSignCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUpdateSignCount.t.Errorf("IWebAuthnRepositoryMock.UpdateSignCount got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSignCount.UpdateSignCountMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.signCount != nil && !minimock.Equal(*mm_want_ptrs.signCount, mm_got.signCount) {
+				mmUpdateSignCount.t.Errorf("IWebAuthnRepositoryMock.UpdateSignCount got unexpected parameter signCount, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSignCount.UpdateSignCountMock.defaultExpectation.expectationOrigins.originSignCount, *mm_want_ptrs.signCount, mm_got.signCount, minimock.Diff(*mm_want_ptrs.signCount, mm_got.signCount))
+			}
+
+			if mm_want_ptrs.usedAt != nil && !minimock.Equal(*mm_want_ptrs.usedAt, mm_got.usedAt) {
+				mmUpdateSignCount.t.Errorf("IWebAuthnRepositoryMock.UpdateSignCount got unexpected parameter usedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSignCount.UpdateSignCountMock.defaultExpectation.expectationOrigins.originUsedAt, *mm_want_ptrs.usedAt, mm_got.usedAt, minimock.Diff(*mm_want_ptrs.usedAt, mm_got.usedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateSignCount.t.Errorf("IWebAuthnRepositoryMock.UpdateSignCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateSignCount.UpdateSignCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateSignCount.UpdateSignCountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateSignCount.t.Fatal("No results are set for the IWebAuthnRepositoryMock.UpdateSignCount")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateSignCount.funcUpdateSignCount != nil {
+		return mmUpdateSignCount.funcUpdateSignCount(ctx, id, signCount, usedAt)
+	}
+	mmUpdateSignCount.t.Fatalf("Unexpected call to IWebAuthnRepositoryMock.UpdateSignCount. %v %v %v %v", ctx, id, signCount, usedAt)
+	return
+}
+
+// UpdateSignCountAfterCounter returns a count of finished IWebAuthnRepositoryMock.UpdateSignCount invocations
+func (mmUpdateSignCount *IWebAuthnRepositoryMock) UpdateSignCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSignCount.afterUpdateSignCountCounter)
+}
+
+// UpdateSignCountBeforeCounter returns a count of IWebAuthnRepositoryMock.UpdateSignCount invocations
+func (mmUpdateSignCount *IWebAuthnRepositoryMock) UpdateSignCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSignCount.beforeUpdateSignCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to IWebAuthnRepositoryMock.UpdateSignCount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateSignCount *mIWebAuthnRepositoryMockUpdateSignCount) Calls() []*IWebAuthnRepositoryMockUpdateSignCountParams {
+	mmUpdateSignCount.mutex.RLock()
+
+	argCopy := make([]*IWebAuthnRepositoryMockUpdateSignCountParams, len(mmUpdateSignCount.callArgs))
+	copy(argCopy, mmUpdateSignCount.callArgs)
+
+	mmUpdateSignCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateSignCountDone returns true if the count of the UpdateSignCount invocations corresponds
+// the number of defined expectations
+func (m *IWebAuthnRepositoryMock) MinimockUpdateSignCountDone() bool {
+	if m.UpdateSignCountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateSignCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateSignCountMock.invocationsDone()
+}
+
+// MinimockUpdateSignCountInspect logs each unmet expectation
+func (m *IWebAuthnRepositoryMock) MinimockUpdateSignCountInspect() {
+	for _, e := range m.UpdateSignCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.UpdateSignCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateSignCountCounter := mm_atomic.LoadUint64(&m.afterUpdateSignCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateSignCountMock.defaultExpectation != nil && afterUpdateSignCountCounter < 1 {
+		if m.UpdateSignCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.UpdateSignCount at\n%s", m.UpdateSignCountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IWebAuthnRepositoryMock.UpdateSignCount at\n%s with params: %#v", m.UpdateSignCountMock.defaultExpectation.expectationOrigins.origin, *m.UpdateSignCountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateSignCount != nil && afterUpdateSignCountCounter < 1 {
+		m.t.Errorf("Expected call to IWebAuthnRepositoryMock.UpdateSignCount at\n%s", m.funcUpdateSignCountOrigin)
+	}
+
+	if !m.UpdateSignCountMock.invocationsDone() && afterUpdateSignCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to IWebAuthnRepositoryMock.UpdateSignCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateSignCountMock.expectedInvocations), m.UpdateSignCountMock.expectedInvocationsOrigin, afterUpdateSignCountCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IWebAuthnRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddInspect()
+
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockListByUserInspect()
+
+			m.MinimockUpdateSignCountInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IWebAuthnRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IWebAuthnRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddDone() &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockListByUserDone() &&
+		m.MinimockUpdateSignCountDone()
+}
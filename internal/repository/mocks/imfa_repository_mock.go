@@ -0,0 +1,1901 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/maisiq/go-auth-service/internal/repository.IMFARepository -o imfa_repository_mock.go -n IMFARepositoryMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+// IMFARepositoryMock implements mm_repository.IMFARepository
+type IMFARepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDelete          func(ctx context.Context, userID uuid.UUID) (err error)
+	funcDeleteOrigin    string
+	inspectFuncDelete   func(ctx context.Context, userID uuid.UUID)
+	afterDeleteCounter  uint64
+	beforeDeleteCounter uint64
+	DeleteMock          mIMFARepositoryMockDelete
+
+	funcGet          func(ctx context.Context, userID uuid.UUID) (m1 domain.MFA, err error)
+	funcGetOrigin    string
+	inspectFuncGet   func(ctx context.Context, userID uuid.UUID)
+	afterGetCounter  uint64
+	beforeGetCounter uint64
+	GetMock          mIMFARepositoryMockGet
+
+	funcSave          func(ctx context.Context, mfa domain.MFA) (err error)
+	funcSaveOrigin    string
+	inspectFuncSave   func(ctx context.Context, mfa domain.MFA)
+	afterSaveCounter  uint64
+	beforeSaveCounter uint64
+	SaveMock          mIMFARepositoryMockSave
+
+	funcSetRecoveryCodes          func(ctx context.Context, userID uuid.UUID, codeHashes []string) (err error)
+	funcSetRecoveryCodesOrigin    string
+	inspectFuncSetRecoveryCodes   func(ctx context.Context, userID uuid.UUID, codeHashes []string)
+	afterSetRecoveryCodesCounter  uint64
+	beforeSetRecoveryCodesCounter uint64
+	SetRecoveryCodesMock          mIMFARepositoryMockSetRecoveryCodes
+
+	funcUseRecoveryCode          func(ctx context.Context, userID uuid.UUID, codeHash string) (err error)
+	funcUseRecoveryCodeOrigin    string
+	inspectFuncUseRecoveryCode   func(ctx context.Context, userID uuid.UUID, codeHash string)
+	afterUseRecoveryCodeCounter  uint64
+	beforeUseRecoveryCodeCounter uint64
+	UseRecoveryCodeMock          mIMFARepositoryMockUseRecoveryCode
+}
+
+// NewIMFARepositoryMock returns a mock for mm_repository.IMFARepository
+func NewIMFARepositoryMock(t minimock.Tester) *IMFARepositoryMock {
+	m := &IMFARepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteMock = mIMFARepositoryMockDelete{mock: m}
+	m.DeleteMock.callArgs = []*IMFARepositoryMockDeleteParams{}
+
+	m.GetMock = mIMFARepositoryMockGet{mock: m}
+	m.GetMock.callArgs = []*IMFARepositoryMockGetParams{}
+
+	m.SaveMock = mIMFARepositoryMockSave{mock: m}
+	m.SaveMock.callArgs = []*IMFARepositoryMockSaveParams{}
+
+	m.SetRecoveryCodesMock = mIMFARepositoryMockSetRecoveryCodes{mock: m}
+	m.SetRecoveryCodesMock.callArgs = []*IMFARepositoryMockSetRecoveryCodesParams{}
+
+	m.UseRecoveryCodeMock = mIMFARepositoryMockUseRecoveryCode{mock: m}
+	m.UseRecoveryCodeMock.callArgs = []*IMFARepositoryMockUseRecoveryCodeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIMFARepositoryMockDelete struct {
+	optional           bool
+	mock               *IMFARepositoryMock
+	defaultExpectation *IMFARepositoryMockDeleteExpectation
+	expectations       []*IMFARepositoryMockDeleteExpectation
+
+	callArgs []*IMFARepositoryMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IMFARepositoryMockDeleteExpectation specifies expectation struct of the IMFARepository.Delete
+type IMFARepositoryMockDeleteExpectation struct {
+	mock               *IMFARepositoryMock
+	params             *IMFARepositoryMockDeleteParams
+	paramPtrs          *IMFARepositoryMockDeleteParamPtrs
+	expectationOrigins IMFARepositoryMockDeleteExpectationOrigins
+	results            *IMFARepositoryMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IMFARepositoryMockDeleteParams contains parameters of the IMFARepository.Delete
+type IMFARepositoryMockDeleteParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// IMFARepositoryMockDeleteParamPtrs contains pointers to parameters of the IMFARepository.Delete
+type IMFARepositoryMockDeleteParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// IMFARepositoryMockDeleteResults contains results of the IMFARepository.Delete
+type IMFARepositoryMockDeleteResults struct {
+	err error
+}
+
+// IMFARepositoryMockDeleteOrigins contains origins of expectations of the IMFARepository.Delete
+type IMFARepositoryMockDeleteExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mIMFARepositoryMockDelete) Optional() *mIMFARepositoryMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for IMFARepository.Delete
+func (mmDelete *mIMFARepositoryMockDelete) Expect(ctx context.Context, userID uuid.UUID) *mIMFARepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IMFARepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IMFARepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("IMFARepositoryMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &IMFARepositoryMockDeleteParams{ctx, userID}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IMFARepository.Delete
+func (mmDelete *mIMFARepositoryMockDelete) ExpectCtxParam1(ctx context.Context) *mIMFARepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IMFARepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IMFARepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IMFARepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IMFARepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IMFARepository.Delete
+func (mmDelete *mIMFARepositoryMockDelete) ExpectUserIDParam2(userID uuid.UUID) *mIMFARepositoryMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IMFARepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IMFARepositoryMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("IMFARepositoryMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &IMFARepositoryMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.userID = &userID
+	mmDelete.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the IMFARepository.Delete
+func (mmDelete *mIMFARepositoryMockDelete) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mIMFARepositoryMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for IMFARepositoryMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by IMFARepository.Delete
+func (mmDelete *mIMFARepositoryMockDelete) Return(err error) *IMFARepositoryMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IMFARepositoryMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &IMFARepositoryMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &IMFARepositoryMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the IMFARepository.Delete method
+func (mmDelete *mIMFARepositoryMockDelete) Set(f func(ctx context.Context, userID uuid.UUID) (err error)) *IMFARepositoryMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the IMFARepository.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the IMFARepository.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the IMFARepository.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mIMFARepositoryMockDelete) When(ctx context.Context, userID uuid.UUID) *IMFARepositoryMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("IMFARepositoryMock.Delete mock is already set by Set")
+	}
+
+	expectation := &IMFARepositoryMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &IMFARepositoryMockDeleteParams{ctx, userID},
+		expectationOrigins: IMFARepositoryMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IMFARepository.Delete return parameters for the expectation previously defined by the When method
+func (e *IMFARepositoryMockDeleteExpectation) Then(err error) *IMFARepositoryMock {
+	e.results = &IMFARepositoryMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times IMFARepository.Delete should be invoked
+func (mmDelete *mIMFARepositoryMockDelete) Times(n uint64) *mIMFARepositoryMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of IMFARepositoryMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mIMFARepositoryMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_repository.IMFARepository
+func (mmDelete *IMFARepositoryMock) Delete(ctx context.Context, userID uuid.UUID) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, userID)
+	}
+
+	mm_params := IMFARepositoryMockDeleteParams{ctx, userID}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := IMFARepositoryMockDeleteParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("IMFARepositoryMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDelete.t.Errorf("IMFARepositoryMock.Delete got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("IMFARepositoryMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the IMFARepositoryMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, userID)
+	}
+	mmDelete.t.Fatalf("Unexpected call to IMFARepositoryMock.Delete. %v %v", ctx, userID)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished IMFARepositoryMock.Delete invocations
+func (mmDelete *IMFARepositoryMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of IMFARepositoryMock.Delete invocations
+func (mmDelete *IMFARepositoryMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to IMFARepositoryMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mIMFARepositoryMockDelete) Calls() []*IMFARepositoryMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*IMFARepositoryMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *IMFARepositoryMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *IMFARepositoryMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IMFARepositoryMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IMFARepositoryMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IMFARepositoryMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to IMFARepositoryMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to IMFARepositoryMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mIMFARepositoryMockGet struct {
+	optional           bool
+	mock               *IMFARepositoryMock
+	defaultExpectation *IMFARepositoryMockGetExpectation
+	expectations       []*IMFARepositoryMockGetExpectation
+
+	callArgs []*IMFARepositoryMockGetParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IMFARepositoryMockGetExpectation specifies expectation struct of the IMFARepository.Get
+type IMFARepositoryMockGetExpectation struct {
+	mock               *IMFARepositoryMock
+	params             *IMFARepositoryMockGetParams
+	paramPtrs          *IMFARepositoryMockGetParamPtrs
+	expectationOrigins IMFARepositoryMockGetExpectationOrigins
+	results            *IMFARepositoryMockGetResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IMFARepositoryMockGetParams contains parameters of the IMFARepository.Get
+type IMFARepositoryMockGetParams struct {
+	ctx    context.Context
+	userID uuid.UUID
+}
+
+// IMFARepositoryMockGetParamPtrs contains pointers to parameters of the IMFARepository.Get
+type IMFARepositoryMockGetParamPtrs struct {
+	ctx    *context.Context
+	userID *uuid.UUID
+}
+
+// IMFARepositoryMockGetResults contains results of the IMFARepository.Get
+type IMFARepositoryMockGetResults struct {
+	m1  domain.MFA
+	err error
+}
+
+// IMFARepositoryMockGetOrigins contains origins of expectations of the IMFARepository.Get
+type IMFARepositoryMockGetExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGet *mIMFARepositoryMockGet) Optional() *mIMFARepositoryMockGet {
+	mmGet.optional = true
+	return mmGet
+}
+
+// Expect sets up expected params for IMFARepository.Get
+func (mmGet *mIMFARepositoryMockGet) Expect(ctx context.Context, userID uuid.UUID) *mIMFARepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IMFARepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IMFARepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.paramPtrs != nil {
+		mmGet.mock.t.Fatalf("IMFARepositoryMock.Get mock is already set by ExpectParams functions")
+	}
+
+	mmGet.defaultExpectation.params = &IMFARepositoryMockGetParams{ctx, userID}
+	mmGet.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGet.expectations {
+		if minimock.Equal(e.params, mmGet.defaultExpectation.params) {
+			mmGet.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGet.defaultExpectation.params)
+		}
+	}
+
+	return mmGet
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IMFARepository.Get
+func (mmGet *mIMFARepositoryMockGet) ExpectCtxParam1(ctx context.Context) *mIMFARepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IMFARepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IMFARepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IMFARepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IMFARepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGet.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IMFARepository.Get
+func (mmGet *mIMFARepositoryMockGet) ExpectUserIDParam2(userID uuid.UUID) *mIMFARepositoryMockGet {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IMFARepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IMFARepositoryMockGetExpectation{}
+	}
+
+	if mmGet.defaultExpectation.params != nil {
+		mmGet.mock.t.Fatalf("IMFARepositoryMock.Get mock is already set by Expect")
+	}
+
+	if mmGet.defaultExpectation.paramPtrs == nil {
+		mmGet.defaultExpectation.paramPtrs = &IMFARepositoryMockGetParamPtrs{}
+	}
+	mmGet.defaultExpectation.paramPtrs.userID = &userID
+	mmGet.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGet
+}
+
+// Inspect accepts an inspector function that has same arguments as the IMFARepository.Get
+func (mmGet *mIMFARepositoryMockGet) Inspect(f func(ctx context.Context, userID uuid.UUID)) *mIMFARepositoryMockGet {
+	if mmGet.mock.inspectFuncGet != nil {
+		mmGet.mock.t.Fatalf("Inspect function is already set for IMFARepositoryMock.Get")
+	}
+
+	mmGet.mock.inspectFuncGet = f
+
+	return mmGet
+}
+
+// Return sets up results that will be returned by IMFARepository.Get
+func (mmGet *mIMFARepositoryMockGet) Return(m1 domain.MFA, err error) *IMFARepositoryMock {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IMFARepositoryMock.Get mock is already set by Set")
+	}
+
+	if mmGet.defaultExpectation == nil {
+		mmGet.defaultExpectation = &IMFARepositoryMockGetExpectation{mock: mmGet.mock}
+	}
+	mmGet.defaultExpectation.results = &IMFARepositoryMockGetResults{m1, err}
+	mmGet.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// Set uses given function f to mock the IMFARepository.Get method
+func (mmGet *mIMFARepositoryMockGet) Set(f func(ctx context.Context, userID uuid.UUID) (m1 domain.MFA, err error)) *IMFARepositoryMock {
+	if mmGet.defaultExpectation != nil {
+		mmGet.mock.t.Fatalf("Default expectation is already set for the IMFARepository.Get method")
+	}
+
+	if len(mmGet.expectations) > 0 {
+		mmGet.mock.t.Fatalf("Some expectations are already set for the IMFARepository.Get method")
+	}
+
+	mmGet.mock.funcGet = f
+	mmGet.mock.funcGetOrigin = minimock.CallerInfo(1)
+	return mmGet.mock
+}
+
+// When sets expectation for the IMFARepository.Get which will trigger the result defined by the following
+// Then helper
+func (mmGet *mIMFARepositoryMockGet) When(ctx context.Context, userID uuid.UUID) *IMFARepositoryMockGetExpectation {
+	if mmGet.mock.funcGet != nil {
+		mmGet.mock.t.Fatalf("IMFARepositoryMock.Get mock is already set by Set")
+	}
+
+	expectation := &IMFARepositoryMockGetExpectation{
+		mock:               mmGet.mock,
+		params:             &IMFARepositoryMockGetParams{ctx, userID},
+		expectationOrigins: IMFARepositoryMockGetExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGet.expectations = append(mmGet.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IMFARepository.Get return parameters for the expectation previously defined by the When method
+func (e *IMFARepositoryMockGetExpectation) Then(m1 domain.MFA, err error) *IMFARepositoryMock {
+	e.results = &IMFARepositoryMockGetResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times IMFARepository.Get should be invoked
+func (mmGet *mIMFARepositoryMockGet) Times(n uint64) *mIMFARepositoryMockGet {
+	if n == 0 {
+		mmGet.mock.t.Fatalf("Times of IMFARepositoryMock.Get mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGet.expectedInvocations, n)
+	mmGet.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGet
+}
+
+func (mmGet *mIMFARepositoryMockGet) invocationsDone() bool {
+	if len(mmGet.expectations) == 0 && mmGet.defaultExpectation == nil && mmGet.mock.funcGet == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGet.mock.afterGetCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGet.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Get implements mm_repository.IMFARepository
+func (mmGet *IMFARepositoryMock) Get(ctx context.Context, userID uuid.UUID) (m1 domain.MFA, err error) {
+	mm_atomic.AddUint64(&mmGet.beforeGetCounter, 1)
+	defer mm_atomic.AddUint64(&mmGet.afterGetCounter, 1)
+
+	mmGet.t.Helper()
+
+	if mmGet.inspectFuncGet != nil {
+		mmGet.inspectFuncGet(ctx, userID)
+	}
+
+	mm_params := IMFARepositoryMockGetParams{ctx, userID}
+
+	// Record call args
+	mmGet.GetMock.mutex.Lock()
+	mmGet.GetMock.callArgs = append(mmGet.GetMock.callArgs, &mm_params)
+	mmGet.GetMock.mutex.Unlock()
+
+	for _, e := range mmGet.GetMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGet.GetMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGet.GetMock.defaultExpectation.Counter, 1)
+		mm_want := mmGet.GetMock.defaultExpectation.params
+		mm_want_ptrs := mmGet.GetMock.defaultExpectation.paramPtrs
+
+		mm_got := IMFARepositoryMockGetParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGet.t.Errorf("IMFARepositoryMock.Get got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGet.t.Errorf("IMFARepositoryMock.Get got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGet.GetMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGet.t.Errorf("IMFARepositoryMock.Get got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGet.GetMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGet.GetMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGet.t.Fatal("No results are set for the IMFARepositoryMock.Get")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGet.funcGet != nil {
+		return mmGet.funcGet(ctx, userID)
+	}
+	mmGet.t.Fatalf("Unexpected call to IMFARepositoryMock.Get. %v %v", ctx, userID)
+	return
+}
+
+// GetAfterCounter returns a count of finished IMFARepositoryMock.Get invocations
+func (mmGet *IMFARepositoryMock) GetAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.afterGetCounter)
+}
+
+// GetBeforeCounter returns a count of IMFARepositoryMock.Get invocations
+func (mmGet *IMFARepositoryMock) GetBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGet.beforeGetCounter)
+}
+
+// Calls returns a list of arguments used in each call to IMFARepositoryMock.Get.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGet *mIMFARepositoryMockGet) Calls() []*IMFARepositoryMockGetParams {
+	mmGet.mutex.RLock()
+
+	argCopy := make([]*IMFARepositoryMockGetParams, len(mmGet.callArgs))
+	copy(argCopy, mmGet.callArgs)
+
+	mmGet.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDone returns true if the count of the Get invocations corresponds
+// the number of defined expectations
+func (m *IMFARepositoryMock) MinimockGetDone() bool {
+	if m.GetMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMock.invocationsDone()
+}
+
+// MinimockGetInspect logs each unmet expectation
+func (m *IMFARepositoryMock) MinimockGetInspect() {
+	for _, e := range m.GetMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IMFARepositoryMock.Get at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCounter := mm_atomic.LoadUint64(&m.afterGetCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMock.defaultExpectation != nil && afterGetCounter < 1 {
+		if m.GetMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IMFARepositoryMock.Get at\n%s", m.GetMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IMFARepositoryMock.Get at\n%s with params: %#v", m.GetMock.defaultExpectation.expectationOrigins.origin, *m.GetMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGet != nil && afterGetCounter < 1 {
+		m.t.Errorf("Expected call to IMFARepositoryMock.Get at\n%s", m.funcGetOrigin)
+	}
+
+	if !m.GetMock.invocationsDone() && afterGetCounter > 0 {
+		m.t.Errorf("Expected %d calls to IMFARepositoryMock.Get at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMock.expectedInvocations), m.GetMock.expectedInvocationsOrigin, afterGetCounter)
+	}
+}
+
+type mIMFARepositoryMockSave struct {
+	optional           bool
+	mock               *IMFARepositoryMock
+	defaultExpectation *IMFARepositoryMockSaveExpectation
+	expectations       []*IMFARepositoryMockSaveExpectation
+
+	callArgs []*IMFARepositoryMockSaveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IMFARepositoryMockSaveExpectation specifies expectation struct of the IMFARepository.Save
+type IMFARepositoryMockSaveExpectation struct {
+	mock               *IMFARepositoryMock
+	params             *IMFARepositoryMockSaveParams
+	paramPtrs          *IMFARepositoryMockSaveParamPtrs
+	expectationOrigins IMFARepositoryMockSaveExpectationOrigins
+	results            *IMFARepositoryMockSaveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IMFARepositoryMockSaveParams contains parameters of the IMFARepository.Save
+type IMFARepositoryMockSaveParams struct {
+	ctx context.Context
+	mfa domain.MFA
+}
+
+// IMFARepositoryMockSaveParamPtrs contains pointers to parameters of the IMFARepository.Save
+type IMFARepositoryMockSaveParamPtrs struct {
+	ctx *context.Context
+	mfa *domain.MFA
+}
+
+// IMFARepositoryMockSaveResults contains results of the IMFARepository.Save
+type IMFARepositoryMockSaveResults struct {
+	err error
+}
+
+// IMFARepositoryMockSaveOrigins contains origins of expectations of the IMFARepository.Save
+type IMFARepositoryMockSaveExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originMfa string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSave *mIMFARepositoryMockSave) Optional() *mIMFARepositoryMockSave {
+	mmSave.optional = true
+	return mmSave
+}
+
+// Expect sets up expected params for IMFARepository.Save
+func (mmSave *mIMFARepositoryMockSave) Expect(ctx context.Context, mfa domain.MFA) *mIMFARepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("IMFARepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &IMFARepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.paramPtrs != nil {
+		mmSave.mock.t.Fatalf("IMFARepositoryMock.Save mock is already set by ExpectParams functions")
+	}
+
+	mmSave.defaultExpectation.params = &IMFARepositoryMockSaveParams{ctx, mfa}
+	mmSave.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSave.expectations {
+		if minimock.Equal(e.params, mmSave.defaultExpectation.params) {
+			mmSave.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSave.defaultExpectation.params)
+		}
+	}
+
+	return mmSave
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IMFARepository.Save
+func (mmSave *mIMFARepositoryMockSave) ExpectCtxParam1(ctx context.Context) *mIMFARepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("IMFARepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &IMFARepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("IMFARepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &IMFARepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSave.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// ExpectMfaParam2 sets up expected param mfa for IMFARepository.Save
+func (mmSave *mIMFARepositoryMockSave) ExpectMfaParam2(mfa domain.MFA) *mIMFARepositoryMockSave {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("IMFARepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &IMFARepositoryMockSaveExpectation{}
+	}
+
+	if mmSave.defaultExpectation.params != nil {
+		mmSave.mock.t.Fatalf("IMFARepositoryMock.Save mock is already set by Expect")
+	}
+
+	if mmSave.defaultExpectation.paramPtrs == nil {
+		mmSave.defaultExpectation.paramPtrs = &IMFARepositoryMockSaveParamPtrs{}
+	}
+	mmSave.defaultExpectation.paramPtrs.mfa = &mfa
+	mmSave.defaultExpectation.expectationOrigins.originMfa = minimock.CallerInfo(1)
+
+	return mmSave
+}
+
+// Inspect accepts an inspector function that has same arguments as the IMFARepository.Save
+func (mmSave *mIMFARepositoryMockSave) Inspect(f func(ctx context.Context, mfa domain.MFA)) *mIMFARepositoryMockSave {
+	if mmSave.mock.inspectFuncSave != nil {
+		mmSave.mock.t.Fatalf("Inspect function is already set for IMFARepositoryMock.Save")
+	}
+
+	mmSave.mock.inspectFuncSave = f
+
+	return mmSave
+}
+
+// Return sets up results that will be returned by IMFARepository.Save
+func (mmSave *mIMFARepositoryMockSave) Return(err error) *IMFARepositoryMock {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("IMFARepositoryMock.Save mock is already set by Set")
+	}
+
+	if mmSave.defaultExpectation == nil {
+		mmSave.defaultExpectation = &IMFARepositoryMockSaveExpectation{mock: mmSave.mock}
+	}
+	mmSave.defaultExpectation.results = &IMFARepositoryMockSaveResults{err}
+	mmSave.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// Set uses given function f to mock the IMFARepository.Save method
+func (mmSave *mIMFARepositoryMockSave) Set(f func(ctx context.Context, mfa domain.MFA) (err error)) *IMFARepositoryMock {
+	if mmSave.defaultExpectation != nil {
+		mmSave.mock.t.Fatalf("Default expectation is already set for the IMFARepository.Save method")
+	}
+
+	if len(mmSave.expectations) > 0 {
+		mmSave.mock.t.Fatalf("Some expectations are already set for the IMFARepository.Save method")
+	}
+
+	mmSave.mock.funcSave = f
+	mmSave.mock.funcSaveOrigin = minimock.CallerInfo(1)
+	return mmSave.mock
+}
+
+// When sets expectation for the IMFARepository.Save which will trigger the result defined by the following
+// Then helper
+func (mmSave *mIMFARepositoryMockSave) When(ctx context.Context, mfa domain.MFA) *IMFARepositoryMockSaveExpectation {
+	if mmSave.mock.funcSave != nil {
+		mmSave.mock.t.Fatalf("IMFARepositoryMock.Save mock is already set by Set")
+	}
+
+	expectation := &IMFARepositoryMockSaveExpectation{
+		mock:               mmSave.mock,
+		params:             &IMFARepositoryMockSaveParams{ctx, mfa},
+		expectationOrigins: IMFARepositoryMockSaveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSave.expectations = append(mmSave.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IMFARepository.Save return parameters for the expectation previously defined by the When method
+func (e *IMFARepositoryMockSaveExpectation) Then(err error) *IMFARepositoryMock {
+	e.results = &IMFARepositoryMockSaveResults{err}
+	return e.mock
+}
+
+// Times sets number of times IMFARepository.Save should be invoked
+func (mmSave *mIMFARepositoryMockSave) Times(n uint64) *mIMFARepositoryMockSave {
+	if n == 0 {
+		mmSave.mock.t.Fatalf("Times of IMFARepositoryMock.Save mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSave.expectedInvocations, n)
+	mmSave.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSave
+}
+
+func (mmSave *mIMFARepositoryMockSave) invocationsDone() bool {
+	if len(mmSave.expectations) == 0 && mmSave.defaultExpectation == nil && mmSave.mock.funcSave == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSave.mock.afterSaveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSave.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Save implements mm_repository.IMFARepository
+func (mmSave *IMFARepositoryMock) Save(ctx context.Context, mfa domain.MFA) (err error) {
+	mm_atomic.AddUint64(&mmSave.beforeSaveCounter, 1)
+	defer mm_atomic.AddUint64(&mmSave.afterSaveCounter, 1)
+
+	mmSave.t.Helper()
+
+	if mmSave.inspectFuncSave != nil {
+		mmSave.inspectFuncSave(ctx, mfa)
+	}
+
+	mm_params := IMFARepositoryMockSaveParams{ctx, mfa}
+
+	// Record call args
+	mmSave.SaveMock.mutex.Lock()
+	mmSave.SaveMock.callArgs = append(mmSave.SaveMock.callArgs, &mm_params)
+	mmSave.SaveMock.mutex.Unlock()
+
+	for _, e := range mmSave.SaveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSave.SaveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSave.SaveMock.defaultExpectation.Counter, 1)
+		mm_want := mmSave.SaveMock.defaultExpectation.params
+		mm_want_ptrs := mmSave.SaveMock.defaultExpectation.paramPtrs
+
+		mm_got := IMFARepositoryMockSaveParams{ctx, mfa}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSave.t.Errorf("IMFARepositoryMock.Save got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.mfa != nil && !minimock.Equal(*mm_want_ptrs.mfa, mm_got.mfa) {
+				mmSave.t.Errorf("IMFARepositoryMock.Save got unexpected parameter mfa, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSave.SaveMock.defaultExpectation.expectationOrigins.originMfa, *mm_want_ptrs.mfa, mm_got.mfa, minimock.Diff(*mm_want_ptrs.mfa, mm_got.mfa))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSave.t.Errorf("IMFARepositoryMock.Save got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSave.SaveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSave.SaveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSave.t.Fatal("No results are set for the IMFARepositoryMock.Save")
+		}
+		return (*mm_results).err
+	}
+	if mmSave.funcSave != nil {
+		return mmSave.funcSave(ctx, mfa)
+	}
+	mmSave.t.Fatalf("Unexpected call to IMFARepositoryMock.Save. %v %v", ctx, mfa)
+	return
+}
+
+// SaveAfterCounter returns a count of finished IMFARepositoryMock.Save invocations
+func (mmSave *IMFARepositoryMock) SaveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.afterSaveCounter)
+}
+
+// SaveBeforeCounter returns a count of IMFARepositoryMock.Save invocations
+func (mmSave *IMFARepositoryMock) SaveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSave.beforeSaveCounter)
+}
+
+// Calls returns a list of arguments used in each call to IMFARepositoryMock.Save.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSave *mIMFARepositoryMockSave) Calls() []*IMFARepositoryMockSaveParams {
+	mmSave.mutex.RLock()
+
+	argCopy := make([]*IMFARepositoryMockSaveParams, len(mmSave.callArgs))
+	copy(argCopy, mmSave.callArgs)
+
+	mmSave.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveDone returns true if the count of the Save invocations corresponds
+// the number of defined expectations
+func (m *IMFARepositoryMock) MinimockSaveDone() bool {
+	if m.SaveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveMock.invocationsDone()
+}
+
+// MinimockSaveInspect logs each unmet expectation
+func (m *IMFARepositoryMock) MinimockSaveInspect() {
+	for _, e := range m.SaveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IMFARepositoryMock.Save at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveCounter := mm_atomic.LoadUint64(&m.afterSaveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveMock.defaultExpectation != nil && afterSaveCounter < 1 {
+		if m.SaveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IMFARepositoryMock.Save at\n%s", m.SaveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IMFARepositoryMock.Save at\n%s with params: %#v", m.SaveMock.defaultExpectation.expectationOrigins.origin, *m.SaveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSave != nil && afterSaveCounter < 1 {
+		m.t.Errorf("Expected call to IMFARepositoryMock.Save at\n%s", m.funcSaveOrigin)
+	}
+
+	if !m.SaveMock.invocationsDone() && afterSaveCounter > 0 {
+		m.t.Errorf("Expected %d calls to IMFARepositoryMock.Save at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveMock.expectedInvocations), m.SaveMock.expectedInvocationsOrigin, afterSaveCounter)
+	}
+}
+
+type mIMFARepositoryMockSetRecoveryCodes struct {
+	optional           bool
+	mock               *IMFARepositoryMock
+	defaultExpectation *IMFARepositoryMockSetRecoveryCodesExpectation
+	expectations       []*IMFARepositoryMockSetRecoveryCodesExpectation
+
+	callArgs []*IMFARepositoryMockSetRecoveryCodesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IMFARepositoryMockSetRecoveryCodesExpectation specifies expectation struct of the IMFARepository.SetRecoveryCodes
+type IMFARepositoryMockSetRecoveryCodesExpectation struct {
+	mock               *IMFARepositoryMock
+	params             *IMFARepositoryMockSetRecoveryCodesParams
+	paramPtrs          *IMFARepositoryMockSetRecoveryCodesParamPtrs
+	expectationOrigins IMFARepositoryMockSetRecoveryCodesExpectationOrigins
+	results            *IMFARepositoryMockSetRecoveryCodesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IMFARepositoryMockSetRecoveryCodesParams contains parameters of the IMFARepository.SetRecoveryCodes
+type IMFARepositoryMockSetRecoveryCodesParams struct {
+	ctx        context.Context
+	userID     uuid.UUID
+	codeHashes []string
+}
+
+// IMFARepositoryMockSetRecoveryCodesParamPtrs contains pointers to parameters of the IMFARepository.SetRecoveryCodes
+type IMFARepositoryMockSetRecoveryCodesParamPtrs struct {
+	ctx        *context.Context
+	userID     *uuid.UUID
+	codeHashes *[]string
+}
+
+// IMFARepositoryMockSetRecoveryCodesResults contains results of the IMFARepository.SetRecoveryCodes
+type IMFARepositoryMockSetRecoveryCodesResults struct {
+	err error
+}
+
+// IMFARepositoryMockSetRecoveryCodesOrigins contains origins of expectations of the IMFARepository.SetRecoveryCodes
+type IMFARepositoryMockSetRecoveryCodesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originCodeHashes string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) Optional() *mIMFARepositoryMockSetRecoveryCodes {
+	mmSetRecoveryCodes.optional = true
+	return mmSetRecoveryCodes
+}
+
+// Expect sets up expected params for IMFARepository.SetRecoveryCodes
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) Expect(ctx context.Context, userID uuid.UUID, codeHashes []string) *mIMFARepositoryMockSetRecoveryCodes {
+	if mmSetRecoveryCodes.mock.funcSetRecoveryCodes != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by Set")
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation == nil {
+		mmSetRecoveryCodes.defaultExpectation = &IMFARepositoryMockSetRecoveryCodesExpectation{}
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation.paramPtrs != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by ExpectParams functions")
+	}
+
+	mmSetRecoveryCodes.defaultExpectation.params = &IMFARepositoryMockSetRecoveryCodesParams{ctx, userID, codeHashes}
+	mmSetRecoveryCodes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetRecoveryCodes.expectations {
+		if minimock.Equal(e.params, mmSetRecoveryCodes.defaultExpectation.params) {
+			mmSetRecoveryCodes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetRecoveryCodes.defaultExpectation.params)
+		}
+	}
+
+	return mmSetRecoveryCodes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IMFARepository.SetRecoveryCodes
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) ExpectCtxParam1(ctx context.Context) *mIMFARepositoryMockSetRecoveryCodes {
+	if mmSetRecoveryCodes.mock.funcSetRecoveryCodes != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by Set")
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation == nil {
+		mmSetRecoveryCodes.defaultExpectation = &IMFARepositoryMockSetRecoveryCodesExpectation{}
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation.params != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmSetRecoveryCodes.defaultExpectation.paramPtrs = &IMFARepositoryMockSetRecoveryCodesParamPtrs{}
+	}
+	mmSetRecoveryCodes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetRecoveryCodes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetRecoveryCodes
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IMFARepository.SetRecoveryCodes
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) ExpectUserIDParam2(userID uuid.UUID) *mIMFARepositoryMockSetRecoveryCodes {
+	if mmSetRecoveryCodes.mock.funcSetRecoveryCodes != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by Set")
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation == nil {
+		mmSetRecoveryCodes.defaultExpectation = &IMFARepositoryMockSetRecoveryCodesExpectation{}
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation.params != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmSetRecoveryCodes.defaultExpectation.paramPtrs = &IMFARepositoryMockSetRecoveryCodesParamPtrs{}
+	}
+	mmSetRecoveryCodes.defaultExpectation.paramPtrs.userID = &userID
+	mmSetRecoveryCodes.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSetRecoveryCodes
+}
+
+// ExpectCodeHashesParam3 sets up expected param codeHashes for IMFARepository.SetRecoveryCodes
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) ExpectCodeHashesParam3(codeHashes []string) *mIMFARepositoryMockSetRecoveryCodes {
+	if mmSetRecoveryCodes.mock.funcSetRecoveryCodes != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by Set")
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation == nil {
+		mmSetRecoveryCodes.defaultExpectation = &IMFARepositoryMockSetRecoveryCodesExpectation{}
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation.params != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by Expect")
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation.paramPtrs == nil {
+		mmSetRecoveryCodes.defaultExpectation.paramPtrs = &IMFARepositoryMockSetRecoveryCodesParamPtrs{}
+	}
+	mmSetRecoveryCodes.defaultExpectation.paramPtrs.codeHashes = &codeHashes
+	mmSetRecoveryCodes.defaultExpectation.expectationOrigins.originCodeHashes = minimock.CallerInfo(1)
+
+	return mmSetRecoveryCodes
+}
+
+// Inspect accepts an inspector function that has same arguments as the IMFARepository.SetRecoveryCodes
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) Inspect(f func(ctx context.Context, userID uuid.UUID, codeHashes []string)) *mIMFARepositoryMockSetRecoveryCodes {
+	if mmSetRecoveryCodes.mock.inspectFuncSetRecoveryCodes != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("Inspect function is already set for IMFARepositoryMock.SetRecoveryCodes")
+	}
+
+	mmSetRecoveryCodes.mock.inspectFuncSetRecoveryCodes = f
+
+	return mmSetRecoveryCodes
+}
+
+// Return sets up results that will be returned by IMFARepository.SetRecoveryCodes
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) Return(err error) *IMFARepositoryMock {
+	if mmSetRecoveryCodes.mock.funcSetRecoveryCodes != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by Set")
+	}
+
+	if mmSetRecoveryCodes.defaultExpectation == nil {
+		mmSetRecoveryCodes.defaultExpectation = &IMFARepositoryMockSetRecoveryCodesExpectation{mock: mmSetRecoveryCodes.mock}
+	}
+	mmSetRecoveryCodes.defaultExpectation.results = &IMFARepositoryMockSetRecoveryCodesResults{err}
+	mmSetRecoveryCodes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetRecoveryCodes.mock
+}
+
+// Set uses given function f to mock the IMFARepository.SetRecoveryCodes method
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) Set(f func(ctx context.Context, userID uuid.UUID, codeHashes []string) (err error)) *IMFARepositoryMock {
+	if mmSetRecoveryCodes.defaultExpectation != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("Default expectation is already set for the IMFARepository.SetRecoveryCodes method")
+	}
+
+	if len(mmSetRecoveryCodes.expectations) > 0 {
+		mmSetRecoveryCodes.mock.t.Fatalf("Some expectations are already set for the IMFARepository.SetRecoveryCodes method")
+	}
+
+	mmSetRecoveryCodes.mock.funcSetRecoveryCodes = f
+	mmSetRecoveryCodes.mock.funcSetRecoveryCodesOrigin = minimock.CallerInfo(1)
+	return mmSetRecoveryCodes.mock
+}
+
+// When sets expectation for the IMFARepository.SetRecoveryCodes which will trigger the result defined by the following
+// Then helper
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) When(ctx context.Context, userID uuid.UUID, codeHashes []string) *IMFARepositoryMockSetRecoveryCodesExpectation {
+	if mmSetRecoveryCodes.mock.funcSetRecoveryCodes != nil {
+		mmSetRecoveryCodes.mock.t.Fatalf("IMFARepositoryMock.SetRecoveryCodes mock is already set by Set")
+	}
+
+	expectation := &IMFARepositoryMockSetRecoveryCodesExpectation{
+		mock:               mmSetRecoveryCodes.mock,
+		params:             &IMFARepositoryMockSetRecoveryCodesParams{ctx, userID, codeHashes},
+		expectationOrigins: IMFARepositoryMockSetRecoveryCodesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetRecoveryCodes.expectations = append(mmSetRecoveryCodes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IMFARepository.SetRecoveryCodes return parameters for the expectation previously defined by the When method
+func (e *IMFARepositoryMockSetRecoveryCodesExpectation) Then(err error) *IMFARepositoryMock {
+	e.results = &IMFARepositoryMockSetRecoveryCodesResults{err}
+	return e.mock
+}
+
+// Times sets number of times IMFARepository.SetRecoveryCodes should be invoked
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) Times(n uint64) *mIMFARepositoryMockSetRecoveryCodes {
+	if n == 0 {
+		mmSetRecoveryCodes.mock.t.Fatalf("Times of IMFARepositoryMock.SetRecoveryCodes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetRecoveryCodes.expectedInvocations, n)
+	mmSetRecoveryCodes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetRecoveryCodes
+}
+
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) invocationsDone() bool {
+	if len(mmSetRecoveryCodes.expectations) == 0 && mmSetRecoveryCodes.defaultExpectation == nil && mmSetRecoveryCodes.mock.funcSetRecoveryCodes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetRecoveryCodes.mock.afterSetRecoveryCodesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetRecoveryCodes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetRecoveryCodes implements mm_repository.IMFARepository
+func (mmSetRecoveryCodes *IMFARepositoryMock) SetRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) (err error) {
+	mm_atomic.AddUint64(&mmSetRecoveryCodes.beforeSetRecoveryCodesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetRecoveryCodes.afterSetRecoveryCodesCounter, 1)
+
+	mmSetRecoveryCodes.t.Helper()
+
+	if mmSetRecoveryCodes.inspectFuncSetRecoveryCodes != nil {
+		mmSetRecoveryCodes.inspectFuncSetRecoveryCodes(ctx, userID, codeHashes)
+	}
+
+	mm_params := IMFARepositoryMockSetRecoveryCodesParams{ctx, userID, codeHashes}
+
+	// Record call args
+	mmSetRecoveryCodes.SetRecoveryCodesMock.mutex.Lock()
+	mmSetRecoveryCodes.SetRecoveryCodesMock.callArgs = append(mmSetRecoveryCodes.SetRecoveryCodesMock.callArgs, &mm_params)
+	mmSetRecoveryCodes.SetRecoveryCodesMock.mutex.Unlock()
+
+	for _, e := range mmSetRecoveryCodes.SetRecoveryCodesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetRecoveryCodes.SetRecoveryCodesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetRecoveryCodes.SetRecoveryCodesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetRecoveryCodes.SetRecoveryCodesMock.defaultExpectation.params
+		mm_want_ptrs := mmSetRecoveryCodes.SetRecoveryCodesMock.defaultExpectation.paramPtrs
+
+		mm_got := IMFARepositoryMockSetRecoveryCodesParams{ctx, userID, codeHashes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetRecoveryCodes.t.Errorf("IMFARepositoryMock.SetRecoveryCodes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetRecoveryCodes.SetRecoveryCodesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetRecoveryCodes.t.Errorf("IMFARepositoryMock.SetRecoveryCodes got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetRecoveryCodes.SetRecoveryCodesMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.codeHashes != nil && !minimock.Equal(*mm_want_ptrs.codeHashes, mm_got.codeHashes) {
+				mmSetRecoveryCodes.t.Errorf("IMFARepositoryMock.SetRecoveryCodes got unexpected parameter codeHashes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetRecoveryCodes.SetRecoveryCodesMock.defaultExpectation.expectationOrigins.originCodeHashes, *mm_want_ptrs.codeHashes, mm_got.codeHashes, minimock.Diff(*mm_want_ptrs.codeHashes, mm_got.codeHashes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetRecoveryCodes.t.Errorf("IMFARepositoryMock.SetRecoveryCodes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetRecoveryCodes.SetRecoveryCodesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetRecoveryCodes.SetRecoveryCodesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetRecoveryCodes.t.Fatal("No results are set for the IMFARepositoryMock.SetRecoveryCodes")
+		}
+		return (*mm_results).err
+	}
+	if mmSetRecoveryCodes.funcSetRecoveryCodes != nil {
+		return mmSetRecoveryCodes.funcSetRecoveryCodes(ctx, userID, codeHashes)
+	}
+	mmSetRecoveryCodes.t.Fatalf("Unexpected call to IMFARepositoryMock.SetRecoveryCodes. %v %v %v", ctx, userID, codeHashes)
+	return
+}
+
+// SetRecoveryCodesAfterCounter returns a count of finished IMFARepositoryMock.SetRecoveryCodes invocations
+func (mmSetRecoveryCodes *IMFARepositoryMock) SetRecoveryCodesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRecoveryCodes.afterSetRecoveryCodesCounter)
+}
+
+// SetRecoveryCodesBeforeCounter returns a count of IMFARepositoryMock.SetRecoveryCodes invocations
+func (mmSetRecoveryCodes *IMFARepositoryMock) SetRecoveryCodesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetRecoveryCodes.beforeSetRecoveryCodesCounter)
+}
+
+// Calls returns a list of arguments used in each call to IMFARepositoryMock.SetRecoveryCodes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetRecoveryCodes *mIMFARepositoryMockSetRecoveryCodes) Calls() []*IMFARepositoryMockSetRecoveryCodesParams {
+	mmSetRecoveryCodes.mutex.RLock()
+
+	argCopy := make([]*IMFARepositoryMockSetRecoveryCodesParams, len(mmSetRecoveryCodes.callArgs))
+	copy(argCopy, mmSetRecoveryCodes.callArgs)
+
+	mmSetRecoveryCodes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetRecoveryCodesDone returns true if the count of the SetRecoveryCodes invocations corresponds
+// the number of defined expectations
+func (m *IMFARepositoryMock) MinimockSetRecoveryCodesDone() bool {
+	if m.SetRecoveryCodesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetRecoveryCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetRecoveryCodesMock.invocationsDone()
+}
+
+// MinimockSetRecoveryCodesInspect logs each unmet expectation
+func (m *IMFARepositoryMock) MinimockSetRecoveryCodesInspect() {
+	for _, e := range m.SetRecoveryCodesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IMFARepositoryMock.SetRecoveryCodes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetRecoveryCodesCounter := mm_atomic.LoadUint64(&m.afterSetRecoveryCodesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetRecoveryCodesMock.defaultExpectation != nil && afterSetRecoveryCodesCounter < 1 {
+		if m.SetRecoveryCodesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IMFARepositoryMock.SetRecoveryCodes at\n%s", m.SetRecoveryCodesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IMFARepositoryMock.SetRecoveryCodes at\n%s with params: %#v", m.SetRecoveryCodesMock.defaultExpectation.expectationOrigins.origin, *m.SetRecoveryCodesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetRecoveryCodes != nil && afterSetRecoveryCodesCounter < 1 {
+		m.t.Errorf("Expected call to IMFARepositoryMock.SetRecoveryCodes at\n%s", m.funcSetRecoveryCodesOrigin)
+	}
+
+	if !m.SetRecoveryCodesMock.invocationsDone() && afterSetRecoveryCodesCounter > 0 {
+		m.t.Errorf("Expected %d calls to IMFARepositoryMock.SetRecoveryCodes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetRecoveryCodesMock.expectedInvocations), m.SetRecoveryCodesMock.expectedInvocationsOrigin, afterSetRecoveryCodesCounter)
+	}
+}
+
+type mIMFARepositoryMockUseRecoveryCode struct {
+	optional           bool
+	mock               *IMFARepositoryMock
+	defaultExpectation *IMFARepositoryMockUseRecoveryCodeExpectation
+	expectations       []*IMFARepositoryMockUseRecoveryCodeExpectation
+
+	callArgs []*IMFARepositoryMockUseRecoveryCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IMFARepositoryMockUseRecoveryCodeExpectation specifies expectation struct of the IMFARepository.UseRecoveryCode
+type IMFARepositoryMockUseRecoveryCodeExpectation struct {
+	mock               *IMFARepositoryMock
+	params             *IMFARepositoryMockUseRecoveryCodeParams
+	paramPtrs          *IMFARepositoryMockUseRecoveryCodeParamPtrs
+	expectationOrigins IMFARepositoryMockUseRecoveryCodeExpectationOrigins
+	results            *IMFARepositoryMockUseRecoveryCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IMFARepositoryMockUseRecoveryCodeParams contains parameters of the IMFARepository.UseRecoveryCode
+type IMFARepositoryMockUseRecoveryCodeParams struct {
+	ctx      context.Context
+	userID   uuid.UUID
+	codeHash string
+}
+
+// IMFARepositoryMockUseRecoveryCodeParamPtrs contains pointers to parameters of the IMFARepository.UseRecoveryCode
+type IMFARepositoryMockUseRecoveryCodeParamPtrs struct {
+	ctx      *context.Context
+	userID   *uuid.UUID
+	codeHash *string
+}
+
+// IMFARepositoryMockUseRecoveryCodeResults contains results of the IMFARepository.UseRecoveryCode
+type IMFARepositoryMockUseRecoveryCodeResults struct {
+	err error
+}
+
+// IMFARepositoryMockUseRecoveryCodeOrigins contains origins of expectations of the IMFARepository.UseRecoveryCode
+type IMFARepositoryMockUseRecoveryCodeExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originCodeHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) Optional() *mIMFARepositoryMockUseRecoveryCode {
+	mmUseRecoveryCode.optional = true
+	return mmUseRecoveryCode
+}
+
+// Expect sets up expected params for IMFARepository.UseRecoveryCode
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) Expect(ctx context.Context, userID uuid.UUID, codeHash string) *mIMFARepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &IMFARepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by ExpectParams functions")
+	}
+
+	mmUseRecoveryCode.defaultExpectation.params = &IMFARepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash}
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUseRecoveryCode.expectations {
+		if minimock.Equal(e.params, mmUseRecoveryCode.defaultExpectation.params) {
+			mmUseRecoveryCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUseRecoveryCode.defaultExpectation.params)
+		}
+	}
+
+	return mmUseRecoveryCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IMFARepository.UseRecoveryCode
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) ExpectCtxParam1(ctx context.Context) *mIMFARepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &IMFARepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.params != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by Expect")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmUseRecoveryCode.defaultExpectation.paramPtrs = &IMFARepositoryMockUseRecoveryCodeParamPtrs{}
+	}
+	mmUseRecoveryCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUseRecoveryCode
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IMFARepository.UseRecoveryCode
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) ExpectUserIDParam2(userID uuid.UUID) *mIMFARepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &IMFARepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.params != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by Expect")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmUseRecoveryCode.defaultExpectation.paramPtrs = &IMFARepositoryMockUseRecoveryCodeParamPtrs{}
+	}
+	mmUseRecoveryCode.defaultExpectation.paramPtrs.userID = &userID
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmUseRecoveryCode
+}
+
+// ExpectCodeHashParam3 sets up expected param codeHash for IMFARepository.UseRecoveryCode
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) ExpectCodeHashParam3(codeHash string) *mIMFARepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &IMFARepositoryMockUseRecoveryCodeExpectation{}
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.params != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by Expect")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation.paramPtrs == nil {
+		mmUseRecoveryCode.defaultExpectation.paramPtrs = &IMFARepositoryMockUseRecoveryCodeParamPtrs{}
+	}
+	mmUseRecoveryCode.defaultExpectation.paramPtrs.codeHash = &codeHash
+	mmUseRecoveryCode.defaultExpectation.expectationOrigins.originCodeHash = minimock.CallerInfo(1)
+
+	return mmUseRecoveryCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the IMFARepository.UseRecoveryCode
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) Inspect(f func(ctx context.Context, userID uuid.UUID, codeHash string)) *mIMFARepositoryMockUseRecoveryCode {
+	if mmUseRecoveryCode.mock.inspectFuncUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("Inspect function is already set for IMFARepositoryMock.UseRecoveryCode")
+	}
+
+	mmUseRecoveryCode.mock.inspectFuncUseRecoveryCode = f
+
+	return mmUseRecoveryCode
+}
+
+// Return sets up results that will be returned by IMFARepository.UseRecoveryCode
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) Return(err error) *IMFARepositoryMock {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	if mmUseRecoveryCode.defaultExpectation == nil {
+		mmUseRecoveryCode.defaultExpectation = &IMFARepositoryMockUseRecoveryCodeExpectation{mock: mmUseRecoveryCode.mock}
+	}
+	mmUseRecoveryCode.defaultExpectation.results = &IMFARepositoryMockUseRecoveryCodeResults{err}
+	mmUseRecoveryCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUseRecoveryCode.mock
+}
+
+// Set uses given function f to mock the IMFARepository.UseRecoveryCode method
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) Set(f func(ctx context.Context, userID uuid.UUID, codeHash string) (err error)) *IMFARepositoryMock {
+	if mmUseRecoveryCode.defaultExpectation != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("Default expectation is already set for the IMFARepository.UseRecoveryCode method")
+	}
+
+	if len(mmUseRecoveryCode.expectations) > 0 {
+		mmUseRecoveryCode.mock.t.Fatalf("Some expectations are already set for the IMFARepository.UseRecoveryCode method")
+	}
+
+	mmUseRecoveryCode.mock.funcUseRecoveryCode = f
+	mmUseRecoveryCode.mock.funcUseRecoveryCodeOrigin = minimock.CallerInfo(1)
+	return mmUseRecoveryCode.mock
+}
+
+// When sets expectation for the IMFARepository.UseRecoveryCode which will trigger the result defined by the following
+// Then helper
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) When(ctx context.Context, userID uuid.UUID, codeHash string) *IMFARepositoryMockUseRecoveryCodeExpectation {
+	if mmUseRecoveryCode.mock.funcUseRecoveryCode != nil {
+		mmUseRecoveryCode.mock.t.Fatalf("IMFARepositoryMock.UseRecoveryCode mock is already set by Set")
+	}
+
+	expectation := &IMFARepositoryMockUseRecoveryCodeExpectation{
+		mock:               mmUseRecoveryCode.mock,
+		params:             &IMFARepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash},
+		expectationOrigins: IMFARepositoryMockUseRecoveryCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUseRecoveryCode.expectations = append(mmUseRecoveryCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IMFARepository.UseRecoveryCode return parameters for the expectation previously defined by the When method
+func (e *IMFARepositoryMockUseRecoveryCodeExpectation) Then(err error) *IMFARepositoryMock {
+	e.results = &IMFARepositoryMockUseRecoveryCodeResults{err}
+	return e.mock
+}
+
+// Times sets number of times IMFARepository.UseRecoveryCode should be invoked
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) Times(n uint64) *mIMFARepositoryMockUseRecoveryCode {
+	if n == 0 {
+		mmUseRecoveryCode.mock.t.Fatalf("Times of IMFARepositoryMock.UseRecoveryCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUseRecoveryCode.expectedInvocations, n)
+	mmUseRecoveryCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUseRecoveryCode
+}
+
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) invocationsDone() bool {
+	if len(mmUseRecoveryCode.expectations) == 0 && mmUseRecoveryCode.defaultExpectation == nil && mmUseRecoveryCode.mock.funcUseRecoveryCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUseRecoveryCode.mock.afterUseRecoveryCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUseRecoveryCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UseRecoveryCode implements mm_repository.IMFARepository
+func (mmUseRecoveryCode *IMFARepositoryMock) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (err error) {
+	mm_atomic.AddUint64(&mmUseRecoveryCode.beforeUseRecoveryCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmUseRecoveryCode.afterUseRecoveryCodeCounter, 1)
+
+	mmUseRecoveryCode.t.Helper()
+
+	if mmUseRecoveryCode.inspectFuncUseRecoveryCode != nil {
+		mmUseRecoveryCode.inspectFuncUseRecoveryCode(ctx, userID, codeHash)
+	}
+
+	mm_params := IMFARepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash}
+
+	// Record call args
+	mmUseRecoveryCode.UseRecoveryCodeMock.mutex.Lock()
+	mmUseRecoveryCode.UseRecoveryCodeMock.callArgs = append(mmUseRecoveryCode.UseRecoveryCodeMock.callArgs, &mm_params)
+	mmUseRecoveryCode.UseRecoveryCodeMock.mutex.Unlock()
+
+	for _, e := range mmUseRecoveryCode.UseRecoveryCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := IMFARepositoryMockUseRecoveryCodeParams{ctx, userID, codeHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUseRecoveryCode.t.Errorf("IMFARepositoryMock.UseRecoveryCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmUseRecoveryCode.t.Errorf("IMFARepositoryMock.UseRecoveryCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.codeHash != nil && !minimock.Equal(*mm_want_ptrs.codeHash, mm_got.codeHash) {
+				mmUseRecoveryCode.t.Errorf("IMFARepositoryMock.UseRecoveryCode got unexpected parameter codeHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.originCodeHash, *mm_want_ptrs.codeHash, mm_got.codeHash, minimock.Diff(*mm_want_ptrs.codeHash, mm_got.codeHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUseRecoveryCode.t.Errorf("IMFARepositoryMock.UseRecoveryCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUseRecoveryCode.UseRecoveryCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUseRecoveryCode.t.Fatal("No results are set for the IMFARepositoryMock.UseRecoveryCode")
+		}
+		return (*mm_results).err
+	}
+	if mmUseRecoveryCode.funcUseRecoveryCode != nil {
+		return mmUseRecoveryCode.funcUseRecoveryCode(ctx, userID, codeHash)
+	}
+	mmUseRecoveryCode.t.Fatalf("Unexpected call to IMFARepositoryMock.UseRecoveryCode. %v %v %v", ctx, userID, codeHash)
+	return
+}
+
+// UseRecoveryCodeAfterCounter returns a count of finished IMFARepositoryMock.UseRecoveryCode invocations
+func (mmUseRecoveryCode *IMFARepositoryMock) UseRecoveryCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseRecoveryCode.afterUseRecoveryCodeCounter)
+}
+
+// UseRecoveryCodeBeforeCounter returns a count of IMFARepositoryMock.UseRecoveryCode invocations
+func (mmUseRecoveryCode *IMFARepositoryMock) UseRecoveryCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUseRecoveryCode.beforeUseRecoveryCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to IMFARepositoryMock.UseRecoveryCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUseRecoveryCode *mIMFARepositoryMockUseRecoveryCode) Calls() []*IMFARepositoryMockUseRecoveryCodeParams {
+	mmUseRecoveryCode.mutex.RLock()
+
+	argCopy := make([]*IMFARepositoryMockUseRecoveryCodeParams, len(mmUseRecoveryCode.callArgs))
+	copy(argCopy, mmUseRecoveryCode.callArgs)
+
+	mmUseRecoveryCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUseRecoveryCodeDone returns true if the count of the UseRecoveryCode invocations corresponds
+// the number of defined expectations
+func (m *IMFARepositoryMock) MinimockUseRecoveryCodeDone() bool {
+	if m.UseRecoveryCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UseRecoveryCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UseRecoveryCodeMock.invocationsDone()
+}
+
+// MinimockUseRecoveryCodeInspect logs each unmet expectation
+func (m *IMFARepositoryMock) MinimockUseRecoveryCodeInspect() {
+	for _, e := range m.UseRecoveryCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IMFARepositoryMock.UseRecoveryCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUseRecoveryCodeCounter := mm_atomic.LoadUint64(&m.afterUseRecoveryCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UseRecoveryCodeMock.defaultExpectation != nil && afterUseRecoveryCodeCounter < 1 {
+		if m.UseRecoveryCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IMFARepositoryMock.UseRecoveryCode at\n%s", m.UseRecoveryCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IMFARepositoryMock.UseRecoveryCode at\n%s with params: %#v", m.UseRecoveryCodeMock.defaultExpectation.expectationOrigins.origin, *m.UseRecoveryCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUseRecoveryCode != nil && afterUseRecoveryCodeCounter < 1 {
+		m.t.Errorf("Expected call to IMFARepositoryMock.UseRecoveryCode at\n%s", m.funcUseRecoveryCodeOrigin)
+	}
+
+	if !m.UseRecoveryCodeMock.invocationsDone() && afterUseRecoveryCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to IMFARepositoryMock.UseRecoveryCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UseRecoveryCodeMock.expectedInvocations), m.UseRecoveryCodeMock.expectedInvocationsOrigin, afterUseRecoveryCodeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IMFARepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteInspect()
+
+			m.MinimockGetInspect()
+
+			m.MinimockSaveInspect()
+
+			m.MinimockSetRecoveryCodesInspect()
+
+			m.MinimockUseRecoveryCodeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IMFARepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IMFARepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteDone() &&
+		m.MinimockGetDone() &&
+		m.MinimockSaveDone() &&
+		m.MinimockSetRecoveryCodesDone() &&
+		m.MinimockUseRecoveryCodeDone()
+}
@@ -11,6 +11,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/maisiq/go-auth-service/internal/domain"
 )
 
 // SecretRepositoryMock implements mm_repository.SecretRepository
@@ -18,6 +19,20 @@ type SecretRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcDecrypt          func(ctx context.Context, ciphertext string, keyName string) (s1 string, err error)
+	funcDecryptOrigin    string
+	inspectFuncDecrypt   func(ctx context.Context, ciphertext string, keyName string)
+	afterDecryptCounter  uint64
+	beforeDecryptCounter uint64
+	DecryptMock          mSecretRepositoryMockDecrypt
+
+	funcEncrypt          func(ctx context.Context, plaintext string, keyName string) (s1 string, err error)
+	funcEncryptOrigin    string
+	inspectFuncEncrypt   func(ctx context.Context, plaintext string, keyName string)
+	afterEncryptCounter  uint64
+	beforeEncryptCounter uint64
+	EncryptMock          mSecretRepositoryMockEncrypt
+
 	funcGetKID          func(ctx context.Context, keyName string) (s1 string, err error)
 	funcGetKIDOrigin    string
 	inspectFuncGetKID   func(ctx context.Context, keyName string)
@@ -32,12 +47,40 @@ type SecretRepositoryMock struct {
 	beforeGetPublicKeysCounter uint64
 	GetPublicKeysMock          mSecretRepositoryMockGetPublicKeys
 
-	funcSignJWT          func(ctx context.Context, data string, keyName string) (s1 string, err error)
+	funcKeyVersions          func(ctx context.Context, keyName string) (ka1 []domain.KeyVersion, err error)
+	funcKeyVersionsOrigin    string
+	inspectFuncKeyVersions   func(ctx context.Context, keyName string)
+	afterKeyVersionsCounter  uint64
+	beforeKeyVersionsCounter uint64
+	KeyVersionsMock          mSecretRepositoryMockKeyVersions
+
+	funcRetireKeyVersions          func(ctx context.Context, keyName string, minVersion int) (err error)
+	funcRetireKeyVersionsOrigin    string
+	inspectFuncRetireKeyVersions   func(ctx context.Context, keyName string, minVersion int)
+	afterRetireKeyVersionsCounter  uint64
+	beforeRetireKeyVersionsCounter uint64
+	RetireKeyVersionsMock          mSecretRepositoryMockRetireKeyVersions
+
+	funcRotateKey          func(ctx context.Context, keyName string) (s1 string, err error)
+	funcRotateKeyOrigin    string
+	inspectFuncRotateKey   func(ctx context.Context, keyName string)
+	afterRotateKeyCounter  uint64
+	beforeRotateKeyCounter uint64
+	RotateKeyMock          mSecretRepositoryMockRotateKey
+
+	funcSignJWT          func(ctx context.Context, data string, keyName string, version string) (s1 string, err error)
 	funcSignJWTOrigin    string
-	inspectFuncSignJWT   func(ctx context.Context, data string, keyName string)
+	inspectFuncSignJWT   func(ctx context.Context, data string, keyName string, version string)
 	afterSignJWTCounter  uint64
 	beforeSignJWTCounter uint64
 	SignJWTMock          mSecretRepositoryMockSignJWT
+
+	funcSigningAlgorithm          func(ctx context.Context, keyName string) (s1 string, err error)
+	funcSigningAlgorithmOrigin    string
+	inspectFuncSigningAlgorithm   func(ctx context.Context, keyName string)
+	afterSigningAlgorithmCounter  uint64
+	beforeSigningAlgorithmCounter uint64
+	SigningAlgorithmMock          mSecretRepositoryMockSigningAlgorithm
 }
 
 // NewSecretRepositoryMock returns a mock for mm_repository.SecretRepository
@@ -48,67 +91,88 @@ func NewSecretRepositoryMock(t minimock.Tester) *SecretRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.DecryptMock = mSecretRepositoryMockDecrypt{mock: m}
+	m.DecryptMock.callArgs = []*SecretRepositoryMockDecryptParams{}
+
+	m.EncryptMock = mSecretRepositoryMockEncrypt{mock: m}
+	m.EncryptMock.callArgs = []*SecretRepositoryMockEncryptParams{}
+
 	m.GetKIDMock = mSecretRepositoryMockGetKID{mock: m}
 	m.GetKIDMock.callArgs = []*SecretRepositoryMockGetKIDParams{}
 
 	m.GetPublicKeysMock = mSecretRepositoryMockGetPublicKeys{mock: m}
 	m.GetPublicKeysMock.callArgs = []*SecretRepositoryMockGetPublicKeysParams{}
 
+	m.KeyVersionsMock = mSecretRepositoryMockKeyVersions{mock: m}
+	m.KeyVersionsMock.callArgs = []*SecretRepositoryMockKeyVersionsParams{}
+
+	m.RetireKeyVersionsMock = mSecretRepositoryMockRetireKeyVersions{mock: m}
+	m.RetireKeyVersionsMock.callArgs = []*SecretRepositoryMockRetireKeyVersionsParams{}
+
+	m.RotateKeyMock = mSecretRepositoryMockRotateKey{mock: m}
+	m.RotateKeyMock.callArgs = []*SecretRepositoryMockRotateKeyParams{}
+
 	m.SignJWTMock = mSecretRepositoryMockSignJWT{mock: m}
 	m.SignJWTMock.callArgs = []*SecretRepositoryMockSignJWTParams{}
 
+	m.SigningAlgorithmMock = mSecretRepositoryMockSigningAlgorithm{mock: m}
+	m.SigningAlgorithmMock.callArgs = []*SecretRepositoryMockSigningAlgorithmParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mSecretRepositoryMockGetKID struct {
+type mSecretRepositoryMockDecrypt struct {
 	optional           bool
 	mock               *SecretRepositoryMock
-	defaultExpectation *SecretRepositoryMockGetKIDExpectation
-	expectations       []*SecretRepositoryMockGetKIDExpectation
+	defaultExpectation *SecretRepositoryMockDecryptExpectation
+	expectations       []*SecretRepositoryMockDecryptExpectation
 
-	callArgs []*SecretRepositoryMockGetKIDParams
+	callArgs []*SecretRepositoryMockDecryptParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// SecretRepositoryMockGetKIDExpectation specifies expectation struct of the SecretRepository.GetKID
-type SecretRepositoryMockGetKIDExpectation struct {
+// SecretRepositoryMockDecryptExpectation specifies expectation struct of the SecretRepository.Decrypt
+type SecretRepositoryMockDecryptExpectation struct {
 	mock               *SecretRepositoryMock
-	params             *SecretRepositoryMockGetKIDParams
-	paramPtrs          *SecretRepositoryMockGetKIDParamPtrs
-	expectationOrigins SecretRepositoryMockGetKIDExpectationOrigins
-	results            *SecretRepositoryMockGetKIDResults
+	params             *SecretRepositoryMockDecryptParams
+	paramPtrs          *SecretRepositoryMockDecryptParamPtrs
+	expectationOrigins SecretRepositoryMockDecryptExpectationOrigins
+	results            *SecretRepositoryMockDecryptResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// SecretRepositoryMockGetKIDParams contains parameters of the SecretRepository.GetKID
-type SecretRepositoryMockGetKIDParams struct {
-	ctx     context.Context
-	keyName string
+// SecretRepositoryMockDecryptParams contains parameters of the SecretRepository.Decrypt
+type SecretRepositoryMockDecryptParams struct {
+	ctx        context.Context
+	ciphertext string
+	keyName    string
 }
 
-// SecretRepositoryMockGetKIDParamPtrs contains pointers to parameters of the SecretRepository.GetKID
-type SecretRepositoryMockGetKIDParamPtrs struct {
-	ctx     *context.Context
-	keyName *string
+// SecretRepositoryMockDecryptParamPtrs contains pointers to parameters of the SecretRepository.Decrypt
+type SecretRepositoryMockDecryptParamPtrs struct {
+	ctx        *context.Context
+	ciphertext *string
+	keyName    *string
 }
 
-// SecretRepositoryMockGetKIDResults contains results of the SecretRepository.GetKID
-type SecretRepositoryMockGetKIDResults struct {
+// SecretRepositoryMockDecryptResults contains results of the SecretRepository.Decrypt
+type SecretRepositoryMockDecryptResults struct {
 	s1  string
 	err error
 }
 
-// SecretRepositoryMockGetKIDOrigins contains origins of expectations of the SecretRepository.GetKID
-type SecretRepositoryMockGetKIDExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originKeyName string
+// SecretRepositoryMockDecryptOrigins contains origins of expectations of the SecretRepository.Decrypt
+type SecretRepositoryMockDecryptExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCiphertext string
+	originKeyName    string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -116,342 +180,373 @@ type SecretRepositoryMockGetKIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetKID *mSecretRepositoryMockGetKID) Optional() *mSecretRepositoryMockGetKID {
-	mmGetKID.optional = true
-	return mmGetKID
+func (mmDecrypt *mSecretRepositoryMockDecrypt) Optional() *mSecretRepositoryMockDecrypt {
+	mmDecrypt.optional = true
+	return mmDecrypt
 }
 
-// Expect sets up expected params for SecretRepository.GetKID
-func (mmGetKID *mSecretRepositoryMockGetKID) Expect(ctx context.Context, keyName string) *mSecretRepositoryMockGetKID {
-	if mmGetKID.mock.funcGetKID != nil {
-		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+// Expect sets up expected params for SecretRepository.Decrypt
+func (mmDecrypt *mSecretRepositoryMockDecrypt) Expect(ctx context.Context, ciphertext string, keyName string) *mSecretRepositoryMockDecrypt {
+	if mmDecrypt.mock.funcDecrypt != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by Set")
 	}
 
-	if mmGetKID.defaultExpectation == nil {
-		mmGetKID.defaultExpectation = &SecretRepositoryMockGetKIDExpectation{}
+	if mmDecrypt.defaultExpectation == nil {
+		mmDecrypt.defaultExpectation = &SecretRepositoryMockDecryptExpectation{}
 	}
 
-	if mmGetKID.defaultExpectation.paramPtrs != nil {
-		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by ExpectParams functions")
+	if mmDecrypt.defaultExpectation.paramPtrs != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by ExpectParams functions")
 	}
 
-	mmGetKID.defaultExpectation.params = &SecretRepositoryMockGetKIDParams{ctx, keyName}
-	mmGetKID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetKID.expectations {
-		if minimock.Equal(e.params, mmGetKID.defaultExpectation.params) {
-			mmGetKID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetKID.defaultExpectation.params)
+	mmDecrypt.defaultExpectation.params = &SecretRepositoryMockDecryptParams{ctx, ciphertext, keyName}
+	mmDecrypt.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDecrypt.expectations {
+		if minimock.Equal(e.params, mmDecrypt.defaultExpectation.params) {
+			mmDecrypt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDecrypt.defaultExpectation.params)
 		}
 	}
 
-	return mmGetKID
+	return mmDecrypt
 }
 
-// ExpectCtxParam1 sets up expected param ctx for SecretRepository.GetKID
-func (mmGetKID *mSecretRepositoryMockGetKID) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockGetKID {
-	if mmGetKID.mock.funcGetKID != nil {
-		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for SecretRepository.Decrypt
+func (mmDecrypt *mSecretRepositoryMockDecrypt) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockDecrypt {
+	if mmDecrypt.mock.funcDecrypt != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by Set")
 	}
 
-	if mmGetKID.defaultExpectation == nil {
-		mmGetKID.defaultExpectation = &SecretRepositoryMockGetKIDExpectation{}
+	if mmDecrypt.defaultExpectation == nil {
+		mmDecrypt.defaultExpectation = &SecretRepositoryMockDecryptExpectation{}
 	}
 
-	if mmGetKID.defaultExpectation.params != nil {
-		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Expect")
+	if mmDecrypt.defaultExpectation.params != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by Expect")
 	}
 
-	if mmGetKID.defaultExpectation.paramPtrs == nil {
-		mmGetKID.defaultExpectation.paramPtrs = &SecretRepositoryMockGetKIDParamPtrs{}
+	if mmDecrypt.defaultExpectation.paramPtrs == nil {
+		mmDecrypt.defaultExpectation.paramPtrs = &SecretRepositoryMockDecryptParamPtrs{}
 	}
-	mmGetKID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetKID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDecrypt.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDecrypt.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetKID
+	return mmDecrypt
 }
 
-// ExpectKeyNameParam2 sets up expected param keyName for SecretRepository.GetKID
-func (mmGetKID *mSecretRepositoryMockGetKID) ExpectKeyNameParam2(keyName string) *mSecretRepositoryMockGetKID {
-	if mmGetKID.mock.funcGetKID != nil {
-		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+// ExpectCiphertextParam2 sets up expected param ciphertext for SecretRepository.Decrypt
+func (mmDecrypt *mSecretRepositoryMockDecrypt) ExpectCiphertextParam2(ciphertext string) *mSecretRepositoryMockDecrypt {
+	if mmDecrypt.mock.funcDecrypt != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by Set")
 	}
 
-	if mmGetKID.defaultExpectation == nil {
-		mmGetKID.defaultExpectation = &SecretRepositoryMockGetKIDExpectation{}
+	if mmDecrypt.defaultExpectation == nil {
+		mmDecrypt.defaultExpectation = &SecretRepositoryMockDecryptExpectation{}
 	}
 
-	if mmGetKID.defaultExpectation.params != nil {
-		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Expect")
+	if mmDecrypt.defaultExpectation.params != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by Expect")
 	}
 
-	if mmGetKID.defaultExpectation.paramPtrs == nil {
-		mmGetKID.defaultExpectation.paramPtrs = &SecretRepositoryMockGetKIDParamPtrs{}
+	if mmDecrypt.defaultExpectation.paramPtrs == nil {
+		mmDecrypt.defaultExpectation.paramPtrs = &SecretRepositoryMockDecryptParamPtrs{}
 	}
-	mmGetKID.defaultExpectation.paramPtrs.keyName = &keyName
-	mmGetKID.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
+	mmDecrypt.defaultExpectation.paramPtrs.ciphertext = &ciphertext
+	mmDecrypt.defaultExpectation.expectationOrigins.originCiphertext = minimock.CallerInfo(1)
 
-	return mmGetKID
+	return mmDecrypt
 }
 
-// Inspect accepts an inspector function that has same arguments as the SecretRepository.GetKID
-func (mmGetKID *mSecretRepositoryMockGetKID) Inspect(f func(ctx context.Context, keyName string)) *mSecretRepositoryMockGetKID {
-	if mmGetKID.mock.inspectFuncGetKID != nil {
-		mmGetKID.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.GetKID")
+// ExpectKeyNameParam3 sets up expected param keyName for SecretRepository.Decrypt
+func (mmDecrypt *mSecretRepositoryMockDecrypt) ExpectKeyNameParam3(keyName string) *mSecretRepositoryMockDecrypt {
+	if mmDecrypt.mock.funcDecrypt != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by Set")
 	}
 
-	mmGetKID.mock.inspectFuncGetKID = f
+	if mmDecrypt.defaultExpectation == nil {
+		mmDecrypt.defaultExpectation = &SecretRepositoryMockDecryptExpectation{}
+	}
 
-	return mmGetKID
+	if mmDecrypt.defaultExpectation.params != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by Expect")
+	}
+
+	if mmDecrypt.defaultExpectation.paramPtrs == nil {
+		mmDecrypt.defaultExpectation.paramPtrs = &SecretRepositoryMockDecryptParamPtrs{}
+	}
+	mmDecrypt.defaultExpectation.paramPtrs.keyName = &keyName
+	mmDecrypt.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
+
+	return mmDecrypt
 }
 
-// Return sets up results that will be returned by SecretRepository.GetKID
-func (mmGetKID *mSecretRepositoryMockGetKID) Return(s1 string, err error) *SecretRepositoryMock {
-	if mmGetKID.mock.funcGetKID != nil {
-		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the SecretRepository.Decrypt
+func (mmDecrypt *mSecretRepositoryMockDecrypt) Inspect(f func(ctx context.Context, ciphertext string, keyName string)) *mSecretRepositoryMockDecrypt {
+	if mmDecrypt.mock.inspectFuncDecrypt != nil {
+		mmDecrypt.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.Decrypt")
 	}
 
-	if mmGetKID.defaultExpectation == nil {
-		mmGetKID.defaultExpectation = &SecretRepositoryMockGetKIDExpectation{mock: mmGetKID.mock}
+	mmDecrypt.mock.inspectFuncDecrypt = f
+
+	return mmDecrypt
+}
+
+// Return sets up results that will be returned by SecretRepository.Decrypt
+func (mmDecrypt *mSecretRepositoryMockDecrypt) Return(s1 string, err error) *SecretRepositoryMock {
+	if mmDecrypt.mock.funcDecrypt != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by Set")
 	}
-	mmGetKID.defaultExpectation.results = &SecretRepositoryMockGetKIDResults{s1, err}
-	mmGetKID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetKID.mock
+
+	if mmDecrypt.defaultExpectation == nil {
+		mmDecrypt.defaultExpectation = &SecretRepositoryMockDecryptExpectation{mock: mmDecrypt.mock}
+	}
+	mmDecrypt.defaultExpectation.results = &SecretRepositoryMockDecryptResults{s1, err}
+	mmDecrypt.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDecrypt.mock
 }
 
-// Set uses given function f to mock the SecretRepository.GetKID method
-func (mmGetKID *mSecretRepositoryMockGetKID) Set(f func(ctx context.Context, keyName string) (s1 string, err error)) *SecretRepositoryMock {
-	if mmGetKID.defaultExpectation != nil {
-		mmGetKID.mock.t.Fatalf("Default expectation is already set for the SecretRepository.GetKID method")
+// Set uses given function f to mock the SecretRepository.Decrypt method
+func (mmDecrypt *mSecretRepositoryMockDecrypt) Set(f func(ctx context.Context, ciphertext string, keyName string) (s1 string, err error)) *SecretRepositoryMock {
+	if mmDecrypt.defaultExpectation != nil {
+		mmDecrypt.mock.t.Fatalf("Default expectation is already set for the SecretRepository.Decrypt method")
 	}
 
-	if len(mmGetKID.expectations) > 0 {
-		mmGetKID.mock.t.Fatalf("Some expectations are already set for the SecretRepository.GetKID method")
+	if len(mmDecrypt.expectations) > 0 {
+		mmDecrypt.mock.t.Fatalf("Some expectations are already set for the SecretRepository.Decrypt method")
 	}
 
-	mmGetKID.mock.funcGetKID = f
-	mmGetKID.mock.funcGetKIDOrigin = minimock.CallerInfo(1)
-	return mmGetKID.mock
+	mmDecrypt.mock.funcDecrypt = f
+	mmDecrypt.mock.funcDecryptOrigin = minimock.CallerInfo(1)
+	return mmDecrypt.mock
 }
 
-// When sets expectation for the SecretRepository.GetKID which will trigger the result defined by the following
+// When sets expectation for the SecretRepository.Decrypt which will trigger the result defined by the following
 // Then helper
-func (mmGetKID *mSecretRepositoryMockGetKID) When(ctx context.Context, keyName string) *SecretRepositoryMockGetKIDExpectation {
-	if mmGetKID.mock.funcGetKID != nil {
-		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+func (mmDecrypt *mSecretRepositoryMockDecrypt) When(ctx context.Context, ciphertext string, keyName string) *SecretRepositoryMockDecryptExpectation {
+	if mmDecrypt.mock.funcDecrypt != nil {
+		mmDecrypt.mock.t.Fatalf("SecretRepositoryMock.Decrypt mock is already set by Set")
 	}
 
-	expectation := &SecretRepositoryMockGetKIDExpectation{
-		mock:               mmGetKID.mock,
-		params:             &SecretRepositoryMockGetKIDParams{ctx, keyName},
-		expectationOrigins: SecretRepositoryMockGetKIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &SecretRepositoryMockDecryptExpectation{
+		mock:               mmDecrypt.mock,
+		params:             &SecretRepositoryMockDecryptParams{ctx, ciphertext, keyName},
+		expectationOrigins: SecretRepositoryMockDecryptExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetKID.expectations = append(mmGetKID.expectations, expectation)
+	mmDecrypt.expectations = append(mmDecrypt.expectations, expectation)
 	return expectation
 }
 
-// Then sets up SecretRepository.GetKID return parameters for the expectation previously defined by the When method
-func (e *SecretRepositoryMockGetKIDExpectation) Then(s1 string, err error) *SecretRepositoryMock {
-	e.results = &SecretRepositoryMockGetKIDResults{s1, err}
+// Then sets up SecretRepository.Decrypt return parameters for the expectation previously defined by the When method
+func (e *SecretRepositoryMockDecryptExpectation) Then(s1 string, err error) *SecretRepositoryMock {
+	e.results = &SecretRepositoryMockDecryptResults{s1, err}
 	return e.mock
 }
 
-// Times sets number of times SecretRepository.GetKID should be invoked
-func (mmGetKID *mSecretRepositoryMockGetKID) Times(n uint64) *mSecretRepositoryMockGetKID {
+// Times sets number of times SecretRepository.Decrypt should be invoked
+func (mmDecrypt *mSecretRepositoryMockDecrypt) Times(n uint64) *mSecretRepositoryMockDecrypt {
 	if n == 0 {
-		mmGetKID.mock.t.Fatalf("Times of SecretRepositoryMock.GetKID mock can not be zero")
+		mmDecrypt.mock.t.Fatalf("Times of SecretRepositoryMock.Decrypt mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetKID.expectedInvocations, n)
-	mmGetKID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetKID
+	mm_atomic.StoreUint64(&mmDecrypt.expectedInvocations, n)
+	mmDecrypt.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDecrypt
 }
 
-func (mmGetKID *mSecretRepositoryMockGetKID) invocationsDone() bool {
-	if len(mmGetKID.expectations) == 0 && mmGetKID.defaultExpectation == nil && mmGetKID.mock.funcGetKID == nil {
+func (mmDecrypt *mSecretRepositoryMockDecrypt) invocationsDone() bool {
+	if len(mmDecrypt.expectations) == 0 && mmDecrypt.defaultExpectation == nil && mmDecrypt.mock.funcDecrypt == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetKID.mock.afterGetKIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetKID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmDecrypt.mock.afterDecryptCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDecrypt.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetKID implements mm_repository.SecretRepository
-func (mmGetKID *SecretRepositoryMock) GetKID(ctx context.Context, keyName string) (s1 string, err error) {
-	mm_atomic.AddUint64(&mmGetKID.beforeGetKIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetKID.afterGetKIDCounter, 1)
+// Decrypt implements mm_repository.SecretRepository
+func (mmDecrypt *SecretRepositoryMock) Decrypt(ctx context.Context, ciphertext string, keyName string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmDecrypt.beforeDecryptCounter, 1)
+	defer mm_atomic.AddUint64(&mmDecrypt.afterDecryptCounter, 1)
 
-	mmGetKID.t.Helper()
+	mmDecrypt.t.Helper()
 
-	if mmGetKID.inspectFuncGetKID != nil {
-		mmGetKID.inspectFuncGetKID(ctx, keyName)
+	if mmDecrypt.inspectFuncDecrypt != nil {
+		mmDecrypt.inspectFuncDecrypt(ctx, ciphertext, keyName)
 	}
 
-	mm_params := SecretRepositoryMockGetKIDParams{ctx, keyName}
+	mm_params := SecretRepositoryMockDecryptParams{ctx, ciphertext, keyName}
 
 	// Record call args
-	mmGetKID.GetKIDMock.mutex.Lock()
-	mmGetKID.GetKIDMock.callArgs = append(mmGetKID.GetKIDMock.callArgs, &mm_params)
-	mmGetKID.GetKIDMock.mutex.Unlock()
+	mmDecrypt.DecryptMock.mutex.Lock()
+	mmDecrypt.DecryptMock.callArgs = append(mmDecrypt.DecryptMock.callArgs, &mm_params)
+	mmDecrypt.DecryptMock.mutex.Unlock()
 
-	for _, e := range mmGetKID.GetKIDMock.expectations {
+	for _, e := range mmDecrypt.DecryptMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmGetKID.GetKIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetKID.GetKIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetKID.GetKIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetKID.GetKIDMock.defaultExpectation.paramPtrs
+	if mmDecrypt.DecryptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDecrypt.DecryptMock.defaultExpectation.Counter, 1)
+		mm_want := mmDecrypt.DecryptMock.defaultExpectation.params
+		mm_want_ptrs := mmDecrypt.DecryptMock.defaultExpectation.paramPtrs
 
-		mm_got := SecretRepositoryMockGetKIDParams{ctx, keyName}
+		mm_got := SecretRepositoryMockDecryptParams{ctx, ciphertext, keyName}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetKID.t.Errorf("SecretRepositoryMock.GetKID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetKID.GetKIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmDecrypt.t.Errorf("SecretRepositoryMock.Decrypt got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDecrypt.DecryptMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ciphertext != nil && !minimock.Equal(*mm_want_ptrs.ciphertext, mm_got.ciphertext) {
+				mmDecrypt.t.Errorf("SecretRepositoryMock.Decrypt got unexpected parameter ciphertext, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDecrypt.DecryptMock.defaultExpectation.expectationOrigins.originCiphertext, *mm_want_ptrs.ciphertext, mm_got.ciphertext, minimock.Diff(*mm_want_ptrs.ciphertext, mm_got.ciphertext))
 			}
 
 			if mm_want_ptrs.keyName != nil && !minimock.Equal(*mm_want_ptrs.keyName, mm_got.keyName) {
-				mmGetKID.t.Errorf("SecretRepositoryMock.GetKID got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetKID.GetKIDMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
+				mmDecrypt.t.Errorf("SecretRepositoryMock.Decrypt got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDecrypt.DecryptMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetKID.t.Errorf("SecretRepositoryMock.GetKID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetKID.GetKIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmDecrypt.t.Errorf("SecretRepositoryMock.Decrypt got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDecrypt.DecryptMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetKID.GetKIDMock.defaultExpectation.results
+		mm_results := mmDecrypt.DecryptMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetKID.t.Fatal("No results are set for the SecretRepositoryMock.GetKID")
+			mmDecrypt.t.Fatal("No results are set for the SecretRepositoryMock.Decrypt")
 		}
 		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmGetKID.funcGetKID != nil {
-		return mmGetKID.funcGetKID(ctx, keyName)
+	if mmDecrypt.funcDecrypt != nil {
+		return mmDecrypt.funcDecrypt(ctx, ciphertext, keyName)
 	}
-	mmGetKID.t.Fatalf("Unexpected call to SecretRepositoryMock.GetKID. %v %v", ctx, keyName)
+	mmDecrypt.t.Fatalf("Unexpected call to SecretRepositoryMock.Decrypt. %v %v %v", ctx, ciphertext, keyName)
 	return
 }
 
-// GetKIDAfterCounter returns a count of finished SecretRepositoryMock.GetKID invocations
-func (mmGetKID *SecretRepositoryMock) GetKIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetKID.afterGetKIDCounter)
+// DecryptAfterCounter returns a count of finished SecretRepositoryMock.Decrypt invocations
+func (mmDecrypt *SecretRepositoryMock) DecryptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDecrypt.afterDecryptCounter)
 }
 
-// GetKIDBeforeCounter returns a count of SecretRepositoryMock.GetKID invocations
-func (mmGetKID *SecretRepositoryMock) GetKIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetKID.beforeGetKIDCounter)
+// DecryptBeforeCounter returns a count of SecretRepositoryMock.Decrypt invocations
+func (mmDecrypt *SecretRepositoryMock) DecryptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDecrypt.beforeDecryptCounter)
 }
 
-// Calls returns a list of arguments used in each call to SecretRepositoryMock.GetKID.
+// Calls returns a list of arguments used in each call to SecretRepositoryMock.Decrypt.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetKID *mSecretRepositoryMockGetKID) Calls() []*SecretRepositoryMockGetKIDParams {
-	mmGetKID.mutex.RLock()
+func (mmDecrypt *mSecretRepositoryMockDecrypt) Calls() []*SecretRepositoryMockDecryptParams {
+	mmDecrypt.mutex.RLock()
 
-	argCopy := make([]*SecretRepositoryMockGetKIDParams, len(mmGetKID.callArgs))
-	copy(argCopy, mmGetKID.callArgs)
+	argCopy := make([]*SecretRepositoryMockDecryptParams, len(mmDecrypt.callArgs))
+	copy(argCopy, mmDecrypt.callArgs)
 
-	mmGetKID.mutex.RUnlock()
+	mmDecrypt.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetKIDDone returns true if the count of the GetKID invocations corresponds
+// MinimockDecryptDone returns true if the count of the Decrypt invocations corresponds
 // the number of defined expectations
-func (m *SecretRepositoryMock) MinimockGetKIDDone() bool {
-	if m.GetKIDMock.optional {
+func (m *SecretRepositoryMock) MinimockDecryptDone() bool {
+	if m.DecryptMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetKIDMock.expectations {
+	for _, e := range m.DecryptMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetKIDMock.invocationsDone()
+	return m.DecryptMock.invocationsDone()
 }
 
-// MinimockGetKIDInspect logs each unmet expectation
-func (m *SecretRepositoryMock) MinimockGetKIDInspect() {
-	for _, e := range m.GetKIDMock.expectations {
+// MinimockDecryptInspect logs each unmet expectation
+func (m *SecretRepositoryMock) MinimockDecryptInspect() {
+	for _, e := range m.DecryptMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to SecretRepositoryMock.GetKID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to SecretRepositoryMock.Decrypt at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetKIDCounter := mm_atomic.LoadUint64(&m.afterGetKIDCounter)
+	afterDecryptCounter := mm_atomic.LoadUint64(&m.afterDecryptCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetKIDMock.defaultExpectation != nil && afterGetKIDCounter < 1 {
-		if m.GetKIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to SecretRepositoryMock.GetKID at\n%s", m.GetKIDMock.defaultExpectation.returnOrigin)
+	if m.DecryptMock.defaultExpectation != nil && afterDecryptCounter < 1 {
+		if m.DecryptMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SecretRepositoryMock.Decrypt at\n%s", m.DecryptMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to SecretRepositoryMock.GetKID at\n%s with params: %#v", m.GetKIDMock.defaultExpectation.expectationOrigins.origin, *m.GetKIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to SecretRepositoryMock.Decrypt at\n%s with params: %#v", m.DecryptMock.defaultExpectation.expectationOrigins.origin, *m.DecryptMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetKID != nil && afterGetKIDCounter < 1 {
-		m.t.Errorf("Expected call to SecretRepositoryMock.GetKID at\n%s", m.funcGetKIDOrigin)
+	if m.funcDecrypt != nil && afterDecryptCounter < 1 {
+		m.t.Errorf("Expected call to SecretRepositoryMock.Decrypt at\n%s", m.funcDecryptOrigin)
 	}
 
-	if !m.GetKIDMock.invocationsDone() && afterGetKIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to SecretRepositoryMock.GetKID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetKIDMock.expectedInvocations), m.GetKIDMock.expectedInvocationsOrigin, afterGetKIDCounter)
+	if !m.DecryptMock.invocationsDone() && afterDecryptCounter > 0 {
+		m.t.Errorf("Expected %d calls to SecretRepositoryMock.Decrypt at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DecryptMock.expectedInvocations), m.DecryptMock.expectedInvocationsOrigin, afterDecryptCounter)
 	}
 }
 
-type mSecretRepositoryMockGetPublicKeys struct {
+type mSecretRepositoryMockEncrypt struct {
 	optional           bool
 	mock               *SecretRepositoryMock
-	defaultExpectation *SecretRepositoryMockGetPublicKeysExpectation
-	expectations       []*SecretRepositoryMockGetPublicKeysExpectation
+	defaultExpectation *SecretRepositoryMockEncryptExpectation
+	expectations       []*SecretRepositoryMockEncryptExpectation
 
-	callArgs []*SecretRepositoryMockGetPublicKeysParams
+	callArgs []*SecretRepositoryMockEncryptParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// SecretRepositoryMockGetPublicKeysExpectation specifies expectation struct of the SecretRepository.GetPublicKeys
-type SecretRepositoryMockGetPublicKeysExpectation struct {
+// SecretRepositoryMockEncryptExpectation specifies expectation struct of the SecretRepository.Encrypt
+type SecretRepositoryMockEncryptExpectation struct {
 	mock               *SecretRepositoryMock
-	params             *SecretRepositoryMockGetPublicKeysParams
-	paramPtrs          *SecretRepositoryMockGetPublicKeysParamPtrs
-	expectationOrigins SecretRepositoryMockGetPublicKeysExpectationOrigins
-	results            *SecretRepositoryMockGetPublicKeysResults
+	params             *SecretRepositoryMockEncryptParams
+	paramPtrs          *SecretRepositoryMockEncryptParamPtrs
+	expectationOrigins SecretRepositoryMockEncryptExpectationOrigins
+	results            *SecretRepositoryMockEncryptResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// SecretRepositoryMockGetPublicKeysParams contains parameters of the SecretRepository.GetPublicKeys
-type SecretRepositoryMockGetPublicKeysParams struct {
-	ctx     context.Context
-	keyName string
+// SecretRepositoryMockEncryptParams contains parameters of the SecretRepository.Encrypt
+type SecretRepositoryMockEncryptParams struct {
+	ctx       context.Context
+	plaintext string
+	keyName   string
 }
 
-// SecretRepositoryMockGetPublicKeysParamPtrs contains pointers to parameters of the SecretRepository.GetPublicKeys
-type SecretRepositoryMockGetPublicKeysParamPtrs struct {
-	ctx     *context.Context
-	keyName *string
+// SecretRepositoryMockEncryptParamPtrs contains pointers to parameters of the SecretRepository.Encrypt
+type SecretRepositoryMockEncryptParamPtrs struct {
+	ctx       *context.Context
+	plaintext *string
+	keyName   *string
 }
 
-// SecretRepositoryMockGetPublicKeysResults contains results of the SecretRepository.GetPublicKeys
-type SecretRepositoryMockGetPublicKeysResults struct {
-	m1  map[string]string
+// SecretRepositoryMockEncryptResults contains results of the SecretRepository.Encrypt
+type SecretRepositoryMockEncryptResults struct {
+	s1  string
 	err error
 }
 
-// SecretRepositoryMockGetPublicKeysOrigins contains origins of expectations of the SecretRepository.GetPublicKeys
-type SecretRepositoryMockGetPublicKeysExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originKeyName string
+// SecretRepositoryMockEncryptOrigins contains origins of expectations of the SecretRepository.Encrypt
+type SecretRepositoryMockEncryptExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originPlaintext string
+	originKeyName   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -459,292 +554,2065 @@ type SecretRepositoryMockGetPublicKeysExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Optional() *mSecretRepositoryMockGetPublicKeys {
-	mmGetPublicKeys.optional = true
-	return mmGetPublicKeys
+func (mmEncrypt *mSecretRepositoryMockEncrypt) Optional() *mSecretRepositoryMockEncrypt {
+	mmEncrypt.optional = true
+	return mmEncrypt
 }
 
-// Expect sets up expected params for SecretRepository.GetPublicKeys
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Expect(ctx context.Context, keyName string) *mSecretRepositoryMockGetPublicKeys {
-	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
-		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+// Expect sets up expected params for SecretRepository.Encrypt
+func (mmEncrypt *mSecretRepositoryMockEncrypt) Expect(ctx context.Context, plaintext string, keyName string) *mSecretRepositoryMockEncrypt {
+	if mmEncrypt.mock.funcEncrypt != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by Set")
 	}
 
-	if mmGetPublicKeys.defaultExpectation == nil {
-		mmGetPublicKeys.defaultExpectation = &SecretRepositoryMockGetPublicKeysExpectation{}
+	if mmEncrypt.defaultExpectation == nil {
+		mmEncrypt.defaultExpectation = &SecretRepositoryMockEncryptExpectation{}
 	}
 
-	if mmGetPublicKeys.defaultExpectation.paramPtrs != nil {
-		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by ExpectParams functions")
+	if mmEncrypt.defaultExpectation.paramPtrs != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by ExpectParams functions")
 	}
 
-	mmGetPublicKeys.defaultExpectation.params = &SecretRepositoryMockGetPublicKeysParams{ctx, keyName}
-	mmGetPublicKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetPublicKeys.expectations {
-		if minimock.Equal(e.params, mmGetPublicKeys.defaultExpectation.params) {
-			mmGetPublicKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPublicKeys.defaultExpectation.params)
+	mmEncrypt.defaultExpectation.params = &SecretRepositoryMockEncryptParams{ctx, plaintext, keyName}
+	mmEncrypt.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEncrypt.expectations {
+		if minimock.Equal(e.params, mmEncrypt.defaultExpectation.params) {
+			mmEncrypt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEncrypt.defaultExpectation.params)
 		}
 	}
 
-	return mmGetPublicKeys
+	return mmEncrypt
 }
 
-// ExpectCtxParam1 sets up expected param ctx for SecretRepository.GetPublicKeys
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockGetPublicKeys {
-	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
-		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for SecretRepository.Encrypt
+func (mmEncrypt *mSecretRepositoryMockEncrypt) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockEncrypt {
+	if mmEncrypt.mock.funcEncrypt != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by Set")
 	}
 
-	if mmGetPublicKeys.defaultExpectation == nil {
-		mmGetPublicKeys.defaultExpectation = &SecretRepositoryMockGetPublicKeysExpectation{}
+	if mmEncrypt.defaultExpectation == nil {
+		mmEncrypt.defaultExpectation = &SecretRepositoryMockEncryptExpectation{}
 	}
 
-	if mmGetPublicKeys.defaultExpectation.params != nil {
-		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Expect")
+	if mmEncrypt.defaultExpectation.params != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by Expect")
 	}
 
-	if mmGetPublicKeys.defaultExpectation.paramPtrs == nil {
-		mmGetPublicKeys.defaultExpectation.paramPtrs = &SecretRepositoryMockGetPublicKeysParamPtrs{}
+	if mmEncrypt.defaultExpectation.paramPtrs == nil {
+		mmEncrypt.defaultExpectation.paramPtrs = &SecretRepositoryMockEncryptParamPtrs{}
 	}
-	mmGetPublicKeys.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetPublicKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmEncrypt.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEncrypt.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetPublicKeys
+	return mmEncrypt
 }
 
-// ExpectKeyNameParam2 sets up expected param keyName for SecretRepository.GetPublicKeys
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) ExpectKeyNameParam2(keyName string) *mSecretRepositoryMockGetPublicKeys {
-	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
-		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+// ExpectPlaintextParam2 sets up expected param plaintext for SecretRepository.Encrypt
+func (mmEncrypt *mSecretRepositoryMockEncrypt) ExpectPlaintextParam2(plaintext string) *mSecretRepositoryMockEncrypt {
+	if mmEncrypt.mock.funcEncrypt != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by Set")
 	}
 
-	if mmGetPublicKeys.defaultExpectation == nil {
+	if mmEncrypt.defaultExpectation == nil {
+		mmEncrypt.defaultExpectation = &SecretRepositoryMockEncryptExpectation{}
+	}
+
+	if mmEncrypt.defaultExpectation.params != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by Expect")
+	}
+
+	if mmEncrypt.defaultExpectation.paramPtrs == nil {
+		mmEncrypt.defaultExpectation.paramPtrs = &SecretRepositoryMockEncryptParamPtrs{}
+	}
+	mmEncrypt.defaultExpectation.paramPtrs.plaintext = &plaintext
+	mmEncrypt.defaultExpectation.expectationOrigins.originPlaintext = minimock.CallerInfo(1)
+
+	return mmEncrypt
+}
+
+// ExpectKeyNameParam3 sets up expected param keyName for SecretRepository.Encrypt
+func (mmEncrypt *mSecretRepositoryMockEncrypt) ExpectKeyNameParam3(keyName string) *mSecretRepositoryMockEncrypt {
+	if mmEncrypt.mock.funcEncrypt != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by Set")
+	}
+
+	if mmEncrypt.defaultExpectation == nil {
+		mmEncrypt.defaultExpectation = &SecretRepositoryMockEncryptExpectation{}
+	}
+
+	if mmEncrypt.defaultExpectation.params != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by Expect")
+	}
+
+	if mmEncrypt.defaultExpectation.paramPtrs == nil {
+		mmEncrypt.defaultExpectation.paramPtrs = &SecretRepositoryMockEncryptParamPtrs{}
+	}
+	mmEncrypt.defaultExpectation.paramPtrs.keyName = &keyName
+	mmEncrypt.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
+
+	return mmEncrypt
+}
+
+// Inspect accepts an inspector function that has same arguments as the SecretRepository.Encrypt
+func (mmEncrypt *mSecretRepositoryMockEncrypt) Inspect(f func(ctx context.Context, plaintext string, keyName string)) *mSecretRepositoryMockEncrypt {
+	if mmEncrypt.mock.inspectFuncEncrypt != nil {
+		mmEncrypt.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.Encrypt")
+	}
+
+	mmEncrypt.mock.inspectFuncEncrypt = f
+
+	return mmEncrypt
+}
+
+// Return sets up results that will be returned by SecretRepository.Encrypt
+func (mmEncrypt *mSecretRepositoryMockEncrypt) Return(s1 string, err error) *SecretRepositoryMock {
+	if mmEncrypt.mock.funcEncrypt != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by Set")
+	}
+
+	if mmEncrypt.defaultExpectation == nil {
+		mmEncrypt.defaultExpectation = &SecretRepositoryMockEncryptExpectation{mock: mmEncrypt.mock}
+	}
+	mmEncrypt.defaultExpectation.results = &SecretRepositoryMockEncryptResults{s1, err}
+	mmEncrypt.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEncrypt.mock
+}
+
+// Set uses given function f to mock the SecretRepository.Encrypt method
+func (mmEncrypt *mSecretRepositoryMockEncrypt) Set(f func(ctx context.Context, plaintext string, keyName string) (s1 string, err error)) *SecretRepositoryMock {
+	if mmEncrypt.defaultExpectation != nil {
+		mmEncrypt.mock.t.Fatalf("Default expectation is already set for the SecretRepository.Encrypt method")
+	}
+
+	if len(mmEncrypt.expectations) > 0 {
+		mmEncrypt.mock.t.Fatalf("Some expectations are already set for the SecretRepository.Encrypt method")
+	}
+
+	mmEncrypt.mock.funcEncrypt = f
+	mmEncrypt.mock.funcEncryptOrigin = minimock.CallerInfo(1)
+	return mmEncrypt.mock
+}
+
+// When sets expectation for the SecretRepository.Encrypt which will trigger the result defined by the following
+// Then helper
+func (mmEncrypt *mSecretRepositoryMockEncrypt) When(ctx context.Context, plaintext string, keyName string) *SecretRepositoryMockEncryptExpectation {
+	if mmEncrypt.mock.funcEncrypt != nil {
+		mmEncrypt.mock.t.Fatalf("SecretRepositoryMock.Encrypt mock is already set by Set")
+	}
+
+	expectation := &SecretRepositoryMockEncryptExpectation{
+		mock:               mmEncrypt.mock,
+		params:             &SecretRepositoryMockEncryptParams{ctx, plaintext, keyName},
+		expectationOrigins: SecretRepositoryMockEncryptExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEncrypt.expectations = append(mmEncrypt.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SecretRepository.Encrypt return parameters for the expectation previously defined by the When method
+func (e *SecretRepositoryMockEncryptExpectation) Then(s1 string, err error) *SecretRepositoryMock {
+	e.results = &SecretRepositoryMockEncryptResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times SecretRepository.Encrypt should be invoked
+func (mmEncrypt *mSecretRepositoryMockEncrypt) Times(n uint64) *mSecretRepositoryMockEncrypt {
+	if n == 0 {
+		mmEncrypt.mock.t.Fatalf("Times of SecretRepositoryMock.Encrypt mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEncrypt.expectedInvocations, n)
+	mmEncrypt.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEncrypt
+}
+
+func (mmEncrypt *mSecretRepositoryMockEncrypt) invocationsDone() bool {
+	if len(mmEncrypt.expectations) == 0 && mmEncrypt.defaultExpectation == nil && mmEncrypt.mock.funcEncrypt == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEncrypt.mock.afterEncryptCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEncrypt.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Encrypt implements mm_repository.SecretRepository
+func (mmEncrypt *SecretRepositoryMock) Encrypt(ctx context.Context, plaintext string, keyName string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmEncrypt.beforeEncryptCounter, 1)
+	defer mm_atomic.AddUint64(&mmEncrypt.afterEncryptCounter, 1)
+
+	mmEncrypt.t.Helper()
+
+	if mmEncrypt.inspectFuncEncrypt != nil {
+		mmEncrypt.inspectFuncEncrypt(ctx, plaintext, keyName)
+	}
+
+	mm_params := SecretRepositoryMockEncryptParams{ctx, plaintext, keyName}
+
+	// Record call args
+	mmEncrypt.EncryptMock.mutex.Lock()
+	mmEncrypt.EncryptMock.callArgs = append(mmEncrypt.EncryptMock.callArgs, &mm_params)
+	mmEncrypt.EncryptMock.mutex.Unlock()
+
+	for _, e := range mmEncrypt.EncryptMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmEncrypt.EncryptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEncrypt.EncryptMock.defaultExpectation.Counter, 1)
+		mm_want := mmEncrypt.EncryptMock.defaultExpectation.params
+		mm_want_ptrs := mmEncrypt.EncryptMock.defaultExpectation.paramPtrs
+
+		mm_got := SecretRepositoryMockEncryptParams{ctx, plaintext, keyName}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEncrypt.t.Errorf("SecretRepositoryMock.Encrypt got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEncrypt.EncryptMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.plaintext != nil && !minimock.Equal(*mm_want_ptrs.plaintext, mm_got.plaintext) {
+				mmEncrypt.t.Errorf("SecretRepositoryMock.Encrypt got unexpected parameter plaintext, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEncrypt.EncryptMock.defaultExpectation.expectationOrigins.originPlaintext, *mm_want_ptrs.plaintext, mm_got.plaintext, minimock.Diff(*mm_want_ptrs.plaintext, mm_got.plaintext))
+			}
+
+			if mm_want_ptrs.keyName != nil && !minimock.Equal(*mm_want_ptrs.keyName, mm_got.keyName) {
+				mmEncrypt.t.Errorf("SecretRepositoryMock.Encrypt got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEncrypt.EncryptMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEncrypt.t.Errorf("SecretRepositoryMock.Encrypt got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEncrypt.EncryptMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEncrypt.EncryptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEncrypt.t.Fatal("No results are set for the SecretRepositoryMock.Encrypt")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmEncrypt.funcEncrypt != nil {
+		return mmEncrypt.funcEncrypt(ctx, plaintext, keyName)
+	}
+	mmEncrypt.t.Fatalf("Unexpected call to SecretRepositoryMock.Encrypt. %v %v %v", ctx, plaintext, keyName)
+	return
+}
+
+// EncryptAfterCounter returns a count of finished SecretRepositoryMock.Encrypt invocations
+func (mmEncrypt *SecretRepositoryMock) EncryptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEncrypt.afterEncryptCounter)
+}
+
+// EncryptBeforeCounter returns a count of SecretRepositoryMock.Encrypt invocations
+func (mmEncrypt *SecretRepositoryMock) EncryptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEncrypt.beforeEncryptCounter)
+}
+
+// Calls returns a list of arguments used in each call to SecretRepositoryMock.Encrypt.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEncrypt *mSecretRepositoryMockEncrypt) Calls() []*SecretRepositoryMockEncryptParams {
+	mmEncrypt.mutex.RLock()
+
+	argCopy := make([]*SecretRepositoryMockEncryptParams, len(mmEncrypt.callArgs))
+	copy(argCopy, mmEncrypt.callArgs)
+
+	mmEncrypt.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEncryptDone returns true if the count of the Encrypt invocations corresponds
+// the number of defined expectations
+func (m *SecretRepositoryMock) MinimockEncryptDone() bool {
+	if m.EncryptMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EncryptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EncryptMock.invocationsDone()
+}
+
+// MinimockEncryptInspect logs each unmet expectation
+func (m *SecretRepositoryMock) MinimockEncryptInspect() {
+	for _, e := range m.EncryptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SecretRepositoryMock.Encrypt at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEncryptCounter := mm_atomic.LoadUint64(&m.afterEncryptCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EncryptMock.defaultExpectation != nil && afterEncryptCounter < 1 {
+		if m.EncryptMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SecretRepositoryMock.Encrypt at\n%s", m.EncryptMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SecretRepositoryMock.Encrypt at\n%s with params: %#v", m.EncryptMock.defaultExpectation.expectationOrigins.origin, *m.EncryptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEncrypt != nil && afterEncryptCounter < 1 {
+		m.t.Errorf("Expected call to SecretRepositoryMock.Encrypt at\n%s", m.funcEncryptOrigin)
+	}
+
+	if !m.EncryptMock.invocationsDone() && afterEncryptCounter > 0 {
+		m.t.Errorf("Expected %d calls to SecretRepositoryMock.Encrypt at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EncryptMock.expectedInvocations), m.EncryptMock.expectedInvocationsOrigin, afterEncryptCounter)
+	}
+}
+
+type mSecretRepositoryMockGetKID struct {
+	optional           bool
+	mock               *SecretRepositoryMock
+	defaultExpectation *SecretRepositoryMockGetKIDExpectation
+	expectations       []*SecretRepositoryMockGetKIDExpectation
+
+	callArgs []*SecretRepositoryMockGetKIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SecretRepositoryMockGetKIDExpectation specifies expectation struct of the SecretRepository.GetKID
+type SecretRepositoryMockGetKIDExpectation struct {
+	mock               *SecretRepositoryMock
+	params             *SecretRepositoryMockGetKIDParams
+	paramPtrs          *SecretRepositoryMockGetKIDParamPtrs
+	expectationOrigins SecretRepositoryMockGetKIDExpectationOrigins
+	results            *SecretRepositoryMockGetKIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SecretRepositoryMockGetKIDParams contains parameters of the SecretRepository.GetKID
+type SecretRepositoryMockGetKIDParams struct {
+	ctx     context.Context
+	keyName string
+}
+
+// SecretRepositoryMockGetKIDParamPtrs contains pointers to parameters of the SecretRepository.GetKID
+type SecretRepositoryMockGetKIDParamPtrs struct {
+	ctx     *context.Context
+	keyName *string
+}
+
+// SecretRepositoryMockGetKIDResults contains results of the SecretRepository.GetKID
+type SecretRepositoryMockGetKIDResults struct {
+	s1  string
+	err error
+}
+
+// SecretRepositoryMockGetKIDOrigins contains origins of expectations of the SecretRepository.GetKID
+type SecretRepositoryMockGetKIDExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originKeyName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetKID *mSecretRepositoryMockGetKID) Optional() *mSecretRepositoryMockGetKID {
+	mmGetKID.optional = true
+	return mmGetKID
+}
+
+// Expect sets up expected params for SecretRepository.GetKID
+func (mmGetKID *mSecretRepositoryMockGetKID) Expect(ctx context.Context, keyName string) *mSecretRepositoryMockGetKID {
+	if mmGetKID.mock.funcGetKID != nil {
+		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+	}
+
+	if mmGetKID.defaultExpectation == nil {
+		mmGetKID.defaultExpectation = &SecretRepositoryMockGetKIDExpectation{}
+	}
+
+	if mmGetKID.defaultExpectation.paramPtrs != nil {
+		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by ExpectParams functions")
+	}
+
+	mmGetKID.defaultExpectation.params = &SecretRepositoryMockGetKIDParams{ctx, keyName}
+	mmGetKID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetKID.expectations {
+		if minimock.Equal(e.params, mmGetKID.defaultExpectation.params) {
+			mmGetKID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetKID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetKID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SecretRepository.GetKID
+func (mmGetKID *mSecretRepositoryMockGetKID) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockGetKID {
+	if mmGetKID.mock.funcGetKID != nil {
+		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+	}
+
+	if mmGetKID.defaultExpectation == nil {
+		mmGetKID.defaultExpectation = &SecretRepositoryMockGetKIDExpectation{}
+	}
+
+	if mmGetKID.defaultExpectation.params != nil {
+		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Expect")
+	}
+
+	if mmGetKID.defaultExpectation.paramPtrs == nil {
+		mmGetKID.defaultExpectation.paramPtrs = &SecretRepositoryMockGetKIDParamPtrs{}
+	}
+	mmGetKID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetKID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetKID
+}
+
+// ExpectKeyNameParam2 sets up expected param keyName for SecretRepository.GetKID
+func (mmGetKID *mSecretRepositoryMockGetKID) ExpectKeyNameParam2(keyName string) *mSecretRepositoryMockGetKID {
+	if mmGetKID.mock.funcGetKID != nil {
+		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+	}
+
+	if mmGetKID.defaultExpectation == nil {
+		mmGetKID.defaultExpectation = &SecretRepositoryMockGetKIDExpectation{}
+	}
+
+	if mmGetKID.defaultExpectation.params != nil {
+		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Expect")
+	}
+
+	if mmGetKID.defaultExpectation.paramPtrs == nil {
+		mmGetKID.defaultExpectation.paramPtrs = &SecretRepositoryMockGetKIDParamPtrs{}
+	}
+	mmGetKID.defaultExpectation.paramPtrs.keyName = &keyName
+	mmGetKID.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
+
+	return mmGetKID
+}
+
+// Inspect accepts an inspector function that has same arguments as the SecretRepository.GetKID
+func (mmGetKID *mSecretRepositoryMockGetKID) Inspect(f func(ctx context.Context, keyName string)) *mSecretRepositoryMockGetKID {
+	if mmGetKID.mock.inspectFuncGetKID != nil {
+		mmGetKID.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.GetKID")
+	}
+
+	mmGetKID.mock.inspectFuncGetKID = f
+
+	return mmGetKID
+}
+
+// Return sets up results that will be returned by SecretRepository.GetKID
+func (mmGetKID *mSecretRepositoryMockGetKID) Return(s1 string, err error) *SecretRepositoryMock {
+	if mmGetKID.mock.funcGetKID != nil {
+		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+	}
+
+	if mmGetKID.defaultExpectation == nil {
+		mmGetKID.defaultExpectation = &SecretRepositoryMockGetKIDExpectation{mock: mmGetKID.mock}
+	}
+	mmGetKID.defaultExpectation.results = &SecretRepositoryMockGetKIDResults{s1, err}
+	mmGetKID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetKID.mock
+}
+
+// Set uses given function f to mock the SecretRepository.GetKID method
+func (mmGetKID *mSecretRepositoryMockGetKID) Set(f func(ctx context.Context, keyName string) (s1 string, err error)) *SecretRepositoryMock {
+	if mmGetKID.defaultExpectation != nil {
+		mmGetKID.mock.t.Fatalf("Default expectation is already set for the SecretRepository.GetKID method")
+	}
+
+	if len(mmGetKID.expectations) > 0 {
+		mmGetKID.mock.t.Fatalf("Some expectations are already set for the SecretRepository.GetKID method")
+	}
+
+	mmGetKID.mock.funcGetKID = f
+	mmGetKID.mock.funcGetKIDOrigin = minimock.CallerInfo(1)
+	return mmGetKID.mock
+}
+
+// When sets expectation for the SecretRepository.GetKID which will trigger the result defined by the following
+// Then helper
+func (mmGetKID *mSecretRepositoryMockGetKID) When(ctx context.Context, keyName string) *SecretRepositoryMockGetKIDExpectation {
+	if mmGetKID.mock.funcGetKID != nil {
+		mmGetKID.mock.t.Fatalf("SecretRepositoryMock.GetKID mock is already set by Set")
+	}
+
+	expectation := &SecretRepositoryMockGetKIDExpectation{
+		mock:               mmGetKID.mock,
+		params:             &SecretRepositoryMockGetKIDParams{ctx, keyName},
+		expectationOrigins: SecretRepositoryMockGetKIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetKID.expectations = append(mmGetKID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SecretRepository.GetKID return parameters for the expectation previously defined by the When method
+func (e *SecretRepositoryMockGetKIDExpectation) Then(s1 string, err error) *SecretRepositoryMock {
+	e.results = &SecretRepositoryMockGetKIDResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times SecretRepository.GetKID should be invoked
+func (mmGetKID *mSecretRepositoryMockGetKID) Times(n uint64) *mSecretRepositoryMockGetKID {
+	if n == 0 {
+		mmGetKID.mock.t.Fatalf("Times of SecretRepositoryMock.GetKID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetKID.expectedInvocations, n)
+	mmGetKID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetKID
+}
+
+func (mmGetKID *mSecretRepositoryMockGetKID) invocationsDone() bool {
+	if len(mmGetKID.expectations) == 0 && mmGetKID.defaultExpectation == nil && mmGetKID.mock.funcGetKID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetKID.mock.afterGetKIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetKID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetKID implements mm_repository.SecretRepository
+func (mmGetKID *SecretRepositoryMock) GetKID(ctx context.Context, keyName string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetKID.beforeGetKIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetKID.afterGetKIDCounter, 1)
+
+	mmGetKID.t.Helper()
+
+	if mmGetKID.inspectFuncGetKID != nil {
+		mmGetKID.inspectFuncGetKID(ctx, keyName)
+	}
+
+	mm_params := SecretRepositoryMockGetKIDParams{ctx, keyName}
+
+	// Record call args
+	mmGetKID.GetKIDMock.mutex.Lock()
+	mmGetKID.GetKIDMock.callArgs = append(mmGetKID.GetKIDMock.callArgs, &mm_params)
+	mmGetKID.GetKIDMock.mutex.Unlock()
+
+	for _, e := range mmGetKID.GetKIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetKID.GetKIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetKID.GetKIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetKID.GetKIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetKID.GetKIDMock.defaultExpectation.paramPtrs
+
+		mm_got := SecretRepositoryMockGetKIDParams{ctx, keyName}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetKID.t.Errorf("SecretRepositoryMock.GetKID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetKID.GetKIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keyName != nil && !minimock.Equal(*mm_want_ptrs.keyName, mm_got.keyName) {
+				mmGetKID.t.Errorf("SecretRepositoryMock.GetKID got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetKID.GetKIDMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetKID.t.Errorf("SecretRepositoryMock.GetKID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetKID.GetKIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetKID.GetKIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetKID.t.Fatal("No results are set for the SecretRepositoryMock.GetKID")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetKID.funcGetKID != nil {
+		return mmGetKID.funcGetKID(ctx, keyName)
+	}
+	mmGetKID.t.Fatalf("Unexpected call to SecretRepositoryMock.GetKID. %v %v", ctx, keyName)
+	return
+}
+
+// GetKIDAfterCounter returns a count of finished SecretRepositoryMock.GetKID invocations
+func (mmGetKID *SecretRepositoryMock) GetKIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetKID.afterGetKIDCounter)
+}
+
+// GetKIDBeforeCounter returns a count of SecretRepositoryMock.GetKID invocations
+func (mmGetKID *SecretRepositoryMock) GetKIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetKID.beforeGetKIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to SecretRepositoryMock.GetKID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetKID *mSecretRepositoryMockGetKID) Calls() []*SecretRepositoryMockGetKIDParams {
+	mmGetKID.mutex.RLock()
+
+	argCopy := make([]*SecretRepositoryMockGetKIDParams, len(mmGetKID.callArgs))
+	copy(argCopy, mmGetKID.callArgs)
+
+	mmGetKID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetKIDDone returns true if the count of the GetKID invocations corresponds
+// the number of defined expectations
+func (m *SecretRepositoryMock) MinimockGetKIDDone() bool {
+	if m.GetKIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetKIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetKIDMock.invocationsDone()
+}
+
+// MinimockGetKIDInspect logs each unmet expectation
+func (m *SecretRepositoryMock) MinimockGetKIDInspect() {
+	for _, e := range m.GetKIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SecretRepositoryMock.GetKID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetKIDCounter := mm_atomic.LoadUint64(&m.afterGetKIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetKIDMock.defaultExpectation != nil && afterGetKIDCounter < 1 {
+		if m.GetKIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SecretRepositoryMock.GetKID at\n%s", m.GetKIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SecretRepositoryMock.GetKID at\n%s with params: %#v", m.GetKIDMock.defaultExpectation.expectationOrigins.origin, *m.GetKIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetKID != nil && afterGetKIDCounter < 1 {
+		m.t.Errorf("Expected call to SecretRepositoryMock.GetKID at\n%s", m.funcGetKIDOrigin)
+	}
+
+	if !m.GetKIDMock.invocationsDone() && afterGetKIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to SecretRepositoryMock.GetKID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetKIDMock.expectedInvocations), m.GetKIDMock.expectedInvocationsOrigin, afterGetKIDCounter)
+	}
+}
+
+type mSecretRepositoryMockGetPublicKeys struct {
+	optional           bool
+	mock               *SecretRepositoryMock
+	defaultExpectation *SecretRepositoryMockGetPublicKeysExpectation
+	expectations       []*SecretRepositoryMockGetPublicKeysExpectation
+
+	callArgs []*SecretRepositoryMockGetPublicKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SecretRepositoryMockGetPublicKeysExpectation specifies expectation struct of the SecretRepository.GetPublicKeys
+type SecretRepositoryMockGetPublicKeysExpectation struct {
+	mock               *SecretRepositoryMock
+	params             *SecretRepositoryMockGetPublicKeysParams
+	paramPtrs          *SecretRepositoryMockGetPublicKeysParamPtrs
+	expectationOrigins SecretRepositoryMockGetPublicKeysExpectationOrigins
+	results            *SecretRepositoryMockGetPublicKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SecretRepositoryMockGetPublicKeysParams contains parameters of the SecretRepository.GetPublicKeys
+type SecretRepositoryMockGetPublicKeysParams struct {
+	ctx     context.Context
+	keyName string
+}
+
+// SecretRepositoryMockGetPublicKeysParamPtrs contains pointers to parameters of the SecretRepository.GetPublicKeys
+type SecretRepositoryMockGetPublicKeysParamPtrs struct {
+	ctx     *context.Context
+	keyName *string
+}
+
+// SecretRepositoryMockGetPublicKeysResults contains results of the SecretRepository.GetPublicKeys
+type SecretRepositoryMockGetPublicKeysResults struct {
+	m1  map[string]string
+	err error
+}
+
+// SecretRepositoryMockGetPublicKeysOrigins contains origins of expectations of the SecretRepository.GetPublicKeys
+type SecretRepositoryMockGetPublicKeysExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originKeyName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Optional() *mSecretRepositoryMockGetPublicKeys {
+	mmGetPublicKeys.optional = true
+	return mmGetPublicKeys
+}
+
+// Expect sets up expected params for SecretRepository.GetPublicKeys
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Expect(ctx context.Context, keyName string) *mSecretRepositoryMockGetPublicKeys {
+	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
+		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+	}
+
+	if mmGetPublicKeys.defaultExpectation == nil {
+		mmGetPublicKeys.defaultExpectation = &SecretRepositoryMockGetPublicKeysExpectation{}
+	}
+
+	if mmGetPublicKeys.defaultExpectation.paramPtrs != nil {
+		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by ExpectParams functions")
+	}
+
+	mmGetPublicKeys.defaultExpectation.params = &SecretRepositoryMockGetPublicKeysParams{ctx, keyName}
+	mmGetPublicKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPublicKeys.expectations {
+		if minimock.Equal(e.params, mmGetPublicKeys.defaultExpectation.params) {
+			mmGetPublicKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPublicKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPublicKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SecretRepository.GetPublicKeys
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockGetPublicKeys {
+	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
+		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+	}
+
+	if mmGetPublicKeys.defaultExpectation == nil {
+		mmGetPublicKeys.defaultExpectation = &SecretRepositoryMockGetPublicKeysExpectation{}
+	}
+
+	if mmGetPublicKeys.defaultExpectation.params != nil {
+		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Expect")
+	}
+
+	if mmGetPublicKeys.defaultExpectation.paramPtrs == nil {
+		mmGetPublicKeys.defaultExpectation.paramPtrs = &SecretRepositoryMockGetPublicKeysParamPtrs{}
+	}
+	mmGetPublicKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPublicKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPublicKeys
+}
+
+// ExpectKeyNameParam2 sets up expected param keyName for SecretRepository.GetPublicKeys
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) ExpectKeyNameParam2(keyName string) *mSecretRepositoryMockGetPublicKeys {
+	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
+		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+	}
+
+	if mmGetPublicKeys.defaultExpectation == nil {
 		mmGetPublicKeys.defaultExpectation = &SecretRepositoryMockGetPublicKeysExpectation{}
 	}
 
-	if mmGetPublicKeys.defaultExpectation.params != nil {
-		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Expect")
+	if mmGetPublicKeys.defaultExpectation.params != nil {
+		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Expect")
+	}
+
+	if mmGetPublicKeys.defaultExpectation.paramPtrs == nil {
+		mmGetPublicKeys.defaultExpectation.paramPtrs = &SecretRepositoryMockGetPublicKeysParamPtrs{}
+	}
+	mmGetPublicKeys.defaultExpectation.paramPtrs.keyName = &keyName
+	mmGetPublicKeys.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
+
+	return mmGetPublicKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the SecretRepository.GetPublicKeys
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Inspect(f func(ctx context.Context, keyName string)) *mSecretRepositoryMockGetPublicKeys {
+	if mmGetPublicKeys.mock.inspectFuncGetPublicKeys != nil {
+		mmGetPublicKeys.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.GetPublicKeys")
+	}
+
+	mmGetPublicKeys.mock.inspectFuncGetPublicKeys = f
+
+	return mmGetPublicKeys
+}
+
+// Return sets up results that will be returned by SecretRepository.GetPublicKeys
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Return(m1 map[string]string, err error) *SecretRepositoryMock {
+	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
+		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+	}
+
+	if mmGetPublicKeys.defaultExpectation == nil {
+		mmGetPublicKeys.defaultExpectation = &SecretRepositoryMockGetPublicKeysExpectation{mock: mmGetPublicKeys.mock}
+	}
+	mmGetPublicKeys.defaultExpectation.results = &SecretRepositoryMockGetPublicKeysResults{m1, err}
+	mmGetPublicKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPublicKeys.mock
+}
+
+// Set uses given function f to mock the SecretRepository.GetPublicKeys method
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Set(f func(ctx context.Context, keyName string) (m1 map[string]string, err error)) *SecretRepositoryMock {
+	if mmGetPublicKeys.defaultExpectation != nil {
+		mmGetPublicKeys.mock.t.Fatalf("Default expectation is already set for the SecretRepository.GetPublicKeys method")
+	}
+
+	if len(mmGetPublicKeys.expectations) > 0 {
+		mmGetPublicKeys.mock.t.Fatalf("Some expectations are already set for the SecretRepository.GetPublicKeys method")
+	}
+
+	mmGetPublicKeys.mock.funcGetPublicKeys = f
+	mmGetPublicKeys.mock.funcGetPublicKeysOrigin = minimock.CallerInfo(1)
+	return mmGetPublicKeys.mock
+}
+
+// When sets expectation for the SecretRepository.GetPublicKeys which will trigger the result defined by the following
+// Then helper
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) When(ctx context.Context, keyName string) *SecretRepositoryMockGetPublicKeysExpectation {
+	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
+		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+	}
+
+	expectation := &SecretRepositoryMockGetPublicKeysExpectation{
+		mock:               mmGetPublicKeys.mock,
+		params:             &SecretRepositoryMockGetPublicKeysParams{ctx, keyName},
+		expectationOrigins: SecretRepositoryMockGetPublicKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPublicKeys.expectations = append(mmGetPublicKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SecretRepository.GetPublicKeys return parameters for the expectation previously defined by the When method
+func (e *SecretRepositoryMockGetPublicKeysExpectation) Then(m1 map[string]string, err error) *SecretRepositoryMock {
+	e.results = &SecretRepositoryMockGetPublicKeysResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times SecretRepository.GetPublicKeys should be invoked
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Times(n uint64) *mSecretRepositoryMockGetPublicKeys {
+	if n == 0 {
+		mmGetPublicKeys.mock.t.Fatalf("Times of SecretRepositoryMock.GetPublicKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPublicKeys.expectedInvocations, n)
+	mmGetPublicKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPublicKeys
+}
+
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) invocationsDone() bool {
+	if len(mmGetPublicKeys.expectations) == 0 && mmGetPublicKeys.defaultExpectation == nil && mmGetPublicKeys.mock.funcGetPublicKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPublicKeys.mock.afterGetPublicKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPublicKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPublicKeys implements mm_repository.SecretRepository
+func (mmGetPublicKeys *SecretRepositoryMock) GetPublicKeys(ctx context.Context, keyName string) (m1 map[string]string, err error) {
+	mm_atomic.AddUint64(&mmGetPublicKeys.beforeGetPublicKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPublicKeys.afterGetPublicKeysCounter, 1)
+
+	mmGetPublicKeys.t.Helper()
+
+	if mmGetPublicKeys.inspectFuncGetPublicKeys != nil {
+		mmGetPublicKeys.inspectFuncGetPublicKeys(ctx, keyName)
+	}
+
+	mm_params := SecretRepositoryMockGetPublicKeysParams{ctx, keyName}
+
+	// Record call args
+	mmGetPublicKeys.GetPublicKeysMock.mutex.Lock()
+	mmGetPublicKeys.GetPublicKeysMock.callArgs = append(mmGetPublicKeys.GetPublicKeysMock.callArgs, &mm_params)
+	mmGetPublicKeys.GetPublicKeysMock.mutex.Unlock()
+
+	for _, e := range mmGetPublicKeys.GetPublicKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetPublicKeys.GetPublicKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := SecretRepositoryMockGetPublicKeysParams{ctx, keyName}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPublicKeys.t.Errorf("SecretRepositoryMock.GetPublicKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keyName != nil && !minimock.Equal(*mm_want_ptrs.keyName, mm_got.keyName) {
+				mmGetPublicKeys.t.Errorf("SecretRepositoryMock.GetPublicKeys got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPublicKeys.t.Errorf("SecretRepositoryMock.GetPublicKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPublicKeys.t.Fatal("No results are set for the SecretRepositoryMock.GetPublicKeys")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetPublicKeys.funcGetPublicKeys != nil {
+		return mmGetPublicKeys.funcGetPublicKeys(ctx, keyName)
+	}
+	mmGetPublicKeys.t.Fatalf("Unexpected call to SecretRepositoryMock.GetPublicKeys. %v %v", ctx, keyName)
+	return
+}
+
+// GetPublicKeysAfterCounter returns a count of finished SecretRepositoryMock.GetPublicKeys invocations
+func (mmGetPublicKeys *SecretRepositoryMock) GetPublicKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPublicKeys.afterGetPublicKeysCounter)
+}
+
+// GetPublicKeysBeforeCounter returns a count of SecretRepositoryMock.GetPublicKeys invocations
+func (mmGetPublicKeys *SecretRepositoryMock) GetPublicKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPublicKeys.beforeGetPublicKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to SecretRepositoryMock.GetPublicKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Calls() []*SecretRepositoryMockGetPublicKeysParams {
+	mmGetPublicKeys.mutex.RLock()
+
+	argCopy := make([]*SecretRepositoryMockGetPublicKeysParams, len(mmGetPublicKeys.callArgs))
+	copy(argCopy, mmGetPublicKeys.callArgs)
+
+	mmGetPublicKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPublicKeysDone returns true if the count of the GetPublicKeys invocations corresponds
+// the number of defined expectations
+func (m *SecretRepositoryMock) MinimockGetPublicKeysDone() bool {
+	if m.GetPublicKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPublicKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPublicKeysMock.invocationsDone()
+}
+
+// MinimockGetPublicKeysInspect logs each unmet expectation
+func (m *SecretRepositoryMock) MinimockGetPublicKeysInspect() {
+	for _, e := range m.GetPublicKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SecretRepositoryMock.GetPublicKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPublicKeysCounter := mm_atomic.LoadUint64(&m.afterGetPublicKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPublicKeysMock.defaultExpectation != nil && afterGetPublicKeysCounter < 1 {
+		if m.GetPublicKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SecretRepositoryMock.GetPublicKeys at\n%s", m.GetPublicKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SecretRepositoryMock.GetPublicKeys at\n%s with params: %#v", m.GetPublicKeysMock.defaultExpectation.expectationOrigins.origin, *m.GetPublicKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPublicKeys != nil && afterGetPublicKeysCounter < 1 {
+		m.t.Errorf("Expected call to SecretRepositoryMock.GetPublicKeys at\n%s", m.funcGetPublicKeysOrigin)
+	}
+
+	if !m.GetPublicKeysMock.invocationsDone() && afterGetPublicKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to SecretRepositoryMock.GetPublicKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPublicKeysMock.expectedInvocations), m.GetPublicKeysMock.expectedInvocationsOrigin, afterGetPublicKeysCounter)
+	}
+}
+
+type mSecretRepositoryMockKeyVersions struct {
+	optional           bool
+	mock               *SecretRepositoryMock
+	defaultExpectation *SecretRepositoryMockKeyVersionsExpectation
+	expectations       []*SecretRepositoryMockKeyVersionsExpectation
+
+	callArgs []*SecretRepositoryMockKeyVersionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SecretRepositoryMockKeyVersionsExpectation specifies expectation struct of the SecretRepository.KeyVersions
+type SecretRepositoryMockKeyVersionsExpectation struct {
+	mock               *SecretRepositoryMock
+	params             *SecretRepositoryMockKeyVersionsParams
+	paramPtrs          *SecretRepositoryMockKeyVersionsParamPtrs
+	expectationOrigins SecretRepositoryMockKeyVersionsExpectationOrigins
+	results            *SecretRepositoryMockKeyVersionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SecretRepositoryMockKeyVersionsParams contains parameters of the SecretRepository.KeyVersions
+type SecretRepositoryMockKeyVersionsParams struct {
+	ctx     context.Context
+	keyName string
+}
+
+// SecretRepositoryMockKeyVersionsParamPtrs contains pointers to parameters of the SecretRepository.KeyVersions
+type SecretRepositoryMockKeyVersionsParamPtrs struct {
+	ctx     *context.Context
+	keyName *string
+}
+
+// SecretRepositoryMockKeyVersionsResults contains results of the SecretRepository.KeyVersions
+type SecretRepositoryMockKeyVersionsResults struct {
+	ka1 []domain.KeyVersion
+	err error
+}
+
+// SecretRepositoryMockKeyVersionsOrigins contains origins of expectations of the SecretRepository.KeyVersions
+type SecretRepositoryMockKeyVersionsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originKeyName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) Optional() *mSecretRepositoryMockKeyVersions {
+	mmKeyVersions.optional = true
+	return mmKeyVersions
+}
+
+// Expect sets up expected params for SecretRepository.KeyVersions
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) Expect(ctx context.Context, keyName string) *mSecretRepositoryMockKeyVersions {
+	if mmKeyVersions.mock.funcKeyVersions != nil {
+		mmKeyVersions.mock.t.Fatalf("SecretRepositoryMock.KeyVersions mock is already set by Set")
+	}
+
+	if mmKeyVersions.defaultExpectation == nil {
+		mmKeyVersions.defaultExpectation = &SecretRepositoryMockKeyVersionsExpectation{}
+	}
+
+	if mmKeyVersions.defaultExpectation.paramPtrs != nil {
+		mmKeyVersions.mock.t.Fatalf("SecretRepositoryMock.KeyVersions mock is already set by ExpectParams functions")
+	}
+
+	mmKeyVersions.defaultExpectation.params = &SecretRepositoryMockKeyVersionsParams{ctx, keyName}
+	mmKeyVersions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmKeyVersions.expectations {
+		if minimock.Equal(e.params, mmKeyVersions.defaultExpectation.params) {
+			mmKeyVersions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmKeyVersions.defaultExpectation.params)
+		}
+	}
+
+	return mmKeyVersions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SecretRepository.KeyVersions
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockKeyVersions {
+	if mmKeyVersions.mock.funcKeyVersions != nil {
+		mmKeyVersions.mock.t.Fatalf("SecretRepositoryMock.KeyVersions mock is already set by Set")
+	}
+
+	if mmKeyVersions.defaultExpectation == nil {
+		mmKeyVersions.defaultExpectation = &SecretRepositoryMockKeyVersionsExpectation{}
+	}
+
+	if mmKeyVersions.defaultExpectation.params != nil {
+		mmKeyVersions.mock.t.Fatalf("SecretRepositoryMock.KeyVersions mock is already set by Expect")
+	}
+
+	if mmKeyVersions.defaultExpectation.paramPtrs == nil {
+		mmKeyVersions.defaultExpectation.paramPtrs = &SecretRepositoryMockKeyVersionsParamPtrs{}
+	}
+	mmKeyVersions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmKeyVersions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmKeyVersions
+}
+
+// ExpectKeyNameParam2 sets up expected param keyName for SecretRepository.KeyVersions
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) ExpectKeyNameParam2(keyName string) *mSecretRepositoryMockKeyVersions {
+	if mmKeyVersions.mock.funcKeyVersions != nil {
+		mmKeyVersions.mock.t.Fatalf("SecretRepositoryMock.KeyVersions mock is already set by Set")
+	}
+
+	if mmKeyVersions.defaultExpectation == nil {
+		mmKeyVersions.defaultExpectation = &SecretRepositoryMockKeyVersionsExpectation{}
+	}
+
+	if mmKeyVersions.defaultExpectation.params != nil {
+		mmKeyVersions.mock.t.Fatalf("SecretRepositoryMock.KeyVersions mock is already set by Expect")
+	}
+
+	if mmKeyVersions.defaultExpectation.paramPtrs == nil {
+		mmKeyVersions.defaultExpectation.paramPtrs = &SecretRepositoryMockKeyVersionsParamPtrs{}
+	}
+	mmKeyVersions.defaultExpectation.paramPtrs.keyName = &keyName
+	mmKeyVersions.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
+
+	return mmKeyVersions
+}
+
+// Inspect accepts an inspector function that has same arguments as the SecretRepository.KeyVersions
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) Inspect(f func(ctx context.Context, keyName string)) *mSecretRepositoryMockKeyVersions {
+	if mmKeyVersions.mock.inspectFuncKeyVersions != nil {
+		mmKeyVersions.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.KeyVersions")
+	}
+
+	mmKeyVersions.mock.inspectFuncKeyVersions = f
+
+	return mmKeyVersions
+}
+
+// Return sets up results that will be returned by SecretRepository.KeyVersions
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) Return(ka1 []domain.KeyVersion, err error) *SecretRepositoryMock {
+	if mmKeyVersions.mock.funcKeyVersions != nil {
+		mmKeyVersions.mock.t.Fatalf("SecretRepositoryMock.KeyVersions mock is already set by Set")
+	}
+
+	if mmKeyVersions.defaultExpectation == nil {
+		mmKeyVersions.defaultExpectation = &SecretRepositoryMockKeyVersionsExpectation{mock: mmKeyVersions.mock}
+	}
+	mmKeyVersions.defaultExpectation.results = &SecretRepositoryMockKeyVersionsResults{ka1, err}
+	mmKeyVersions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmKeyVersions.mock
+}
+
+// Set uses given function f to mock the SecretRepository.KeyVersions method
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) Set(f func(ctx context.Context, keyName string) (ka1 []domain.KeyVersion, err error)) *SecretRepositoryMock {
+	if mmKeyVersions.defaultExpectation != nil {
+		mmKeyVersions.mock.t.Fatalf("Default expectation is already set for the SecretRepository.KeyVersions method")
+	}
+
+	if len(mmKeyVersions.expectations) > 0 {
+		mmKeyVersions.mock.t.Fatalf("Some expectations are already set for the SecretRepository.KeyVersions method")
+	}
+
+	mmKeyVersions.mock.funcKeyVersions = f
+	mmKeyVersions.mock.funcKeyVersionsOrigin = minimock.CallerInfo(1)
+	return mmKeyVersions.mock
+}
+
+// When sets expectation for the SecretRepository.KeyVersions which will trigger the result defined by the following
+// Then helper
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) When(ctx context.Context, keyName string) *SecretRepositoryMockKeyVersionsExpectation {
+	if mmKeyVersions.mock.funcKeyVersions != nil {
+		mmKeyVersions.mock.t.Fatalf("SecretRepositoryMock.KeyVersions mock is already set by Set")
+	}
+
+	expectation := &SecretRepositoryMockKeyVersionsExpectation{
+		mock:               mmKeyVersions.mock,
+		params:             &SecretRepositoryMockKeyVersionsParams{ctx, keyName},
+		expectationOrigins: SecretRepositoryMockKeyVersionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmKeyVersions.expectations = append(mmKeyVersions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SecretRepository.KeyVersions return parameters for the expectation previously defined by the When method
+func (e *SecretRepositoryMockKeyVersionsExpectation) Then(ka1 []domain.KeyVersion, err error) *SecretRepositoryMock {
+	e.results = &SecretRepositoryMockKeyVersionsResults{ka1, err}
+	return e.mock
+}
+
+// Times sets number of times SecretRepository.KeyVersions should be invoked
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) Times(n uint64) *mSecretRepositoryMockKeyVersions {
+	if n == 0 {
+		mmKeyVersions.mock.t.Fatalf("Times of SecretRepositoryMock.KeyVersions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmKeyVersions.expectedInvocations, n)
+	mmKeyVersions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmKeyVersions
+}
+
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) invocationsDone() bool {
+	if len(mmKeyVersions.expectations) == 0 && mmKeyVersions.defaultExpectation == nil && mmKeyVersions.mock.funcKeyVersions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmKeyVersions.mock.afterKeyVersionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmKeyVersions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// KeyVersions implements mm_repository.SecretRepository
+func (mmKeyVersions *SecretRepositoryMock) KeyVersions(ctx context.Context, keyName string) (ka1 []domain.KeyVersion, err error) {
+	mm_atomic.AddUint64(&mmKeyVersions.beforeKeyVersionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmKeyVersions.afterKeyVersionsCounter, 1)
+
+	mmKeyVersions.t.Helper()
+
+	if mmKeyVersions.inspectFuncKeyVersions != nil {
+		mmKeyVersions.inspectFuncKeyVersions(ctx, keyName)
+	}
+
+	mm_params := SecretRepositoryMockKeyVersionsParams{ctx, keyName}
+
+	// Record call args
+	mmKeyVersions.KeyVersionsMock.mutex.Lock()
+	mmKeyVersions.KeyVersionsMock.callArgs = append(mmKeyVersions.KeyVersionsMock.callArgs, &mm_params)
+	mmKeyVersions.KeyVersionsMock.mutex.Unlock()
+
+	for _, e := range mmKeyVersions.KeyVersionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ka1, e.results.err
+		}
+	}
+
+	if mmKeyVersions.KeyVersionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmKeyVersions.KeyVersionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmKeyVersions.KeyVersionsMock.defaultExpectation.params
+		mm_want_ptrs := mmKeyVersions.KeyVersionsMock.defaultExpectation.paramPtrs
+
+		mm_got := SecretRepositoryMockKeyVersionsParams{ctx, keyName}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmKeyVersions.t.Errorf("SecretRepositoryMock.KeyVersions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmKeyVersions.KeyVersionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keyName != nil && !minimock.Equal(*mm_want_ptrs.keyName, mm_got.keyName) {
+				mmKeyVersions.t.Errorf("SecretRepositoryMock.KeyVersions got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmKeyVersions.KeyVersionsMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmKeyVersions.t.Errorf("SecretRepositoryMock.KeyVersions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmKeyVersions.KeyVersionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmKeyVersions.KeyVersionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmKeyVersions.t.Fatal("No results are set for the SecretRepositoryMock.KeyVersions")
+		}
+		return (*mm_results).ka1, (*mm_results).err
+	}
+	if mmKeyVersions.funcKeyVersions != nil {
+		return mmKeyVersions.funcKeyVersions(ctx, keyName)
+	}
+	mmKeyVersions.t.Fatalf("Unexpected call to SecretRepositoryMock.KeyVersions. %v %v", ctx, keyName)
+	return
+}
+
+// KeyVersionsAfterCounter returns a count of finished SecretRepositoryMock.KeyVersions invocations
+func (mmKeyVersions *SecretRepositoryMock) KeyVersionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmKeyVersions.afterKeyVersionsCounter)
+}
+
+// KeyVersionsBeforeCounter returns a count of SecretRepositoryMock.KeyVersions invocations
+func (mmKeyVersions *SecretRepositoryMock) KeyVersionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmKeyVersions.beforeKeyVersionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to SecretRepositoryMock.KeyVersions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmKeyVersions *mSecretRepositoryMockKeyVersions) Calls() []*SecretRepositoryMockKeyVersionsParams {
+	mmKeyVersions.mutex.RLock()
+
+	argCopy := make([]*SecretRepositoryMockKeyVersionsParams, len(mmKeyVersions.callArgs))
+	copy(argCopy, mmKeyVersions.callArgs)
+
+	mmKeyVersions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockKeyVersionsDone returns true if the count of the KeyVersions invocations corresponds
+// the number of defined expectations
+func (m *SecretRepositoryMock) MinimockKeyVersionsDone() bool {
+	if m.KeyVersionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.KeyVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.KeyVersionsMock.invocationsDone()
+}
+
+// MinimockKeyVersionsInspect logs each unmet expectation
+func (m *SecretRepositoryMock) MinimockKeyVersionsInspect() {
+	for _, e := range m.KeyVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SecretRepositoryMock.KeyVersions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterKeyVersionsCounter := mm_atomic.LoadUint64(&m.afterKeyVersionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.KeyVersionsMock.defaultExpectation != nil && afterKeyVersionsCounter < 1 {
+		if m.KeyVersionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SecretRepositoryMock.KeyVersions at\n%s", m.KeyVersionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SecretRepositoryMock.KeyVersions at\n%s with params: %#v", m.KeyVersionsMock.defaultExpectation.expectationOrigins.origin, *m.KeyVersionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcKeyVersions != nil && afterKeyVersionsCounter < 1 {
+		m.t.Errorf("Expected call to SecretRepositoryMock.KeyVersions at\n%s", m.funcKeyVersionsOrigin)
+	}
+
+	if !m.KeyVersionsMock.invocationsDone() && afterKeyVersionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to SecretRepositoryMock.KeyVersions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.KeyVersionsMock.expectedInvocations), m.KeyVersionsMock.expectedInvocationsOrigin, afterKeyVersionsCounter)
+	}
+}
+
+type mSecretRepositoryMockRetireKeyVersions struct {
+	optional           bool
+	mock               *SecretRepositoryMock
+	defaultExpectation *SecretRepositoryMockRetireKeyVersionsExpectation
+	expectations       []*SecretRepositoryMockRetireKeyVersionsExpectation
+
+	callArgs []*SecretRepositoryMockRetireKeyVersionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SecretRepositoryMockRetireKeyVersionsExpectation specifies expectation struct of the SecretRepository.RetireKeyVersions
+type SecretRepositoryMockRetireKeyVersionsExpectation struct {
+	mock               *SecretRepositoryMock
+	params             *SecretRepositoryMockRetireKeyVersionsParams
+	paramPtrs          *SecretRepositoryMockRetireKeyVersionsParamPtrs
+	expectationOrigins SecretRepositoryMockRetireKeyVersionsExpectationOrigins
+	results            *SecretRepositoryMockRetireKeyVersionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SecretRepositoryMockRetireKeyVersionsParams contains parameters of the SecretRepository.RetireKeyVersions
+type SecretRepositoryMockRetireKeyVersionsParams struct {
+	ctx        context.Context
+	keyName    string
+	minVersion int
+}
+
+// SecretRepositoryMockRetireKeyVersionsParamPtrs contains pointers to parameters of the SecretRepository.RetireKeyVersions
+type SecretRepositoryMockRetireKeyVersionsParamPtrs struct {
+	ctx        *context.Context
+	keyName    *string
+	minVersion *int
+}
+
+// SecretRepositoryMockRetireKeyVersionsResults contains results of the SecretRepository.RetireKeyVersions
+type SecretRepositoryMockRetireKeyVersionsResults struct {
+	err error
+}
+
+// SecretRepositoryMockRetireKeyVersionsOrigins contains origins of expectations of the SecretRepository.RetireKeyVersions
+type SecretRepositoryMockRetireKeyVersionsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originKeyName    string
+	originMinVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) Optional() *mSecretRepositoryMockRetireKeyVersions {
+	mmRetireKeyVersions.optional = true
+	return mmRetireKeyVersions
+}
+
+// Expect sets up expected params for SecretRepository.RetireKeyVersions
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) Expect(ctx context.Context, keyName string, minVersion int) *mSecretRepositoryMockRetireKeyVersions {
+	if mmRetireKeyVersions.mock.funcRetireKeyVersions != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by Set")
+	}
+
+	if mmRetireKeyVersions.defaultExpectation == nil {
+		mmRetireKeyVersions.defaultExpectation = &SecretRepositoryMockRetireKeyVersionsExpectation{}
+	}
+
+	if mmRetireKeyVersions.defaultExpectation.paramPtrs != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by ExpectParams functions")
+	}
+
+	mmRetireKeyVersions.defaultExpectation.params = &SecretRepositoryMockRetireKeyVersionsParams{ctx, keyName, minVersion}
+	mmRetireKeyVersions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRetireKeyVersions.expectations {
+		if minimock.Equal(e.params, mmRetireKeyVersions.defaultExpectation.params) {
+			mmRetireKeyVersions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRetireKeyVersions.defaultExpectation.params)
+		}
+	}
+
+	return mmRetireKeyVersions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SecretRepository.RetireKeyVersions
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockRetireKeyVersions {
+	if mmRetireKeyVersions.mock.funcRetireKeyVersions != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by Set")
+	}
+
+	if mmRetireKeyVersions.defaultExpectation == nil {
+		mmRetireKeyVersions.defaultExpectation = &SecretRepositoryMockRetireKeyVersionsExpectation{}
+	}
+
+	if mmRetireKeyVersions.defaultExpectation.params != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by Expect")
+	}
+
+	if mmRetireKeyVersions.defaultExpectation.paramPtrs == nil {
+		mmRetireKeyVersions.defaultExpectation.paramPtrs = &SecretRepositoryMockRetireKeyVersionsParamPtrs{}
+	}
+	mmRetireKeyVersions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRetireKeyVersions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRetireKeyVersions
+}
+
+// ExpectKeyNameParam2 sets up expected param keyName for SecretRepository.RetireKeyVersions
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) ExpectKeyNameParam2(keyName string) *mSecretRepositoryMockRetireKeyVersions {
+	if mmRetireKeyVersions.mock.funcRetireKeyVersions != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by Set")
+	}
+
+	if mmRetireKeyVersions.defaultExpectation == nil {
+		mmRetireKeyVersions.defaultExpectation = &SecretRepositoryMockRetireKeyVersionsExpectation{}
 	}
 
-	if mmGetPublicKeys.defaultExpectation.paramPtrs == nil {
-		mmGetPublicKeys.defaultExpectation.paramPtrs = &SecretRepositoryMockGetPublicKeysParamPtrs{}
+	if mmRetireKeyVersions.defaultExpectation.params != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by Expect")
 	}
-	mmGetPublicKeys.defaultExpectation.paramPtrs.keyName = &keyName
-	mmGetPublicKeys.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
 
-	return mmGetPublicKeys
+	if mmRetireKeyVersions.defaultExpectation.paramPtrs == nil {
+		mmRetireKeyVersions.defaultExpectation.paramPtrs = &SecretRepositoryMockRetireKeyVersionsParamPtrs{}
+	}
+	mmRetireKeyVersions.defaultExpectation.paramPtrs.keyName = &keyName
+	mmRetireKeyVersions.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
+
+	return mmRetireKeyVersions
 }
 
-// Inspect accepts an inspector function that has same arguments as the SecretRepository.GetPublicKeys
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Inspect(f func(ctx context.Context, keyName string)) *mSecretRepositoryMockGetPublicKeys {
-	if mmGetPublicKeys.mock.inspectFuncGetPublicKeys != nil {
-		mmGetPublicKeys.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.GetPublicKeys")
+// ExpectMinVersionParam3 sets up expected param minVersion for SecretRepository.RetireKeyVersions
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) ExpectMinVersionParam3(minVersion int) *mSecretRepositoryMockRetireKeyVersions {
+	if mmRetireKeyVersions.mock.funcRetireKeyVersions != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by Set")
 	}
 
-	mmGetPublicKeys.mock.inspectFuncGetPublicKeys = f
+	if mmRetireKeyVersions.defaultExpectation == nil {
+		mmRetireKeyVersions.defaultExpectation = &SecretRepositoryMockRetireKeyVersionsExpectation{}
+	}
 
-	return mmGetPublicKeys
+	if mmRetireKeyVersions.defaultExpectation.params != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by Expect")
+	}
+
+	if mmRetireKeyVersions.defaultExpectation.paramPtrs == nil {
+		mmRetireKeyVersions.defaultExpectation.paramPtrs = &SecretRepositoryMockRetireKeyVersionsParamPtrs{}
+	}
+	mmRetireKeyVersions.defaultExpectation.paramPtrs.minVersion = &minVersion
+	mmRetireKeyVersions.defaultExpectation.expectationOrigins.originMinVersion = minimock.CallerInfo(1)
+
+	return mmRetireKeyVersions
 }
 
-// Return sets up results that will be returned by SecretRepository.GetPublicKeys
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Return(m1 map[string]string, err error) *SecretRepositoryMock {
-	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
-		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the SecretRepository.RetireKeyVersions
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) Inspect(f func(ctx context.Context, keyName string, minVersion int)) *mSecretRepositoryMockRetireKeyVersions {
+	if mmRetireKeyVersions.mock.inspectFuncRetireKeyVersions != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.RetireKeyVersions")
 	}
 
-	if mmGetPublicKeys.defaultExpectation == nil {
-		mmGetPublicKeys.defaultExpectation = &SecretRepositoryMockGetPublicKeysExpectation{mock: mmGetPublicKeys.mock}
+	mmRetireKeyVersions.mock.inspectFuncRetireKeyVersions = f
+
+	return mmRetireKeyVersions
+}
+
+// Return sets up results that will be returned by SecretRepository.RetireKeyVersions
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) Return(err error) *SecretRepositoryMock {
+	if mmRetireKeyVersions.mock.funcRetireKeyVersions != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by Set")
 	}
-	mmGetPublicKeys.defaultExpectation.results = &SecretRepositoryMockGetPublicKeysResults{m1, err}
-	mmGetPublicKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetPublicKeys.mock
+
+	if mmRetireKeyVersions.defaultExpectation == nil {
+		mmRetireKeyVersions.defaultExpectation = &SecretRepositoryMockRetireKeyVersionsExpectation{mock: mmRetireKeyVersions.mock}
+	}
+	mmRetireKeyVersions.defaultExpectation.results = &SecretRepositoryMockRetireKeyVersionsResults{err}
+	mmRetireKeyVersions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRetireKeyVersions.mock
 }
 
-// Set uses given function f to mock the SecretRepository.GetPublicKeys method
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Set(f func(ctx context.Context, keyName string) (m1 map[string]string, err error)) *SecretRepositoryMock {
-	if mmGetPublicKeys.defaultExpectation != nil {
-		mmGetPublicKeys.mock.t.Fatalf("Default expectation is already set for the SecretRepository.GetPublicKeys method")
+// Set uses given function f to mock the SecretRepository.RetireKeyVersions method
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) Set(f func(ctx context.Context, keyName string, minVersion int) (err error)) *SecretRepositoryMock {
+	if mmRetireKeyVersions.defaultExpectation != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("Default expectation is already set for the SecretRepository.RetireKeyVersions method")
 	}
 
-	if len(mmGetPublicKeys.expectations) > 0 {
-		mmGetPublicKeys.mock.t.Fatalf("Some expectations are already set for the SecretRepository.GetPublicKeys method")
+	if len(mmRetireKeyVersions.expectations) > 0 {
+		mmRetireKeyVersions.mock.t.Fatalf("Some expectations are already set for the SecretRepository.RetireKeyVersions method")
 	}
 
-	mmGetPublicKeys.mock.funcGetPublicKeys = f
-	mmGetPublicKeys.mock.funcGetPublicKeysOrigin = minimock.CallerInfo(1)
-	return mmGetPublicKeys.mock
+	mmRetireKeyVersions.mock.funcRetireKeyVersions = f
+	mmRetireKeyVersions.mock.funcRetireKeyVersionsOrigin = minimock.CallerInfo(1)
+	return mmRetireKeyVersions.mock
 }
 
-// When sets expectation for the SecretRepository.GetPublicKeys which will trigger the result defined by the following
+// When sets expectation for the SecretRepository.RetireKeyVersions which will trigger the result defined by the following
 // Then helper
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) When(ctx context.Context, keyName string) *SecretRepositoryMockGetPublicKeysExpectation {
-	if mmGetPublicKeys.mock.funcGetPublicKeys != nil {
-		mmGetPublicKeys.mock.t.Fatalf("SecretRepositoryMock.GetPublicKeys mock is already set by Set")
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) When(ctx context.Context, keyName string, minVersion int) *SecretRepositoryMockRetireKeyVersionsExpectation {
+	if mmRetireKeyVersions.mock.funcRetireKeyVersions != nil {
+		mmRetireKeyVersions.mock.t.Fatalf("SecretRepositoryMock.RetireKeyVersions mock is already set by Set")
 	}
 
-	expectation := &SecretRepositoryMockGetPublicKeysExpectation{
-		mock:               mmGetPublicKeys.mock,
-		params:             &SecretRepositoryMockGetPublicKeysParams{ctx, keyName},
-		expectationOrigins: SecretRepositoryMockGetPublicKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &SecretRepositoryMockRetireKeyVersionsExpectation{
+		mock:               mmRetireKeyVersions.mock,
+		params:             &SecretRepositoryMockRetireKeyVersionsParams{ctx, keyName, minVersion},
+		expectationOrigins: SecretRepositoryMockRetireKeyVersionsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetPublicKeys.expectations = append(mmGetPublicKeys.expectations, expectation)
+	mmRetireKeyVersions.expectations = append(mmRetireKeyVersions.expectations, expectation)
 	return expectation
 }
 
-// Then sets up SecretRepository.GetPublicKeys return parameters for the expectation previously defined by the When method
-func (e *SecretRepositoryMockGetPublicKeysExpectation) Then(m1 map[string]string, err error) *SecretRepositoryMock {
-	e.results = &SecretRepositoryMockGetPublicKeysResults{m1, err}
+// Then sets up SecretRepository.RetireKeyVersions return parameters for the expectation previously defined by the When method
+func (e *SecretRepositoryMockRetireKeyVersionsExpectation) Then(err error) *SecretRepositoryMock {
+	e.results = &SecretRepositoryMockRetireKeyVersionsResults{err}
 	return e.mock
 }
 
-// Times sets number of times SecretRepository.GetPublicKeys should be invoked
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Times(n uint64) *mSecretRepositoryMockGetPublicKeys {
+// Times sets number of times SecretRepository.RetireKeyVersions should be invoked
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) Times(n uint64) *mSecretRepositoryMockRetireKeyVersions {
 	if n == 0 {
-		mmGetPublicKeys.mock.t.Fatalf("Times of SecretRepositoryMock.GetPublicKeys mock can not be zero")
+		mmRetireKeyVersions.mock.t.Fatalf("Times of SecretRepositoryMock.RetireKeyVersions mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetPublicKeys.expectedInvocations, n)
-	mmGetPublicKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetPublicKeys
+	mm_atomic.StoreUint64(&mmRetireKeyVersions.expectedInvocations, n)
+	mmRetireKeyVersions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRetireKeyVersions
 }
 
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) invocationsDone() bool {
-	if len(mmGetPublicKeys.expectations) == 0 && mmGetPublicKeys.defaultExpectation == nil && mmGetPublicKeys.mock.funcGetPublicKeys == nil {
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) invocationsDone() bool {
+	if len(mmRetireKeyVersions.expectations) == 0 && mmRetireKeyVersions.defaultExpectation == nil && mmRetireKeyVersions.mock.funcRetireKeyVersions == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetPublicKeys.mock.afterGetPublicKeysCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetPublicKeys.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRetireKeyVersions.mock.afterRetireKeyVersionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRetireKeyVersions.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetPublicKeys implements mm_repository.SecretRepository
-func (mmGetPublicKeys *SecretRepositoryMock) GetPublicKeys(ctx context.Context, keyName string) (m1 map[string]string, err error) {
-	mm_atomic.AddUint64(&mmGetPublicKeys.beforeGetPublicKeysCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetPublicKeys.afterGetPublicKeysCounter, 1)
+// RetireKeyVersions implements mm_repository.SecretRepository
+func (mmRetireKeyVersions *SecretRepositoryMock) RetireKeyVersions(ctx context.Context, keyName string, minVersion int) (err error) {
+	mm_atomic.AddUint64(&mmRetireKeyVersions.beforeRetireKeyVersionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmRetireKeyVersions.afterRetireKeyVersionsCounter, 1)
 
-	mmGetPublicKeys.t.Helper()
+	mmRetireKeyVersions.t.Helper()
 
-	if mmGetPublicKeys.inspectFuncGetPublicKeys != nil {
-		mmGetPublicKeys.inspectFuncGetPublicKeys(ctx, keyName)
+	if mmRetireKeyVersions.inspectFuncRetireKeyVersions != nil {
+		mmRetireKeyVersions.inspectFuncRetireKeyVersions(ctx, keyName, minVersion)
 	}
 
-	mm_params := SecretRepositoryMockGetPublicKeysParams{ctx, keyName}
+	mm_params := SecretRepositoryMockRetireKeyVersionsParams{ctx, keyName, minVersion}
 
 	// Record call args
-	mmGetPublicKeys.GetPublicKeysMock.mutex.Lock()
-	mmGetPublicKeys.GetPublicKeysMock.callArgs = append(mmGetPublicKeys.GetPublicKeysMock.callArgs, &mm_params)
-	mmGetPublicKeys.GetPublicKeysMock.mutex.Unlock()
+	mmRetireKeyVersions.RetireKeyVersionsMock.mutex.Lock()
+	mmRetireKeyVersions.RetireKeyVersionsMock.callArgs = append(mmRetireKeyVersions.RetireKeyVersionsMock.callArgs, &mm_params)
+	mmRetireKeyVersions.RetireKeyVersionsMock.mutex.Unlock()
+
+	for _, e := range mmRetireKeyVersions.RetireKeyVersionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRetireKeyVersions.RetireKeyVersionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRetireKeyVersions.RetireKeyVersionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmRetireKeyVersions.RetireKeyVersionsMock.defaultExpectation.params
+		mm_want_ptrs := mmRetireKeyVersions.RetireKeyVersionsMock.defaultExpectation.paramPtrs
+
+		mm_got := SecretRepositoryMockRetireKeyVersionsParams{ctx, keyName, minVersion}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRetireKeyVersions.t.Errorf("SecretRepositoryMock.RetireKeyVersions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetireKeyVersions.RetireKeyVersionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keyName != nil && !minimock.Equal(*mm_want_ptrs.keyName, mm_got.keyName) {
+				mmRetireKeyVersions.t.Errorf("SecretRepositoryMock.RetireKeyVersions got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetireKeyVersions.RetireKeyVersionsMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
+			}
+
+			if mm_want_ptrs.minVersion != nil && !minimock.Equal(*mm_want_ptrs.minVersion, mm_got.minVersion) {
+				mmRetireKeyVersions.t.Errorf("SecretRepositoryMock.RetireKeyVersions got unexpected parameter minVersion, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRetireKeyVersions.RetireKeyVersionsMock.defaultExpectation.expectationOrigins.originMinVersion, *mm_want_ptrs.minVersion, mm_got.minVersion, minimock.Diff(*mm_want_ptrs.minVersion, mm_got.minVersion))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRetireKeyVersions.t.Errorf("SecretRepositoryMock.RetireKeyVersions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRetireKeyVersions.RetireKeyVersionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRetireKeyVersions.RetireKeyVersionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRetireKeyVersions.t.Fatal("No results are set for the SecretRepositoryMock.RetireKeyVersions")
+		}
+		return (*mm_results).err
+	}
+	if mmRetireKeyVersions.funcRetireKeyVersions != nil {
+		return mmRetireKeyVersions.funcRetireKeyVersions(ctx, keyName, minVersion)
+	}
+	mmRetireKeyVersions.t.Fatalf("Unexpected call to SecretRepositoryMock.RetireKeyVersions. %v %v %v", ctx, keyName, minVersion)
+	return
+}
+
+// RetireKeyVersionsAfterCounter returns a count of finished SecretRepositoryMock.RetireKeyVersions invocations
+func (mmRetireKeyVersions *SecretRepositoryMock) RetireKeyVersionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetireKeyVersions.afterRetireKeyVersionsCounter)
+}
+
+// RetireKeyVersionsBeforeCounter returns a count of SecretRepositoryMock.RetireKeyVersions invocations
+func (mmRetireKeyVersions *SecretRepositoryMock) RetireKeyVersionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRetireKeyVersions.beforeRetireKeyVersionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to SecretRepositoryMock.RetireKeyVersions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRetireKeyVersions *mSecretRepositoryMockRetireKeyVersions) Calls() []*SecretRepositoryMockRetireKeyVersionsParams {
+	mmRetireKeyVersions.mutex.RLock()
+
+	argCopy := make([]*SecretRepositoryMockRetireKeyVersionsParams, len(mmRetireKeyVersions.callArgs))
+	copy(argCopy, mmRetireKeyVersions.callArgs)
+
+	mmRetireKeyVersions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRetireKeyVersionsDone returns true if the count of the RetireKeyVersions invocations corresponds
+// the number of defined expectations
+func (m *SecretRepositoryMock) MinimockRetireKeyVersionsDone() bool {
+	if m.RetireKeyVersionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RetireKeyVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RetireKeyVersionsMock.invocationsDone()
+}
+
+// MinimockRetireKeyVersionsInspect logs each unmet expectation
+func (m *SecretRepositoryMock) MinimockRetireKeyVersionsInspect() {
+	for _, e := range m.RetireKeyVersionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SecretRepositoryMock.RetireKeyVersions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRetireKeyVersionsCounter := mm_atomic.LoadUint64(&m.afterRetireKeyVersionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RetireKeyVersionsMock.defaultExpectation != nil && afterRetireKeyVersionsCounter < 1 {
+		if m.RetireKeyVersionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SecretRepositoryMock.RetireKeyVersions at\n%s", m.RetireKeyVersionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SecretRepositoryMock.RetireKeyVersions at\n%s with params: %#v", m.RetireKeyVersionsMock.defaultExpectation.expectationOrigins.origin, *m.RetireKeyVersionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRetireKeyVersions != nil && afterRetireKeyVersionsCounter < 1 {
+		m.t.Errorf("Expected call to SecretRepositoryMock.RetireKeyVersions at\n%s", m.funcRetireKeyVersionsOrigin)
+	}
+
+	if !m.RetireKeyVersionsMock.invocationsDone() && afterRetireKeyVersionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to SecretRepositoryMock.RetireKeyVersions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RetireKeyVersionsMock.expectedInvocations), m.RetireKeyVersionsMock.expectedInvocationsOrigin, afterRetireKeyVersionsCounter)
+	}
+}
+
+type mSecretRepositoryMockRotateKey struct {
+	optional           bool
+	mock               *SecretRepositoryMock
+	defaultExpectation *SecretRepositoryMockRotateKeyExpectation
+	expectations       []*SecretRepositoryMockRotateKeyExpectation
+
+	callArgs []*SecretRepositoryMockRotateKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SecretRepositoryMockRotateKeyExpectation specifies expectation struct of the SecretRepository.RotateKey
+type SecretRepositoryMockRotateKeyExpectation struct {
+	mock               *SecretRepositoryMock
+	params             *SecretRepositoryMockRotateKeyParams
+	paramPtrs          *SecretRepositoryMockRotateKeyParamPtrs
+	expectationOrigins SecretRepositoryMockRotateKeyExpectationOrigins
+	results            *SecretRepositoryMockRotateKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SecretRepositoryMockRotateKeyParams contains parameters of the SecretRepository.RotateKey
+type SecretRepositoryMockRotateKeyParams struct {
+	ctx     context.Context
+	keyName string
+}
+
+// SecretRepositoryMockRotateKeyParamPtrs contains pointers to parameters of the SecretRepository.RotateKey
+type SecretRepositoryMockRotateKeyParamPtrs struct {
+	ctx     *context.Context
+	keyName *string
+}
+
+// SecretRepositoryMockRotateKeyResults contains results of the SecretRepository.RotateKey
+type SecretRepositoryMockRotateKeyResults struct {
+	s1  string
+	err error
+}
+
+// SecretRepositoryMockRotateKeyOrigins contains origins of expectations of the SecretRepository.RotateKey
+type SecretRepositoryMockRotateKeyExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originKeyName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRotateKey *mSecretRepositoryMockRotateKey) Optional() *mSecretRepositoryMockRotateKey {
+	mmRotateKey.optional = true
+	return mmRotateKey
+}
+
+// Expect sets up expected params for SecretRepository.RotateKey
+func (mmRotateKey *mSecretRepositoryMockRotateKey) Expect(ctx context.Context, keyName string) *mSecretRepositoryMockRotateKey {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("SecretRepositoryMock.RotateKey mock is already set by Set")
+	}
+
+	if mmRotateKey.defaultExpectation == nil {
+		mmRotateKey.defaultExpectation = &SecretRepositoryMockRotateKeyExpectation{}
+	}
+
+	if mmRotateKey.defaultExpectation.paramPtrs != nil {
+		mmRotateKey.mock.t.Fatalf("SecretRepositoryMock.RotateKey mock is already set by ExpectParams functions")
+	}
+
+	mmRotateKey.defaultExpectation.params = &SecretRepositoryMockRotateKeyParams{ctx, keyName}
+	mmRotateKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRotateKey.expectations {
+		if minimock.Equal(e.params, mmRotateKey.defaultExpectation.params) {
+			mmRotateKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRotateKey.defaultExpectation.params)
+		}
+	}
+
+	return mmRotateKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SecretRepository.RotateKey
+func (mmRotateKey *mSecretRepositoryMockRotateKey) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockRotateKey {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("SecretRepositoryMock.RotateKey mock is already set by Set")
+	}
+
+	if mmRotateKey.defaultExpectation == nil {
+		mmRotateKey.defaultExpectation = &SecretRepositoryMockRotateKeyExpectation{}
+	}
+
+	if mmRotateKey.defaultExpectation.params != nil {
+		mmRotateKey.mock.t.Fatalf("SecretRepositoryMock.RotateKey mock is already set by Expect")
+	}
+
+	if mmRotateKey.defaultExpectation.paramPtrs == nil {
+		mmRotateKey.defaultExpectation.paramPtrs = &SecretRepositoryMockRotateKeyParamPtrs{}
+	}
+	mmRotateKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRotateKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRotateKey
+}
+
+// ExpectKeyNameParam2 sets up expected param keyName for SecretRepository.RotateKey
+func (mmRotateKey *mSecretRepositoryMockRotateKey) ExpectKeyNameParam2(keyName string) *mSecretRepositoryMockRotateKey {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("SecretRepositoryMock.RotateKey mock is already set by Set")
+	}
+
+	if mmRotateKey.defaultExpectation == nil {
+		mmRotateKey.defaultExpectation = &SecretRepositoryMockRotateKeyExpectation{}
+	}
+
+	if mmRotateKey.defaultExpectation.params != nil {
+		mmRotateKey.mock.t.Fatalf("SecretRepositoryMock.RotateKey mock is already set by Expect")
+	}
+
+	if mmRotateKey.defaultExpectation.paramPtrs == nil {
+		mmRotateKey.defaultExpectation.paramPtrs = &SecretRepositoryMockRotateKeyParamPtrs{}
+	}
+	mmRotateKey.defaultExpectation.paramPtrs.keyName = &keyName
+	mmRotateKey.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
+
+	return mmRotateKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the SecretRepository.RotateKey
+func (mmRotateKey *mSecretRepositoryMockRotateKey) Inspect(f func(ctx context.Context, keyName string)) *mSecretRepositoryMockRotateKey {
+	if mmRotateKey.mock.inspectFuncRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.RotateKey")
+	}
+
+	mmRotateKey.mock.inspectFuncRotateKey = f
+
+	return mmRotateKey
+}
+
+// Return sets up results that will be returned by SecretRepository.RotateKey
+func (mmRotateKey *mSecretRepositoryMockRotateKey) Return(s1 string, err error) *SecretRepositoryMock {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("SecretRepositoryMock.RotateKey mock is already set by Set")
+	}
+
+	if mmRotateKey.defaultExpectation == nil {
+		mmRotateKey.defaultExpectation = &SecretRepositoryMockRotateKeyExpectation{mock: mmRotateKey.mock}
+	}
+	mmRotateKey.defaultExpectation.results = &SecretRepositoryMockRotateKeyResults{s1, err}
+	mmRotateKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRotateKey.mock
+}
+
+// Set uses given function f to mock the SecretRepository.RotateKey method
+func (mmRotateKey *mSecretRepositoryMockRotateKey) Set(f func(ctx context.Context, keyName string) (s1 string, err error)) *SecretRepositoryMock {
+	if mmRotateKey.defaultExpectation != nil {
+		mmRotateKey.mock.t.Fatalf("Default expectation is already set for the SecretRepository.RotateKey method")
+	}
+
+	if len(mmRotateKey.expectations) > 0 {
+		mmRotateKey.mock.t.Fatalf("Some expectations are already set for the SecretRepository.RotateKey method")
+	}
+
+	mmRotateKey.mock.funcRotateKey = f
+	mmRotateKey.mock.funcRotateKeyOrigin = minimock.CallerInfo(1)
+	return mmRotateKey.mock
+}
+
+// When sets expectation for the SecretRepository.RotateKey which will trigger the result defined by the following
+// Then helper
+func (mmRotateKey *mSecretRepositoryMockRotateKey) When(ctx context.Context, keyName string) *SecretRepositoryMockRotateKeyExpectation {
+	if mmRotateKey.mock.funcRotateKey != nil {
+		mmRotateKey.mock.t.Fatalf("SecretRepositoryMock.RotateKey mock is already set by Set")
+	}
+
+	expectation := &SecretRepositoryMockRotateKeyExpectation{
+		mock:               mmRotateKey.mock,
+		params:             &SecretRepositoryMockRotateKeyParams{ctx, keyName},
+		expectationOrigins: SecretRepositoryMockRotateKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRotateKey.expectations = append(mmRotateKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SecretRepository.RotateKey return parameters for the expectation previously defined by the When method
+func (e *SecretRepositoryMockRotateKeyExpectation) Then(s1 string, err error) *SecretRepositoryMock {
+	e.results = &SecretRepositoryMockRotateKeyResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times SecretRepository.RotateKey should be invoked
+func (mmRotateKey *mSecretRepositoryMockRotateKey) Times(n uint64) *mSecretRepositoryMockRotateKey {
+	if n == 0 {
+		mmRotateKey.mock.t.Fatalf("Times of SecretRepositoryMock.RotateKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRotateKey.expectedInvocations, n)
+	mmRotateKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRotateKey
+}
+
+func (mmRotateKey *mSecretRepositoryMockRotateKey) invocationsDone() bool {
+	if len(mmRotateKey.expectations) == 0 && mmRotateKey.defaultExpectation == nil && mmRotateKey.mock.funcRotateKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRotateKey.mock.afterRotateKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRotateKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RotateKey implements mm_repository.SecretRepository
+func (mmRotateKey *SecretRepositoryMock) RotateKey(ctx context.Context, keyName string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmRotateKey.beforeRotateKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRotateKey.afterRotateKeyCounter, 1)
+
+	mmRotateKey.t.Helper()
+
+	if mmRotateKey.inspectFuncRotateKey != nil {
+		mmRotateKey.inspectFuncRotateKey(ctx, keyName)
+	}
+
+	mm_params := SecretRepositoryMockRotateKeyParams{ctx, keyName}
+
+	// Record call args
+	mmRotateKey.RotateKeyMock.mutex.Lock()
+	mmRotateKey.RotateKeyMock.callArgs = append(mmRotateKey.RotateKeyMock.callArgs, &mm_params)
+	mmRotateKey.RotateKeyMock.mutex.Unlock()
 
-	for _, e := range mmGetPublicKeys.GetPublicKeysMock.expectations {
+	for _, e := range mmRotateKey.RotateKeyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.m1, e.results.err
+			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmGetPublicKeys.GetPublicKeysMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.params
-		mm_want_ptrs := mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.paramPtrs
+	if mmRotateKey.RotateKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRotateKey.RotateKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRotateKey.RotateKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmRotateKey.RotateKeyMock.defaultExpectation.paramPtrs
 
-		mm_got := SecretRepositoryMockGetPublicKeysParams{ctx, keyName}
+		mm_got := SecretRepositoryMockRotateKeyParams{ctx, keyName}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetPublicKeys.t.Errorf("SecretRepositoryMock.GetPublicKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRotateKey.t.Errorf("SecretRepositoryMock.RotateKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateKey.RotateKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.keyName != nil && !minimock.Equal(*mm_want_ptrs.keyName, mm_got.keyName) {
-				mmGetPublicKeys.t.Errorf("SecretRepositoryMock.GetPublicKeys got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
+				mmRotateKey.t.Errorf("SecretRepositoryMock.RotateKey got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRotateKey.RotateKeyMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetPublicKeys.t.Errorf("SecretRepositoryMock.GetPublicKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRotateKey.t.Errorf("SecretRepositoryMock.RotateKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRotateKey.RotateKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetPublicKeys.GetPublicKeysMock.defaultExpectation.results
+		mm_results := mmRotateKey.RotateKeyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetPublicKeys.t.Fatal("No results are set for the SecretRepositoryMock.GetPublicKeys")
+			mmRotateKey.t.Fatal("No results are set for the SecretRepositoryMock.RotateKey")
 		}
-		return (*mm_results).m1, (*mm_results).err
+		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmGetPublicKeys.funcGetPublicKeys != nil {
-		return mmGetPublicKeys.funcGetPublicKeys(ctx, keyName)
+	if mmRotateKey.funcRotateKey != nil {
+		return mmRotateKey.funcRotateKey(ctx, keyName)
 	}
-	mmGetPublicKeys.t.Fatalf("Unexpected call to SecretRepositoryMock.GetPublicKeys. %v %v", ctx, keyName)
+	mmRotateKey.t.Fatalf("Unexpected call to SecretRepositoryMock.RotateKey. %v %v", ctx, keyName)
 	return
 }
 
-// GetPublicKeysAfterCounter returns a count of finished SecretRepositoryMock.GetPublicKeys invocations
-func (mmGetPublicKeys *SecretRepositoryMock) GetPublicKeysAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPublicKeys.afterGetPublicKeysCounter)
+// RotateKeyAfterCounter returns a count of finished SecretRepositoryMock.RotateKey invocations
+func (mmRotateKey *SecretRepositoryMock) RotateKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotateKey.afterRotateKeyCounter)
 }
 
-// GetPublicKeysBeforeCounter returns a count of SecretRepositoryMock.GetPublicKeys invocations
-func (mmGetPublicKeys *SecretRepositoryMock) GetPublicKeysBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetPublicKeys.beforeGetPublicKeysCounter)
+// RotateKeyBeforeCounter returns a count of SecretRepositoryMock.RotateKey invocations
+func (mmRotateKey *SecretRepositoryMock) RotateKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRotateKey.beforeRotateKeyCounter)
 }
 
-// Calls returns a list of arguments used in each call to SecretRepositoryMock.GetPublicKeys.
+// Calls returns a list of arguments used in each call to SecretRepositoryMock.RotateKey.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetPublicKeys *mSecretRepositoryMockGetPublicKeys) Calls() []*SecretRepositoryMockGetPublicKeysParams {
-	mmGetPublicKeys.mutex.RLock()
+func (mmRotateKey *mSecretRepositoryMockRotateKey) Calls() []*SecretRepositoryMockRotateKeyParams {
+	mmRotateKey.mutex.RLock()
 
-	argCopy := make([]*SecretRepositoryMockGetPublicKeysParams, len(mmGetPublicKeys.callArgs))
-	copy(argCopy, mmGetPublicKeys.callArgs)
+	argCopy := make([]*SecretRepositoryMockRotateKeyParams, len(mmRotateKey.callArgs))
+	copy(argCopy, mmRotateKey.callArgs)
 
-	mmGetPublicKeys.mutex.RUnlock()
+	mmRotateKey.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetPublicKeysDone returns true if the count of the GetPublicKeys invocations corresponds
+// MinimockRotateKeyDone returns true if the count of the RotateKey invocations corresponds
 // the number of defined expectations
-func (m *SecretRepositoryMock) MinimockGetPublicKeysDone() bool {
-	if m.GetPublicKeysMock.optional {
+func (m *SecretRepositoryMock) MinimockRotateKeyDone() bool {
+	if m.RotateKeyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetPublicKeysMock.expectations {
+	for _, e := range m.RotateKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetPublicKeysMock.invocationsDone()
+	return m.RotateKeyMock.invocationsDone()
 }
 
-// MinimockGetPublicKeysInspect logs each unmet expectation
-func (m *SecretRepositoryMock) MinimockGetPublicKeysInspect() {
-	for _, e := range m.GetPublicKeysMock.expectations {
+// MinimockRotateKeyInspect logs each unmet expectation
+func (m *SecretRepositoryMock) MinimockRotateKeyInspect() {
+	for _, e := range m.RotateKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to SecretRepositoryMock.GetPublicKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to SecretRepositoryMock.RotateKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetPublicKeysCounter := mm_atomic.LoadUint64(&m.afterGetPublicKeysCounter)
+	afterRotateKeyCounter := mm_atomic.LoadUint64(&m.afterRotateKeyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetPublicKeysMock.defaultExpectation != nil && afterGetPublicKeysCounter < 1 {
-		if m.GetPublicKeysMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to SecretRepositoryMock.GetPublicKeys at\n%s", m.GetPublicKeysMock.defaultExpectation.returnOrigin)
+	if m.RotateKeyMock.defaultExpectation != nil && afterRotateKeyCounter < 1 {
+		if m.RotateKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SecretRepositoryMock.RotateKey at\n%s", m.RotateKeyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to SecretRepositoryMock.GetPublicKeys at\n%s with params: %#v", m.GetPublicKeysMock.defaultExpectation.expectationOrigins.origin, *m.GetPublicKeysMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to SecretRepositoryMock.RotateKey at\n%s with params: %#v", m.RotateKeyMock.defaultExpectation.expectationOrigins.origin, *m.RotateKeyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetPublicKeys != nil && afterGetPublicKeysCounter < 1 {
-		m.t.Errorf("Expected call to SecretRepositoryMock.GetPublicKeys at\n%s", m.funcGetPublicKeysOrigin)
+	if m.funcRotateKey != nil && afterRotateKeyCounter < 1 {
+		m.t.Errorf("Expected call to SecretRepositoryMock.RotateKey at\n%s", m.funcRotateKeyOrigin)
 	}
 
-	if !m.GetPublicKeysMock.invocationsDone() && afterGetPublicKeysCounter > 0 {
-		m.t.Errorf("Expected %d calls to SecretRepositoryMock.GetPublicKeys at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetPublicKeysMock.expectedInvocations), m.GetPublicKeysMock.expectedInvocationsOrigin, afterGetPublicKeysCounter)
+	if !m.RotateKeyMock.invocationsDone() && afterRotateKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to SecretRepositoryMock.RotateKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RotateKeyMock.expectedInvocations), m.RotateKeyMock.expectedInvocationsOrigin, afterRotateKeyCounter)
 	}
 }
 
@@ -777,6 +2645,7 @@ type SecretRepositoryMockSignJWTParams struct {
 	ctx     context.Context
 	data    string
 	keyName string
+	version string
 }
 
 // SecretRepositoryMockSignJWTParamPtrs contains pointers to parameters of the SecretRepository.SignJWT
@@ -784,6 +2653,7 @@ type SecretRepositoryMockSignJWTParamPtrs struct {
 	ctx     *context.Context
 	data    *string
 	keyName *string
+	version *string
 }
 
 // SecretRepositoryMockSignJWTResults contains results of the SecretRepository.SignJWT
@@ -798,6 +2668,7 @@ type SecretRepositoryMockSignJWTExpectationOrigins struct {
 	originCtx     string
 	originData    string
 	originKeyName string
+	originVersion string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -811,7 +2682,7 @@ func (mmSignJWT *mSecretRepositoryMockSignJWT) Optional() *mSecretRepositoryMock
 }
 
 // Expect sets up expected params for SecretRepository.SignJWT
-func (mmSignJWT *mSecretRepositoryMockSignJWT) Expect(ctx context.Context, data string, keyName string) *mSecretRepositoryMockSignJWT {
+func (mmSignJWT *mSecretRepositoryMockSignJWT) Expect(ctx context.Context, data string, keyName string, version string) *mSecretRepositoryMockSignJWT {
 	if mmSignJWT.mock.funcSignJWT != nil {
 		mmSignJWT.mock.t.Fatalf("SecretRepositoryMock.SignJWT mock is already set by Set")
 	}
@@ -824,7 +2695,7 @@ func (mmSignJWT *mSecretRepositoryMockSignJWT) Expect(ctx context.Context, data
 		mmSignJWT.mock.t.Fatalf("SecretRepositoryMock.SignJWT mock is already set by ExpectParams functions")
 	}
 
-	mmSignJWT.defaultExpectation.params = &SecretRepositoryMockSignJWTParams{ctx, data, keyName}
+	mmSignJWT.defaultExpectation.params = &SecretRepositoryMockSignJWTParams{ctx, data, keyName, version}
 	mmSignJWT.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmSignJWT.expectations {
 		if minimock.Equal(e.params, mmSignJWT.defaultExpectation.params) {
@@ -904,8 +2775,31 @@ func (mmSignJWT *mSecretRepositoryMockSignJWT) ExpectKeyNameParam3(keyName strin
 	return mmSignJWT
 }
 
+// ExpectVersionParam4 sets up expected param version for SecretRepository.SignJWT
+func (mmSignJWT *mSecretRepositoryMockSignJWT) ExpectVersionParam4(version string) *mSecretRepositoryMockSignJWT {
+	if mmSignJWT.mock.funcSignJWT != nil {
+		mmSignJWT.mock.t.Fatalf("SecretRepositoryMock.SignJWT mock is already set by Set")
+	}
+
+	if mmSignJWT.defaultExpectation == nil {
+		mmSignJWT.defaultExpectation = &SecretRepositoryMockSignJWTExpectation{}
+	}
+
+	if mmSignJWT.defaultExpectation.params != nil {
+		mmSignJWT.mock.t.Fatalf("SecretRepositoryMock.SignJWT mock is already set by Expect")
+	}
+
+	if mmSignJWT.defaultExpectation.paramPtrs == nil {
+		mmSignJWT.defaultExpectation.paramPtrs = &SecretRepositoryMockSignJWTParamPtrs{}
+	}
+	mmSignJWT.defaultExpectation.paramPtrs.version = &version
+	mmSignJWT.defaultExpectation.expectationOrigins.originVersion = minimock.CallerInfo(1)
+
+	return mmSignJWT
+}
+
 // Inspect accepts an inspector function that has same arguments as the SecretRepository.SignJWT
-func (mmSignJWT *mSecretRepositoryMockSignJWT) Inspect(f func(ctx context.Context, data string, keyName string)) *mSecretRepositoryMockSignJWT {
+func (mmSignJWT *mSecretRepositoryMockSignJWT) Inspect(f func(ctx context.Context, data string, keyName string, version string)) *mSecretRepositoryMockSignJWT {
 	if mmSignJWT.mock.inspectFuncSignJWT != nil {
 		mmSignJWT.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.SignJWT")
 	}
@@ -930,7 +2824,7 @@ func (mmSignJWT *mSecretRepositoryMockSignJWT) Return(s1 string, err error) *Sec
 }
 
 // Set uses given function f to mock the SecretRepository.SignJWT method
-func (mmSignJWT *mSecretRepositoryMockSignJWT) Set(f func(ctx context.Context, data string, keyName string) (s1 string, err error)) *SecretRepositoryMock {
+func (mmSignJWT *mSecretRepositoryMockSignJWT) Set(f func(ctx context.Context, data string, keyName string, version string) (s1 string, err error)) *SecretRepositoryMock {
 	if mmSignJWT.defaultExpectation != nil {
 		mmSignJWT.mock.t.Fatalf("Default expectation is already set for the SecretRepository.SignJWT method")
 	}
@@ -946,14 +2840,14 @@ func (mmSignJWT *mSecretRepositoryMockSignJWT) Set(f func(ctx context.Context, d
 
 // When sets expectation for the SecretRepository.SignJWT which will trigger the result defined by the following
 // Then helper
-func (mmSignJWT *mSecretRepositoryMockSignJWT) When(ctx context.Context, data string, keyName string) *SecretRepositoryMockSignJWTExpectation {
+func (mmSignJWT *mSecretRepositoryMockSignJWT) When(ctx context.Context, data string, keyName string, version string) *SecretRepositoryMockSignJWTExpectation {
 	if mmSignJWT.mock.funcSignJWT != nil {
 		mmSignJWT.mock.t.Fatalf("SecretRepositoryMock.SignJWT mock is already set by Set")
 	}
 
 	expectation := &SecretRepositoryMockSignJWTExpectation{
 		mock:               mmSignJWT.mock,
-		params:             &SecretRepositoryMockSignJWTParams{ctx, data, keyName},
+		params:             &SecretRepositoryMockSignJWTParams{ctx, data, keyName, version},
 		expectationOrigins: SecretRepositoryMockSignJWTExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmSignJWT.expectations = append(mmSignJWT.expectations, expectation)
@@ -988,17 +2882,17 @@ func (mmSignJWT *mSecretRepositoryMockSignJWT) invocationsDone() bool {
 }
 
 // SignJWT implements mm_repository.SecretRepository
-func (mmSignJWT *SecretRepositoryMock) SignJWT(ctx context.Context, data string, keyName string) (s1 string, err error) {
+func (mmSignJWT *SecretRepositoryMock) SignJWT(ctx context.Context, data string, keyName string, version string) (s1 string, err error) {
 	mm_atomic.AddUint64(&mmSignJWT.beforeSignJWTCounter, 1)
 	defer mm_atomic.AddUint64(&mmSignJWT.afterSignJWTCounter, 1)
 
 	mmSignJWT.t.Helper()
 
 	if mmSignJWT.inspectFuncSignJWT != nil {
-		mmSignJWT.inspectFuncSignJWT(ctx, data, keyName)
+		mmSignJWT.inspectFuncSignJWT(ctx, data, keyName, version)
 	}
 
-	mm_params := SecretRepositoryMockSignJWTParams{ctx, data, keyName}
+	mm_params := SecretRepositoryMockSignJWTParams{ctx, data, keyName, version}
 
 	// Record call args
 	mmSignJWT.SignJWTMock.mutex.Lock()
@@ -1017,7 +2911,7 @@ func (mmSignJWT *SecretRepositoryMock) SignJWT(ctx context.Context, data string,
 		mm_want := mmSignJWT.SignJWTMock.defaultExpectation.params
 		mm_want_ptrs := mmSignJWT.SignJWTMock.defaultExpectation.paramPtrs
 
-		mm_got := SecretRepositoryMockSignJWTParams{ctx, data, keyName}
+		mm_got := SecretRepositoryMockSignJWTParams{ctx, data, keyName, version}
 
 		if mm_want_ptrs != nil {
 
@@ -1036,6 +2930,11 @@ func (mmSignJWT *SecretRepositoryMock) SignJWT(ctx context.Context, data string,
 					mmSignJWT.SignJWTMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
 			}
 
+			if mm_want_ptrs.version != nil && !minimock.Equal(*mm_want_ptrs.version, mm_got.version) {
+				mmSignJWT.t.Errorf("SecretRepositoryMock.SignJWT got unexpected parameter version, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignJWT.SignJWTMock.defaultExpectation.expectationOrigins.originVersion, *mm_want_ptrs.version, mm_got.version, minimock.Diff(*mm_want_ptrs.version, mm_got.version))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmSignJWT.t.Errorf("SecretRepositoryMock.SignJWT got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmSignJWT.SignJWTMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1048,9 +2947,9 @@ func (mmSignJWT *SecretRepositoryMock) SignJWT(ctx context.Context, data string,
 		return (*mm_results).s1, (*mm_results).err
 	}
 	if mmSignJWT.funcSignJWT != nil {
-		return mmSignJWT.funcSignJWT(ctx, data, keyName)
+		return mmSignJWT.funcSignJWT(ctx, data, keyName, version)
 	}
-	mmSignJWT.t.Fatalf("Unexpected call to SecretRepositoryMock.SignJWT. %v %v %v", ctx, data, keyName)
+	mmSignJWT.t.Fatalf("Unexpected call to SecretRepositoryMock.SignJWT. %v %v %v %v", ctx, data, keyName, version)
 	return
 }
 
@@ -1122,15 +3021,370 @@ func (m *SecretRepositoryMock) MinimockSignJWTInspect() {
 	}
 }
 
+type mSecretRepositoryMockSigningAlgorithm struct {
+	optional           bool
+	mock               *SecretRepositoryMock
+	defaultExpectation *SecretRepositoryMockSigningAlgorithmExpectation
+	expectations       []*SecretRepositoryMockSigningAlgorithmExpectation
+
+	callArgs []*SecretRepositoryMockSigningAlgorithmParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SecretRepositoryMockSigningAlgorithmExpectation specifies expectation struct of the SecretRepository.SigningAlgorithm
+type SecretRepositoryMockSigningAlgorithmExpectation struct {
+	mock               *SecretRepositoryMock
+	params             *SecretRepositoryMockSigningAlgorithmParams
+	paramPtrs          *SecretRepositoryMockSigningAlgorithmParamPtrs
+	expectationOrigins SecretRepositoryMockSigningAlgorithmExpectationOrigins
+	results            *SecretRepositoryMockSigningAlgorithmResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SecretRepositoryMockSigningAlgorithmParams contains parameters of the SecretRepository.SigningAlgorithm
+type SecretRepositoryMockSigningAlgorithmParams struct {
+	ctx     context.Context
+	keyName string
+}
+
+// SecretRepositoryMockSigningAlgorithmParamPtrs contains pointers to parameters of the SecretRepository.SigningAlgorithm
+type SecretRepositoryMockSigningAlgorithmParamPtrs struct {
+	ctx     *context.Context
+	keyName *string
+}
+
+// SecretRepositoryMockSigningAlgorithmResults contains results of the SecretRepository.SigningAlgorithm
+type SecretRepositoryMockSigningAlgorithmResults struct {
+	s1  string
+	err error
+}
+
+// SecretRepositoryMockSigningAlgorithmOrigins contains origins of expectations of the SecretRepository.SigningAlgorithm
+type SecretRepositoryMockSigningAlgorithmExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originKeyName string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) Optional() *mSecretRepositoryMockSigningAlgorithm {
+	mmSigningAlgorithm.optional = true
+	return mmSigningAlgorithm
+}
+
+// Expect sets up expected params for SecretRepository.SigningAlgorithm
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) Expect(ctx context.Context, keyName string) *mSecretRepositoryMockSigningAlgorithm {
+	if mmSigningAlgorithm.mock.funcSigningAlgorithm != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("SecretRepositoryMock.SigningAlgorithm mock is already set by Set")
+	}
+
+	if mmSigningAlgorithm.defaultExpectation == nil {
+		mmSigningAlgorithm.defaultExpectation = &SecretRepositoryMockSigningAlgorithmExpectation{}
+	}
+
+	if mmSigningAlgorithm.defaultExpectation.paramPtrs != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("SecretRepositoryMock.SigningAlgorithm mock is already set by ExpectParams functions")
+	}
+
+	mmSigningAlgorithm.defaultExpectation.params = &SecretRepositoryMockSigningAlgorithmParams{ctx, keyName}
+	mmSigningAlgorithm.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSigningAlgorithm.expectations {
+		if minimock.Equal(e.params, mmSigningAlgorithm.defaultExpectation.params) {
+			mmSigningAlgorithm.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSigningAlgorithm.defaultExpectation.params)
+		}
+	}
+
+	return mmSigningAlgorithm
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SecretRepository.SigningAlgorithm
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) ExpectCtxParam1(ctx context.Context) *mSecretRepositoryMockSigningAlgorithm {
+	if mmSigningAlgorithm.mock.funcSigningAlgorithm != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("SecretRepositoryMock.SigningAlgorithm mock is already set by Set")
+	}
+
+	if mmSigningAlgorithm.defaultExpectation == nil {
+		mmSigningAlgorithm.defaultExpectation = &SecretRepositoryMockSigningAlgorithmExpectation{}
+	}
+
+	if mmSigningAlgorithm.defaultExpectation.params != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("SecretRepositoryMock.SigningAlgorithm mock is already set by Expect")
+	}
+
+	if mmSigningAlgorithm.defaultExpectation.paramPtrs == nil {
+		mmSigningAlgorithm.defaultExpectation.paramPtrs = &SecretRepositoryMockSigningAlgorithmParamPtrs{}
+	}
+	mmSigningAlgorithm.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSigningAlgorithm.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSigningAlgorithm
+}
+
+// ExpectKeyNameParam2 sets up expected param keyName for SecretRepository.SigningAlgorithm
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) ExpectKeyNameParam2(keyName string) *mSecretRepositoryMockSigningAlgorithm {
+	if mmSigningAlgorithm.mock.funcSigningAlgorithm != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("SecretRepositoryMock.SigningAlgorithm mock is already set by Set")
+	}
+
+	if mmSigningAlgorithm.defaultExpectation == nil {
+		mmSigningAlgorithm.defaultExpectation = &SecretRepositoryMockSigningAlgorithmExpectation{}
+	}
+
+	if mmSigningAlgorithm.defaultExpectation.params != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("SecretRepositoryMock.SigningAlgorithm mock is already set by Expect")
+	}
+
+	if mmSigningAlgorithm.defaultExpectation.paramPtrs == nil {
+		mmSigningAlgorithm.defaultExpectation.paramPtrs = &SecretRepositoryMockSigningAlgorithmParamPtrs{}
+	}
+	mmSigningAlgorithm.defaultExpectation.paramPtrs.keyName = &keyName
+	mmSigningAlgorithm.defaultExpectation.expectationOrigins.originKeyName = minimock.CallerInfo(1)
+
+	return mmSigningAlgorithm
+}
+
+// Inspect accepts an inspector function that has same arguments as the SecretRepository.SigningAlgorithm
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) Inspect(f func(ctx context.Context, keyName string)) *mSecretRepositoryMockSigningAlgorithm {
+	if mmSigningAlgorithm.mock.inspectFuncSigningAlgorithm != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("Inspect function is already set for SecretRepositoryMock.SigningAlgorithm")
+	}
+
+	mmSigningAlgorithm.mock.inspectFuncSigningAlgorithm = f
+
+	return mmSigningAlgorithm
+}
+
+// Return sets up results that will be returned by SecretRepository.SigningAlgorithm
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) Return(s1 string, err error) *SecretRepositoryMock {
+	if mmSigningAlgorithm.mock.funcSigningAlgorithm != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("SecretRepositoryMock.SigningAlgorithm mock is already set by Set")
+	}
+
+	if mmSigningAlgorithm.defaultExpectation == nil {
+		mmSigningAlgorithm.defaultExpectation = &SecretRepositoryMockSigningAlgorithmExpectation{mock: mmSigningAlgorithm.mock}
+	}
+	mmSigningAlgorithm.defaultExpectation.results = &SecretRepositoryMockSigningAlgorithmResults{s1, err}
+	mmSigningAlgorithm.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSigningAlgorithm.mock
+}
+
+// Set uses given function f to mock the SecretRepository.SigningAlgorithm method
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) Set(f func(ctx context.Context, keyName string) (s1 string, err error)) *SecretRepositoryMock {
+	if mmSigningAlgorithm.defaultExpectation != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("Default expectation is already set for the SecretRepository.SigningAlgorithm method")
+	}
+
+	if len(mmSigningAlgorithm.expectations) > 0 {
+		mmSigningAlgorithm.mock.t.Fatalf("Some expectations are already set for the SecretRepository.SigningAlgorithm method")
+	}
+
+	mmSigningAlgorithm.mock.funcSigningAlgorithm = f
+	mmSigningAlgorithm.mock.funcSigningAlgorithmOrigin = minimock.CallerInfo(1)
+	return mmSigningAlgorithm.mock
+}
+
+// When sets expectation for the SecretRepository.SigningAlgorithm which will trigger the result defined by the following
+// Then helper
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) When(ctx context.Context, keyName string) *SecretRepositoryMockSigningAlgorithmExpectation {
+	if mmSigningAlgorithm.mock.funcSigningAlgorithm != nil {
+		mmSigningAlgorithm.mock.t.Fatalf("SecretRepositoryMock.SigningAlgorithm mock is already set by Set")
+	}
+
+	expectation := &SecretRepositoryMockSigningAlgorithmExpectation{
+		mock:               mmSigningAlgorithm.mock,
+		params:             &SecretRepositoryMockSigningAlgorithmParams{ctx, keyName},
+		expectationOrigins: SecretRepositoryMockSigningAlgorithmExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSigningAlgorithm.expectations = append(mmSigningAlgorithm.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SecretRepository.SigningAlgorithm return parameters for the expectation previously defined by the When method
+func (e *SecretRepositoryMockSigningAlgorithmExpectation) Then(s1 string, err error) *SecretRepositoryMock {
+	e.results = &SecretRepositoryMockSigningAlgorithmResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times SecretRepository.SigningAlgorithm should be invoked
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) Times(n uint64) *mSecretRepositoryMockSigningAlgorithm {
+	if n == 0 {
+		mmSigningAlgorithm.mock.t.Fatalf("Times of SecretRepositoryMock.SigningAlgorithm mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSigningAlgorithm.expectedInvocations, n)
+	mmSigningAlgorithm.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSigningAlgorithm
+}
+
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) invocationsDone() bool {
+	if len(mmSigningAlgorithm.expectations) == 0 && mmSigningAlgorithm.defaultExpectation == nil && mmSigningAlgorithm.mock.funcSigningAlgorithm == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSigningAlgorithm.mock.afterSigningAlgorithmCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSigningAlgorithm.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SigningAlgorithm implements mm_repository.SecretRepository
+func (mmSigningAlgorithm *SecretRepositoryMock) SigningAlgorithm(ctx context.Context, keyName string) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmSigningAlgorithm.beforeSigningAlgorithmCounter, 1)
+	defer mm_atomic.AddUint64(&mmSigningAlgorithm.afterSigningAlgorithmCounter, 1)
+
+	mmSigningAlgorithm.t.Helper()
+
+	if mmSigningAlgorithm.inspectFuncSigningAlgorithm != nil {
+		mmSigningAlgorithm.inspectFuncSigningAlgorithm(ctx, keyName)
+	}
+
+	mm_params := SecretRepositoryMockSigningAlgorithmParams{ctx, keyName}
+
+	// Record call args
+	mmSigningAlgorithm.SigningAlgorithmMock.mutex.Lock()
+	mmSigningAlgorithm.SigningAlgorithmMock.callArgs = append(mmSigningAlgorithm.SigningAlgorithmMock.callArgs, &mm_params)
+	mmSigningAlgorithm.SigningAlgorithmMock.mutex.Unlock()
+
+	for _, e := range mmSigningAlgorithm.SigningAlgorithmMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmSigningAlgorithm.SigningAlgorithmMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSigningAlgorithm.SigningAlgorithmMock.defaultExpectation.Counter, 1)
+		mm_want := mmSigningAlgorithm.SigningAlgorithmMock.defaultExpectation.params
+		mm_want_ptrs := mmSigningAlgorithm.SigningAlgorithmMock.defaultExpectation.paramPtrs
+
+		mm_got := SecretRepositoryMockSigningAlgorithmParams{ctx, keyName}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSigningAlgorithm.t.Errorf("SecretRepositoryMock.SigningAlgorithm got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSigningAlgorithm.SigningAlgorithmMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.keyName != nil && !minimock.Equal(*mm_want_ptrs.keyName, mm_got.keyName) {
+				mmSigningAlgorithm.t.Errorf("SecretRepositoryMock.SigningAlgorithm got unexpected parameter keyName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSigningAlgorithm.SigningAlgorithmMock.defaultExpectation.expectationOrigins.originKeyName, *mm_want_ptrs.keyName, mm_got.keyName, minimock.Diff(*mm_want_ptrs.keyName, mm_got.keyName))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSigningAlgorithm.t.Errorf("SecretRepositoryMock.SigningAlgorithm got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSigningAlgorithm.SigningAlgorithmMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSigningAlgorithm.SigningAlgorithmMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSigningAlgorithm.t.Fatal("No results are set for the SecretRepositoryMock.SigningAlgorithm")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmSigningAlgorithm.funcSigningAlgorithm != nil {
+		return mmSigningAlgorithm.funcSigningAlgorithm(ctx, keyName)
+	}
+	mmSigningAlgorithm.t.Fatalf("Unexpected call to SecretRepositoryMock.SigningAlgorithm. %v %v", ctx, keyName)
+	return
+}
+
+// SigningAlgorithmAfterCounter returns a count of finished SecretRepositoryMock.SigningAlgorithm invocations
+func (mmSigningAlgorithm *SecretRepositoryMock) SigningAlgorithmAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSigningAlgorithm.afterSigningAlgorithmCounter)
+}
+
+// SigningAlgorithmBeforeCounter returns a count of SecretRepositoryMock.SigningAlgorithm invocations
+func (mmSigningAlgorithm *SecretRepositoryMock) SigningAlgorithmBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSigningAlgorithm.beforeSigningAlgorithmCounter)
+}
+
+// Calls returns a list of arguments used in each call to SecretRepositoryMock.SigningAlgorithm.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSigningAlgorithm *mSecretRepositoryMockSigningAlgorithm) Calls() []*SecretRepositoryMockSigningAlgorithmParams {
+	mmSigningAlgorithm.mutex.RLock()
+
+	argCopy := make([]*SecretRepositoryMockSigningAlgorithmParams, len(mmSigningAlgorithm.callArgs))
+	copy(argCopy, mmSigningAlgorithm.callArgs)
+
+	mmSigningAlgorithm.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSigningAlgorithmDone returns true if the count of the SigningAlgorithm invocations corresponds
+// the number of defined expectations
+func (m *SecretRepositoryMock) MinimockSigningAlgorithmDone() bool {
+	if m.SigningAlgorithmMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SigningAlgorithmMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SigningAlgorithmMock.invocationsDone()
+}
+
+// MinimockSigningAlgorithmInspect logs each unmet expectation
+func (m *SecretRepositoryMock) MinimockSigningAlgorithmInspect() {
+	for _, e := range m.SigningAlgorithmMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SecretRepositoryMock.SigningAlgorithm at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSigningAlgorithmCounter := mm_atomic.LoadUint64(&m.afterSigningAlgorithmCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SigningAlgorithmMock.defaultExpectation != nil && afterSigningAlgorithmCounter < 1 {
+		if m.SigningAlgorithmMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SecretRepositoryMock.SigningAlgorithm at\n%s", m.SigningAlgorithmMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SecretRepositoryMock.SigningAlgorithm at\n%s with params: %#v", m.SigningAlgorithmMock.defaultExpectation.expectationOrigins.origin, *m.SigningAlgorithmMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSigningAlgorithm != nil && afterSigningAlgorithmCounter < 1 {
+		m.t.Errorf("Expected call to SecretRepositoryMock.SigningAlgorithm at\n%s", m.funcSigningAlgorithmOrigin)
+	}
+
+	if !m.SigningAlgorithmMock.invocationsDone() && afterSigningAlgorithmCounter > 0 {
+		m.t.Errorf("Expected %d calls to SecretRepositoryMock.SigningAlgorithm at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SigningAlgorithmMock.expectedInvocations), m.SigningAlgorithmMock.expectedInvocationsOrigin, afterSigningAlgorithmCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *SecretRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockDecryptInspect()
+
+			m.MinimockEncryptInspect()
+
 			m.MinimockGetKIDInspect()
 
 			m.MinimockGetPublicKeysInspect()
 
+			m.MinimockKeyVersionsInspect()
+
+			m.MinimockRetireKeyVersionsInspect()
+
+			m.MinimockRotateKeyInspect()
+
 			m.MinimockSignJWTInspect()
+
+			m.MinimockSigningAlgorithmInspect()
 		}
 	})
 }
@@ -1154,7 +3408,13 @@ func (m *SecretRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *SecretRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockDecryptDone() &&
+		m.MinimockEncryptDone() &&
 		m.MinimockGetKIDDone() &&
 		m.MinimockGetPublicKeysDone() &&
-		m.MinimockSignJWTDone()
+		m.MinimockKeyVersionsDone() &&
+		m.MinimockRetireKeyVersionsDone() &&
+		m.MinimockRotateKeyDone() &&
+		m.MinimockSignJWTDone() &&
+		m.MinimockSigningAlgorithmDone()
 }
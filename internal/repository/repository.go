@@ -4,28 +4,105 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/maisiq/go-auth-service/internal/domain"
 )
 
-//go:generate minimock -i IUserRepository,SecretRepository,ITokenRepository -o ./mocks/ -s "_mock.go"
+//go:generate minimock -i IUserRepository,SecretRepository,ITokenRepository,IMFARepository,IWebAuthnRepository,IIdentityRepository,IClientRepository -o ./mocks/ -s "_mock.go"
 type IUserRepository interface {
 	Add(ctx context.Context, user domain.User) error
 	GetByEmail(ctx context.Context, email string) (domain.User, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.User, error)
+	List(ctx context.Context, filter domain.UserFilter) ([]domain.User, int, error)
 	Logs(ctx context.Context, email string) ([]domain.UserLog, error)
 	AddLog(ctx context.Context, log domain.UserLog) error
-	Update(ctx context.Context, user domain.User) error
+	// targeted updates write only their own columns, so concurrent changes of the user don't revert each other
+	UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error
+	UpdateStatus(ctx context.Context, user domain.User) error
+	UpdatePassword(ctx context.Context, id uuid.UUID, hashedPassword string) error
+	// MarkVerified sets verification time unless email is verified already, pending accounts become active
+	MarkVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error
 }
 
 type SecretRepository interface {
 	GetKID(ctx context.Context, keyName string) (string, error)
+	// SigningAlgorithm returns JWS algorithm of the latest version of the key: ES256, ES384, ES512, RS256 or EdDSA
+	SigningAlgorithm(ctx context.Context, keyName string) (string, error)
 	GetPublicKeys(ctx context.Context, keyName string) (map[string]string, error)
-	SignJWT(ctx context.Context, data string, keyName string) (string, error)
+	// SignJWT signs data with the version of the key, empty version is the latest one.
+	// It returns ErrKeyVersionRetired if the version can't be used for signing anymore
+	SignJWT(ctx context.Context, data, keyName, version string) (string, error)
+	Encrypt(ctx context.Context, plaintext string, keyName string) (string, error)
+	Decrypt(ctx context.Context, ciphertext string, keyName string) (string, error)
+	// KeyVersions returns available versions of the key sorted by version
+	KeyVersions(ctx context.Context, keyName string) ([]domain.KeyVersion, error)
+	// RotateKey creates a new version of the key and returns it
+	RotateKey(ctx context.Context, keyName string) (string, error)
+	// RetireKeyVersions makes versions below minVersion unavailable for verification and decryption
+	RetireKeyVersions(ctx context.Context, keyName string, minVersion int) error
 }
 
 type ITokenRepository interface {
 	Get(ctx context.Context, key string) (string, error)
+	// GetDel returns value of the key and deletes it in one step, so the value is returned once
+	GetDel(ctx context.Context, key string) (string, error)
 	Add(ctx context.Context, key, value string, expiration time.Duration) error
+	// AddNX adds the key only if it doesn't exist, it returns ErrAlreadyExists otherwise
+	AddNX(ctx context.Context, key, value string, expiration time.Duration) error
 	Delete(ctx context.Context, keys ...string) error
 	Push(ctx context.Context, key string, values ...string) error
 	List(ctx context.Context, key string) ([]string, error)
+	// Incr increments counter, expiration is set when the counter is created
+	Incr(ctx context.Context, key string, expiration time.Duration) (int64, error)
+	// TTL returns remaining time to live of the key, it is not positive if the key doesn't exist
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	// refresh tokens are identified by keyed hash of the token
+	AddRefreshToken(ctx context.Context, token domain.RefreshToken, expiration time.Duration) error
+	GetRefreshToken(ctx context.Context, id string) (domain.RefreshToken, error)
+	// MigrateRefreshToken moves token stored under its raw value to its hash id.
+	// It returns ErrNotFound if there is no such legacy token or the token isn't in legacy format
+	MigrateRefreshToken(ctx context.Context, token, id string, expiration time.Duration) error
+	// MarkRefreshTokenRotated returns ErrAlreadyExists if token has been rotated before
+	MarkRefreshTokenRotated(ctx context.Context, token domain.RefreshToken, expiration time.Duration) error
+	DeleteRefreshTokens(ctx context.Context, ids ...string) error
+	FamilyTokens(ctx context.Context, familyID string) ([]string, error)
+	// SaveSession creates or overwrites session and adds it to the user's sessions
+	SaveSession(ctx context.Context, session domain.Session, expiration time.Duration) error
+	GetSession(ctx context.Context, id string) (domain.Session, error)
+	ListSessions(ctx context.Context, email string) ([]domain.Session, error)
+	DeleteSessions(ctx context.Context, email string, ids ...string) error
+}
+
+type IMFARepository interface {
+	Get(ctx context.Context, userID uuid.UUID) (domain.MFA, error)
+	Save(ctx context.Context, mfa domain.MFA) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+	// SetRecoveryCodes replaces all recovery codes of the user
+	SetRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+	// UseRecoveryCode deletes the code, returns ErrNotFound if there is no such code
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+}
+
+type IWebAuthnRepository interface {
+	Add(ctx context.Context, cred domain.WebAuthnCredential) error
+	Get(ctx context.Context, id []byte) (domain.WebAuthnCredential, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.WebAuthnCredential, error)
+	UpdateSignCount(ctx context.Context, id []byte, signCount uint32, usedAt time.Time) error
+	Delete(ctx context.Context, userID uuid.UUID, id []byte) error
+}
+
+type IIdentityRepository interface {
+	// Add returns ErrAlreadyExists if the identity is linked to any user
+	Add(ctx context.Context, identity domain.UserIdentity) error
+	Get(ctx context.Context, provider, subject string) (domain.UserIdentity, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.UserIdentity, error)
+	// Delete returns ErrLastLoginMethod if the user would be left without a way to log in
+	Delete(ctx context.Context, userID uuid.UUID, provider, subject string) error
+}
+
+type IClientRepository interface {
+	Add(ctx context.Context, client domain.OAuthClient) error
+	Get(ctx context.Context, id string) (domain.OAuthClient, error)
+	List(ctx context.Context) ([]domain.OAuthClient, error)
+	Delete(ctx context.Context, id string) error
 }
@@ -3,28 +3,71 @@ package repository
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/x509"
 	"encoding/asn1"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
+	"maps"
 	"math/big"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
 	"github.com/maisiq/go-auth-service/internal/logger"
+	"github.com/maisiq/go-auth-service/internal/vault"
 )
 
+// vaultSigningAlgorithms maps types of vault transit keys to JWS algorithms
+var vaultSigningAlgorithms = map[string]string{
+	"ecdsa-p256": "ES256",
+	"ecdsa-p384": "ES384",
+	"ecdsa-p521": "ES512",
+	"rsa-2048":   "RS256",
+	"rsa-3072":   "RS256",
+	"rsa-4096":   "RS256",
+	"ed25519":    "EdDSA",
+}
+
+// vaultSignParams are transit sign options producing signatures of the JWS algorithm of the key type
+var vaultSignParams = map[string]map[string]any{
+	"ecdsa-p256": {"hash_algorithm": "sha2-256"},
+	"ecdsa-p384": {"hash_algorithm": "sha2-384"},
+	"ecdsa-p521": {"hash_algorithm": "sha2-512"},
+	"rsa-2048":   {"hash_algorithm": "sha2-256", "signature_algorithm": "pkcs1v15"},
+	"rsa-3072":   {"hash_algorithm": "sha2-256", "signature_algorithm": "pkcs1v15"},
+	"rsa-4096":   {"hash_algorithm": "sha2-256", "signature_algorithm": "pkcs1v15"},
+	"ed25519":    {},
+}
+
+// vaultECDSASizes are byte sizes of r and s in JWS signature of the curve
+var vaultECDSASizes = map[string]int{
+	"ecdsa-p256": 32,
+	"ecdsa-p384": 48,
+	"ecdsa-p521": 66,
+}
+
 type VaultSecretRepository struct {
 	cfg    *configs.VaultConfig
 	client *http.Client
+	tokens vault.TokenSource
+	// types caches key types by key name
+	types sync.Map
 }
 
-func NewVaultSecretRepository(cfg *configs.VaultConfig, client *http.Client) *VaultSecretRepository {
+func NewVaultSecretRepository(cfg *configs.VaultConfig, client *http.Client, tokens vault.TokenSource) *VaultSecretRepository {
 	return &VaultSecretRepository{
 		cfg:    cfg,
 		client: client,
+		tokens: tokens,
 	}
 }
 
@@ -38,7 +81,9 @@ func (r *VaultSecretRepository) getKeyData(ctx context.Context, keyName string)
 	if err != nil {
 		return nil, fmt.Errorf("failed create request: %w", err)
 	}
-	req.Header.Set("X-Vault-Token", r.cfg.TOKEN)
+	if err := r.authorize(req); err != nil {
+		return nil, err
+	}
 	resp, err := r.client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
@@ -85,10 +130,24 @@ func (r *VaultSecretRepository) GetPublicKeys(ctx context.Context, keyName strin
 		return nil, fmt.Errorf("unpredictable response from vault")
 	}
 	keysData := d["keys"].(map[string]interface{})
+	minVersion, _ := d["min_decryption_version"].(float64)
+	keyType, _ := d["type"].(string)
 
 	for k, v := range keysData {
-		pk := v.(map[string]interface{})["public_key"]
-		keys[k] = pk.(string)
+		// retired versions must not verify signatures
+		if version, err := strconv.Atoi(k); err == nil && version < int(minVersion) {
+			continue
+		}
+		pk := v.(map[string]interface{})["public_key"].(string)
+		if keyType == "ed25519" {
+			// vault returns raw ed25519 keys, they are converted to PEM like the others
+			pemKey, err := ed25519ToPEM(pk)
+			if err != nil {
+				return nil, err
+			}
+			pk = pemKey
+		}
+		keys[k] = pk
 	}
 
 	if len(keys) < 1 {
@@ -97,21 +156,91 @@ func (r *VaultSecretRepository) GetPublicKeys(ctx context.Context, keyName strin
 	return keys, nil
 }
 
+func (r *VaultSecretRepository) KeyVersions(ctx context.Context, keyName string) ([]domain.KeyVersion, error) {
+	data, err := r.getKeyData(ctx, keyName)
+	if err != nil {
+		return nil, err
+	}
+	d, ok := data["data"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unpredictable response from vault")
+	}
+	keysData, ok := d["keys"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unpredictable response from vault")
+	}
+	minVersion, _ := d["min_decryption_version"].(float64)
+
+	versions := make([]domain.KeyVersion, 0, len(keysData))
+	for k, v := range keysData {
+		version, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected key version from vault: %s", k)
+		}
+		if version < int(minVersion) {
+			continue
+		}
+		kv := domain.KeyVersion{Version: version}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			// asymmetric keys
+			if ts, ok := v["creation_time"].(string); ok {
+				kv.CreatedAt, _ = time.Parse(time.RFC3339Nano, ts)
+			}
+		case float64:
+			// symmetric keys report unix time of creation only
+			kv.CreatedAt = time.Unix(int64(v), 0)
+		}
+		versions = append(versions, kv)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version < versions[j].Version
+	})
+	return versions, nil
+}
+
+func (r *VaultSecretRepository) RotateKey(ctx context.Context, keyName string) (string, error) {
+	if _, err := r.transit(ctx, "keys/"+keyName+"/rotate", map[string]any{}); err != nil {
+		return "", err
+	}
+	return r.GetKID(ctx, keyName)
+}
+
+// RetireKeyVersions sets min_decryption_version of the key, versions below it are kept by vault until trimmed
+func (r *VaultSecretRepository) RetireKeyVersions(ctx context.Context, keyName string, minVersion int) error {
+	_, err := r.transit(ctx, "keys/"+keyName+"/config", map[string]any{
+		"min_decryption_version": minVersion,
+	})
+	return err
+}
+
+// versionRetired reports whether version is below the versions available in vault
+func (r *VaultSecretRepository) versionRetired(ctx context.Context, keyName string, version int) bool {
+	versions, err := r.KeyVersions(ctx, keyName)
+	if err != nil || len(versions) == 0 {
+		return false
+	}
+	return version < versions[0].Version
+}
+
 type ECDSASignature struct {
 	R *big.Int
 	S *big.Int
 }
 
-func (r *VaultSecretRepository) derToRaw(derSig []byte) ([]byte, error) {
+// derToRaw converts ASN.1 ECDSA signature to JWS format: r and s padded to the curve size
+func (r *VaultSecretRepository) derToRaw(derSig []byte, size int) ([]byte, error) {
 	var sig ECDSASignature
 	_, err := asn1.Unmarshal(derSig, &sig)
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshaling ASN.1 signature: %w", err)
 	}
 
-	size := 32
 	rBytes := sig.R.Bytes()
 	sBytes := sig.S.Bytes()
+	if len(rBytes) > size || len(sBytes) > size {
+		return nil, fmt.Errorf("signature doesn't match curve size %d", size)
+	}
 
 	rPadded := make([]byte, size)
 	sPadded := make([]byte, size)
@@ -122,59 +251,186 @@ func (r *VaultSecretRepository) derToRaw(derSig []byte) ([]byte, error) {
 	return append(rPadded, sPadded...), nil
 }
 
-func (r *VaultSecretRepository) SignJWT(ctx context.Context, data string, keyName string) (string, error) {
-	b64data := base64.StdEncoding.EncodeToString([]byte(data))
+// keyType returns vault type of the key, it can't be changed for an existing key so it's fetched once
+func (r *VaultSecretRepository) keyType(ctx context.Context, keyName string) (string, error) {
+	if t, ok := r.types.Load(keyName); ok {
+		return t.(string), nil
+	}
+	data, err := r.getKeyData(ctx, keyName)
+	if err != nil {
+		return "", err
+	}
+	d, ok := data["data"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("unpredictable response from vault")
+	}
+	t, ok := d["type"].(string)
+	if !ok {
+		return "", fmt.Errorf("unpredictable response from vault")
+	}
+	r.types.Store(keyName, t)
+	return t, nil
+}
+
+func (r *VaultSecretRepository) SigningAlgorithm(ctx context.Context, keyName string) (string, error) {
+	t, err := r.keyType(ctx, keyName)
+	if err != nil {
+		return "", err
+	}
+	alg, ok := vaultSigningAlgorithms[t]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedKeyType, t)
+	}
+	return alg, nil
+}
 
-	payload, err := json.Marshal(map[string]string{"input": b64data})
+func (r *VaultSecretRepository) SignJWT(ctx context.Context, data, keyName, version string) (string, error) {
+	t, err := r.keyType(ctx, keyName)
 	if err != nil {
 		return "", err
 	}
+	params, ok := vaultSignParams[t]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedKeyType, t)
+	}
+
+	payload := map[string]any{"input": base64.StdEncoding.EncodeToString([]byte(data))}
+	maps.Copy(payload, params)
+
+	var keyVersion int
+	if version != "" {
+		if keyVersion, err = strconv.Atoi(version); err != nil {
+			return "", fmt.Errorf("invalid key version: %q", version)
+		}
+		payload["key_version"] = keyVersion
+	}
+
+	d, err := r.transit(ctx, "sign/"+keyName, payload)
+	if err != nil {
+		if keyVersion > 0 && r.versionRetired(ctx, keyName, keyVersion) {
+			return "", fmt.Errorf("%w: %s version %d", ErrKeyVersionRetired, keyName, keyVersion)
+		}
+		return "", fmt.Errorf("failed to sign jwt token: %w", err)
+	}
+	sig, ok := d["signature"].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected response body from vault: %+v", d)
+	}
+
+	logger.GetLogger().Debugf("signature from vault: %s", sig)
+
+	// signature is prefixed with vault:v<version>:
+	sigb64slice := strings.Split(sig, ":")
+	sigRaw, err := base64.StdEncoding.DecodeString(sigb64slice[len(sigb64slice)-1])
+	if err != nil {
+		return "", fmt.Errorf("failed to decode signature: %w", err)
+	}
+	// RSA and Ed25519 signatures are the same in JWS, ECDSA ones are DER encoded
+	if size, ok := vaultECDSASizes[t]; ok {
+		sigRaw, err = r.derToRaw(sigRaw, size)
+		if err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("%s.%s", data, base64.RawURLEncoding.EncodeToString(sigRaw)), nil
+}
+
+func ed25519ToPEM(b64 string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode ed25519 public key: %w", err)
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return "", fmt.Errorf("invalid ed25519 public key size: %d", len(raw))
+	}
+	der, err := x509.MarshalPKIXPublicKey(ed25519.PublicKey(raw))
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ed25519 public key: %w", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+func (r *VaultSecretRepository) authorize(req *http.Request) error {
+	token, err := r.tokens.Token(req.Context())
+	if err != nil {
+		return fmt.Errorf("failed to get vault token: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", token)
+	return nil
+}
+
+// transit makes POST request to vault transit engine and returns data field of response
+func (r *VaultSecretRepository) transit(ctx context.Context, path string, payload map[string]any) (map[string]interface{}, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodPost,
-		r.cfg.BaseURL+fmt.Sprintf("/v1/transit/sign/%s", keyName),
-		bytes.NewReader(payload),
+		r.cfg.BaseURL+"/v1/transit/"+path,
+		bytes.NewReader(body),
 	)
 	if err != nil {
-		return "", fmt.Errorf("failed to create new request: %w", err)
+		return nil, fmt.Errorf("failed to create new request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Vault-Token", r.cfg.TOKEN)
+	if err := r.authorize(req); err != nil {
+		return nil, err
+	}
 
 	resp, err := r.client.Do(req)
 	if err != nil {
-		return "", err
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return map[string]interface{}{}, nil
 	}
 	if resp.StatusCode != http.StatusOK {
 		data, _ := io.ReadAll(resp.Body)
-		defer resp.Body.Close()
-		return "", fmt.Errorf("failed to sign jwt token: %s", string(data))
+		return nil, fmt.Errorf("bad request to vault: %s", string(data))
 	}
 	var respData map[string]interface{}
-
 	if err := json.NewDecoder(resp.Body).Decode(&respData); err != nil {
-		return "", fmt.Errorf("failed to decode response: %w", err)
+		return nil, fmt.Errorf("failed to decode response from vault: %w", err)
 	}
-	if d, ok := respData["data"].(map[string]interface{}); !ok {
-		return "", fmt.Errorf("unexpected response body from vault: %+v", err)
-	} else {
-		sig := d["signature"].(string)
-
-		logger.GetLogger().Debugf("signature from vault: %s", sig)
+	d, ok := respData["data"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unpredictable response from vault")
+	}
+	return d, nil
+}
 
-		sigb64slice := strings.Split(sig, ":")
-		sigb64 := sigb64slice[len(sigb64slice)-1]
+func (r *VaultSecretRepository) Encrypt(ctx context.Context, plaintext string, keyName string) (string, error) {
+	d, err := r.transit(ctx, "encrypt/"+keyName, map[string]any{
+		"plaintext": base64.StdEncoding.EncodeToString([]byte(plaintext)),
+	})
+	if err != nil {
+		return "", err
+	}
+	ciphertext, ok := d["ciphertext"].(string)
+	if !ok {
+		return "", fmt.Errorf("unpredictable response from vault")
+	}
+	return ciphertext, nil
+}
 
-		var sigDer []byte
-		sigDer, err := base64.StdEncoding.DecodeString(sigb64)
-		if err != nil {
-			return "", fmt.Errorf("failed to decode sigDer: %w", err)
-		}
-		sigRaw, err := r.derToRaw(sigDer)
-		if err != nil {
-			return "", err
-		}
-		sigb64url := base64.RawURLEncoding.EncodeToString(sigRaw)
-		return fmt.Sprintf("%s.%s", data, sigb64url), nil
+func (r *VaultSecretRepository) Decrypt(ctx context.Context, ciphertext string, keyName string) (string, error) {
+	d, err := r.transit(ctx, "decrypt/"+keyName, map[string]any{
+		"ciphertext": ciphertext,
+	})
+	if err != nil {
+		return "", err
+	}
+	b64, ok := d["plaintext"].(string)
+	if !ok {
+		return "", fmt.Errorf("unpredictable response from vault")
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode plaintext: %w", err)
 	}
+	return string(plaintext), nil
 }
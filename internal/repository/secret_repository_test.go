@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/logger"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/internal/vault"
+	"github.com/maisiq/go-auth-service/internal/vault/vaulttest"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestVaultSecretRepositorySign(t *testing.T) {
+	ctx := context.Background()
+	logger.L = zap.NewNop().Sugar()
+	server := vaulttest.NewServer(t)
+
+	cfg := &configs.VaultConfig{BaseURL: server.URL, TOKEN: server.IssueToken()}
+	auth, err := vault.NewAuth(cfg, http.DefaultClient, zap.NewNop().Sugar())
+	require.NoError(t, err)
+	repo := repository.NewVaultSecretRepository(cfg, http.DefaultClient, auth)
+
+	for keyType, alg := range map[string]string{"ecdsa-p256": "ES256", "rsa-2048": "RS256", "ed25519": "EdDSA"} {
+		t.Run(keyType, func(t *testing.T) {
+			server.CreateKey(t, keyType, keyType)
+
+			signingAlg, err := repo.SigningAlgorithm(ctx, keyType)
+			require.NoError(t, err)
+			require.Equal(t, alg, signingAlg)
+
+			unsigned, err := jwt.NewWithClaims(jwt.GetSigningMethod(alg), jwt.MapClaims{"sub": "user"}).SigningString()
+			require.NoError(t, err)
+			token, err := repo.SignJWT(ctx, unsigned, keyType, "")
+			require.NoError(t, err)
+
+			keys, err := repo.GetPublicKeys(ctx, keyType)
+			require.NoError(t, err)
+			block, _ := pem.Decode([]byte(keys["1"]))
+			require.NotNil(t, block)
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			require.NoError(t, err)
+
+			_, err = jwt.Parse(token, func(*jwt.Token) (any, error) {
+				return pub, nil
+			}, jwt.WithValidMethods([]string{alg}))
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("retired version", func(t *testing.T) {
+		server.CreateKey(t, "jwt-key", "ecdsa-p256")
+		unsigned, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"sub": "user"}).SigningString()
+		require.NoError(t, err)
+
+		kid, err := repo.RotateKey(ctx, "jwt-key")
+		require.NoError(t, err)
+		require.Equal(t, "2", kid)
+
+		// previous version signs until it's retired
+		token, err := repo.SignJWT(ctx, unsigned, "jwt-key", "1")
+		require.NoError(t, err)
+		keys, err := repo.GetPublicKeys(ctx, "jwt-key")
+		require.NoError(t, err)
+		block, _ := pem.Decode([]byte(keys["1"]))
+		require.NotNil(t, block)
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		require.NoError(t, err)
+		_, err = jwt.Parse(token, func(*jwt.Token) (any, error) {
+			return pub, nil
+		}, jwt.WithValidMethods([]string{"ES256"}))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.RetireKeyVersions(ctx, "jwt-key", 2))
+		_, err = repo.SignJWT(ctx, unsigned, "jwt-key", "1")
+		require.ErrorIs(t, err, repository.ErrKeyVersionRetired)
+	})
+}
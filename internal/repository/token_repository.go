@@ -3,12 +3,37 @@ package repository
 import (
 	"context"
 	"errors"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/maisiq/go-auth-service/internal/db"
+	"github.com/maisiq/go-auth-service/internal/domain"
 	"github.com/redis/go-redis/v9"
 )
 
+const (
+	refreshTokenPrefix  = "refresh:"
+	refreshFamilyPrefix = "refresh-family:"
+	sessionPrefix       = "session:"
+	userSessionsPrefix  = "sessions:"
+)
+
+// legacyRefreshToken is the format of refresh tokens issued before they were stored by hash id,
+// no other key of memory db looks like this, so the token can't point migration at them
+var legacyRefreshToken = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)
+
+// incrWithExpiry increments counter and sets its expiration in one step,
+// so the counter can't be left without expiration. Counters missing it are fixed too
+var incrWithExpiry = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 or redis.call('PTTL', KEYS[1]) < 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`)
+
 type TokenRepository struct {
 	client db.RedisClient
 }
@@ -35,6 +60,17 @@ func (r *TokenRepository) Get(ctx context.Context, key string) (string, error) {
 	return value, nil
 }
 
+func (r *TokenRepository) GetDel(ctx context.Context, key string) (string, error) {
+	value, err := r.client.GetDel(ctx, key).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", ErrNotFound
+		}
+		return "", err
+	}
+	return value, nil
+}
+
 func (r *TokenRepository) Add(ctx context.Context, key, value string, expiration time.Duration) error {
 	resp := r.client.Set(ctx, key, value, expiration)
 	if err := resp.Err(); err != nil {
@@ -43,6 +79,17 @@ func (r *TokenRepository) Add(ctx context.Context, key, value string, expiration
 	return nil
 }
 
+func (r *TokenRepository) AddNX(ctx context.Context, key, value string, expiration time.Duration) error {
+	added, err := r.client.SetNX(ctx, key, value, expiration).Result()
+	if err != nil {
+		return err
+	}
+	if !added {
+		return ErrAlreadyExists
+	}
+	return nil
+}
+
 func (r *TokenRepository) Delete(ctx context.Context, keys ...string) error {
 	resp := r.client.Del(ctx, keys...)
 	if resp.Err() != nil {
@@ -67,3 +114,241 @@ func (r *TokenRepository) List(ctx context.Context, key string) ([]string, error
 	}
 	return values, nil
 }
+
+func (r *TokenRepository) Incr(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	return incrWithExpiry.Run(ctx, r.client, []string{key}, expiration.Milliseconds()).Int64()
+}
+
+func (r *TokenRepository) TTL(ctx context.Context, key string) (time.Duration, error) {
+	return r.client.TTL(ctx, key).Result()
+}
+
+func (r *TokenRepository) AddRefreshToken(ctx context.Context, token domain.RefreshToken, expiration time.Duration) error {
+	key := refreshTokenPrefix + token.ID
+	resp := r.client.HSet(ctx, key, map[string]interface{}{
+		"email":       token.Email,
+		"family":      token.FamilyID,
+		"parent":      token.ParentID,
+		"issued_at":   token.IssuedAt.Unix(),
+		"client":      token.ClientID,
+		"scope":       strings.Join(token.Scope, " "),
+		"third_party": token.ThirdParty,
+	})
+	if err := resp.Err(); err != nil {
+		return err
+	}
+	if err := r.client.Expire(ctx, key, expiration).Err(); err != nil {
+		return err
+	}
+
+	// family lives as long as its latest token
+	familyKey := refreshFamilyPrefix + token.FamilyID
+	if err := r.client.SAdd(ctx, familyKey, token.ID).Err(); err != nil {
+		return err
+	}
+	return r.client.Expire(ctx, familyKey, expiration).Err()
+}
+
+func (r *TokenRepository) GetRefreshToken(ctx context.Context, id string) (domain.RefreshToken, error) {
+	fields, err := r.client.HGetAll(ctx, refreshTokenPrefix+id).Result()
+	if err != nil {
+		return domain.RefreshToken{}, err
+	}
+	if len(fields) == 0 {
+		return domain.RefreshToken{}, ErrNotFound
+	}
+
+	t := domain.RefreshToken{
+		ID:         id,
+		Email:      fields["email"],
+		FamilyID:   fields["family"],
+		ParentID:   fields["parent"],
+		ClientID:   fields["client"],
+		Scope:      strings.Fields(fields["scope"]),
+		ThirdParty: fields["third_party"] == "1",
+	}
+	if ts, err := strconv.ParseInt(fields["issued_at"], 10, 64); err == nil {
+		t.IssuedAt = time.Unix(ts, 0)
+	}
+	if ts, err := strconv.ParseInt(fields["rotated_at"], 10, 64); err == nil {
+		t.RotatedAt = time.Unix(ts, 0)
+	}
+	return t, nil
+}
+
+func (r *TokenRepository) MigrateRefreshToken(ctx context.Context, token, id string, expiration time.Duration) error {
+	if !legacyRefreshToken.MatchString(token) {
+		return ErrNotFound
+	}
+
+	oldKey := refreshTokenPrefix + token
+	fields, err := r.client.HGetAll(ctx, oldKey).Result()
+	if err != nil {
+		return err
+	}
+
+	if len(fields) == 0 {
+		// tokens issued before families were introduced are stored as plain token-email pairs
+		oldKey = token
+		email, err := r.Get(ctx, token)
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(email, "@") {
+			return ErrNotFound
+		}
+		fields = map[string]string{"email": email}
+	}
+
+	ttl, err := r.client.TTL(ctx, oldKey).Result()
+	if err != nil {
+		return err
+	}
+	if ttl <= 0 {
+		ttl = expiration
+	}
+
+	key := refreshTokenPrefix + id
+	if err := r.client.HSet(ctx, key, fields).Err(); err != nil {
+		return err
+	}
+	if err := r.client.Expire(ctx, key, ttl).Err(); err != nil {
+		return err
+	}
+	if family := fields["family"]; family != "" {
+		if err := r.client.SAdd(ctx, refreshFamilyPrefix+family, id).Err(); err != nil {
+			return err
+		}
+	}
+	return r.client.Del(ctx, oldKey).Err()
+}
+
+func (r *TokenRepository) MarkRefreshTokenRotated(ctx context.Context, token domain.RefreshToken, expiration time.Duration) error {
+	key := refreshTokenPrefix + token.ID
+
+	// family of a migrated legacy token is assigned on its first rotation
+	if err := r.client.HSetNX(ctx, key, "family", token.FamilyID).Err(); err != nil {
+		return err
+	}
+
+	ok, err := r.client.HSetNX(ctx, key, "rotated_at", time.Now().Unix()).Result()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrAlreadyExists
+	}
+	if err := r.client.ExpireNX(ctx, key, expiration).Err(); err != nil {
+		return err
+	}
+	return r.client.SAdd(ctx, refreshFamilyPrefix+token.FamilyID, token.ID).Err()
+}
+
+func (r *TokenRepository) DeleteRefreshTokens(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	// user's and family sets may still contain raw tokens issued before hashing,
+	// so keys of both legacy formats are removed as well
+	keys := make([]string, 0, 2*len(ids))
+	for _, id := range ids {
+		keys = append(keys, refreshTokenPrefix+id, id)
+	}
+	return r.Delete(ctx, keys...)
+}
+
+func (r *TokenRepository) FamilyTokens(ctx context.Context, familyID string) ([]string, error) {
+	return r.List(ctx, refreshFamilyPrefix+familyID)
+}
+
+func (r *TokenRepository) SaveSession(ctx context.Context, session domain.Session, expiration time.Duration) error {
+	key := sessionPrefix + session.ID
+	resp := r.client.HSet(ctx, key, map[string]interface{}{
+		"email":        session.Email,
+		"method":       session.Method,
+		"client":       session.ClientID,
+		"user_agent":   session.UserAgent,
+		"ip":           session.IP,
+		"created_at":   session.CreatedAt.Unix(),
+		"last_used_at": session.LastUsedAt.Unix(),
+	})
+	if err := resp.Err(); err != nil {
+		return err
+	}
+	if err := r.client.Expire(ctx, key, expiration).Err(); err != nil {
+		return err
+	}
+
+	userKey := userSessionsPrefix + session.Email
+	if err := r.client.SAdd(ctx, userKey, session.ID).Err(); err != nil {
+		return err
+	}
+	return r.client.Expire(ctx, userKey, expiration).Err()
+}
+
+func (r *TokenRepository) GetSession(ctx context.Context, id string) (domain.Session, error) {
+	fields, err := r.client.HGetAll(ctx, sessionPrefix+id).Result()
+	if err != nil {
+		return domain.Session{}, err
+	}
+	if len(fields) == 0 {
+		return domain.Session{}, ErrNotFound
+	}
+
+	s := domain.Session{
+		ID:        id,
+		Email:     fields["email"],
+		Method:    fields["method"],
+		ClientID:  fields["client"],
+		UserAgent: fields["user_agent"],
+		IP:        fields["ip"],
+	}
+	if ts, err := strconv.ParseInt(fields["created_at"], 10, 64); err == nil {
+		s.CreatedAt = time.Unix(ts, 0)
+	}
+	if ts, err := strconv.ParseInt(fields["last_used_at"], 10, 64); err == nil {
+		s.LastUsedAt = time.Unix(ts, 0)
+	}
+	return s, nil
+}
+
+func (r *TokenRepository) ListSessions(ctx context.Context, email string) ([]domain.Session, error) {
+	userKey := userSessionsPrefix + email
+	ids, err := r.List(ctx, userKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]domain.Session, 0, len(ids))
+	for _, id := range ids {
+		s, err := r.GetSession(ctx, id)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				// session has expired, set is cleaned up lazily
+				if err := r.client.SRem(ctx, userKey, id).Err(); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, nil
+}
+
+func (r *TokenRepository) DeleteSessions(ctx context.Context, email string, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(ids))
+	members := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, sessionPrefix+id)
+		members = append(members, id)
+	}
+	if err := r.Delete(ctx, keys...); err != nil {
+		return err
+	}
+	return r.client.SRem(ctx, userSessionsPrefix+email, members...).Err()
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,6 +15,9 @@ import (
 	"github.com/maisiq/go-auth-service/internal/domain"
 )
 
+const userColumns = "id, email, role, hashed_password, created_at, verified_at, " +
+	"status, status_reason, status_changed_at, locked_until, social_account, social_id, social_provider"
+
 type UserRepository struct {
 	client *sqlx.DB
 }
@@ -24,19 +29,22 @@ func NewUserRepository(c *sqlx.DB) *UserRepository {
 }
 
 func (r *UserRepository) Add(ctx context.Context, user domain.User) error {
-	stmt := `INSERT INTO users(id, email, role, hashed_password, created_at, social_account, social_id, social_provider) 
-			 VALUES(:id, :email, :role, :hashed_password, :created_at, :social_account, :social_id, :social_provider)`
+	stmt := `INSERT INTO users(id, email, role, hashed_password, created_at, verified_at, status, status_changed_at, social_account, social_id, social_provider) 
+			 VALUES(:id, :email, :role, :hashed_password, :created_at, :verified_at, :status, :status_changed_at, :social_account, :social_id, :social_provider)`
 
 	_, err := r.client.NamedExecContext(ctx, stmt,
 		map[string]interface{}{
-			"id":              user.ID,
-			"email":           user.Email,
-			"role":            user.Role,
-			"hashed_password": user.HashedPassword,
-			"created_at":      user.CreatedAT.Unix(),
-			"social_account":  user.SocialAccount,
-			"social_id":       user.SocialID,
-			"social_provider": user.SocialProvider,
+			"id":                user.ID,
+			"email":             user.Email,
+			"role":              user.Role,
+			"hashed_password":   user.HashedPassword,
+			"created_at":        user.CreatedAT.Unix(),
+			"verified_at":       nullableUnix(user.VerifiedAt),
+			"status":            statusOrActive(user.Status),
+			"status_changed_at": nullableUnix(user.StatusChangedAt),
+			"social_account":    user.SocialAccount,
+			"social_id":         user.SocialID,
+			"social_provider":   user.SocialProvider,
 		},
 	)
 
@@ -54,17 +62,140 @@ func (r *UserRepository) Add(ctx context.Context, user domain.User) error {
 }
 
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (domain.User, error) {
-	query := "SELECT id, email, hashed_password FROM users WHERE email=$1"
-	var user domain.User
+	query := "SELECT " + userColumns + " FROM users WHERE email=$1"
+	return scanUser(r.client.QueryRowContext(ctx, query, email))
+}
+
+// List returns a page of users matching filter ordered from the newest and total number of matching users
+func (r *UserRepository) List(ctx context.Context, filter domain.UserFilter) ([]domain.User, int, error) {
+	var (
+		conds []string
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if filter.Email != "" {
+		conds = append(conds, "email ILIKE "+arg("%"+likeEscaper.Replace(filter.Email)+"%"))
+	}
+	if filter.Role != "" {
+		conds = append(conds, "role = "+arg(filter.Role))
+	}
+	if filter.Status != "" {
+		conds = append(conds, "status = "+arg(filter.Status))
+	}
+	if filter.SocialProvider != "" {
+		conds = append(conds, "social_provider = "+arg(filter.SocialProvider))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		conds = append(conds, "created_at >= "+arg(filter.CreatedFrom.Unix()))
+	}
+	if !filter.CreatedTo.IsZero() {
+		conds = append(conds, "created_at < "+arg(filter.CreatedTo.Unix()))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
+	}
+
+	var total int
+	if err := r.client.QueryRowContext(ctx, "SELECT count(*) FROM users"+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := "SELECT " + userColumns + " FROM users" + where +
+		" ORDER BY created_at DESC, id LIMIT " + arg(filter.Limit) + " OFFSET " + arg(filter.Offset)
+	rows, err := r.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0, filter.Limit)
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (domain.User, error) {
+	query := "SELECT " + userColumns + " FROM users WHERE id=$1"
+	return scanUser(r.client.QueryRowContext(ctx, query, id))
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanUser reads user selected with userColumns
+func scanUser(row rowScanner) (domain.User, error) {
+	var (
+		user           domain.User
+		hashedPassword sql.NullString
+		createdAt      sql.NullInt64
+		verifiedAt     sql.NullInt64
+		statusReason   sql.NullString
+		statusChanged  sql.NullInt64
+		lockedUntil    sql.NullInt64
+		socialID       sql.NullString
+		socialProvider sql.NullString
+	)
 
-	if err := r.client.QueryRowxContext(ctx, query, email).StructScan(&user); err != nil {
+	err := row.Scan(
+		&user.ID, &user.Email, &user.Role, &hashedPassword, &createdAt, &verifiedAt,
+		&user.Status, &statusReason, &statusChanged, &lockedUntil,
+		&user.SocialAccount, &socialID, &socialProvider,
+	)
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return domain.User{}, ErrNotFound
 		}
 		return domain.User{}, err
 	}
+	user.HashedPassword = hashedPassword.String
+	user.CreatedAT = time.Unix(createdAt.Int64, 0)
+	if verifiedAt.Valid {
+		user.VerifiedAt = time.Unix(verifiedAt.Int64, 0)
+	}
+	user.StatusReason = statusReason.String
+	if statusChanged.Valid {
+		user.StatusChangedAt = time.Unix(statusChanged.Int64, 0)
+	}
+	if lockedUntil.Valid {
+		user.LockedUntil = time.Unix(lockedUntil.Int64, 0)
+	}
+	user.SocialID = socialID.String
+	user.SocialProvider = socialProvider.String
 	return user, nil
+}
+
+func nullableString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func statusOrActive(s domain.AccountStatus) domain.AccountStatus {
+	if s == "" {
+		return domain.StatusActive
+	}
+	return s
+}
 
+func nullableUnix(t time.Time) sql.NullInt64 {
+	if t.IsZero() {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: t.Unix(), Valid: true}
 }
 
 func (r *UserRepository) Logs(ctx context.Context, email string) ([]domain.UserLog, error) {
@@ -115,13 +246,37 @@ func (r *UserRepository) AddLog(ctx context.Context, log domain.UserLog) error {
 	return nil
 }
 
-func (r *UserRepository) Update(ctx context.Context, user domain.User) error {
+func (r *UserRepository) UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error {
+	_, err := r.client.ExecContext(ctx, "UPDATE users SET role = $1 WHERE id = $2", role, id)
+	return err
+}
+
+func (r *UserRepository) UpdateStatus(ctx context.Context, user domain.User) error {
 	stmt := `UPDATE users
-			 SET email = $1, hashed_password = $2
-			 WHERE id = $3`
-	_, err := r.client.ExecContext(ctx, stmt, user.Email, user.HashedPassword, user.ID)
-	if err != nil {
-		return err
-	}
-	return nil
+			 SET status = $1, status_reason = $2, status_changed_at = $3, locked_until = $4
+			 WHERE id = $5`
+	_, err := r.client.ExecContext(ctx, stmt,
+		statusOrActive(user.Status), nullableString(user.StatusReason), nullableUnix(user.StatusChangedAt), nullableUnix(user.LockedUntil),
+		user.ID,
+	)
+	return err
+}
+
+func (r *UserRepository) UpdatePassword(ctx context.Context, id uuid.UUID, hashedPassword string) error {
+	_, err := r.client.ExecContext(ctx, "UPDATE users SET hashed_password = $1 WHERE id = $2", hashedPassword, id)
+	return err
+}
+
+// MarkVerified checks status in the same statement, so an account disabled meanwhile isn't activated
+func (r *UserRepository) MarkVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error {
+	stmt := `UPDATE users
+			 SET verified_at = COALESCE(verified_at, $1),
+			     status = CASE WHEN status = $2 THEN $3 ELSE status END,
+			     status_reason = CASE WHEN status = $2 THEN NULL ELSE status_reason END,
+			     status_changed_at = CASE WHEN status = $2 THEN $1 ELSE status_changed_at END
+			 WHERE id = $4`
+	_, err := r.client.ExecContext(ctx, stmt,
+		verifiedAt.Unix(), domain.StatusPendingVerification, domain.StatusActive, id,
+	)
+	return err
 }
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jmoiron/sqlx"
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+const webAuthnColumns = "id, user_id, name, public_key, sign_count, created_at, last_used_at"
+
+type WebAuthnRepository struct {
+	client *sqlx.DB
+}
+
+func NewWebAuthnRepository(c *sqlx.DB) *WebAuthnRepository {
+	return &WebAuthnRepository{
+		client: c,
+	}
+}
+
+func (r *WebAuthnRepository) Add(ctx context.Context, cred domain.WebAuthnCredential) error {
+	stmt := `INSERT INTO webauthn_credentials(id, user_id, name, public_key, sign_count, created_at)
+			 VALUES($1, $2, $3, $4, $5, $6)`
+	_, err := r.client.ExecContext(ctx, stmt, cred.ID, cred.UserID, cred.Name, cred.PublicKey, cred.SignCount, cred.CreatedAt.Unix())
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return ErrAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *WebAuthnRepository) Get(ctx context.Context, id []byte) (domain.WebAuthnCredential, error) {
+	query := "SELECT " + webAuthnColumns + " FROM webauthn_credentials WHERE id=$1"
+	return scanWebAuthnCredential(r.client.QueryRowContext(ctx, query, id))
+}
+
+func (r *WebAuthnRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.WebAuthnCredential, error) {
+	var creds = make([]domain.WebAuthnCredential, 0)
+
+	query := "SELECT " + webAuthnColumns + " FROM webauthn_credentials WHERE user_id=$1 ORDER BY created_at"
+	rows, err := r.client.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		cred, err := scanWebAuthnCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, cred)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return creds, nil
+}
+
+func (r *WebAuthnRepository) UpdateSignCount(ctx context.Context, id []byte, signCount uint32, usedAt time.Time) error {
+	stmt := "UPDATE webauthn_credentials SET sign_count = $1, last_used_at = $2 WHERE id = $3"
+	_, err := r.client.ExecContext(ctx, stmt, signCount, usedAt.Unix(), id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *WebAuthnRepository) Delete(ctx context.Context, userID uuid.UUID, id []byte) error {
+	stmt := "DELETE FROM webauthn_credentials WHERE user_id = $1 AND id = $2"
+	res, err := r.client.ExecContext(ctx, stmt, userID, id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func scanWebAuthnCredential(row rowScanner) (domain.WebAuthnCredential, error) {
+	var (
+		cred       domain.WebAuthnCredential
+		signCount  int64
+		createdAt  int64
+		lastUsedAt sql.NullInt64
+	)
+
+	err := row.Scan(&cred.ID, &cred.UserID, &cred.Name, &cred.PublicKey, &signCount, &createdAt, &lastUsedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.WebAuthnCredential{}, ErrNotFound
+		}
+		return domain.WebAuthnCredential{}, err
+	}
+	cred.SignCount = uint32(signCount)
+	cred.CreatedAt = time.Unix(createdAt, 0)
+	if lastUsedAt.Valid {
+		cred.LastUsedAt = time.Unix(lastUsedAt.Int64, 0)
+	}
+	return cred, nil
+}
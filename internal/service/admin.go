@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+const (
+	DefaultUsersPageSize = 50
+	MaxUsersPageSize     = 200
+)
+
+func (s *UserService) ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, int, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultUsersPageSize
+	}
+	if filter.Limit > MaxUsersPageSize {
+		filter.Limit = MaxUsersPageSize
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	filter.Email = normalizeEmail(filter.Email)
+
+	users, total, err := s.userRepo.List(ctx, filter)
+	if err != nil {
+		s.log.Errorf("failed to list users: %w", err)
+		return nil, 0, ErrInternal
+	}
+	return users, total, nil
+}
+
+func (s *UserService) GetUser(ctx context.Context, userID string) (domain.User, error) {
+	return s.adminGetUser(ctx, userID)
+}
+
+// SetRole changes role of the user. Access tokens with the old role are revoked,
+// so the new one takes effect on the next refresh
+func (s *UserService) SetRole(ctx context.Context, userID string, role domain.Role) error {
+	if !role.Valid() {
+		return ErrInvalidRole
+	}
+	u, err := s.adminGetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if u.Role == role {
+		return nil
+	}
+
+	if err := s.userRepo.UpdateRole(ctx, u.ID, role); err != nil {
+		s.log.Errorf("failed to update user: %w", err)
+		return ErrInternal
+	}
+	if err := revokeUserAccess(ctx, s.tokenRepo, u.ID.String()); err != nil {
+		s.log.Errorf("failed to revoke access tokens: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+// SetAccountStatus changes status of the account. Tokens of disabled and locked accounts are revoked.
+// lockedUntil is used only for locked status, zero value locks the account until it is unlocked explicitly
+func (s *UserService) SetAccountStatus(
+	ctx context.Context,
+	userID string,
+	status domain.AccountStatus,
+	reason string,
+	lockedUntil time.Time,
+) error {
+	if !status.Valid() {
+		return ErrInvalidStatus
+	}
+	u, err := s.adminGetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	u.SetStatus(status, reason, time.Now())
+	if status == domain.StatusLocked {
+		u.LockedUntil = lockedUntil
+	}
+	if err := s.userRepo.UpdateStatus(ctx, u); err != nil {
+		s.log.Errorf("failed to update user: %w", err)
+		return ErrInternal
+	}
+
+	if status == domain.StatusDisabled || status == domain.StatusLocked {
+		if err := s.revokeAllTokens(ctx, u); err != nil {
+			s.log.Errorf("failed to revoke tokens: %w", err)
+			return ErrInternal
+		}
+	}
+	return nil
+}
+
+// ForcePasswordReset signs the user out everywhere and sends a password reset link
+func (s *UserService) ForcePasswordReset(ctx context.Context, userID string) error {
+	u, err := s.adminGetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.revokeAllTokens(ctx, u); err != nil {
+		s.log.Errorf("failed to revoke tokens: %w", err)
+		return ErrInternal
+	}
+	return s.sendPasswordReset(ctx, u)
+}
+
+func (s *UserService) RevokeUserSessions(ctx context.Context, userID string) error {
+	u, err := s.adminGetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.revokeAllTokens(ctx, u); err != nil {
+		s.log.Errorf("failed to revoke tokens: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+// adminGetUser is getUser for ids from path, where malformed id means there is no such user
+func (s *UserService) adminGetUser(ctx context.Context, userID string) (domain.User, error) {
+	u, err := s.getUser(ctx, userID)
+	if errors.Is(err, ErrInvalidToken) {
+		return domain.User{}, ErrNotFound
+	}
+	return u, err
+}
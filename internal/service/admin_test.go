@@ -0,0 +1,95 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository/mocks"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestListUsers(t *testing.T) {
+	logger := zap.NewExample()
+	userRepo := mocks.NewIUserRepositoryMock(t)
+
+	ctx := context.Background()
+
+	userService := service.NewUserService(logger.Sugar(), nil, userRepo, nil, nil, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+	t.Run("list users caps page size", func(t *testing.T) {
+		userRepo.ListMock.Inspect(func(ctx context.Context, filter domain.UserFilter) {
+			require.Equal(t, service.MaxUsersPageSize, filter.Limit)
+			require.Equal(t, "example", filter.Email)
+		}).Return([]domain.User{{ID: uuid.New()}}, 1, nil)
+
+		users, total, err := userService.ListUsers(ctx, domain.UserFilter{Email: "ExAmple", Limit: 10000})
+
+		require.NoError(t, err)
+		require.Len(t, users, 1)
+		require.Equal(t, 1, total)
+	})
+}
+
+func TestAdminUserManagement(t *testing.T) {
+	logger := zap.NewExample()
+
+	ctx := context.Background()
+
+	t.Run("set unknown role", func(t *testing.T) {
+		userService := service.NewUserService(logger.Sugar(), nil, nil, nil, nil, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		err := userService.SetRole(ctx, uuid.NewString(), "root")
+
+		require.ErrorIs(t, err, service.ErrInvalidRole)
+	})
+
+	t.Run("set unknown status", func(t *testing.T) {
+		userService := service.NewUserService(logger.Sugar(), nil, nil, nil, nil, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		err := userService.SetAccountStatus(ctx, uuid.NewString(), "banned", "", time.Time{})
+
+		require.ErrorIs(t, err, service.ErrInvalidStatus)
+	})
+
+	t.Run("get user with malformed id", func(t *testing.T) {
+		userService := service.NewUserService(logger.Sugar(), nil, nil, nil, nil, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		_, err := userService.GetUser(ctx, "not-uuid")
+
+		require.ErrorIs(t, err, service.ErrNotFound)
+	})
+
+	t.Run("disable user revokes all tokens", func(t *testing.T) {
+		userRepo := mocks.NewIUserRepositoryMock(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, nil, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		id := uuid.New()
+		email := "example@gmail.com"
+		userRepo.GetByIDMock.Expect(minimock.AnyContext, id).Return(domain.User{ID: id, Email: email}, nil)
+		userRepo.UpdateStatusMock.Inspect(func(ctx context.Context, user domain.User) {
+			require.True(t, user.Disabled())
+			require.Equal(t, "abuse", user.StatusReason)
+			require.False(t, user.StatusChangedAt.IsZero())
+		}).Return(nil)
+		tokenRepo.AddMock.Inspect(func(ctx context.Context, key, value string, expiration time.Duration) {
+			require.Equal(t, "tokens-valid-after:"+id.String(), key)
+		}).Return(nil)
+		tokenRepo.ListMock.Expect(minimock.AnyContext, email).Return([]string{"token"}, nil)
+		tokenRepo.DeleteRefreshTokensMock.Expect(minimock.AnyContext, "token").Return(nil)
+		tokenRepo.DeleteMock.Expect(minimock.AnyContext, email).Return(nil)
+		tokenRepo.ListSessionsMock.Expect(minimock.AnyContext, email).Return(nil, nil)
+		tokenRepo.DeleteSessionsMock.Return(nil)
+
+		err := userService.SetAccountStatus(ctx, id.String(), domain.StatusDisabled, "abuse", time.Time{})
+
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,634 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+// AuthorizationRequestTTL is how long user may stay on login and consent screens
+const AuthorizationRequestTTL = 10 * time.Minute
+
+// AuthorizationCodeTTL is how long client may take to exchange authorization code for tokens
+const AuthorizationCodeTTL = time.Minute
+
+const authorizationRequestPrefix = "authorization-request:"
+const authorizationCodePrefix = "authorization-code:"
+
+// OAuth 2.0 error codes, see RFC 6749 sections 4.1.2.1 and 5.2
+const (
+	OAuthInvalidRequest          = "invalid_request"
+	OAuthInvalidClient           = "invalid_client"
+	OAuthInvalidGrant            = "invalid_grant"
+	OAuthInvalidScope            = "invalid_scope"
+	OAuthUnsupportedGrantType    = "unsupported_grant_type"
+	OAuthUnsupportedResponseType = "unsupported_response_type"
+	OAuthAccessDenied            = "access_denied"
+	OAuthUnauthorizedClient      = "unauthorized_client"
+	// OAuthInvalidTarget is returned for audience the client may not get tokens for, RFC 8707 section 2
+	OAuthInvalidTarget = "invalid_target"
+)
+
+// supportedGrantTypes are grant types clients may be registered with
+var supportedGrantTypes = []string{domain.GrantAuthorizationCode, domain.GrantRefreshToken, domain.GrantClientCredentials}
+
+// OAuthError is an error returned to the client by authorization and token endpoints
+type OAuthError struct {
+	Code        string
+	Description string
+	// RedirectURI is set if the error is returned to the client by redirect from authorization endpoint,
+	// otherwise the error is shown to the user
+	RedirectURI string
+	State       string
+}
+
+func (e *OAuthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+// RedirectURL returns URL of the client the error is passed to
+func (e *OAuthError) RedirectURL() string {
+	q := url.Values{"error": {e.Code}}
+	if e.Description != "" {
+		q.Set("error_description", e.Description)
+	}
+	if e.State != "" {
+		q.Set("state", e.State)
+	}
+	return withQuery(e.RedirectURI, q)
+}
+
+// AuthorizeParams are parameters of authorization endpoint request
+type AuthorizeParams struct {
+	ResponseType        string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	State               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+// Consent is shown to the user before the client gets authorization code
+type Consent struct {
+	ClientName string
+	Scope      []string
+	// Required is false for first-party clients which are authorized without asking
+	Required bool
+}
+
+// TokenParams are parameters of token endpoint request
+type TokenParams struct {
+	GrantType    string
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	// Scope and Audience are space separated, they are requested by client_credentials grant
+	Scope    string
+	Audience string
+}
+
+// TokenResponse is a successful response of token endpoint, RFC 6749 section 5.1
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// ClientRegistration is metadata of a new OAuth client.
+// Clients are registered with authorization code and refresh token grants if GrantTypes is empty
+type ClientRegistration struct {
+	Name         string
+	RedirectURIs []string
+	Scopes       []string
+	GrantTypes   []string
+	Audiences    []string
+	Public       bool
+	FirstParty   bool
+}
+
+// AuthorizationService is OAuth 2.0 authorization server issuing tokens of users to registered clients
+type AuthorizationService struct {
+	log        *zap.SugaredLogger
+	clients    repository.IClientRepository
+	userRepo   IUserRepository
+	tokenRepo  ITokenRepository
+	secretRepo repository.SecretRepository
+	users      IUserService
+	cfg        *configs.AuthConfig
+}
+
+func NewAuthorizationService(
+	log *zap.SugaredLogger,
+	clients repository.IClientRepository,
+	userRepo IUserRepository,
+	tokenRepo ITokenRepository,
+	secretRepo repository.SecretRepository,
+	users IUserService,
+	cfg *configs.AuthConfig,
+) *AuthorizationService {
+	return &AuthorizationService{
+		log:        log,
+		clients:    clients,
+		userRepo:   userRepo,
+		tokenRepo:  tokenRepo,
+		secretRepo: secretRepo,
+		users:      users,
+		cfg:        cfg,
+	}
+}
+
+func (s *AuthorizationService) RegisterClient(ctx context.Context, reg ClientRegistration) (*domain.OAuthClient, string, error) {
+	if strings.TrimSpace(reg.Name) == "" {
+		return nil, "", fmt.Errorf("%w: name is required", ErrInvalidClientMetadata)
+	}
+	grantTypes := reg.GrantTypes
+	if len(grantTypes) == 0 {
+		grantTypes = []string{domain.GrantAuthorizationCode, domain.GrantRefreshToken}
+	}
+	for _, g := range grantTypes {
+		if !slices.Contains(supportedGrantTypes, g) {
+			return nil, "", fmt.Errorf("%w: unsupported grant type %q", ErrInvalidClientMetadata, g)
+		}
+	}
+	if slices.Contains(grantTypes, domain.GrantAuthorizationCode) && len(reg.RedirectURIs) == 0 {
+		return nil, "", fmt.Errorf("%w: at least one redirect uri is required", ErrInvalidClientMetadata)
+	}
+	// client credentials are the only proof of identity of service account
+	if slices.Contains(grantTypes, domain.GrantClientCredentials) {
+		if reg.Public {
+			return nil, "", fmt.Errorf("%w: public client can't use client_credentials grant", ErrInvalidClientMetadata)
+		}
+		if len(reg.Audiences) == 0 {
+			return nil, "", fmt.Errorf("%w: at least one audience is required for client_credentials grant", ErrInvalidClientMetadata)
+		}
+	}
+	for _, uri := range reg.RedirectURIs {
+		if err := validateRedirectURI(uri); err != nil {
+			return nil, "", err
+		}
+	}
+	for _, scope := range reg.Scopes {
+		if !validListItem(scope) {
+			return nil, "", fmt.Errorf("%w: invalid scope %q", ErrInvalidClientMetadata, scope)
+		}
+	}
+	for _, audience := range reg.Audiences {
+		if !validListItem(audience) {
+			return nil, "", fmt.Errorf("%w: invalid audience %q", ErrInvalidClientMetadata, audience)
+		}
+	}
+
+	client := domain.OAuthClient{
+		ID:           uuid.NewString(),
+		Name:         reg.Name,
+		RedirectURIs: reg.RedirectURIs,
+		Scopes:       reg.Scopes,
+		GrantTypes:   grantTypes,
+		Audiences:    reg.Audiences,
+		Public:       reg.Public,
+		FirstParty:   reg.FirstParty,
+		CreatedAt:    time.Now(),
+	}
+	// secret is shown once, only its hash is stored
+	var secret string
+	if !client.Public {
+		var err error
+		if secret, err = createRefreshToken(); err != nil {
+			s.log.Errorf("failed to generate client secret: %w", err)
+			return nil, "", ErrInternal
+		}
+		if client.SecretHash, err = hashPassword(secret); err != nil {
+			s.log.Errorf("failed to hash client secret: %w", err)
+			return nil, "", ErrInternal
+		}
+	}
+	if err := s.clients.Add(ctx, client); err != nil {
+		s.log.Errorf("failed to add client: %w", err)
+		return nil, "", ErrInternal
+	}
+	return &client, secret, nil
+}
+
+func (s *AuthorizationService) Clients(ctx context.Context) ([]domain.OAuthClient, error) {
+	clients, err := s.clients.List(ctx)
+	if err != nil {
+		s.log.Errorf("failed to list clients: %w", err)
+		return nil, ErrInternal
+	}
+	return clients, nil
+}
+
+func (s *AuthorizationService) DeleteClient(ctx context.Context, clientID string) error {
+	if err := s.clients.Delete(ctx, clientID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		s.log.Errorf("failed to delete client: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+// Authorize validates the request of the client and saves it until the user logs in and consents.
+// Errors which can't be returned to the client are *OAuthError without RedirectURI
+func (s *AuthorizationService) Authorize(ctx context.Context, p AuthorizeParams) (string, error) {
+	client, err := s.clients.Get(ctx, p.ClientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", &OAuthError{Code: OAuthInvalidClient, Description: "unknown client"}
+		}
+		s.log.Errorf("failed to get client: %w", err)
+		return "", ErrInternal
+	}
+	// redirect uri is checked before anything is sent to it
+	redirectURI := p.RedirectURI
+	if redirectURI == "" {
+		if len(client.RedirectURIs) != 1 {
+			return "", &OAuthError{Code: OAuthInvalidRequest, Description: "redirect_uri is required"}
+		}
+		redirectURI = client.RedirectURIs[0]
+	} else if !slices.Contains(client.RedirectURIs, redirectURI) {
+		return "", &OAuthError{Code: OAuthInvalidRequest, Description: "redirect_uri is not registered"}
+	}
+
+	fail := func(code, description string) (string, error) {
+		return "", &OAuthError{Code: code, Description: description, RedirectURI: redirectURI, State: p.State}
+	}
+	if p.ResponseType != "code" {
+		return fail(OAuthUnsupportedResponseType, "only code response type is supported")
+	}
+	// PKCE is required for all clients, see OAuth 2.1
+	if p.CodeChallenge == "" || p.CodeChallengeMethod != "S256" {
+		return fail(OAuthInvalidRequest, "code_challenge with S256 method is required")
+	}
+	scope := strings.Fields(p.Scope)
+	if len(scope) == 0 {
+		scope = client.Scopes
+	}
+	for _, sc := range scope {
+		if !slices.Contains(client.Scopes, sc) {
+			return fail(OAuthInvalidScope, "scope "+sc+" is not allowed")
+		}
+	}
+
+	value, err := json.Marshal(domain.AuthorizationRequest{
+		ClientID:         client.ID,
+		RedirectURI:      redirectURI,
+		ExplicitRedirect: p.RedirectURI != "",
+		Scope:            scope,
+		State:            p.State,
+		CodeChallenge:    p.CodeChallenge,
+	})
+	if err != nil {
+		return "", err
+	}
+	requestID, err := issueOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, authorizationRequestPrefix, string(value), AuthorizationRequestTTL)
+	if err != nil {
+		s.log.Errorf("failed to save authorization request: %w", err)
+		return "", ErrInternal
+	}
+	return requestID, nil
+}
+
+// BindUser saves the user who has logged in on the authorization screen into the request
+func (s *AuthorizationService) BindUser(ctx context.Context, requestID, userID string) (*Consent, error) {
+	req, err := s.authorizationRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.clients.Get(ctx, req.ClientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrInvalidAuthorizationRequest
+		}
+		s.log.Errorf("failed to get client: %w", err)
+		return nil, ErrInternal
+	}
+
+	req.UserID = userID
+	value, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	key := authorizationRequestPrefix + hashToken(s.cfg.TokenSecret, requestID)
+	if err := s.tokenRepo.Add(ctx, key, string(value), AuthorizationRequestTTL); err != nil {
+		s.log.Errorf("failed to save authorization request: %w", err)
+		return nil, ErrInternal
+	}
+	return &Consent{ClientName: client.Name, Scope: req.Scope, Required: !client.FirstParty}, nil
+}
+
+// Decide completes the request with the user's decision and returns URL of the client with authorization code or error
+func (s *AuthorizationService) Decide(ctx context.Context, requestID string, approved bool) (string, error) {
+	value, err := consumeOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, authorizationRequestPrefix, requestID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return "", ErrInvalidAuthorizationRequest
+		}
+		s.log.Errorf("failed to consume authorization request: %w", err)
+		return "", ErrInternal
+	}
+	var req domain.AuthorizationRequest
+	if err := json.Unmarshal([]byte(value), &req); err != nil {
+		s.log.Errorf("invalid authorization request: %w", err)
+		return "", ErrInternal
+	}
+	if req.UserID == "" {
+		return "", ErrInvalidAuthorizationRequest
+	}
+	if !approved {
+		e := &OAuthError{Code: OAuthAccessDenied, Description: "user denied access", RedirectURI: req.RedirectURI, State: req.State}
+		return e.RedirectURL(), nil
+	}
+
+	grant := domain.AuthorizationCode{
+		ClientID:      req.ClientID,
+		UserID:        req.UserID,
+		Scope:         req.Scope,
+		CodeChallenge: req.CodeChallenge,
+	}
+	if req.ExplicitRedirect {
+		grant.RedirectURI = req.RedirectURI
+	}
+	codeValue, err := json.Marshal(grant)
+	if err != nil {
+		return "", err
+	}
+	code, err := issueOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, authorizationCodePrefix, string(codeValue), AuthorizationCodeTTL)
+	if err != nil {
+		s.log.Errorf("failed to save authorization code: %w", err)
+		return "", ErrInternal
+	}
+
+	q := url.Values{"code": {code}}
+	if req.State != "" {
+		q.Set("state", req.State)
+	}
+	return withQuery(req.RedirectURI, q), nil
+}
+
+// Token issues tokens to the client for authorization code, refresh token or the client's own credentials
+func (s *AuthorizationService) Token(ctx context.Context, p TokenParams) (*TokenResponse, error) {
+	client, err := s.authenticateClient(ctx, p.ClientID, p.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.GrantType == "" {
+		return nil, &OAuthError{Code: OAuthInvalidRequest, Description: "grant_type is required"}
+	}
+	if !slices.Contains(supportedGrantTypes, p.GrantType) {
+		return nil, &OAuthError{Code: OAuthUnsupportedGrantType, Description: "grant type " + p.GrantType + " is not supported"}
+	}
+	if !client.AllowsGrant(p.GrantType) {
+		return nil, &OAuthError{Code: OAuthUnauthorizedClient, Description: "client may not use grant type " + p.GrantType}
+	}
+
+	switch p.GrantType {
+	case domain.GrantAuthorizationCode:
+		return s.exchangeCode(ctx, client, p)
+	case domain.GrantRefreshToken:
+		return s.refresh(ctx, client, p.RefreshToken)
+	default:
+		return s.clientCredentials(ctx, client, p)
+	}
+}
+
+func (s *AuthorizationService) exchangeCode(ctx context.Context, client domain.OAuthClient, p TokenParams) (*TokenResponse, error) {
+	if p.Code == "" || p.CodeVerifier == "" {
+		return nil, &OAuthError{Code: OAuthInvalidRequest, Description: "code and code_verifier are required"}
+	}
+	invalidGrant := &OAuthError{Code: OAuthInvalidGrant, Description: "authorization code is invalid or expired"}
+
+	value, err := consumeOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, authorizationCodePrefix, p.Code)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, invalidGrant
+		}
+		s.log.Errorf("failed to consume authorization code: %w", err)
+		return nil, ErrInternal
+	}
+	var grant domain.AuthorizationCode
+	if err := json.Unmarshal([]byte(value), &grant); err != nil {
+		s.log.Errorf("invalid authorization code: %w", err)
+		return nil, ErrInternal
+	}
+	if grant.ClientID != client.ID || grant.RedirectURI != p.RedirectURI {
+		return nil, invalidGrant
+	}
+	if !verifyCodeChallenge(p.CodeVerifier, grant.CodeChallenge) {
+		return nil, &OAuthError{Code: OAuthInvalidGrant, Description: "code_verifier doesn't match code_challenge"}
+	}
+
+	id, err := uuid.Parse(grant.UserID)
+	if err != nil {
+		return nil, invalidGrant
+	}
+	u, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, invalidGrant
+		}
+		s.log.Errorf("failed to get user: %w", err)
+		return nil, ErrInternal
+	}
+
+	resp := &TokenResponse{
+		TokenType: "Bearer",
+		ExpiresIn: int(AccessTokenTTL.Seconds()),
+		Scope:     strings.Join(grant.Scope, " "),
+	}
+	tokenGrant := tokenGrant{ClientID: client.ID, Scope: grant.Scope, ThirdParty: !client.FirstParty}
+	if client.AllowsGrant(domain.GrantRefreshToken) {
+		var tokens *TokenPair
+		tokens, err = createGrantTokenPair(ctx, s.secretRepo, s.tokenRepo, s.cfg, u, AuthorizationCodeGrant, tokenGrant)
+		if err == nil {
+			resp.AccessToken, resp.RefreshToken = tokens.Access, tokens.Refresh
+		}
+	} else {
+		// client can't refresh, so neither refresh token nor session is kept for it
+		resp.AccessToken, err = createGrantAccessToken(ctx, s.secretRepo, s.cfg, u, "", tokenGrant)
+	}
+	if err != nil {
+		if IsAccountStatusError(err) {
+			return nil, &OAuthError{Code: OAuthInvalidGrant, Description: err.Error()}
+		}
+		s.log.Errorf("failed to create tokens: %w", err)
+		return nil, ErrInternal
+	}
+	return resp, nil
+}
+
+func (s *AuthorizationService) refresh(ctx context.Context, client domain.OAuthClient, refreshToken string) (*TokenResponse, error) {
+	if refreshToken == "" {
+		return nil, &OAuthError{Code: OAuthInvalidRequest, Description: "refresh_token is required"}
+	}
+	tokens, err := s.users.NewClientRefreshToken(ctx, client.ID, refreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInternal):
+			return nil, err
+		case errors.Is(err, ErrNotFound):
+			return nil, &OAuthError{Code: OAuthInvalidGrant, Description: ErrInvalidRefreshToken.Error()}
+		default:
+			return nil, &OAuthError{Code: OAuthInvalidGrant, Description: err.Error()}
+		}
+	}
+	return &TokenResponse{
+		AccessToken:  tokens.Access,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(AccessTokenTTL.Seconds()),
+		RefreshToken: tokens.Refresh,
+	}, nil
+}
+
+// clientCredentials issues access token of the client itself, there is no refresh token as the client can
+// always authenticate again. Scope and audience default to all the client is registered with
+func (s *AuthorizationService) clientCredentials(ctx context.Context, client domain.OAuthClient, p TokenParams) (*TokenResponse, error) {
+	scope := strings.Fields(p.Scope)
+	if len(scope) == 0 {
+		scope = client.Scopes
+	}
+	for _, sc := range scope {
+		if !slices.Contains(client.Scopes, sc) {
+			return nil, &OAuthError{Code: OAuthInvalidScope, Description: "scope " + sc + " is not allowed"}
+		}
+	}
+	audience := strings.Fields(p.Audience)
+	if len(audience) == 0 {
+		audience = client.Audiences
+	}
+	for _, aud := range audience {
+		if !slices.Contains(client.Audiences, aud) {
+			return nil, &OAuthError{Code: OAuthInvalidTarget, Description: "audience " + aud + " is not allowed"}
+		}
+	}
+
+	accessToken, err := createClientAccessToken(ctx, s.secretRepo, s.cfg.Issuer, client.ID, scope, audience)
+	if err != nil {
+		s.log.Errorf("failed to create client access token: %w", err)
+		return nil, ErrInternal
+	}
+	return &TokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(AccessTokenTTL.Seconds()),
+		Scope:       strings.Join(scope, " "),
+	}, nil
+}
+
+// authenticateClient checks secret of confidential client, public clients are identified by client_id only
+func (s *AuthorizationService) authenticateClient(ctx context.Context, clientID, secret string) (domain.OAuthClient, error) {
+	invalidClient := &OAuthError{Code: OAuthInvalidClient, Description: "client authentication failed"}
+	if clientID == "" {
+		return domain.OAuthClient{}, invalidClient
+	}
+	client, err := s.clients.Get(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.OAuthClient{}, invalidClient
+		}
+		s.log.Errorf("failed to get client: %w", err)
+		return domain.OAuthClient{}, ErrInternal
+	}
+	if client.Public {
+		return client, nil
+	}
+	if secret == "" {
+		return domain.OAuthClient{}, invalidClient
+	}
+	ok, err := authenticate(secret, client.SecretHash)
+	if err != nil {
+		s.log.Errorf("failed to compare client secret: %w", err)
+		return domain.OAuthClient{}, ErrInternal
+	}
+	if !ok {
+		return domain.OAuthClient{}, invalidClient
+	}
+	return client, nil
+}
+
+func (s *AuthorizationService) authorizationRequest(ctx context.Context, requestID string) (domain.AuthorizationRequest, error) {
+	value, err := lookupOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, authorizationRequestPrefix, requestID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.AuthorizationRequest{}, ErrInvalidAuthorizationRequest
+		}
+		s.log.Errorf("failed to get authorization request: %w", err)
+		return domain.AuthorizationRequest{}, ErrInternal
+	}
+	var req domain.AuthorizationRequest
+	if err := json.Unmarshal([]byte(value), &req); err != nil {
+		s.log.Errorf("invalid authorization request: %w", err)
+		return domain.AuthorizationRequest{}, ErrInternal
+	}
+	return req, nil
+}
+
+// validListItem reports whether item may be kept in space separated list
+func validListItem(item string) bool {
+	return item != "" && !strings.ContainsAny(item, " \t\n\"\\")
+}
+
+// verifyCodeChallenge checks PKCE code verifier against S256 challenge, RFC 7636 section 4.6
+func verifyCodeChallenge(verifier, challenge string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// validateRedirectURI accepts absolute https URIs without fragment, http is allowed for loopback only
+func validateRedirectURI(uri string) error {
+	u, err := url.Parse(uri)
+	if err != nil || !u.IsAbs() || u.Host == "" || u.Fragment != "" || strings.ContainsAny(uri, " \t\n") {
+		return fmt.Errorf("%w: invalid redirect uri %q", ErrInvalidClientMetadata, uri)
+	}
+	switch u.Scheme {
+	case "https":
+		return nil
+	case "http":
+		host := u.Hostname()
+		if ip := net.ParseIP(host); host == "localhost" || (ip != nil && ip.IsLoopback()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: redirect uri %q must use https", ErrInvalidClientMetadata, uri)
+}
+
+// withQuery adds parameters to query of the URI keeping its own ones
+func withQuery(uri string, params url.Values) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	q := u.Query()
+	for k, v := range params {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,382 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/internal/repository/mocks"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAuthorizationServer(t *testing.T) {
+	logger := zap.NewExample()
+	ctx := context.Background()
+	cfg := &configs.AuthConfig{TokenSecret: "secret"}
+
+	verifier := strings.Repeat("v", 43)
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	client := domain.OAuthClient{
+		ID:           "client",
+		Name:         "Example",
+		RedirectURIs: []string{"https://app.example.com/callback", "http://127.0.0.1:8080/callback"},
+		Scopes:       []string{"profile", "email"},
+		GrantTypes:   []string{domain.GrantAuthorizationCode, domain.GrantRefreshToken},
+		Public:       true,
+	}
+
+	type deps struct {
+		service    *service.AuthorizationService
+		clients    *mocks.IClientRepositoryMock
+		tokenRepo  *mocks.ITokenRepositoryMock
+		userRepo   *mocks.IUserRepositoryMock
+		secretRepo *mocks.SecretRepositoryMock
+		store      map[string]string
+	}
+	newService := func(t *testing.T, c domain.OAuthClient) deps {
+		d := deps{
+			clients:    mocks.NewIClientRepositoryMock(t),
+			tokenRepo:  mocks.NewITokenRepositoryMock(t),
+			userRepo:   mocks.NewIUserRepositoryMock(t),
+			secretRepo: mocks.NewSecretRepositoryMock(t),
+			store:      make(map[string]string),
+		}
+		d.clients.GetMock.Optional().Set(func(_ context.Context, id string) (domain.OAuthClient, error) {
+			if id != c.ID {
+				return domain.OAuthClient{}, repository.ErrNotFound
+			}
+			return c, nil
+		})
+		d.service = service.NewAuthorizationService(logger.Sugar(), d.clients, d.userRepo, d.tokenRepo, d.secretRepo, nil, cfg)
+		return d
+	}
+	// useStore keeps authorization requests and codes in memory
+	useStore := func(d deps) {
+		d.tokenRepo.AddMock.Optional().Set(func(_ context.Context, key, value string, _ time.Duration) error {
+			d.store[key] = value
+			return nil
+		})
+		d.tokenRepo.GetMock.Optional().Set(func(_ context.Context, key string) (string, error) {
+			value, ok := d.store[key]
+			if !ok {
+				return "", repository.ErrNotFound
+			}
+			return value, nil
+		})
+		d.tokenRepo.GetDelMock.Optional().Set(func(_ context.Context, key string) (string, error) {
+			value, ok := d.store[key]
+			if !ok {
+				return "", repository.ErrNotFound
+			}
+			delete(d.store, key)
+			return value, nil
+		})
+	}
+	authorizeParams := func() service.AuthorizeParams {
+		return service.AuthorizeParams{
+			ResponseType:        "code",
+			ClientID:            client.ID,
+			RedirectURI:         "https://app.example.com/callback",
+			Scope:               "profile",
+			State:               "xyz",
+			CodeChallenge:       challenge,
+			CodeChallengeMethod: "S256",
+		}
+	}
+	// authorizationCode runs the authorization as the user and returns the code sent to the client
+	authorizationCode := func(t *testing.T, d deps, userID uuid.UUID) string {
+		requestID, err := d.service.Authorize(ctx, authorizeParams())
+		require.NoError(t, err)
+		consent, err := d.service.BindUser(ctx, requestID, userID.String())
+		require.NoError(t, err)
+		require.True(t, consent.Required)
+		require.Equal(t, []string{"profile"}, consent.Scope)
+
+		redirectURL, err := d.service.Decide(ctx, requestID, true)
+		require.NoError(t, err)
+		u, err := url.Parse(redirectURL)
+		require.NoError(t, err)
+		require.Equal(t, "app.example.com", u.Host)
+		require.Equal(t, "xyz", u.Query().Get("state"))
+		require.NotEmpty(t, u.Query().Get("code"))
+		return u.Query().Get("code")
+	}
+
+	t.Run("errors are not redirected to unverified redirect uri", func(t *testing.T) {
+		d := newService(t, client)
+		var oauthErr *service.OAuthError
+
+		p := authorizeParams()
+		p.ClientID = "unknown"
+		_, err := d.service.Authorize(ctx, p)
+		require.ErrorAs(t, err, &oauthErr)
+		require.Empty(t, oauthErr.RedirectURI)
+
+		p = authorizeParams()
+		p.RedirectURI = "https://evil.example.com/callback"
+		_, err = d.service.Authorize(ctx, p)
+		require.ErrorAs(t, err, &oauthErr)
+		require.Empty(t, oauthErr.RedirectURI)
+
+		// redirect uri can be omitted only if the client has a single one
+		p = authorizeParams()
+		p.RedirectURI = ""
+		_, err = d.service.Authorize(ctx, p)
+		require.ErrorAs(t, err, &oauthErr)
+		require.Empty(t, oauthErr.RedirectURI)
+	})
+
+	t.Run("invalid request is redirected to the client", func(t *testing.T) {
+		d := newService(t, client)
+
+		for name, modify := range map[string]func(p *service.AuthorizeParams){
+			service.OAuthUnsupportedResponseType: func(p *service.AuthorizeParams) { p.ResponseType = "token" },
+			service.OAuthInvalidRequest:          func(p *service.AuthorizeParams) { p.CodeChallengeMethod = "plain" },
+			service.OAuthInvalidScope:            func(p *service.AuthorizeParams) { p.Scope = "profile admin" },
+		} {
+			p := authorizeParams()
+			modify(&p)
+			_, err := d.service.Authorize(ctx, p)
+
+			var oauthErr *service.OAuthError
+			require.ErrorAs(t, err, &oauthErr, name)
+			require.Equal(t, name, oauthErr.Code)
+			u, err := url.Parse(oauthErr.RedirectURL())
+			require.NoError(t, err)
+			require.Equal(t, "app.example.com", u.Host)
+			require.Equal(t, name, u.Query().Get("error"))
+			require.Equal(t, "xyz", u.Query().Get("state"))
+		}
+	})
+
+	t.Run("denied request is redirected with access_denied", func(t *testing.T) {
+		d := newService(t, client)
+		useStore(d)
+
+		requestID, err := d.service.Authorize(ctx, authorizeParams())
+		require.NoError(t, err)
+
+		// request can't be decided before the user logs in
+		_, err = d.service.Decide(ctx, requestID, false)
+		require.ErrorIs(t, err, service.ErrInvalidAuthorizationRequest)
+
+		requestID, err = d.service.Authorize(ctx, authorizeParams())
+		require.NoError(t, err)
+		_, err = d.service.BindUser(ctx, requestID, uuid.NewString())
+		require.NoError(t, err)
+		redirectURL, err := d.service.Decide(ctx, requestID, false)
+		require.NoError(t, err)
+		require.Contains(t, redirectURL, "error=access_denied")
+
+		_, err = d.service.Decide(ctx, requestID, true)
+		require.ErrorIs(t, err, service.ErrInvalidAuthorizationRequest)
+	})
+
+	t.Run("code is exchanged once with pkce verifier", func(t *testing.T) {
+		d := newService(t, client)
+		useStore(d)
+		allowLogin(d.tokenRepo, d.secretRepo)
+
+		u := activeUser("user@example.com")
+		d.userRepo.GetByIDMock.Expect(minimock.AnyContext, u.ID).Return(u, nil)
+		code := authorizationCode(t, d, u.ID)
+
+		params := service.TokenParams{
+			GrantType:    "authorization_code",
+			ClientID:     client.ID,
+			Code:         code,
+			RedirectURI:  "https://app.example.com/callback",
+			CodeVerifier: verifier,
+		}
+		resp, err := d.service.Token(ctx, params)
+		require.NoError(t, err)
+		require.Equal(t, "Bearer", resp.TokenType)
+		require.Equal(t, "profile", resp.Scope)
+		require.NotEmpty(t, resp.RefreshToken)
+
+		// token of third-party client is issued for the client and carries no permissions
+		claims := tokenClaims(t, resp.AccessToken)
+		require.Equal(t, []any{client.ID}, claims["aud"])
+		require.Equal(t, client.ID, claims["client_id"])
+		require.Equal(t, "profile", claims["scope"])
+		require.Empty(t, claims["permissions"])
+
+		_, err = d.service.Token(ctx, params)
+		var oauthErr *service.OAuthError
+		require.ErrorAs(t, err, &oauthErr)
+		require.Equal(t, service.OAuthInvalidGrant, oauthErr.Code)
+	})
+
+	t.Run("code exchange doesn't issue refresh token without refresh_token grant", func(t *testing.T) {
+		c := client
+		c.GrantTypes = []string{domain.GrantAuthorizationCode}
+		d := newService(t, c)
+		useStore(d)
+		allowSigning(d.secretRepo)
+
+		u := activeUser("user@example.com")
+		d.userRepo.GetByIDMock.Expect(minimock.AnyContext, u.ID).Return(u, nil)
+
+		resp, err := d.service.Token(ctx, service.TokenParams{
+			GrantType:    "authorization_code",
+			ClientID:     c.ID,
+			Code:         authorizationCode(t, d, u.ID),
+			RedirectURI:  "https://app.example.com/callback",
+			CodeVerifier: verifier,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.AccessToken)
+		require.Empty(t, resp.RefreshToken)
+		require.Empty(t, tokenClaims(t, resp.AccessToken)["sid"])
+	})
+
+	t.Run("code isn't exchanged with wrong verifier or redirect uri", func(t *testing.T) {
+		d := newService(t, client)
+		useStore(d)
+
+		for _, modify := range []func(p *service.TokenParams){
+			func(p *service.TokenParams) { p.CodeVerifier = strings.Repeat("w", 43) },
+			func(p *service.TokenParams) { p.RedirectURI = "http://127.0.0.1:8080/callback" },
+		} {
+			params := service.TokenParams{
+				GrantType:    "authorization_code",
+				ClientID:     client.ID,
+				Code:         authorizationCode(t, d, uuid.New()),
+				RedirectURI:  "https://app.example.com/callback",
+				CodeVerifier: verifier,
+			}
+			modify(&params)
+
+			_, err := d.service.Token(ctx, params)
+			var oauthErr *service.OAuthError
+			require.ErrorAs(t, err, &oauthErr)
+			require.Equal(t, service.OAuthInvalidGrant, oauthErr.Code)
+		}
+	})
+
+	t.Run("confidential client is authenticated with secret", func(t *testing.T) {
+		d := newService(t, client)
+		d.clients.AddMock.Return(nil)
+
+		registered, secret, err := d.service.RegisterClient(ctx, service.ClientRegistration{
+			Name:         "Confidential",
+			RedirectURIs: []string{"https://app.example.com/callback"},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, secret)
+		require.NotEqual(t, secret, registered.SecretHash)
+
+		d = newService(t, *registered)
+		for _, wrong := range []string{"", "wrong"} {
+			_, err = d.service.Token(ctx, service.TokenParams{GrantType: "refresh_token", ClientID: registered.ID, ClientSecret: wrong})
+			var oauthErr *service.OAuthError
+			require.ErrorAs(t, err, &oauthErr)
+			require.Equal(t, service.OAuthInvalidClient, oauthErr.Code)
+		}
+
+		// authenticated client gets to validation of the grant
+		_, err = d.service.Token(ctx, service.TokenParams{GrantType: "refresh_token", ClientID: registered.ID, ClientSecret: secret})
+		var oauthErr *service.OAuthError
+		require.ErrorAs(t, err, &oauthErr)
+		require.Equal(t, service.OAuthInvalidRequest, oauthErr.Code)
+	})
+
+	t.Run("service account gets token of its own with client credentials", func(t *testing.T) {
+		d := newService(t, client)
+		d.clients.AddMock.Return(nil)
+
+		registered, secret, err := d.service.RegisterClient(ctx, service.ClientRegistration{
+			Name:       "Billing",
+			Scopes:     []string{"invoices:read", "invoices:write"},
+			GrantTypes: []string{domain.GrantClientCredentials},
+			Audiences:  []string{"orders", "payments"},
+		})
+		require.NoError(t, err)
+
+		d = newService(t, *registered)
+		allowSigning(d.secretRepo)
+		resp, err := d.service.Token(ctx, service.TokenParams{
+			GrantType:    domain.GrantClientCredentials,
+			ClientID:     registered.ID,
+			ClientSecret: secret,
+			Scope:        "invoices:read",
+			Audience:     "orders",
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.RefreshToken)
+		require.Equal(t, "invoices:read", resp.Scope)
+
+		claims := tokenClaims(t, resp.AccessToken)
+		require.Equal(t, registered.ID, claims["sub"])
+		require.Equal(t, registered.ID, claims["client_id"])
+		require.Equal(t, []any{"orders"}, claims["aud"])
+		require.Equal(t, "invoices:read", claims["scope"])
+		require.NotContains(t, claims, "email")
+		require.NotContains(t, claims, "role")
+
+		// all registered scopes and audiences are granted by default
+		resp, err = d.service.Token(ctx, service.TokenParams{GrantType: domain.GrantClientCredentials, ClientID: registered.ID, ClientSecret: secret})
+		require.NoError(t, err)
+		require.Equal(t, "invoices:read invoices:write", resp.Scope)
+		require.Equal(t, []any{"orders", "payments"}, tokenClaims(t, resp.AccessToken)["aud"])
+
+		var oauthErr *service.OAuthError
+		_, err = d.service.Token(ctx, service.TokenParams{
+			GrantType: domain.GrantClientCredentials, ClientID: registered.ID, ClientSecret: secret, Audience: "users",
+		})
+		require.ErrorAs(t, err, &oauthErr)
+		require.Equal(t, service.OAuthInvalidTarget, oauthErr.Code)
+
+		_, err = d.service.Token(ctx, service.TokenParams{
+			GrantType: domain.GrantClientCredentials, ClientID: registered.ID, ClientSecret: secret, Scope: "admin",
+		})
+		require.ErrorAs(t, err, &oauthErr)
+		require.Equal(t, service.OAuthInvalidScope, oauthErr.Code)
+
+		_, err = d.service.Token(ctx, service.TokenParams{
+			GrantType: domain.GrantRefreshToken, ClientID: registered.ID, ClientSecret: secret, RefreshToken: "token",
+		})
+		require.ErrorAs(t, err, &oauthErr)
+		require.Equal(t, service.OAuthUnauthorizedClient, oauthErr.Code)
+	})
+
+	t.Run("client credentials are not granted to clients of users", func(t *testing.T) {
+		d := newService(t, client)
+
+		_, err := d.service.Token(ctx, service.TokenParams{GrantType: domain.GrantClientCredentials, ClientID: client.ID})
+		var oauthErr *service.OAuthError
+		require.ErrorAs(t, err, &oauthErr)
+		require.Equal(t, service.OAuthUnauthorizedClient, oauthErr.Code)
+
+		for _, reg := range []service.ClientRegistration{
+			{Name: "Public", GrantTypes: []string{domain.GrantClientCredentials}, Audiences: []string{"orders"}, Public: true},
+			{Name: "No audience", GrantTypes: []string{domain.GrantClientCredentials}},
+			{Name: "Unknown grant", GrantTypes: []string{"password"}, Audiences: []string{"orders"}},
+		} {
+			_, _, err := d.service.RegisterClient(ctx, reg)
+			require.ErrorIs(t, err, service.ErrInvalidClientMetadata, reg.Name)
+		}
+	})
+
+	t.Run("client registration validates redirect uris", func(t *testing.T) {
+		d := newService(t, client)
+
+		for _, uri := range []string{"http://app.example.com/callback", "https://app.example.com/cb#fragment", "/callback"} {
+			_, _, err := d.service.RegisterClient(ctx, service.ClientRegistration{Name: "Example", RedirectURIs: []string{uri}})
+			require.ErrorIs(t, err, service.ErrInvalidClientMetadata, uri)
+		}
+	})
+}
@@ -8,4 +8,26 @@ var ErrNotFound = fmt.Errorf("not found")
 var ErrBadCredentials = fmt.Errorf("bad credentials")
 var ErrInvalidToken = fmt.Errorf("invalid token")
 var ErrInvalidRefreshToken = fmt.Errorf("invalid refresh token")
+var ErrRefreshTokenReused = fmt.Errorf("refresh token has already been used")
+var ErrTokenRevoked = fmt.Errorf("token has been revoked")
+var ErrInvalidKeyVersion = fmt.Errorf("invalid key version")
+var ErrKeyVersionInUse = fmt.Errorf("key version may still be in use")
 var ErrInvalidKID = fmt.Errorf("invalid kid")
+var ErrEmailNotVerified = fmt.Errorf("email is not verified")
+var ErrMFARequired = fmt.Errorf("mfa required")
+var ErrMFAAlreadyEnabled = fmt.Errorf("mfa is already enabled")
+var ErrMFANotEnabled = fmt.Errorf("mfa is not enabled")
+var ErrInvalidMFACode = fmt.Errorf("invalid mfa code")
+var ErrInvalidRole = fmt.Errorf("invalid role")
+var ErrAccountDisabled = fmt.Errorf("account is disabled")
+var ErrAccountLocked = fmt.Errorf("account is locked")
+var ErrInvalidStatus = fmt.Errorf("invalid account status")
+var ErrTooManyAttempts = fmt.Errorf("too many failed attempts")
+var ErrInvalidCredential = fmt.Errorf("invalid credential")
+var ErrInvalidOAuthState = fmt.Errorf("invalid oauth state")
+var ErrInvalidRedirect = fmt.Errorf("redirect url is not allowed")
+var ErrIdentityNotLinked = fmt.Errorf("account with this email exists, sign in to link the provider")
+var ErrIdentityLinked = fmt.Errorf("identity is linked to another account")
+var ErrLastLoginMethod = fmt.Errorf("can't remove the last login method")
+var ErrInvalidClientMetadata = fmt.Errorf("invalid client metadata")
+var ErrInvalidAuthorizationRequest = fmt.Errorf("authorization request is invalid or expired")
@@ -0,0 +1,58 @@
+package service_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+// allowSigning makes secret repository sign tokens with a fake signature, so their claims can be read
+func allowSigning(secretRepo *mocks.SecretRepositoryMock) {
+	secretRepo.SigningAlgorithmMock.Return("ES256", nil)
+	secretRepo.GetKIDMock.Return("1", nil)
+	secretRepo.SignJWTMock.Set(func(ctx context.Context, data, keyName, version string) (string, error) {
+		return data + ".signature", nil
+	})
+}
+
+// allowLogin lets token pairs be issued: tokens are signed, refresh tokens and sessions are saved
+func allowLogin(tokenRepo *mocks.ITokenRepositoryMock, secretRepo *mocks.SecretRepositoryMock) {
+	tokenRepo.AddRefreshTokenMock.Return(nil)
+	tokenRepo.PushMock.Return(nil)
+	tokenRepo.SaveSessionMock.Return(nil)
+	allowSigning(secretRepo)
+}
+
+// activeUser returns the user with verified email who may log in
+func activeUser(email string) domain.User {
+	u := domain.User{ID: uuid.New(), Email: email, Role: domain.UserRole, HashedPassword: "hash", VerifiedAt: time.Now()}
+	u.SetStatus(domain.StatusActive, "", time.Now())
+	return u
+}
+
+// tokenClaims returns claims of the token without verifying it
+func tokenClaims(t *testing.T, token string) map[string]any {
+	payload, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[1])
+	require.NoError(t, err)
+	var claims map[string]any
+	require.NoError(t, json.Unmarshal(payload, &claims))
+	return claims
+}
+
+// oneTimeKey returns the key the service keeps one-time token under
+func oneTimeKey(secret, prefix, token string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(token))
+	return prefix + hex.EncodeToString(mac.Sum(nil))
+}
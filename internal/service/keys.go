@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/domain"
+)
+
+// SigningKey is a version of the JWT signing key with its rotation state
+type SigningKey struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	// Signing is set for the latest version, new tokens are signed with it only
+	Signing bool `json:"signing"`
+	// Verifying is set while tokens signed with the version are accepted
+	Verifying bool `json:"verifying"`
+	// SupersededAt is creation time of the next version, zero for the signing one
+	SupersededAt time.Time `json:"superseded_at,omitempty"`
+}
+
+// signingKeys computes rotation state of sorted key versions.
+// Old versions keep verifying for grace period after the next version is created, zero grace means never expire.
+// Config validation keeps non-zero grace at least AccessTokenTTL
+func signingKeys(versions []domain.KeyVersion, grace time.Duration, now time.Time) []SigningKey {
+	keys := make([]SigningKey, 0, len(versions))
+	for i, v := range versions {
+		key := SigningKey{
+			Version:   v.Version,
+			CreatedAt: v.CreatedAt,
+			Signing:   i == len(versions)-1,
+			Verifying: true,
+		}
+		if !key.Signing {
+			key.SupersededAt = versions[i+1].CreatedAt
+			key.Verifying = grace <= 0 || now.Before(key.SupersededAt.Add(grace))
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (s *VaultService) SigningKeys(ctx context.Context) ([]SigningKey, error) {
+	versions, err := s.repo.KeyVersions(ctx, JWTSingingKey)
+	if err != nil {
+		s.log.Errorf("failed to get signing key versions: %w", err)
+		return nil, ErrInternal
+	}
+	return signingKeys(versions, s.cfg.KeyGracePeriod, time.Now()), nil
+}
+
+// RotateSigningKey creates a new signing key version. The previous one keeps verifying for grace period
+func (s *VaultService) RotateSigningKey(ctx context.Context) (string, error) {
+	kid, err := s.repo.RotateKey(ctx, JWTSingingKey)
+	if err != nil {
+		s.log.Errorf("failed to rotate signing key: %w", err)
+		return "", ErrInternal
+	}
+	s.purgeKeysCache(ctx)
+	s.log.Infow("signing key rotated", "kid", kid)
+	return kid, nil
+}
+
+// RetireSigningKeys removes versions below minVersion from the secret repository.
+// Versions which may have signed still valid tokens are kept unless force is set
+func (s *VaultService) RetireSigningKeys(ctx context.Context, minVersion int, force bool) error {
+	keys, err := s.SigningKeys(ctx)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 || minVersion > keys[len(keys)-1].Version {
+		return ErrInvalidKeyVersion
+	}
+
+	now := time.Now()
+	inUse := max(s.cfg.KeyGracePeriod, AccessTokenTTL)
+	for _, key := range keys {
+		if key.Version >= minVersion {
+			break
+		}
+		if !force && now.Before(key.SupersededAt.Add(inUse)) {
+			return ErrKeyVersionInUse
+		}
+	}
+
+	if err := s.repo.RetireKeyVersions(ctx, JWTSingingKey, minVersion); err != nil {
+		s.log.Errorf("failed to retire signing key versions: %w", err)
+		return ErrInternal
+	}
+	s.purgeKeysCache(ctx)
+	s.log.Infow("signing key versions retired", "min_version", strconv.Itoa(minVersion))
+	return nil
+}
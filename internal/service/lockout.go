@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	loginFailuresPrefix = "login-failures:"
+	loginLockPrefix     = "login-lock:"
+	loginLockoutsPrefix = "login-lockouts:"
+)
+
+// lockoutHistoryTTL is how long previous lockouts are remembered to grow the next one
+const lockoutHistoryTTL = 24 * time.Hour
+
+// LockedOutError is returned when login is blocked after too many failed attempts
+type LockedOutError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LockedOutError) Error() string {
+	return fmt.Sprintf("too many failed login attempts, retry after %s", e.RetryAfter)
+}
+
+func (e *LockedOutError) Unwrap() error {
+	return ErrTooManyAttempts
+}
+
+// loginSubjects returns lockout keys of the attempt with their failure limits.
+// Subjects with zero limit are not tracked
+func (s *UserService) loginSubjects(ctx context.Context, email string) map[string]int {
+	subjects := make(map[string]int, 2)
+	if s.cfg.Lockout.MaxAttempts > 0 {
+		subjects["email:"+normalizeEmail(email)] = s.cfg.Lockout.MaxAttempts
+	}
+	if ip := clientInfoFromContext(ctx).IP; ip != "" && s.cfg.Lockout.IPMaxAttempts > 0 {
+		subjects["ip:"+ip] = s.cfg.Lockout.IPMaxAttempts
+	}
+	return subjects
+}
+
+// checkLockout returns *LockedOutError if either the account or the client is locked out
+func (s *UserService) checkLockout(ctx context.Context, email string) error {
+	var retryAfter time.Duration
+	for subject := range s.loginSubjects(ctx, email) {
+		ttl, err := s.tokenRepo.TTL(ctx, loginLockPrefix+subject)
+		if err != nil {
+			return err
+		}
+		retryAfter = max(retryAfter, ttl)
+	}
+	if retryAfter > 0 {
+		return &LockedOutError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// registerLoginFailure counts failed attempt and locks subjects out when they reach the limit.
+// Every next lockout of the subject is twice as long as the previous one
+func (s *UserService) registerLoginFailure(ctx context.Context, email string) error {
+	policy := s.cfg.Lockout
+	for subject, limit := range s.loginSubjects(ctx, email) {
+		failures, err := s.tokenRepo.Incr(ctx, loginFailuresPrefix+subject, policy.Window)
+		if err != nil {
+			return err
+		}
+		if failures < int64(limit) {
+			continue
+		}
+
+		lockouts, err := s.tokenRepo.Incr(ctx, loginLockoutsPrefix+subject, lockoutHistoryTTL)
+		if err != nil {
+			return err
+		}
+		d := lockoutDuration(policy.BaseLockout, policy.MaxLockout, lockouts)
+		if err := s.tokenRepo.Add(ctx, loginLockPrefix+subject, "1", d); err != nil {
+			return err
+		}
+		if err := s.tokenRepo.Delete(ctx, loginFailuresPrefix+subject); err != nil {
+			return err
+		}
+		s.log.Warnw("security event: login locked out",
+			"event", "login_lockout",
+			"subject", subject,
+			"failures", failures,
+			"lockout", d,
+		)
+	}
+	return nil
+}
+
+// loginFailed registers failure on a best-effort basis, login must not fail because of the counters
+func (s *UserService) loginFailed(ctx context.Context, email string) {
+	if err := s.registerLoginFailure(ctx, email); err != nil {
+		s.log.Errorw("failed to register login failure", "user_email", email, "error", err)
+	}
+}
+
+// resetLoginFailures forgets failures of the account after successful login.
+// Counters of the client are kept, so a valid account can't be used to reset them
+func (s *UserService) resetLoginFailures(ctx context.Context, email string) error {
+	if s.cfg.Lockout.MaxAttempts <= 0 {
+		return nil
+	}
+	subject := "email:" + normalizeEmail(email)
+	return s.tokenRepo.Delete(ctx, loginFailuresPrefix+subject, loginLockoutsPrefix+subject)
+}
+
+func lockoutDuration(base, limit time.Duration, lockouts int64) time.Duration {
+	d := base
+	for i := int64(1); i < lockouts && (limit <= 0 || d < limit); i++ {
+		d *= 2
+	}
+	if limit > 0 && d > limit {
+		d = limit
+	}
+	return d
+}
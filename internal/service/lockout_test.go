@@ -0,0 +1,97 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alexedwards/argon2id"
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository/mocks"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/zap"
+)
+
+func TestLockout(t *testing.T) {
+	logger := zap.NewExample()
+	tracer := noop.NewTracerProvider().Tracer("")
+
+	cfg := &configs.AuthConfig{
+		Lockout: configs.LockoutConfig{
+			MaxAttempts: 5,
+			Window:      15 * time.Minute,
+			BaseLockout: time.Minute,
+			MaxLockout:  time.Hour,
+		},
+	}
+
+	hash, err := argon2id.CreateHash("secret", argon2id.DefaultParams)
+	require.NoError(t, err)
+	user := domain.User{ID: uuid.New(), Email: "user@example.com", HashedPassword: hash}
+
+	ctx := context.Background()
+
+	t.Run("locked out account is rejected before password check", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(mc)
+		userService := service.NewUserService(logger.Sugar(), tracer, nil, tokenRepo, nil, nil, nil, nil, cfg, nil, nil)
+
+		tokenRepo.TTLMock.Expect(minimock.AnyContext, "login-lock:email:user@example.com").Return(30*time.Second, nil)
+
+		_, err := userService.Authenticate(ctx, user.Email, "secret")
+
+		var lockedOut *service.LockedOutError
+		require.ErrorAs(t, err, &lockedOut)
+		require.ErrorIs(t, err, service.ErrTooManyAttempts)
+		require.Equal(t, 30*time.Second, lockedOut.RetryAfter)
+	})
+
+	t.Run("reaching the limit locks account with growing duration", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(mc)
+		userRepo := mocks.NewIUserRepositoryMock(mc)
+		userService := service.NewUserService(logger.Sugar(), tracer, userRepo, tokenRepo, nil, nil, nil, nil, cfg, nil, nil)
+
+		tokenRepo.TTLMock.Return(-2, nil)
+		userRepo.GetByEmailMock.Return(user, nil)
+		tokenRepo.IncrMock.Set(func(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+			switch key {
+			case "login-failures:email:user@example.com":
+				require.Equal(t, cfg.Lockout.Window, expiration)
+				return 5, nil
+			case "login-lockouts:email:user@example.com":
+				return 3, nil
+			}
+			t.Fatalf("unexpected key %s", key)
+			return 0, nil
+		})
+		tokenRepo.AddMock.Expect(minimock.AnyContext, "login-lock:email:user@example.com", "1", 4*time.Minute).Return(nil)
+		tokenRepo.DeleteMock.Expect(minimock.AnyContext, "login-failures:email:user@example.com").Return(nil)
+
+		_, err := userService.Authenticate(ctx, user.Email, "wrong")
+
+		require.ErrorIs(t, err, service.ErrBadCredentials)
+	})
+
+	t.Run("successful login resets account failures", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(mc)
+		userRepo := mocks.NewIUserRepositoryMock(mc)
+		userService := service.NewUserService(logger.Sugar(), tracer, userRepo, tokenRepo, nil, nil, nil, nil, cfg, nil, nil)
+
+		tokenRepo.TTLMock.Return(-2, nil)
+		userRepo.GetByEmailMock.Return(domain.User{ID: user.ID, Email: user.Email, HashedPassword: hash, Status: domain.StatusDisabled}, nil)
+		tokenRepo.DeleteMock.Expect(
+			minimock.AnyContext, "login-failures:email:user@example.com", "login-lockouts:email:user@example.com",
+		).Return(nil)
+
+		_, err := userService.Authenticate(ctx, user.Email, "secret")
+
+		require.ErrorIs(t, err, service.ErrAccountDisabled)
+	})
+}
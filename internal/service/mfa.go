@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/pkg/totp"
+)
+
+const MFAChallengeTTL = 5 * time.Minute
+
+// MFAChallengeAttempts is how many codes may be tried with one challenge
+const MFAChallengeAttempts = 5
+
+// MFASecretKey is a name of the key encrypting TOTP secrets in secret repository
+var MFASecretKey = "mfa-key"
+
+const (
+	mfaChallengePrefix = "mfa-challenge:"
+	mfaAttemptsPrefix  = "mfa-attempts:"
+	totpUsedPrefix     = "totp-used:"
+	recoveryCodesCount = 10
+	defaultMFAIssuer   = "go-auth-service"
+)
+
+const (
+	TOTPMethod     = "totp"
+	WebAuthnMethod = "webauthn"
+)
+
+// MFAChallengeError is returned on successful password check when user has to pass the second factor.
+// Token must be sent back with the code (or WebAuthn assertion) to complete login
+type MFAChallengeError struct {
+	Token string
+	// Methods are second factors available to the user
+	Methods []string
+}
+
+func (e *MFAChallengeError) Error() string {
+	return ErrMFARequired.Error()
+}
+
+func (e *MFAChallengeError) Unwrap() error {
+	return ErrMFARequired
+}
+
+type TOTPEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// EnrollTOTP creates a new unconfirmed TOTP secret for the user.
+// MFA isn't enabled until the user confirms it with a valid code
+func (s *UserService) EnrollTOTP(ctx context.Context, userID string) (*TOTPEnrollment, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.mfaRepo.Get(ctx, u.ID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		s.log.Errorf("failed to get mfa: %w", err)
+		return nil, ErrInternal
+	}
+	if current.Enabled() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		s.log.Errorf("failed to generate totp secret: %w", err)
+		return nil, ErrInternal
+	}
+	encrypted, err := s.secretRepo.Encrypt(ctx, secret, MFASecretKey)
+	if err != nil {
+		s.log.Errorf("failed to encrypt totp secret: %w", err)
+		return nil, ErrInternal
+	}
+
+	mfa := domain.MFA{
+		UserID:    u.ID,
+		Secret:    encrypted,
+		CreatedAt: time.Now(),
+	}
+	if err := s.mfaRepo.Save(ctx, mfa); err != nil {
+		s.log.Errorf("failed to save mfa: %w", err)
+		return nil, ErrInternal
+	}
+
+	issuer := s.cfg.MFAIssuer
+	if issuer == "" {
+		issuer = defaultMFAIssuer
+	}
+	return &TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(issuer, u.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP enables MFA and returns recovery codes. Codes are shown only once
+func (s *UserService) ConfirmTOTP(ctx context.Context, userID, code string) ([]string, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	mfa, err := s.mfaRepo.Get(ctx, u.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrMFANotEnabled
+		}
+		s.log.Errorf("failed to get mfa: %w", err)
+		return nil, ErrInternal
+	}
+	if mfa.Enabled() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+	if err := s.verifyTOTP(ctx, mfa, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		s.log.Errorf("failed to generate recovery codes: %w", err)
+		return nil, ErrInternal
+	}
+	if err := s.mfaRepo.SetRecoveryCodes(ctx, u.ID, hashes); err != nil {
+		s.log.Errorf("failed to save recovery codes: %w", err)
+		return nil, ErrInternal
+	}
+
+	mfa.ConfirmedAt = time.Now()
+	if err := s.mfaRepo.Save(ctx, mfa); err != nil {
+		s.log.Errorf("failed to save mfa: %w", err)
+		return nil, ErrInternal
+	}
+	return codes, nil
+}
+
+// DisableTOTP turns MFA off. Valid TOTP or recovery code is required
+func (s *UserService) DisableTOTP(ctx context.Context, userID, code string) error {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	mfa, err := s.mfaRepo.Get(ctx, u.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrMFANotEnabled
+		}
+		s.log.Errorf("failed to get mfa: %w", err)
+		return ErrInternal
+	}
+	if !mfa.Enabled() {
+		return ErrMFANotEnabled
+	}
+	if err := s.verifySecondFactor(ctx, mfa, code); err != nil {
+		return err
+	}
+
+	if err := s.mfaRepo.Delete(ctx, u.ID); err != nil {
+		s.log.Errorf("failed to delete mfa: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+// CompleteMFALogin finishes login started by Authenticate.
+// The challenge survives wrong codes, it is consumed by the right one or after MFAChallengeAttempts
+func (s *UserService) CompleteMFALogin(ctx context.Context, mfaToken, code string) (*TokenPair, error) {
+	userID, err := lookupOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, mfaChallengePrefix, mfaToken)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrInvalidToken
+		}
+		s.log.Errorf("failed to get mfa challenge: %w", err)
+		return nil, ErrInternal
+	}
+
+	attemptsKey := mfaAttemptsPrefix + hashToken(s.cfg.TokenSecret, mfaToken)
+	attempts, err := s.tokenRepo.Incr(ctx, attemptsKey, MFAChallengeTTL)
+	if err != nil {
+		s.log.Errorf("failed to count mfa attempts: %w", err)
+		return nil, ErrInternal
+	}
+	if attempts > MFAChallengeAttempts {
+		if _, err := consumeOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, mfaChallengePrefix, mfaToken); err != nil && !errors.Is(err, repository.ErrNotFound) {
+			s.log.Errorf("failed to consume mfa challenge: %w", err)
+		}
+		return nil, ErrInvalidToken
+	}
+
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	mfa, err := s.mfaRepo.Get(ctx, u.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrMFANotEnabled
+		}
+		s.log.Errorf("failed to get mfa: %w", err)
+		return nil, ErrInternal
+	}
+	if err := s.verifySecondFactor(ctx, mfa, code); err != nil {
+		return nil, err
+	}
+	// concurrent requests may pass verification, only one of them consumes the challenge
+	if _, err := consumeOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, mfaChallengePrefix, mfaToken); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrInvalidToken
+		}
+		s.log.Errorf("failed to consume mfa challenge: %w", err)
+		return nil, ErrInternal
+	}
+
+	tokens, err := createTokenPair(ctx, s.secretRepo, s.tokenRepo, s.cfg, u, PasswordLogin)
+	if err != nil {
+		if IsAccountStatusError(err) {
+			return nil, err
+		}
+		s.log.Errorf("failed to create tokens: %w", err)
+		return nil, ErrInternal
+	}
+	return tokens, nil
+}
+
+// mfaChallenge returns challenge error if user has any second factor, nil otherwise
+func (s *UserService) mfaChallenge(ctx context.Context, u domain.User) error {
+	var methods []string
+
+	mfa, err := s.mfaRepo.Get(ctx, u.ID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+	if mfa.Enabled() {
+		methods = append(methods, TOTPMethod)
+	}
+
+	creds, err := s.passkeys.ListByUser(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+	if len(creds) > 0 {
+		methods = append(methods, WebAuthnMethod)
+	}
+
+	if len(methods) == 0 {
+		return nil
+	}
+	token, err := issueOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, mfaChallengePrefix, u.ID.String(), MFAChallengeTTL)
+	if err != nil {
+		return err
+	}
+	return &MFAChallengeError{Token: token, Methods: methods}
+}
+
+// verifySecondFactor accepts either TOTP code or one of recovery codes
+func (s *UserService) verifySecondFactor(ctx context.Context, mfa domain.MFA, code string) error {
+	code = strings.TrimSpace(code)
+	if len(code) == totp.Digits {
+		return s.verifyTOTP(ctx, mfa, code)
+	}
+
+	err := s.mfaRepo.UseRecoveryCode(ctx, mfa.UserID, hashToken(s.cfg.TokenSecret, normalizeRecoveryCode(code)))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrInvalidMFACode
+		}
+		s.log.Errorf("failed to use recovery code: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+func (s *UserService) verifyTOTP(ctx context.Context, mfa domain.MFA, code string) error {
+	secret, err := s.secretRepo.Decrypt(ctx, mfa.Secret, MFASecretKey)
+	if err != nil {
+		s.log.Errorf("failed to decrypt totp secret: %w", err)
+		return ErrInternal
+	}
+	step, ok := totp.Validate(secret, code, time.Now())
+	if !ok {
+		return ErrInvalidMFACode
+	}
+
+	// the same code can't be used twice, the step is claimed atomically
+	key := fmt.Sprintf("%s%s:%d", totpUsedPrefix, mfa.UserID, step)
+	if err := s.tokenRepo.AddNX(ctx, key, "1", 3*totp.Period); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return ErrInvalidMFACode
+		}
+		s.log.Errorf("failed to save used totp code: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+func (s *UserService) generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	for i := 0; i < recoveryCodesCount; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(encoding.EncodeToString(b))
+		codes = append(codes, raw[:4]+"-"+raw[4:])
+		hashes = append(hashes, hashToken(s.cfg.TokenSecret, raw))
+	}
+	return codes, hashes, nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(code, "-", ""))
+}
+
+func (s *UserService) getUser(ctx context.Context, userID string) (domain.User, error) {
+	return getUserByID(ctx, s.userRepo, s.log, userID)
+}
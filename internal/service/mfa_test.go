@@ -0,0 +1,160 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/internal/repository/mocks"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/maisiq/go-auth-service/pkg/totp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestConfirmTOTP(t *testing.T) {
+	logger := zap.NewExample()
+	userRepo := mocks.NewIUserRepositoryMock(t)
+	tokenRepo := mocks.NewITokenRepositoryMock(t)
+	secretRepo := mocks.NewSecretRepositoryMock(t)
+	mfaRepo := mocks.NewIMFARepositoryMock(t)
+
+	ctx := context.Background()
+
+	userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, mfaRepo, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+	id := uuid.New()
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	userRepo.GetByIDMock.Expect(minimock.AnyContext, id).Return(domain.User{ID: id}, nil)
+	mfaRepo.GetMock.Expect(minimock.AnyContext, id).Return(domain.MFA{UserID: id, Secret: "vault:v1:encrypted"}, nil)
+	secretRepo.DecryptMock.Expect(minimock.AnyContext, "vault:v1:encrypted", service.MFASecretKey).Return(secret, nil)
+
+	used := map[string]bool{}
+	tokenRepo.AddNXMock.Set(func(ctx context.Context, key, value string, expiration time.Duration) error {
+		if used[key] {
+			return repository.ErrAlreadyExists
+		}
+		used[key] = true
+		return nil
+	})
+
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+
+	t.Run("confirm enables mfa and returns recovery codes", func(t *testing.T) {
+		mfaRepo.SetRecoveryCodesMock.Inspect(func(ctx context.Context, userID uuid.UUID, codeHashes []string) {
+			require.Len(t, codeHashes, 10)
+		}).Return(nil)
+		mfaRepo.SaveMock.Inspect(func(ctx context.Context, mfa domain.MFA) {
+			require.True(t, mfa.Enabled())
+		}).Return(nil)
+
+		codes, err := userService.ConfirmTOTP(ctx, id.String(), code)
+
+		require.NoError(t, err)
+		require.Len(t, codes, 10)
+	})
+
+	t.Run("confirm with used code", func(t *testing.T) {
+		_, err := userService.ConfirmTOTP(ctx, id.String(), code)
+
+		require.ErrorIs(t, err, service.ErrInvalidMFACode)
+	})
+
+	t.Run("confirm with wrong code", func(t *testing.T) {
+		_, err := userService.ConfirmTOTP(ctx, id.String(), "000000x")
+
+		require.ErrorIs(t, err, service.ErrInvalidMFACode)
+	})
+}
+
+func TestCompleteMFALogin(t *testing.T) {
+	logger := zap.NewExample()
+	ctx := context.Background()
+	cfg := &configs.AuthConfig{TokenSecret: "secret"}
+
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	u := activeUser("user@example.com")
+	mfaToken := "challenge"
+
+	// newService keeps the challenge of the user in memory
+	newService := func(t *testing.T) *service.UserService {
+		userRepo := mocks.NewIUserRepositoryMock(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		mfaRepo := mocks.NewIMFARepositoryMock(t)
+
+		store := map[string]string{oneTimeKey(cfg.TokenSecret, "mfa-challenge:", mfaToken): u.ID.String()}
+		attempts := map[string]int64{}
+		tokenRepo.GetMock.Set(func(_ context.Context, key string) (string, error) {
+			value, ok := store[key]
+			if !ok {
+				return "", repository.ErrNotFound
+			}
+			return value, nil
+		})
+		tokenRepo.GetDelMock.Optional().Set(func(_ context.Context, key string) (string, error) {
+			value, ok := store[key]
+			if !ok {
+				return "", repository.ErrNotFound
+			}
+			delete(store, key)
+			return value, nil
+		})
+		tokenRepo.IncrMock.Set(func(_ context.Context, key string, _ time.Duration) (int64, error) {
+			attempts[key]++
+			return attempts[key], nil
+		})
+		tokenRepo.AddNXMock.Optional().Return(nil)
+		userRepo.GetByIDMock.Optional().Return(u, nil)
+		mfaRepo.GetMock.Optional().Return(domain.MFA{UserID: u.ID, Secret: "vault:v1:encrypted", ConfirmedAt: time.Now()}, nil)
+		mfaRepo.UseRecoveryCodeMock.Optional().Return(repository.ErrNotFound)
+		secretRepo.DecryptMock.Optional().Return(secret, nil)
+		allowLogin(tokenRepo, secretRepo)
+		tokenRepo.AddRefreshTokenMock.Optional()
+		tokenRepo.PushMock.Optional()
+		tokenRepo.SaveSessionMock.Optional()
+		secretRepo.SigningAlgorithmMock.Optional()
+		secretRepo.GetKIDMock.Optional()
+		secretRepo.SignJWTMock.Optional()
+
+		return service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, mfaRepo, nil, nil, cfg, nil, nil)
+	}
+
+	t.Run("challenge survives wrong code", func(t *testing.T) {
+		s := newService(t)
+
+		_, err := s.CompleteMFALogin(ctx, mfaToken, "wrong-recovery-code")
+		require.ErrorIs(t, err, service.ErrInvalidMFACode)
+
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		tokens, err := s.CompleteMFALogin(ctx, mfaToken, code)
+		require.NoError(t, err)
+		require.NotEmpty(t, tokens.Access)
+
+		_, err = s.CompleteMFALogin(ctx, mfaToken, code)
+		require.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+
+	t.Run("challenge is dropped after too many attempts", func(t *testing.T) {
+		s := newService(t)
+
+		for range service.MFAChallengeAttempts {
+			_, err := s.CompleteMFALogin(ctx, mfaToken, "wrong-recovery-code")
+			require.ErrorIs(t, err, service.ErrInvalidMFACode)
+		}
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		_, err = s.CompleteMFALogin(ctx, mfaToken, code)
+		require.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
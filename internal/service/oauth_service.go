@@ -2,104 +2,336 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
 	"github.com/maisiq/go-auth-service/internal/domain"
 	"github.com/maisiq/go-auth-service/internal/oauth"
 	"github.com/maisiq/go-auth-service/internal/repository"
 	"go.uber.org/zap"
 )
 
+// OAuthStateTTL is how long user may stay on the provider's consent page
+const OAuthStateTTL = 10 * time.Minute
+
+const oauthStatePrefix = "oauth-state:"
+
+// OAuthResult is an outcome of the provider's callback
+type OAuthResult struct {
+	Tokens *TokenPair
+	// Identity is set instead of tokens when signed in user links the identity
+	Identity   *domain.UserIdentity
+	RedirectTo string
+}
+
 type OAuthService struct {
 	log        *zap.SugaredLogger
 	tokenRepo  ITokenRepository
 	userRepo   IUserRepository
 	secretRepo repository.SecretRepository
+	identities repository.IIdentityRepository
+	providers  *oauth.Registry
+	cfg        *configs.AuthConfig
 }
 
-func NewOAuthService(log *zap.SugaredLogger, userRepo IUserRepository, tokenRepo ITokenRepository, secretRepo repository.SecretRepository) *OAuthService {
+func NewOAuthService(
+	log *zap.SugaredLogger,
+	userRepo IUserRepository,
+	tokenRepo ITokenRepository,
+	secretRepo repository.SecretRepository,
+	identities repository.IIdentityRepository,
+	providers *oauth.Registry,
+	cfg *configs.AuthConfig,
+) *OAuthService {
 	return &OAuthService{
 		tokenRepo:  tokenRepo,
 		userRepo:   userRepo,
 		secretRepo: secretRepo,
+		identities: identities,
+		providers:  providers,
 		log:        log,
+		cfg:        cfg,
+	}
+}
+
+func (s *OAuthService) BeginLogin(ctx context.Context, providerName oauth.OAuthProviderT, redirectTo string) (string, string, error) {
+	return s.begin(ctx, providerName, redirectTo, "")
+}
+
+func (s *OAuthService) BeginLink(ctx context.Context, userID string, providerName oauth.OAuthProviderT, redirectTo string) (string, string, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	return s.begin(ctx, providerName, redirectTo, u.ID.String())
+}
+
+func (s *OAuthService) begin(ctx context.Context, providerName oauth.OAuthProviderT, redirectTo, linkUserID string) (string, string, error) {
+	provider, err := s.providers.Get(providerName)
+	if err != nil {
+		return "", "", err
+	}
+	if redirectTo != "" && !allowedRedirect(redirectTo, s.cfg.AllowedRedirects) {
+		return "", "", ErrInvalidRedirect
+	}
+
+	verifier, err := createRefreshToken()
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := createRefreshToken()
+	if err != nil {
+		return "", "", err
+	}
+	value, err := json.Marshal(domain.OAuthState{
+		Provider:     string(providerName),
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		RedirectTo:   redirectTo,
+		LinkUserID:   linkUserID,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	// state is a one-time token, only its hash is stored
+	state, err := issueOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, oauthStatePrefix, string(value), OAuthStateTTL)
+	if err != nil {
+		s.log.Errorf("failed to save oauth state: %w", err)
+		return "", "", ErrInternal
 	}
+
+	redirectURL, err := provider.AuthorizeURL(ctx, oauth.AuthRequest{State: state, CodeVerifier: verifier, Nonce: nonce})
+	if err != nil {
+		return "", "", err
+	}
+	return redirectURL, state, nil
 }
 
-func (s *OAuthService) CreateTokens(ctx context.Context, provider oauth.OAuthProvider, authorizationCode string) (*TokenPair, error) {
-	data, err := provider.GetData(authorizationCode)
+func (s *OAuthService) Callback(
+	ctx context.Context,
+	providerName oauth.OAuthProviderT,
+	state, authorizationCode string,
+) (*OAuthResult, error) {
+	if state == "" {
+		return nil, ErrInvalidOAuthState
+	}
+	value, err := consumeOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, oauthStatePrefix, state)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrInvalidOAuthState
+		}
+		s.log.Errorf("failed to consume oauth state: %w", err)
+		return nil, ErrInternal
+	}
+	var saved domain.OAuthState
+	if err := json.Unmarshal([]byte(value), &saved); err != nil {
+		s.log.Errorf("invalid oauth state: %w", err)
+		return nil, ErrInternal
+	}
+	// state of one provider can't complete login with another one
+	if saved.Provider != string(providerName) {
+		return nil, ErrInvalidOAuthState
+	}
+	provider, err := s.providers.Get(providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := provider.GetData(ctx, authorizationCode, oauth.AuthRequest{
+		State:        state,
+		CodeVerifier: saved.CodeVerifier,
+		Nonce:        saved.Nonce,
+	})
 	if err != nil {
 		return nil, err
 	}
-	u, err := s.getOrCreateUser(ctx, data.Email, data.UserID, data.Provider)
+	if saved.LinkUserID != "" {
+		identity, err := s.link(ctx, saved.LinkUserID, data)
+		if err != nil {
+			return nil, err
+		}
+		return &OAuthResult{Identity: identity, RedirectTo: saved.RedirectTo}, nil
+	}
+
+	u, err := s.getOrCreateUser(ctx, data)
 	if err != nil {
 		return nil, err
 	}
-	access, err := createAccessToken(ctx, s.secretRepo, u.ID.String(), u.Email)
+	tokens, err := createTokenPair(ctx, s.secretRepo, s.tokenRepo, s.cfg, *u, string(data.Provider))
 	if err != nil {
-		s.log.Errorf("failed to create jwt token: %w", err)
+		if IsAccountStatusError(err) {
+			return nil, err
+		}
+		s.log.Errorf("failed to create tokens: %w", err)
 		return nil, ErrInternal
 	}
-	refresh, err := createRefreshToken()
+	return &OAuthResult{Tokens: tokens, RedirectTo: saved.RedirectTo}, nil
+}
+
+func (s *OAuthService) Identities(ctx context.Context, userID string) ([]domain.UserIdentity, error) {
+	u, err := s.getUser(ctx, userID)
 	if err != nil {
-		s.log.Errorf("failed to create refresh token: %w", err)
+		return nil, err
+	}
+	identities, err := s.identities.ListByUser(ctx, u.ID)
+	if err != nil {
+		s.log.Errorf("failed to list identities: %w", err)
 		return nil, ErrInternal
 	}
-	tokens := TokenPair{
-		Refresh: refresh,
-		Access:  access,
+	return identities, nil
+}
+
+func (s *OAuthService) Unlink(ctx context.Context, userID, provider, subject string) error {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	// the user must keep a way to log in, repository checks it along with deletion
+	if err := s.identities.Delete(ctx, u.ID, provider, subject); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return ErrNotFound
+		case errors.Is(err, repository.ErrLastLoginMethod):
+			return ErrLastLoginMethod
+		}
+		s.log.Errorf("failed to delete identity: %w", err)
+		return ErrInternal
 	}
+	return nil
+}
 
-	if err := s.tokenRepo.Add(ctx, refresh, u.Email, RefreshTokenTTL); err != nil {
-		s.log.Errorf("failed to add token to token repo: %w", err)
-		return nil, ErrInternal
+// allowedRedirect accepts paths of this service and URLs of allowed origins
+func allowedRedirect(target string, allowed []string) bool {
+	u, err := url.Parse(target)
+	if err != nil || u.User != nil {
+		return false
 	}
-	if err := s.tokenRepo.Push(ctx, u.Email, refresh); err != nil {
-		s.log.Errorf("failed to add token to all user sessions: %w", err)
-		return nil, ErrInternal
+	if u.Scheme == "" && u.Host == "" {
+		// browsers treat //host and /\host as another origin
+		return strings.HasPrefix(target, "/") && !strings.HasPrefix(target, "//") && !strings.HasPrefix(target, "/\\")
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return false
+	}
+	origin := u.Scheme + "://" + u.Host
+	for _, a := range allowed {
+		if strings.EqualFold(strings.TrimSuffix(a, "/"), origin) {
+			return true
+		}
 	}
-	return &tokens, nil
+	return false
 }
 
-func (s *OAuthService) getOrCreateUser(
-	ctx context.Context,
-	email, socialID string,
-	socialProvider oauth.OAuthProviderT,
-) (*domain.User, error) {
-	normalizedEmail := normalizeEmail(email)
+// getOrCreateUser finds the user by the provider's identity. The identity is linked to the account
+// with the same email only if both the provider and the account have verified the email,
+// otherwise the owner of the account must sign in and link the identity
+func (s *OAuthService) getOrCreateUser(ctx context.Context, data oauth.OAuthData) (*domain.User, error) {
+	identity, err := s.identities.Get(ctx, string(data.Provider), data.UserID)
+	if err == nil {
+		u, err := s.userRepo.GetByID(ctx, identity.UserID)
+		if err != nil {
+			s.log.Errorf("failed to get user of identity: %w", err)
+			return nil, ErrInternal
+		}
+		return &u, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		s.log.Errorf("failed to get identity: %w", err)
+		return nil, ErrInternal
+	}
+
+	normalizedEmail := normalizeEmail(data.Email)
 
 	u, err := s.userRepo.GetByEmail(ctx, normalizedEmail)
-	if err != nil {
-		if !errors.Is(err, repository.ErrNotFound) {
-			s.log.Errorf("failed to get user: %w", err)
-			return nil, ErrInternal
+	if err == nil {
+		if !data.EmailVerified || !u.EmailVerified() {
+			return nil, ErrIdentityNotLinked
 		}
-		// if user doesn't exist
-		id, _ := uuid.NewV7()
-		newUser := domain.User{
-			ID:             id,
-			Email:          normalizedEmail,
-			Role:           domain.UserRole,
-			SocialAccount:  true,
-			SocialID:       socialID,
-			SocialProvider: string(socialProvider),
-			CreatedAT:      time.Now(),
-			LastLoogedAt:   time.Now(),
+		if _, err := s.addIdentity(ctx, u.ID, data); err != nil {
+			return nil, err
 		}
-		if err := s.userRepo.Add(ctx, newUser); err != nil {
-			s.log.Errorw(
-				"failed to add user to user repo",
-				"function", "OAuthService.getOrCreateUser",
-				"error", err.Error(),
-				"error_details", err,
-				"user", newUser,
-			)
-			return nil, ErrInternal
+		return &u, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		s.log.Errorf("failed to get user: %w", err)
+		return nil, ErrInternal
+	}
+
+	// if user doesn't exist
+	id, _ := uuid.NewV7()
+	newUser := domain.User{
+		ID:             id,
+		Email:          normalizedEmail,
+		Role:           domain.UserRole,
+		SocialAccount:  true,
+		SocialID:       data.UserID,
+		SocialProvider: string(data.Provider),
+		CreatedAT:      time.Now(),
+		LastLoogedAt:   time.Now(),
+	}
+	if data.EmailVerified {
+		newUser.VerifiedAt = newUser.CreatedAT
+	}
+	newUser.SetStatus(domain.StatusActive, "", newUser.CreatedAT)
+	if err := s.userRepo.Add(ctx, newUser); err != nil {
+		s.log.Errorw(
+			"failed to add user to user repo",
+			"function", "OAuthService.getOrCreateUser",
+			"error", err.Error(),
+			"error_details", err,
+			"user", newUser,
+		)
+		return nil, ErrInternal
+	}
+	if _, err := s.addIdentity(ctx, newUser.ID, data); err != nil {
+		return nil, err
+	}
+	return &newUser, nil
+}
+
+// link adds the identity to the signed in user, linking it again is a no-op
+func (s *OAuthService) link(ctx context.Context, userID string, data oauth.OAuthData) (*domain.UserIdentity, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := s.identities.Get(ctx, string(data.Provider), data.UserID)
+	if err == nil {
+		if identity.UserID != u.ID {
+			return nil, ErrIdentityLinked
 		}
-		return &newUser, nil
+		return &identity, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		s.log.Errorf("failed to get identity: %w", err)
+		return nil, ErrInternal
+	}
+	return s.addIdentity(ctx, u.ID, data)
+}
+
+func (s *OAuthService) addIdentity(ctx context.Context, userID uuid.UUID, data oauth.OAuthData) (*domain.UserIdentity, error) {
+	identity := domain.UserIdentity{
+		Provider: string(data.Provider),
+		Subject:  data.UserID,
+		UserID:   userID,
+		Email:    normalizeEmail(data.Email),
+		LinkedAt: time.Now(),
 	}
-	// return if user exists
-	return &u, nil
+	if err := s.identities.Add(ctx, identity); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return nil, ErrIdentityLinked
+		}
+		s.log.Errorf("failed to add identity: %w", err)
+		return nil, ErrInternal
+	}
+	return &identity, nil
+}
+
+func (s *OAuthService) getUser(ctx context.Context, userID string) (domain.User, error) {
+	return getUserByID(ctx, s.userRepo, s.log, userID)
 }
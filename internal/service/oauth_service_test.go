@@ -0,0 +1,308 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/oauth"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/internal/repository/mocks"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var errProviderCalled = fmt.Errorf("provider called")
+
+// fakeOAuthProvider records the last authorization request
+type fakeOAuthProvider struct {
+	req  oauth.AuthRequest
+	data *oauth.OAuthData
+}
+
+func (p *fakeOAuthProvider) AuthorizeURL(_ context.Context, req oauth.AuthRequest) (string, error) {
+	p.req = req
+	return "https://provider.example.com/authorize?state=" + req.State, nil
+}
+
+func (p *fakeOAuthProvider) GetData(_ context.Context, _ string, req oauth.AuthRequest) (oauth.OAuthData, error) {
+	p.req = req
+	if p.data == nil {
+		return oauth.OAuthData{}, errProviderCalled
+	}
+	return *p.data, nil
+}
+
+func TestOAuthState(t *testing.T) {
+	logger := zap.NewExample()
+	ctx := context.Background()
+	cfg := &configs.AuthConfig{
+		TokenSecret:      "secret",
+		AllowedRedirects: []string{"https://app.example.com"},
+	}
+
+	newService := func(t *testing.T) (*service.OAuthService, *mocks.ITokenRepositoryMock, *fakeOAuthProvider) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		provider := &fakeOAuthProvider{}
+		registry := oauth.NewRegistry()
+		require.NoError(t, registry.Register("keycloak", provider))
+		require.NoError(t, registry.Register("gitlab", &fakeOAuthProvider{}))
+		s := service.NewOAuthService(logger.Sugar(), nil, tokenRepo, nil, nil, registry, cfg)
+		return s, tokenRepo, provider
+	}
+
+	t.Run("state is saved with pkce verifier, nonce and redirect", func(t *testing.T) {
+		s, tokenRepo, provider := newService(t)
+
+		var saved domain.OAuthState
+		tokenRepo.AddMock.Set(func(_ context.Context, key, value string, expiration time.Duration) error {
+			require.Equal(t, service.OAuthStateTTL, expiration)
+			return json.Unmarshal([]byte(value), &saved)
+		})
+
+		redirectURL, state, err := s.BeginLogin(ctx, "keycloak", "https://app.example.com/settings")
+		require.NoError(t, err)
+		require.NotEmpty(t, state)
+		require.Contains(t, redirectURL, state)
+
+		require.Equal(t, state, provider.req.State)
+		require.Equal(t, "keycloak", saved.Provider)
+		require.Equal(t, provider.req.CodeVerifier, saved.CodeVerifier)
+		require.Equal(t, provider.req.Nonce, saved.Nonce)
+		require.NotEmpty(t, saved.CodeVerifier)
+		require.NotEmpty(t, saved.Nonce)
+		require.Equal(t, "https://app.example.com/settings", saved.RedirectTo)
+	})
+
+	t.Run("redirect must be a local path or an allowed origin", func(t *testing.T) {
+		s, tokenRepo, _ := newService(t)
+		tokenRepo.AddMock.Return(nil)
+
+		for _, target := range []string{"/dashboard", "/profile?tab=security", "https://app.example.com", "HTTPS://APP.example.com/x"} {
+			_, _, err := s.BeginLogin(ctx, "keycloak", target)
+			require.NoError(t, err, target)
+		}
+		for _, target := range []string{
+			"//evil.example.com",
+			"/\\evil.example.com",
+			"https://evil.example.com",
+			"https://app.example.com.evil.example.com",
+			"https://user@app.example.com",
+			"javascript:alert(1)",
+			"dashboard",
+		} {
+			_, _, err := s.BeginLogin(ctx, "keycloak", target)
+			require.ErrorIs(t, err, service.ErrInvalidRedirect, target)
+		}
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		s, _, _ := newService(t)
+		_, _, err := s.BeginLogin(ctx, "google", "")
+		require.ErrorIs(t, err, oauth.ErrUnknownProvider)
+	})
+
+	t.Run("state is consumed and passed to provider", func(t *testing.T) {
+		s, tokenRepo, provider := newService(t)
+
+		value, _ := json.Marshal(domain.OAuthState{Provider: "keycloak", CodeVerifier: "verifier", Nonce: "nonce"})
+		tokenRepo.GetDelMock.Return(string(value), nil)
+
+		_, err := s.Callback(ctx, "keycloak", "state", "code")
+		require.ErrorIs(t, err, errProviderCalled)
+		require.Equal(t, oauth.AuthRequest{State: "state", CodeVerifier: "verifier", Nonce: "nonce"}, provider.req)
+		require.Equal(t, uint64(1), tokenRepo.GetDelAfterCounter())
+	})
+
+	t.Run("state of another provider is rejected", func(t *testing.T) {
+		s, tokenRepo, _ := newService(t)
+
+		value, _ := json.Marshal(domain.OAuthState{Provider: "keycloak", CodeVerifier: "verifier", Nonce: "nonce"})
+		tokenRepo.GetDelMock.Return(string(value), nil)
+
+		_, err := s.Callback(ctx, "gitlab", "state", "code")
+		require.ErrorIs(t, err, service.ErrInvalidOAuthState)
+	})
+
+	t.Run("missing or unknown state is rejected", func(t *testing.T) {
+		s, tokenRepo, _ := newService(t)
+
+		_, err := s.Callback(ctx, "keycloak", "", "code")
+		require.ErrorIs(t, err, service.ErrInvalidOAuthState)
+
+		tokenRepo.GetDelMock.Return("", repository.ErrNotFound)
+		_, err = s.Callback(ctx, "keycloak", "state", "code")
+		require.ErrorIs(t, err, service.ErrInvalidOAuthState)
+	})
+}
+
+func TestOAuthIdentities(t *testing.T) {
+	logger := zap.NewExample()
+	ctx := context.Background()
+	cfg := &configs.AuthConfig{TokenSecret: "secret"}
+
+	type deps struct {
+		service    *service.OAuthService
+		provider   *fakeOAuthProvider
+		tokenRepo  *mocks.ITokenRepositoryMock
+		userRepo   *mocks.IUserRepositoryMock
+		secretRepo *mocks.SecretRepositoryMock
+		identities *mocks.IIdentityRepositoryMock
+	}
+	newService := func(t *testing.T, data oauth.OAuthData) deps {
+		d := deps{
+			provider:   &fakeOAuthProvider{data: &data},
+			tokenRepo:  mocks.NewITokenRepositoryMock(t),
+			userRepo:   mocks.NewIUserRepositoryMock(t),
+			secretRepo: mocks.NewSecretRepositoryMock(t),
+			identities: mocks.NewIIdentityRepositoryMock(t),
+		}
+		registry := oauth.NewRegistry()
+		require.NoError(t, registry.Register("keycloak", d.provider))
+		d.service = service.NewOAuthService(logger.Sugar(), d.userRepo, d.tokenRepo, d.secretRepo, d.identities, registry, cfg)
+		return d
+	}
+	saveState := func(d deps, state domain.OAuthState) {
+		value, _ := json.Marshal(state)
+		d.tokenRepo.GetDelMock.Return(string(value), nil)
+	}
+
+	t.Run("linked identity logs in its user regardless of email", func(t *testing.T) {
+		d := newService(t, oauth.OAuthData{UserID: "subject", Email: "changed@example.com", Provider: "keycloak"})
+		saveState(d, domain.OAuthState{Provider: "keycloak"})
+		allowLogin(d.tokenRepo, d.secretRepo)
+
+		u := activeUser("user@example.com")
+		d.identities.GetMock.Expect(minimock.AnyContext, "keycloak", "subject").Return(
+			domain.UserIdentity{Provider: "keycloak", Subject: "subject", UserID: u.ID}, nil,
+		)
+		d.userRepo.GetByIDMock.Expect(minimock.AnyContext, u.ID).Return(u, nil)
+
+		result, err := d.service.Callback(ctx, "keycloak", "state", "code")
+		require.NoError(t, err)
+		require.NotNil(t, result.Tokens)
+		require.Nil(t, result.Identity)
+	})
+
+	t.Run("unverified email doesn't log into existing account", func(t *testing.T) {
+		d := newService(t, oauth.OAuthData{UserID: "subject", Email: "User@example.com", Provider: "keycloak"})
+		saveState(d, domain.OAuthState{Provider: "keycloak"})
+
+		d.identities.GetMock.Return(domain.UserIdentity{}, repository.ErrNotFound)
+		d.userRepo.GetByEmailMock.Expect(minimock.AnyContext, "user@example.com").Return(activeUser("user@example.com"), nil)
+
+		_, err := d.service.Callback(ctx, "keycloak", "state", "code")
+		require.ErrorIs(t, err, service.ErrIdentityNotLinked)
+	})
+
+	t.Run("verified email isn't linked to account with unverified email", func(t *testing.T) {
+		d := newService(t, oauth.OAuthData{UserID: "subject", Email: "user@example.com", Provider: "keycloak", EmailVerified: true})
+		saveState(d, domain.OAuthState{Provider: "keycloak"})
+
+		u := activeUser("user@example.com")
+		u.VerifiedAt = time.Time{}
+		d.identities.GetMock.Return(domain.UserIdentity{}, repository.ErrNotFound)
+		d.userRepo.GetByEmailMock.Return(u, nil)
+
+		_, err := d.service.Callback(ctx, "keycloak", "state", "code")
+		require.ErrorIs(t, err, service.ErrIdentityNotLinked)
+	})
+
+	t.Run("verified email is linked to existing account", func(t *testing.T) {
+		d := newService(t, oauth.OAuthData{UserID: "subject", Email: "user@example.com", Provider: "keycloak", EmailVerified: true})
+		saveState(d, domain.OAuthState{Provider: "keycloak"})
+		allowLogin(d.tokenRepo, d.secretRepo)
+
+		u := activeUser("user@example.com")
+		d.identities.GetMock.Return(domain.UserIdentity{}, repository.ErrNotFound)
+		d.userRepo.GetByEmailMock.Return(u, nil)
+		d.identities.AddMock.Set(func(_ context.Context, identity domain.UserIdentity) error {
+			require.Equal(t, u.ID, identity.UserID)
+			require.Equal(t, "keycloak", identity.Provider)
+			require.Equal(t, "subject", identity.Subject)
+			return nil
+		})
+
+		result, err := d.service.Callback(ctx, "keycloak", "state", "code")
+		require.NoError(t, err)
+		require.NotNil(t, result.Tokens)
+	})
+
+	t.Run("new user is created with the identity", func(t *testing.T) {
+		d := newService(t, oauth.OAuthData{UserID: "subject", Email: "new@example.com", Provider: "keycloak"})
+		saveState(d, domain.OAuthState{Provider: "keycloak"})
+		allowLogin(d.tokenRepo, d.secretRepo)
+
+		var created domain.User
+		d.identities.GetMock.Return(domain.UserIdentity{}, repository.ErrNotFound)
+		d.userRepo.GetByEmailMock.Return(domain.User{}, repository.ErrNotFound)
+		d.userRepo.AddMock.Set(func(_ context.Context, u domain.User) error {
+			created = u
+			return nil
+		})
+		d.identities.AddMock.Set(func(_ context.Context, identity domain.UserIdentity) error {
+			require.Equal(t, created.ID, identity.UserID)
+			return nil
+		})
+
+		_, err := d.service.Callback(ctx, "keycloak", "state", "code")
+		require.NoError(t, err)
+		require.False(t, created.EmailVerified(), "provider didn't verify the email")
+	})
+
+	t.Run("signed in user links identity", func(t *testing.T) {
+		d := newService(t, oauth.OAuthData{UserID: "subject", Email: "other@example.com", Provider: "keycloak"})
+		u := activeUser("user@example.com")
+		saveState(d, domain.OAuthState{Provider: "keycloak", LinkUserID: u.ID.String(), RedirectTo: "/settings"})
+
+		d.userRepo.GetByIDMock.Return(u, nil)
+		d.identities.GetMock.Return(domain.UserIdentity{}, repository.ErrNotFound)
+		d.identities.AddMock.Return(nil)
+
+		result, err := d.service.Callback(ctx, "keycloak", "state", "code")
+		require.NoError(t, err)
+		require.Nil(t, result.Tokens)
+		require.Equal(t, "/settings", result.RedirectTo)
+		require.Equal(t, u.ID, result.Identity.UserID)
+		require.Equal(t, "other@example.com", result.Identity.Email)
+	})
+
+	t.Run("identity of another user can't be linked", func(t *testing.T) {
+		d := newService(t, oauth.OAuthData{UserID: "subject", Email: "user@example.com", Provider: "keycloak", EmailVerified: true})
+		u := activeUser("user@example.com")
+		saveState(d, domain.OAuthState{Provider: "keycloak", LinkUserID: u.ID.String()})
+
+		d.userRepo.GetByIDMock.Return(u, nil)
+		d.identities.GetMock.Return(domain.UserIdentity{Provider: "keycloak", Subject: "subject", UserID: uuid.New()}, nil)
+
+		_, err := d.service.Callback(ctx, "keycloak", "state", "code")
+		require.ErrorIs(t, err, service.ErrIdentityLinked)
+	})
+
+	t.Run("last login method can't be unlinked", func(t *testing.T) {
+		d := newService(t, oauth.OAuthData{})
+		u := activeUser("user@example.com")
+		u.HashedPassword = ""
+
+		d.userRepo.GetByIDMock.Return(u, nil)
+		d.identities.DeleteMock.When(minimock.AnyContext, u.ID, "keycloak", "subject").Then(repository.ErrLastLoginMethod)
+		d.identities.DeleteMock.When(minimock.AnyContext, u.ID, "keycloak", "another").Then(repository.ErrNotFound)
+		d.identities.DeleteMock.When(minimock.AnyContext, u.ID, "google", "subject").Then(nil)
+
+		err := d.service.Unlink(ctx, u.ID.String(), "keycloak", "subject")
+		require.ErrorIs(t, err, service.ErrLastLoginMethod)
+
+		err = d.service.Unlink(ctx, u.ID.String(), "keycloak", "another")
+		require.ErrorIs(t, err, service.ErrNotFound)
+
+		err = d.service.Unlink(ctx, u.ID.String(), "google", "subject")
+		require.NoError(t, err)
+	})
+}
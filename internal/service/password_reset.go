@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/mailer"
+	"github.com/maisiq/go-auth-service/internal/repository"
+)
+
+const DefaultPasswordResetTTL = 15 * time.Minute
+
+const (
+	passwordResetTokenPrefix = "password-reset:"
+	// keeps time of the last reset of the user, reset tokens issued before it are rejected
+	passwordResetValidAfterPrefix = "password-reset-valid-after:"
+)
+
+func (s *UserService) passwordResetTTL() time.Duration {
+	if s.cfg.PasswordResetTTL == 0 {
+		return DefaultPasswordResetTTL
+	}
+	return s.cfg.PasswordResetTTL
+}
+
+// ForgotPassword sends a password reset link to the user.
+// Unknown emails are ignored silently and the link is sent in background,
+// so neither the response nor its timing reveal registered users
+func (s *UserService) ForgotPassword(ctx context.Context, email string) error {
+	u, err := s.userRepo.GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		s.log.Errorf("failed to get user: %w", err)
+		return ErrInternal
+	}
+	detach(ctx, func(ctx context.Context) {
+		_ = s.sendPasswordReset(ctx, u)
+	})
+	return nil
+}
+
+func (s *UserService) sendPasswordReset(ctx context.Context, u domain.User) error {
+	ttl := s.passwordResetTTL()
+	// issue time lets a reset reject links sent before it
+	value := u.ID.String() + ":" + strconv.FormatInt(time.Now().UnixNano(), 10)
+	token, err := issueOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, passwordResetTokenPrefix, value, ttl)
+	if err != nil {
+		s.log.Errorf("failed to issue password reset token: %w", err)
+		return ErrInternal
+	}
+
+	link := fmt.Sprintf("%s?token=%s", s.cfg.ResetPasswordURL, url.QueryEscape(token))
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      u.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Follow the link to set a new password: %s\n\nThe link expires in %s. If you didn't request it, ignore this email.\n",
+			link, ttl,
+		),
+	})
+	if err != nil {
+		s.log.Errorw("failed to send password reset email",
+			"user_id", u.ID,
+			"error", err,
+		)
+		return ErrInternal
+	}
+	return nil
+}
+
+// ResetPassword sets a new password and revokes all refresh tokens of the user.
+// Other reset links sent to the user stop working
+func (s *UserService) ResetPassword(ctx context.Context, token, password string) error {
+	value, err := consumeOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, passwordResetTokenPrefix, token)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrInvalidToken
+		}
+		s.log.Errorf("failed to consume password reset token: %w", err)
+		return ErrInternal
+	}
+
+	userID, issued, _ := strings.Cut(value, ":")
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		s.log.Errorf("invalid user id in password reset token: %w", err)
+		return ErrInternal
+	}
+	// tokens issued before their issue time was stored count as issued at zero
+	issuedAt, _ := strconv.ParseInt(issued, 10, 64)
+	validAfter, err := s.tokenRepo.Get(ctx, passwordResetValidAfterPrefix+userID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		s.log.Errorf("failed to get password reset state: %w", err)
+		return ErrInternal
+	}
+	if validAfter != "" {
+		after, err := strconv.ParseInt(validAfter, 10, 64)
+		if err != nil || issuedAt <= after {
+			return ErrInvalidToken
+		}
+	}
+	u, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrInvalidToken
+		}
+		s.log.Errorf("failed to get user: %w", err)
+		return ErrInternal
+	}
+
+	hashedPwd, err := hashPassword(password)
+	if err != nil {
+		s.log.Errorf("failed to hash password: %w", err)
+		return ErrInternal
+	}
+	if err := s.userRepo.UpdatePassword(ctx, u.ID, hashedPwd); err != nil {
+		s.log.Errorf("failed to update password: %w", err)
+		return ErrInternal
+	}
+	// user has just proven access to the mailbox
+	if !u.EmailVerified() || u.Status == domain.StatusPendingVerification {
+		if err := s.userRepo.MarkVerified(ctx, u.ID, time.Now()); err != nil {
+			s.log.Errorf("failed to mark user verified: %w", err)
+			return ErrInternal
+		}
+	}
+
+	// links sent before the reset live no longer than ttl, so the mark expires with them
+	now := strconv.FormatInt(time.Now().UnixNano(), 10)
+	if err := s.tokenRepo.Add(ctx, passwordResetValidAfterPrefix+userID, now, s.passwordResetTTL()); err != nil {
+		s.log.Errorf("failed to invalidate password reset tokens: %w", err)
+		return ErrInternal
+	}
+
+	if err := s.revokeAllTokens(ctx, u); err != nil {
+		s.log.Errorf("failed to revoke refresh tokens: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
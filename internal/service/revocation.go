@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/cache"
+	"github.com/maisiq/go-auth-service/internal/repository"
+)
+
+// RevocationCacheTTL is how long revocation state of access tokens is cached in process.
+// Revocation made on another replica takes effect after at most this period
+const RevocationCacheTTL = 5 * time.Second
+
+const (
+	revokedTokenPrefix     = "revoked-token:"
+	revokedSessionPrefix   = "revoked-session:"
+	tokensValidAfterPrefix = "tokens-valid-after:"
+)
+
+// revokeAccessToken puts token id to denylist until the token expires
+func revokeAccessToken(ctx context.Context, tokenRepo ITokenRepository, jti string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if jti == "" || ttl <= 0 {
+		return nil
+	}
+	return tokenRepo.Add(ctx, revokedTokenPrefix+jti, "1", ttl)
+}
+
+// revokeSessionAccess denies access tokens issued for the session
+func revokeSessionAccess(ctx context.Context, tokenRepo ITokenRepository, sid string) error {
+	return tokenRepo.Add(ctx, revokedSessionPrefix+sid, "1", AccessTokenTTL)
+}
+
+// revokeUserAccess denies all access tokens of the user issued up to the current second.
+// The mark is kept as long as the longest-lived access token
+func revokeUserAccess(ctx context.Context, tokenRepo ITokenRepository, userID string) error {
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	return tokenRepo.Add(ctx, tokensValidAfterPrefix+userID, now, AccessTokenTTL)
+}
+
+func (s *VaultService) checkRevoked(ctx context.Context, claims *AuthClaims) error {
+	revoked, err := s.denied(ctx, revokedTokenPrefix+claims.ID)
+	if err != nil {
+		return err
+	}
+	if !revoked && claims.SessionID != "" {
+		revoked, err = s.denied(ctx, revokedSessionPrefix+claims.SessionID)
+		if err != nil {
+			return err
+		}
+	}
+	if revoked {
+		return ErrTokenRevoked
+	}
+
+	validAfter, err := cache.GetOrSet(s.cache, ctx, tokensValidAfterPrefix+claims.Subject, RevocationCacheTTL, func() (int64, error) {
+		v, err := s.tokenRepo.Get(ctx, tokensValidAfterPrefix+claims.Subject)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return 0, nil
+			}
+			return 0, err
+		}
+		return strconv.ParseInt(v, 10, 64)
+	})
+	if err != nil {
+		return err
+	}
+
+	var issuedAt int64
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Unix()
+	}
+	// iat has whole seconds, so tokens issued in the second of revocation are denied too
+	if validAfter > 0 && issuedAt <= validAfter {
+		return ErrTokenRevoked
+	}
+	return nil
+}
+
+// denied reports whether key is present in denylist
+func (s *VaultService) denied(ctx context.Context, key string) (bool, error) {
+	return cache.GetOrSet(s.cache, ctx, key, RevocationCacheTTL, func() (bool, error) {
+		_, err := s.tokenRepo.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	})
+}
+
+func (s *UserService) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if err := revokeAccessToken(ctx, s.tokenRepo, jti, expiresAt); err != nil {
+		s.log.Errorf("failed to revoke access token: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
@@ -2,29 +2,60 @@ package service
 
 import (
 	"context"
+	"crypto"
 	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
+	"errors"
 	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/maisiq/go-auth-service/internal/cache"
+	"github.com/maisiq/go-auth-service/internal/configs"
 	"github.com/maisiq/go-auth-service/internal/repository"
 	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
 )
 
+// KeysRefetchInterval is the least time between refetches of public keys caused by tokens with unknown kid
+const KeysRefetchInterval = 30 * time.Second
+
 type VaultService struct {
-	repo  repository.SecretRepository
-	log   *zap.SugaredLogger
-	cache *cache.Cache
+	repo      repository.SecretRepository
+	tokenRepo ITokenRepository
+	log       *zap.SugaredLogger
+	cache     *cache.Cache
+	cfg       *configs.SecretsConfig
+
+	refetch     singleflight.Group
+	mu          sync.Mutex
+	lastRefetch time.Time
 }
 
-func NewVaultService(log *zap.SugaredLogger, repo repository.SecretRepository, cache *cache.Cache) *VaultService {
+func NewVaultService(
+	log *zap.SugaredLogger,
+	repo repository.SecretRepository,
+	tokenRepo ITokenRepository,
+	cache *cache.Cache,
+	cfg *configs.SecretsConfig,
+) *VaultService {
+	if cfg == nil {
+		cfg = &configs.SecretsConfig{}
+	}
 	return &VaultService{
-		log:   log,
-		repo:  repo,
-		cache: cache,
+		log:       log,
+		repo:      repo,
+		tokenRepo: tokenRepo,
+		cache:     cache,
+		cfg:       cfg,
 	}
 }
 
@@ -32,23 +63,30 @@ func (s *VaultService) ParseJWT(ctx context.Context, token string) (*AuthClaims,
 	claims := &AuthClaims{}
 
 	parsedToken, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodECDSA); !ok {
-			return nil, fmt.Errorf("invalid signing method")
-		}
 		kid, ok := t.Header["kid"]
 		if !ok {
 			return nil, ErrInvalidKID
 		}
 
-		keys, getErr := cache.GetOrSet(s.cache, ctx, JWTSingingKey, 5*time.Minute, func() (map[string]string, error) {
-			return s.repo.GetPublicKeys(ctx, JWTSingingKey)
-		})
+		kidStr, ok := kid.(string)
+		if !ok {
+			return nil, ErrInvalidKID
+		}
+		keys, getErr := s.publicKeys(ctx)
 		if getErr != nil {
 			s.log.Errorf("failed to get public keys from repository: %w", getErr)
 			return nil, ErrInternal
 		}
+		if isNewerKID(kidStr, keys) {
+			// key may have been rotated by another process after the keys were cached
+			if fresh, err := s.refetchPublicKeys(ctx, keys); err != nil {
+				s.log.Errorf("failed to refetch public keys from repository: %w", err)
+			} else {
+				keys = fresh
+			}
+		}
 
-		key, ok := keys[kid.(string)]
+		key, ok := keys[kidStr]
 		if !ok {
 			return nil, ErrInvalidKID
 		}
@@ -57,21 +95,204 @@ func (s *VaultService) ParseJWT(ctx context.Context, token string) (*AuthClaims,
 			s.log.Errorf("failed to parse pem public key: %w", err)
 			return nil, ErrInternal
 		}
+		// algorithm is a property of the key, token header must not choose it
+		method, err := signingMethod(pk)
+		if err != nil {
+			s.log.Errorf("unsupported public key: %w", err)
+			return nil, ErrInternal
+		}
+		if t.Method.Alg() != method.Alg() {
+			return nil, fmt.Errorf("invalid signing method")
+		}
 		return pk, nil
+	}, jwt.WithValidMethods(SupportedSigningAlgorithms))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !parsedToken.Valid {
+		return nil, ErrInvalidToken
+	}
+
+	if err := s.checkRevoked(ctx, claims); err != nil {
+		if errors.Is(err, ErrTokenRevoked) {
+			return nil, err
+		}
+		s.log.Errorf("failed to check access token revocation: %w", err)
+		return nil, ErrInternal
+	}
+	return claims, nil
+
+}
+
+// JWKS returns public part of the signing keys in RFC 7517 format.
+// Key version from the secret repository is used as kid.
+func (s *VaultService) JWKS(ctx context.Context) (*JWKSet, error) {
+	keys, err := s.publicKeys(ctx)
+	if err != nil {
+		s.log.Errorf("failed to get public keys from repository: %w", err)
+		return nil, ErrInternal
+	}
+
+	set := &JWKSet{Keys: make([]JWK, 0, len(keys))}
+	for kid, key := range keys {
+		pk, err := parsePublicKey(key)
+		if err != nil {
+			s.log.Errorf("failed to parse pem public key: %w", err)
+			return nil, ErrInternal
+		}
+		jwk, err := publicKeyToJWK(kid, pk)
+		if err != nil {
+			s.log.Errorf("failed to convert public key to jwk: %w", err)
+			return nil, ErrInternal
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	// stable order keeps response body (and its ETag) the same between calls
+	sort.Slice(set.Keys, func(i, j int) bool {
+		a, errA := strconv.Atoi(set.Keys[i].KID)
+		b, errB := strconv.Atoi(set.Keys[j].KID)
+		if errA != nil || errB != nil {
+			return set.Keys[i].KID < set.Keys[j].KID
+		}
+		return a < b
 	})
+	return set, nil
+}
+
+// publicKeys returns verification set of the signing key, versions out of grace period are excluded
+func (s *VaultService) publicKeys(ctx context.Context) (map[string]string, error) {
+	return cache.GetOrSet(s.cache, ctx, JWTSingingKey, PublicKeysCacheTTL, func() (map[string]string, error) {
+		return s.fetchPublicKeys(ctx)
+	})
+}
+
+// refetchPublicKeys replaces cached keys with the ones of the repository. Tokens with unknown kid are not
+// verified yet, so concurrent refetches are merged into one and happen once per KeysRefetchInterval,
+// cached keys are returned in between. Forged tokens can't flood the repository or evict the cache
+func (s *VaultService) refetchPublicKeys(ctx context.Context, cached map[string]string) (map[string]string, error) {
+	v, err, _ := s.refetch.Do(JWTSingingKey, func() (any, error) {
+		s.mu.Lock()
+		if time.Since(s.lastRefetch) < KeysRefetchInterval {
+			s.mu.Unlock()
+			return cached, nil
+		}
+		s.lastRefetch = time.Now()
+		s.mu.Unlock()
+
+		// shared by the callers, so it isn't canceled with the request which started it
+		ctx := context.WithoutCancel(ctx)
+		keys, err := s.fetchPublicKeys(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := cache.Set(s.cache, ctx, JWTSingingKey, PublicKeysCacheTTL, keys); err != nil {
+			s.log.Errorw("failed to cache signing keys", "error", err)
+		}
+		return keys, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[string]string), nil
+}
 
+func (s *VaultService) fetchPublicKeys(ctx context.Context) (map[string]string, error) {
+	keys, err := s.repo.GetPublicKeys(ctx, JWTSingingKey)
+	if err != nil || s.cfg.KeyGracePeriod <= 0 {
+		return keys, err
+	}
+
+	versions, err := s.repo.KeyVersions(ctx, JWTSingingKey)
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
+	for _, key := range signingKeys(versions, s.cfg.KeyGracePeriod, now) {
+		if !key.Verifying {
+			delete(keys, strconv.Itoa(key.Version))
+		}
+	}
+	return keys, nil
+}
+
+func (s *VaultService) purgeKeysCache(ctx context.Context) {
+	if err := s.cache.Client.Delete(ctx, JWTSingingKey); err != nil {
+		s.log.Errorw("failed to purge signing keys cache", "error", err)
+	}
+}
+
+// isNewerKID reports if kid is a version above all known ones. Unknown older versions are not refetched,
+// they can't be issued anymore
+func isNewerKID(kid string, keys map[string]string) bool {
+	version, err := strconv.Atoi(kid)
+	if err != nil {
+		return false
+	}
+	for k := range keys {
+		if v, err := strconv.Atoi(k); err == nil && v >= version {
+			return false
+		}
+	}
+	return true
+}
+
+// SupportedSigningAlgorithms are JWS algorithms of the supported signing key types
+var SupportedSigningAlgorithms = []string{"ES256", "ES384", "ES512", "RS256", "EdDSA"}
+
+func signingMethod(pk crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch k := pk.(type) {
+	case *ecdsa.PublicKey:
+		switch k.Curve.Params().Name {
+		case "P-256":
+			return jwt.SigningMethodES256, nil
+		case "P-384":
+			return jwt.SigningMethodES384, nil
+		case "P-521":
+			return jwt.SigningMethodES512, nil
+		}
+		return nil, fmt.Errorf("unsupported curve: %s", k.Curve.Params().Name)
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	}
+	return nil, fmt.Errorf("unsupported public key type %T", pk)
+}
 
-	if parsedToken.Valid {
-		return claims, nil
+func publicKeyToJWK(kid string, pk crypto.PublicKey) (JWK, error) {
+	method, err := signingMethod(pk)
+	if err != nil {
+		return JWK{}, err
+	}
+	jwk := JWK{
+		KID: kid,
+		Use: "sig",
+		Alg: method.Alg(),
 	}
-	return nil, ErrInvalidToken
 
+	switch k := pk.(type) {
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		jwk.KTY = "EC"
+		jwk.Crv = k.Curve.Params().Name
+		jwk.X = base64.RawURLEncoding.EncodeToString(k.X.FillBytes(make([]byte, size)))
+		jwk.Y = base64.RawURLEncoding.EncodeToString(k.Y.FillBytes(make([]byte, size)))
+	case *rsa.PublicKey:
+		jwk.KTY = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(k.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KTY = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(k)
+	}
+	return jwk, nil
 }
 
-func parsePublicKey(pemStr string) (*ecdsa.PublicKey, error) {
+func parsePublicKey(pemStr string) (crypto.PublicKey, error) {
 	block, _ := pem.Decode([]byte(pemStr))
 	if block == nil {
 		return nil, fmt.Errorf("failed to parse PEM block")
@@ -79,13 +300,7 @@ func parsePublicKey(pemStr string) (*ecdsa.PublicKey, error) {
 
 	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse ECDSA public key: %w", err)
+		return nil, fmt.Errorf("failed to parse public key: %w", err)
 	}
-
-	ecdsaPub, ok := pub.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, fmt.Errorf("not an ECDSA public key")
-	}
-
-	return ecdsaPub, nil
+	return pub, nil
 }
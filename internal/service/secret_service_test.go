@@ -0,0 +1,340 @@
+package service_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/maisiq/go-auth-service/internal/cache"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/internal/repository/mocks"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestJWKS(t *testing.T) {
+	logger := zap.NewExample()
+	secretRepo := mocks.NewSecretRepositoryMock(t)
+	c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+
+	ctx := context.Background()
+
+	secretService := service.NewVaultService(logger.Sugar(), secretRepo, nil, c, nil)
+
+	t.Run("jwks converts pem keys", func(t *testing.T) {
+		pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKIXPublicKey(&pk.PublicKey)
+		require.NoError(t, err)
+		pemKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+		secretRepo.GetPublicKeysMock.Expect(minimock.AnyContext, service.JWTSingingKey).Return(
+			map[string]string{"2": pemKey, "1": pemKey}, nil,
+		)
+		set, err := secretService.JWKS(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, set.Keys, 2)
+		require.Equal(t, "1", set.Keys[0].KID)
+		require.Equal(t, "2", set.Keys[1].KID)
+
+		jwk := set.Keys[0]
+		require.Equal(t, "EC", jwk.KTY)
+		require.Equal(t, "P-256", jwk.Crv)
+		require.Equal(t, "ES256", jwk.Alg)
+		require.Equal(t, base64.RawURLEncoding.EncodeToString(pk.X.FillBytes(make([]byte, 32))), jwk.X)
+		require.Equal(t, base64.RawURLEncoding.EncodeToString(pk.Y.FillBytes(make([]byte, 32))), jwk.Y)
+	})
+}
+
+func TestParseJWTRevocation(t *testing.T) {
+	logger := zap.NewExample()
+
+	ctx := context.Background()
+
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&pk.PublicKey)
+	require.NoError(t, err)
+	pemKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	sign := func(claims service.AuthClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		token.Header["kid"] = "1"
+		s, err := token.SignedString(pk)
+		require.NoError(t, err)
+		return s
+	}
+	claims := service.AuthClaims{
+		Role:      "user",
+		Email:     "example@gmail.com",
+		SessionID: "session",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "jti",
+			Subject:   "user-id",
+			IssuedAt:  jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+
+	t.Run("denied token id is rejected", func(t *testing.T) {
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		secretService := service.NewVaultService(logger.Sugar(), secretRepo, tokenRepo, c, nil)
+
+		secretRepo.GetPublicKeysMock.Return(map[string]string{"1": pemKey}, nil)
+		tokenRepo.GetMock.Expect(minimock.AnyContext, "revoked-token:jti").Return("1", nil)
+
+		_, err := secretService.ParseJWT(ctx, sign(claims))
+
+		require.ErrorIs(t, err, service.ErrTokenRevoked)
+	})
+
+	t.Run("token issued before revocation of all user's tokens is rejected", func(t *testing.T) {
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		secretService := service.NewVaultService(logger.Sugar(), secretRepo, tokenRepo, c, nil)
+
+		secretRepo.GetPublicKeysMock.Return(map[string]string{"1": pemKey}, nil)
+		tokenRepo.GetMock.Set(func(ctx context.Context, key string) (string, error) {
+			if key == "tokens-valid-after:user-id" {
+				return "9999999999", nil
+			}
+			return "", repository.ErrNotFound
+		})
+
+		_, err := secretService.ParseJWT(ctx, sign(claims))
+
+		require.ErrorIs(t, err, service.ErrTokenRevoked)
+	})
+
+	t.Run("token issued in the second of revocation is rejected", func(t *testing.T) {
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		secretService := service.NewVaultService(logger.Sugar(), secretRepo, tokenRepo, c, nil)
+
+		secretRepo.GetPublicKeysMock.Return(map[string]string{"1": pemKey}, nil)
+		tokenRepo.GetMock.Set(func(ctx context.Context, key string) (string, error) {
+			if key == "tokens-valid-after:user-id" {
+				return strconv.FormatInt(claims.IssuedAt.Unix(), 10), nil
+			}
+			return "", repository.ErrNotFound
+		})
+
+		_, err := secretService.ParseJWT(ctx, sign(claims))
+
+		require.ErrorIs(t, err, service.ErrTokenRevoked)
+	})
+
+	t.Run("valid token is accepted and revocation state is cached", func(t *testing.T) {
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		secretService := service.NewVaultService(logger.Sugar(), secretRepo, tokenRepo, c, nil)
+
+		secretRepo.GetPublicKeysMock.Return(map[string]string{"1": pemKey}, nil)
+		tokenRepo.GetMock.Return("", repository.ErrNotFound)
+
+		token := sign(claims)
+		for i := 0; i < 3; i++ {
+			parsed, err := secretService.ParseJWT(ctx, token)
+			require.NoError(t, err)
+			require.Equal(t, "session", parsed.SessionID)
+		}
+		require.Equal(t, uint64(3), tokenRepo.GetAfterCounter())
+	})
+}
+
+func TestSigningKeyRotation(t *testing.T) {
+	logger := zap.NewExample()
+
+	ctx := context.Background()
+	cfg := &configs.SecretsConfig{KeyGracePeriod: time.Hour}
+	now := time.Now()
+
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&pk.PublicKey)
+	require.NoError(t, err)
+	pemKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	t.Run("versions out of grace period are not verifying", func(t *testing.T) {
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		secretService := service.NewVaultService(logger.Sugar(), secretRepo, nil, c, cfg)
+
+		secretRepo.GetPublicKeysMock.Return(map[string]string{"1": pemKey, "2": pemKey, "3": pemKey}, nil)
+		secretRepo.KeyVersionsMock.Return([]domain.KeyVersion{
+			{Version: 1, CreatedAt: now.Add(-72 * time.Hour)},
+			{Version: 2, CreatedAt: now.Add(-48 * time.Hour)},
+			{Version: 3, CreatedAt: now.Add(-time.Minute)},
+		}, nil)
+
+		set, err := secretService.JWKS(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, set.Keys, 2)
+		require.Equal(t, "2", set.Keys[0].KID)
+		require.Equal(t, "3", set.Keys[1].KID)
+	})
+
+	t.Run("rotation purges cached keys", func(t *testing.T) {
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		secretService := service.NewVaultService(logger.Sugar(), secretRepo, nil, c, nil)
+
+		secretRepo.GetPublicKeysMock.Return(map[string]string{"1": pemKey}, nil)
+		secretRepo.RotateKeyMock.Expect(minimock.AnyContext, service.JWTSingingKey).Return("2", nil)
+
+		_, err := secretService.JWKS(ctx)
+		require.NoError(t, err)
+
+		kid, err := secretService.RotateSigningKey(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "2", kid)
+
+		_, err = secretService.JWKS(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), secretRepo.GetPublicKeysAfterCounter())
+	})
+
+	t.Run("newer kid refetches keys at most once per interval", func(t *testing.T) {
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		secretService := service.NewVaultService(logger.Sugar(), secretRepo, tokenRepo, c, nil)
+
+		// the second version is created by another process after the keys are cached
+		secretRepo.GetPublicKeysMock.Set(func(ctx context.Context, keyName string) (map[string]string, error) {
+			if secretRepo.GetPublicKeysAfterCounter() == 0 {
+				return map[string]string{"1": pemKey}, nil
+			}
+			return map[string]string{"1": pemKey, "2": pemKey}, nil
+		})
+		tokenRepo.GetMock.Return("", repository.ErrNotFound)
+		sign := func(kid string) string {
+			token := jwt.NewWithClaims(jwt.SigningMethodES256, service.AuthClaims{
+				RegisteredClaims: jwt.RegisteredClaims{Subject: "user-id", ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute))},
+			})
+			token.Header["kid"] = kid
+			s, err := token.SignedString(pk)
+			require.NoError(t, err)
+			return s
+		}
+
+		_, err := secretService.JWKS(ctx)
+		require.NoError(t, err)
+		_, err = secretService.ParseJWT(ctx, sign("2"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), secretRepo.GetPublicKeysAfterCounter())
+
+		// forged kids don't reach the repository or evict refetched keys
+		for i := 0; i < 5; i++ {
+			_, err = secretService.ParseJWT(ctx, sign("99"))
+			require.ErrorIs(t, err, service.ErrInvalidKID)
+		}
+		_, err = secretService.ParseJWT(ctx, sign("2"))
+		require.NoError(t, err)
+		require.Equal(t, uint64(2), secretRepo.GetPublicKeysAfterCounter())
+	})
+
+	t.Run("retire refuses versions in grace period", func(t *testing.T) {
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+		secretService := service.NewVaultService(logger.Sugar(), secretRepo, nil, c, cfg)
+
+		secretRepo.KeyVersionsMock.Return([]domain.KeyVersion{
+			{Version: 1, CreatedAt: now.Add(-72 * time.Hour)},
+			{Version: 2, CreatedAt: now.Add(-time.Minute)},
+		}, nil)
+
+		err := secretService.RetireSigningKeys(ctx, 2, false)
+		require.ErrorIs(t, err, service.ErrKeyVersionInUse)
+
+		err = secretService.RetireSigningKeys(ctx, 3, true)
+		require.ErrorIs(t, err, service.ErrInvalidKeyVersion)
+
+		secretRepo.RetireKeyVersionsMock.Expect(minimock.AnyContext, service.JWTSingingKey, 2).Return(nil)
+		err = secretService.RetireSigningKeys(ctx, 2, true)
+		require.NoError(t, err)
+	})
+}
+
+func TestSigningAlgorithms(t *testing.T) {
+	logger := zap.NewExample()
+
+	ctx := context.Background()
+	claims := service.AuthClaims{
+		Role: "user",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        "jti",
+			Subject:   "user-id",
+			IssuedAt:  jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}
+
+	cases := map[string]struct {
+		alg string
+		kty string
+	}{
+		"ecdsa-p384": {alg: "ES384", kty: "EC"},
+		"rsa-2048":   {alg: "RS256", kty: "RSA"},
+		"ed25519":    {alg: "EdDSA", kty: "OKP"},
+	}
+	for keyType, expected := range cases {
+		t.Run(keyType, func(t *testing.T) {
+			secretRepo, err := repository.NewLocalSecretRepository(t.TempDir(), keyType)
+			require.NoError(t, err)
+			tokenRepo := mocks.NewITokenRepositoryMock(t)
+			c := &cache.Cache{Client: cache.NewInMemoryCacheClient()}
+			secretService := service.NewVaultService(logger.Sugar(), secretRepo, tokenRepo, c, nil)
+
+			tokenRepo.GetMock.Return("", repository.ErrNotFound)
+
+			alg, err := secretRepo.SigningAlgorithm(ctx, service.JWTSingingKey)
+			require.NoError(t, err)
+			require.Equal(t, expected.alg, alg)
+
+			token := jwt.NewWithClaims(jwt.GetSigningMethod(alg), claims)
+			token.Header["kid"] = "1"
+			unsigned, err := token.SigningString()
+			require.NoError(t, err)
+			signed, err := secretRepo.SignJWT(ctx, unsigned, service.JWTSingingKey, "1")
+			require.NoError(t, err)
+
+			parsed, err := secretService.ParseJWT(ctx, signed)
+			require.NoError(t, err)
+			require.Equal(t, "user-id", parsed.Subject)
+
+			set, err := secretService.JWKS(ctx)
+			require.NoError(t, err)
+			require.Len(t, set.Keys, 1)
+			require.Equal(t, expected.alg, set.Keys[0].Alg)
+			require.Equal(t, expected.kty, set.Keys[0].KTY)
+
+			// header can't switch algorithm of the key
+			forged := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+			forged.Header["kid"] = "1"
+			forgedToken, err := forged.SignedString([]byte("secret"))
+			require.NoError(t, err)
+			_, err = secretService.ParseJWT(ctx, forgedToken)
+			require.Error(t, err)
+		})
+	}
+}
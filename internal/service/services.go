@@ -4,16 +4,57 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/maisiq/go-auth-service/internal/domain"
 	"github.com/maisiq/go-auth-service/internal/oauth"
+	"github.com/maisiq/go-auth-service/pkg/webauthn"
 )
 
 type IOAuthService interface {
-	CreateTokens(ctx context.Context, provider oauth.OAuthProvider, authorizationCode string) (*TokenPair, error)
+	// BeginLogin saves state of the login and returns URL of the provider's consent page with the state
+	BeginLogin(ctx context.Context, provider oauth.OAuthProviderT, redirectTo string) (redirectURL, state string, err error)
+	// BeginLink is BeginLogin of signed in user, the callback links the provider's identity to the user
+	BeginLink(ctx context.Context, userID string, provider oauth.OAuthProviderT, redirectTo string) (redirectURL, state string, err error)
+	// Callback consumes the state and logs in user identified by the provider
+	// or links the identity if the state is saved by BeginLink
+	Callback(ctx context.Context, provider oauth.OAuthProviderT, state, authorizationCode string) (*OAuthResult, error)
+	Identities(ctx context.Context, userID string) ([]domain.UserIdentity, error)
+	// Unlink refuses to remove the identity if the user can't log in without it
+	Unlink(ctx context.Context, userID, provider, subject string) error
+}
+
+// IAuthorizationService is OAuth 2.0 authorization server of this service
+type IAuthorizationService interface {
+	RegisterClient(ctx context.Context, reg ClientRegistration) (client *domain.OAuthClient, secret string, err error)
+	Clients(ctx context.Context) ([]domain.OAuthClient, error)
+	DeleteClient(ctx context.Context, clientID string) error
+	// Authorize validates and saves request of authorization endpoint until the user logs in and consents
+	Authorize(ctx context.Context, params AuthorizeParams) (requestID string, err error)
+	BindUser(ctx context.Context, requestID, userID string) (*Consent, error)
+	// Decide returns URL of the client with authorization code or access_denied error
+	Decide(ctx context.Context, requestID string, approved bool) (redirectURL string, err error)
+	Token(ctx context.Context, params TokenParams) (*TokenResponse, error)
+}
+
+type IWebAuthnService interface {
+	BeginRegistration(ctx context.Context, userID string) (*webauthn.CreationOptions, error)
+	FinishRegistration(ctx context.Context, userID, name string, resp webauthn.RegistrationResponse) error
+	BeginLogin(ctx context.Context, email, mfaToken string) (*webauthn.RequestOptions, error)
+	FinishLogin(ctx context.Context, mfaToken string, resp webauthn.AssertionResponse) (*TokenPair, error)
+	Credentials(ctx context.Context, userID string) ([]domain.WebAuthnCredential, error)
+	DeleteCredential(ctx context.Context, userID string, credentialID []byte) error
 }
 
 type SecretService interface {
 	ParseJWT(ctx context.Context, token string) (*AuthClaims, error)
+	JWKS(ctx context.Context) (*JWKSet, error)
+}
+
+// KeyManager rotates the JWT signing key
+type KeyManager interface {
+	SigningKeys(ctx context.Context) ([]SigningKey, error)
+	RotateSigningKey(ctx context.Context) (string, error)
+	RetireSigningKeys(ctx context.Context, minVersion int, force bool) error
 }
 
 type IUserService interface {
@@ -22,22 +63,73 @@ type IUserService interface {
 	AddLog(ctx context.Context, userEmail, userAgent, IP string) error
 	Logs(ctx context.Context, email string) ([]domain.UserLog, error)
 	NewRefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error)
+	NewClientRefreshToken(ctx context.Context, clientID, refreshToken string) (*TokenPair, error)
 	Logout(ctx context.Context, refreshToken string, fromAll bool) error
 	UpdatePassword(ctx context.Context, email, old, new string) error
+	UserInfo(ctx context.Context, claims *AuthClaims) (*UserInfo, error)
+	VerifyEmail(ctx context.Context, token string) error
+	ResendVerification(ctx context.Context, email string) error
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, password string) error
+	EnrollTOTP(ctx context.Context, userID string) (*TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userID, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, userID, code string) error
+	CompleteMFALogin(ctx context.Context, mfaToken, code string) (*TokenPair, error)
+	Sessions(ctx context.Context, email string) ([]domain.Session, error)
+	RevokeSession(ctx context.Context, email, sid string) error
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+
+	// admin operations
+	ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, int, error)
+	GetUser(ctx context.Context, userID string) (domain.User, error)
+	SetRole(ctx context.Context, userID string, role domain.Role) error
+	SetAccountStatus(ctx context.Context, userID string, status domain.AccountStatus, reason string, lockedUntil time.Time) error
+	ForcePasswordReset(ctx context.Context, userID string) error
+	RevokeUserSessions(ctx context.Context, userID string) error
 }
 
 type IUserRepository interface {
 	Add(ctx context.Context, user domain.User) error
 	GetByEmail(ctx context.Context, email string) (domain.User, error)
+	GetByID(ctx context.Context, id uuid.UUID) (domain.User, error)
+	List(ctx context.Context, filter domain.UserFilter) ([]domain.User, int, error)
 	Logs(ctx context.Context, email string) ([]domain.UserLog, error)
 	AddLog(ctx context.Context, log domain.UserLog) error
-	Update(ctx context.Context, user domain.User) error
+	// targeted updates write only their own columns, so concurrent changes of the user don't revert each other
+	UpdateRole(ctx context.Context, id uuid.UUID, role domain.Role) error
+	UpdateStatus(ctx context.Context, user domain.User) error
+	UpdatePassword(ctx context.Context, id uuid.UUID, hashedPassword string) error
+	// MarkVerified sets verification time unless email is verified already, pending accounts become active
+	MarkVerified(ctx context.Context, id uuid.UUID, verifiedAt time.Time) error
 }
 
 type ITokenRepository interface {
 	Get(ctx context.Context, key string) (string, error)
+	// GetDel returns value of the key and deletes it in one step, so the value is returned once
+	GetDel(ctx context.Context, key string) (string, error)
 	Add(ctx context.Context, key, value string, expiration time.Duration) error
+	// AddNX adds the key only if it doesn't exist, it returns ErrAlreadyExists otherwise
+	AddNX(ctx context.Context, key, value string, expiration time.Duration) error
 	Delete(ctx context.Context, keys ...string) error
 	Push(ctx context.Context, key string, values ...string) error
 	List(ctx context.Context, key string) ([]string, error)
+	// Incr increments counter, expiration is set when the counter is created
+	Incr(ctx context.Context, key string, expiration time.Duration) (int64, error)
+	// TTL returns remaining time to live of the key, it is not positive if the key doesn't exist
+	TTL(ctx context.Context, key string) (time.Duration, error)
+	// refresh tokens are identified by keyed hash of the token
+	AddRefreshToken(ctx context.Context, token domain.RefreshToken, expiration time.Duration) error
+	GetRefreshToken(ctx context.Context, id string) (domain.RefreshToken, error)
+	// MigrateRefreshToken moves token stored under its raw value to its hash id.
+	// It returns ErrNotFound if there is no such legacy token
+	MigrateRefreshToken(ctx context.Context, token, id string, expiration time.Duration) error
+	// MarkRefreshTokenRotated returns ErrAlreadyExists if token has been rotated before
+	MarkRefreshTokenRotated(ctx context.Context, token domain.RefreshToken, expiration time.Duration) error
+	DeleteRefreshTokens(ctx context.Context, ids ...string) error
+	FamilyTokens(ctx context.Context, familyID string) ([]string, error)
+	// SaveSession creates or overwrites session and adds it to the user's sessions
+	SaveSession(ctx context.Context, session domain.Session, expiration time.Duration) error
+	GetSession(ctx context.Context, id string) (domain.Session, error)
+	ListSessions(ctx context.Context, email string) ([]domain.Session, error)
+	DeleteSessions(ctx context.Context, email string, ids ...string) error
 }
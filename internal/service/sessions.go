@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository"
+)
+
+// Login methods recorded in sessions, oauth sessions use provider name
+const (
+	PasswordLogin = "password"
+	PasskeyLogin  = "webauthn"
+	// AuthorizationCodeGrant is a session of OAuth client the user has authorized
+	AuthorizationCodeGrant = "authorization_code"
+)
+
+// ClientInfo describes device the request came from
+type ClientInfo struct {
+	UserAgent string
+	IP        string
+}
+
+type clientInfoKey struct{}
+
+// WithClientInfo returns context carrying client info which is saved in sessions
+func WithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, info)
+}
+
+func clientInfoFromContext(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return info
+}
+
+// startSession saves session for a new refresh token family, clientID is empty for the service's own logins
+func startSession(ctx context.Context, tokenRepo ITokenRepository, sid, email, method, clientID string) error {
+	client := clientInfoFromContext(ctx)
+	now := time.Now()
+	session := domain.Session{
+		ID:         sid,
+		Email:      email,
+		Method:     method,
+		ClientID:   clientID,
+		UserAgent:  client.UserAgent,
+		IP:         client.IP,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+	return tokenRepo.SaveSession(ctx, session, RefreshTokenTTL)
+}
+
+// touchSession updates last usage of the session on refresh
+func (s *UserService) touchSession(ctx context.Context, sid, email string) error {
+	session, err := s.tokenRepo.GetSession(ctx, sid)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			// refresh token was issued before sessions were introduced
+			return startSession(ctx, s.tokenRepo, sid, email, "", "")
+		}
+		return err
+	}
+
+	client := clientInfoFromContext(ctx)
+	if client.UserAgent != "" {
+		session.UserAgent = client.UserAgent
+	}
+	if client.IP != "" {
+		session.IP = client.IP
+	}
+	session.LastUsedAt = time.Now()
+	return s.tokenRepo.SaveSession(ctx, session, RefreshTokenTTL)
+}
+
+func (s *UserService) Sessions(ctx context.Context, email string) ([]domain.Session, error) {
+	sessions, err := s.tokenRepo.ListSessions(ctx, normalizeEmail(email))
+	if err != nil {
+		s.log.Errorf("failed to list sessions: %w", err)
+		return nil, ErrInternal
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+	return sessions, nil
+}
+
+func (s *UserService) RevokeSession(ctx context.Context, email, sid string) error {
+	email = normalizeEmail(email)
+	session, err := s.tokenRepo.GetSession(ctx, sid)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		s.log.Errorf("failed to get session: %w", err)
+		return ErrInternal
+	}
+	if session.Email != email {
+		return ErrNotFound
+	}
+
+	if err := s.revokeSession(ctx, email, sid); err != nil {
+		s.log.Errorf("failed to revoke session: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+// revokeSession deletes session with all refresh tokens of its family
+func (s *UserService) revokeSession(ctx context.Context, email, sid string) error {
+	ids, err := s.tokenRepo.FamilyTokens(ctx, sid)
+	if err != nil {
+		return err
+	}
+	if err := s.tokenRepo.DeleteRefreshTokens(ctx, ids...); err != nil {
+		return err
+	}
+	if err := revokeSessionAccess(ctx, s.tokenRepo, sid); err != nil {
+		return err
+	}
+	return s.tokenRepo.DeleteSessions(ctx, email, sid)
+}
@@ -3,10 +3,14 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
 	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/mailer"
 	"github.com/maisiq/go-auth-service/internal/repository"
 	"github.com/maisiq/go-auth-service/pkg/resilience"
 	"github.com/sony/gobreaker"
@@ -21,12 +25,25 @@ type TokenPair struct {
 	Refresh string `json:"refresh_token"`
 }
 
+// UserInfo is a set of standard OpenID Connect claims about the user.
+// Claims are omitted if the scope of the token doesn't cover them
+type UserInfo struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+	Role          string `json:"role,omitempty"`
+}
+
 type UserService struct {
 	log        *zap.SugaredLogger
 	tracer     trace.Tracer
 	userRepo   IUserRepository
 	tokenRepo  ITokenRepository
 	secretRepo repository.SecretRepository
+	mfaRepo    repository.IMFARepository
+	passkeys   repository.IWebAuthnRepository
+	mailer     mailer.Mailer
+	cfg        *configs.AuthConfig
 
 	dbCB  *gobreaker.CircuitBreaker
 	retry *resilience.Retry
@@ -38,6 +55,10 @@ func NewUserService(
 	userRepo IUserRepository,
 	tokenRepo ITokenRepository,
 	secretRepo repository.SecretRepository,
+	mfaRepo repository.IMFARepository,
+	passkeys repository.IWebAuthnRepository,
+	mailer mailer.Mailer,
+	cfg *configs.AuthConfig,
 	dbCB *gobreaker.CircuitBreaker,
 	retry *resilience.Retry,
 ) *UserService {
@@ -47,6 +68,10 @@ func NewUserService(
 		userRepo:   userRepo,
 		tokenRepo:  tokenRepo,
 		secretRepo: secretRepo,
+		mfaRepo:    mfaRepo,
+		passkeys:   passkeys,
+		mailer:     mailer,
+		cfg:        cfg,
 		dbCB:       dbCB,
 		retry:      retry,
 	}
@@ -74,6 +99,7 @@ func (s *UserService) CreateUser(ctx context.Context, email, password string) er
 		HashedPassword: hashedPwd,
 		CreatedAT:      time.Now(),
 	}
+	u.SetStatus(domain.StatusPendingVerification, "", u.CreatedAT)
 
 	_, err = s.dbCB.Execute(func() (interface{}, error) {
 		s.log.Debug("CB called")
@@ -94,6 +120,13 @@ func (s *UserService) CreateUser(ctx context.Context, email, password string) er
 		return ErrInternal
 	}
 
+	// account is already created, so user can request another email if this one fails
+	if err := s.sendVerificationEmail(ctx, u); err != nil {
+		s.log.Errorw("failed to send verification email",
+			"user_id", u.ID,
+			"error", err,
+		)
+	}
 	return nil
 }
 
@@ -107,12 +140,23 @@ func (s *UserService) Authenticate(ctx context.Context, email, password string)
 	}
 	span.SetAttributes(attribute.String("user.email", email))
 
+	span.AddEvent("check lockout")
+	if err := s.checkLockout(sctx, email); err != nil {
+		var lockedOut *LockedOutError
+		if errors.As(err, &lockedOut) {
+			return nil, err
+		}
+		s.log.Errorw("failed to check login lockout", "user_email", email, "error", err, "trace_id", traceID)
+		return nil, ErrInternal
+	}
+
 	span.AddEvent("get user from repo")
 	user, err := s.userRepo.GetByEmail(sctx, email)
 
 	if err != nil {
 		span.RecordError(err)
 		if errors.Is(err, repository.ErrNotFound) {
+			s.loginFailed(sctx, email)
 			return nil, ErrNotFound
 		}
 		span.SetStatus(codes.Error, "failed to retrieve user")
@@ -138,61 +182,48 @@ func (s *UserService) Authenticate(ctx context.Context, email, password string)
 	}
 
 	if !ok {
+		s.loginFailed(sctx, email)
 		return nil, ErrBadCredentials
 	}
-
-	span.AddEvent("create access token")
-	token, err := createAccessToken(sctx, s.secretRepo, user.ID.String(), user.Email)
-	if err != nil {
-		errMsg := "failed to create access token"
-		span.RecordError(err)
-		span.SetStatus(codes.Error, errMsg)
-		s.log.Errorw(errMsg,
-			"error", err,
-			"trace_id", traceID,
-		)
-		return nil, err
+	if err := s.resetLoginFailures(sctx, email); err != nil {
+		s.log.Errorw("failed to reset login failures", "user_email", email, "error", err, "trace_id", traceID)
 	}
-
-	refresh, err := createRefreshToken()
-	if err != nil {
-		errMsg := "failed to create refresh token"
-		span.RecordError(err)
-		span.SetStatus(codes.Error, errMsg)
-		s.log.Errorw(errMsg,
-			"error", err,
-			"trace_id", traceID,
-		)
+	if err := checkAccountStatus(s.cfg, user); err != nil {
 		return nil, err
 	}
 
-	span.AddEvent("save refresh token")
-	if err = s.tokenRepo.Add(sctx, refresh, user.Email, RefreshTokenTTL); err != nil {
-		errMsg := "failed to save refresh token"
+	span.AddEvent("check second factor")
+	if err := s.mfaChallenge(sctx, user); err != nil {
+		var challenge *MFAChallengeError
+		if errors.As(err, &challenge) {
+			return nil, err
+		}
+		errMsg := "failed to check second factor"
 		span.RecordError(err)
 		span.SetStatus(codes.Error, errMsg)
-		s.log.Errorf(errMsg,
+		s.log.Errorw(errMsg,
 			"error", err,
 			"trace_id", traceID,
 		)
-		return nil, err
+		return nil, ErrInternal
 	}
 
-	if err = s.tokenRepo.Push(sctx, user.Email, refresh); err != nil {
-		errMsg := "failed to push refresh token to all user's token"
+	span.AddEvent("create tokens")
+	tokens, err := createTokenPair(sctx, s.secretRepo, s.tokenRepo, s.cfg, user, PasswordLogin)
+	if err != nil {
+		if IsAccountStatusError(err) {
+			return nil, err
+		}
+		errMsg := "failed to create tokens"
 		span.RecordError(err)
 		span.SetStatus(codes.Error, errMsg)
-		s.log.Errorf(errMsg,
+		s.log.Errorw(errMsg,
 			"error", err,
 			"trace_id", traceID,
 		)
-		return nil, err
+		return nil, ErrInternal
 	}
-
-	return &TokenPair{
-		Access:  token,
-		Refresh: refresh,
-	}, nil
+	return tokens, nil
 }
 
 func (s *UserService) AddLog(ctx context.Context, userEmail, userAgent, IP string) error {
@@ -228,7 +259,12 @@ func (s *UserService) Logs(ctx context.Context, email string) ([]domain.UserLog,
 }
 
 func (s *UserService) NewRefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
-	email, err := s.tokenRepo.Get(ctx, refreshToken)
+	return s.NewClientRefreshToken(ctx, "", refreshToken)
+}
+
+// NewClientRefreshToken rotates refresh token issued to OAuth client, empty clientID is the service itself
+func (s *UserService) NewClientRefreshToken(ctx context.Context, clientID, refreshToken string) (*TokenPair, error) {
+	old, err := s.getRefreshToken(ctx, refreshToken)
 
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
@@ -237,8 +273,19 @@ func (s *UserService) NewRefreshToken(ctx context.Context, refreshToken string)
 		s.log.Errorf("failed to get refresh token: %w", err)
 		return nil, ErrInternal
 	}
+	// token of one client can't be refreshed by another one
+	if old.ClientID != clientID {
+		return nil, ErrInvalidRefreshToken
+	}
+	if old.Rotated() {
+		return nil, s.refreshTokenReused(ctx, old)
+	}
+	if old.FamilyID == "" {
+		// token issued before families were introduced
+		old.FamilyID = uuid.NewString()
+	}
 
-	user, err := s.userRepo.GetByEmail(ctx, email)
+	user, err := s.userRepo.GetByEmail(ctx, old.Email)
 
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
@@ -248,8 +295,12 @@ func (s *UserService) NewRefreshToken(ctx context.Context, refreshToken string)
 		return nil, ErrInternal
 	}
 
-	newAccess, err := createAccessToken(ctx, s.secretRepo, user.Email, user.Email)
+	grant := tokenGrant{ClientID: old.ClientID, Scope: old.Scope, ThirdParty: old.ThirdParty}
+	newAccess, err := createGrantAccessToken(ctx, s.secretRepo, s.cfg, user, old.FamilyID, grant)
 	if err != nil {
+		if IsAccountStatusError(err) {
+			return nil, err
+		}
 		s.log.Errorf("failed to generate jwt token: %w", err)
 		return nil, ErrInternal
 	}
@@ -260,12 +311,37 @@ func (s *UserService) NewRefreshToken(ctx context.Context, refreshToken string)
 		return nil, ErrInternal
 	}
 
-	if err := s.tokenRepo.Delete(ctx, refreshToken); err != nil {
-		s.log.Errorf("failed to delete old refresh token: %w", err)
+	if err := s.tokenRepo.MarkRefreshTokenRotated(ctx, old, RefreshTokenTTL); err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			// concurrent request has rotated the same token
+			return nil, s.refreshTokenReused(ctx, old)
+		}
+		s.log.Errorf("failed to rotate refresh token: %w", err)
 		return nil, ErrInternal
 	}
 
-	s.tokenRepo.Add(ctx, newRefresh, email, RefreshTokenTTL)
+	token := domain.RefreshToken{
+		ID:         hashToken(s.cfg.TokenSecret, newRefresh),
+		Email:      user.Email,
+		FamilyID:   old.FamilyID,
+		ParentID:   old.ID,
+		IssuedAt:   time.Now(),
+		ClientID:   old.ClientID,
+		Scope:      old.Scope,
+		ThirdParty: old.ThirdParty,
+	}
+	if err := s.tokenRepo.AddRefreshToken(ctx, token, RefreshTokenTTL); err != nil {
+		s.log.Errorf("failed to save refresh token: %w", err)
+		return nil, ErrInternal
+	}
+	if err := s.tokenRepo.Push(ctx, user.Email, token.ID); err != nil {
+		s.log.Errorf("failed to push refresh token to all user's token: %w", err)
+		return nil, ErrInternal
+	}
+	if err := s.touchSession(ctx, old.FamilyID, user.Email); err != nil {
+		s.log.Errorf("failed to update session: %w", err)
+		return nil, ErrInternal
+	}
 
 	return &TokenPair{
 		Access:  newAccess,
@@ -274,32 +350,110 @@ func (s *UserService) NewRefreshToken(ctx context.Context, refreshToken string)
 }
 
 func (s *UserService) Logout(ctx context.Context, refreshToken string, fromAll bool) error {
-	var tokens = []string{refreshToken}
-
-	if fromAll {
-		email, err := s.tokenRepo.Get(ctx, refreshToken)
-		if err != nil {
-			if errors.Is(err, repository.ErrNotFound) {
+	token, err := s.getRefreshToken(ctx, refreshToken)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			if fromAll {
 				return ErrNotFound
 			}
+			return nil
+		}
+		s.log.Errorf("failed to get refresh token: %w", err)
+		return ErrInternal
+	}
+
+	if !fromAll {
+		if err := s.revokeTokenFamily(ctx, token); err != nil {
 			s.log.Errorf("failed to delete tokens: %w", err)
 			return ErrInternal
 		}
+		return nil
+	}
 
-		t, err := s.tokenRepo.List(ctx, email)
-		if err != nil {
-			return err
+	u, err := s.userRepo.GetByEmail(ctx, token.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
 		}
-		tokens = append(tokens, email)
-		tokens = append(tokens, t...)
+		s.log.Errorf("failed to get user: %w", err)
+		return ErrInternal
 	}
-	if err := s.tokenRepo.Delete(ctx, tokens...); err != nil {
+	if err := s.revokeAllTokens(ctx, u); err != nil {
 		s.log.Errorf("failed to delete tokens: %w", err)
 		return ErrInternal
 	}
 	return nil
 }
 
+// getRefreshToken looks refresh token up by its hash.
+// Tokens issued before hashing was introduced are migrated on first use
+func (s *UserService) getRefreshToken(ctx context.Context, refreshToken string) (domain.RefreshToken, error) {
+	id := hashToken(s.cfg.TokenSecret, refreshToken)
+	token, err := s.tokenRepo.GetRefreshToken(ctx, id)
+	if !errors.Is(err, repository.ErrNotFound) {
+		return token, err
+	}
+
+	if err := s.tokenRepo.MigrateRefreshToken(ctx, refreshToken, id, RefreshTokenTTL); err != nil {
+		return domain.RefreshToken{}, err
+	}
+	return s.tokenRepo.GetRefreshToken(ctx, id)
+}
+
+// revokeAllTokens deletes all refresh tokens and sessions of the user
+// and denies access tokens issued so far
+func (s *UserService) revokeAllTokens(ctx context.Context, u domain.User) error {
+	if err := revokeUserAccess(ctx, s.tokenRepo, u.ID.String()); err != nil {
+		return err
+	}
+
+	email := u.Email
+	tokens, err := s.tokenRepo.List(ctx, email)
+	if err != nil {
+		return err
+	}
+	if err := s.tokenRepo.DeleteRefreshTokens(ctx, tokens...); err != nil {
+		return err
+	}
+	if err := s.tokenRepo.Delete(ctx, email); err != nil {
+		return err
+	}
+
+	sessions, err := s.tokenRepo.ListSessions(ctx, email)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		ids = append(ids, session.ID)
+	}
+	return s.tokenRepo.DeleteSessions(ctx, email, ids...)
+}
+
+// revokeTokenFamily deletes token and all tokens rotated from the same login
+func (s *UserService) revokeTokenFamily(ctx context.Context, token domain.RefreshToken) error {
+	if token.FamilyID == "" {
+		return s.tokenRepo.DeleteRefreshTokens(ctx, token.ID)
+	}
+	return s.revokeSession(ctx, token.Email, token.FamilyID)
+}
+
+// refreshTokenReused handles presenting of an already rotated refresh token.
+// Either the legitimate client or an attacker holds a stolen copy, so the whole family is revoked
+func (s *UserService) refreshTokenReused(ctx context.Context, token domain.RefreshToken) error {
+	s.log.Warnw("security event: refresh token reuse detected",
+		"event", "refresh_token_reuse",
+		"email", token.Email,
+		"family_id", token.FamilyID,
+		"rotated_at", token.RotatedAt,
+	)
+	if err := s.revokeTokenFamily(ctx, token); err != nil {
+		s.log.Errorf("failed to revoke refresh token family: %w", err)
+		return ErrInternal
+	}
+	return ErrRefreshTokenReused
+}
+
 func (s *UserService) UpdatePassword(ctx context.Context, email, old, new string) error {
 	u, err := s.userRepo.GetByEmail(ctx, email)
 	if err != nil {
@@ -323,12 +477,40 @@ func (s *UserService) UpdatePassword(ctx context.Context, email, old, new string
 		s.log.Errorf("failed to hash password: %w", err)
 		return ErrInternal
 	}
-	u.HashedPassword = newHashedPassword
-	if err := s.userRepo.Update(ctx, u); err != nil {
+	if err := s.userRepo.UpdatePassword(ctx, u.ID, newHashedPassword); err != nil {
 		s.log.Errorf("failed to update user: %w", err)
 		return ErrInternal
 	}
 
+	// access tokens are denied everywhere, including the current one.
 	//TODO: logout from account(s), but it needs refresh token
+	if err := revokeUserAccess(ctx, s.tokenRepo, u.ID.String()); err != nil {
+		s.log.Errorf("failed to revoke access tokens: %w", err)
+		return ErrInternal
+	}
 	return nil
 }
+
+// UserInfo returns claims about the user the token is issued for.
+// Third-party clients get email only with email scope and never get the role
+func (s *UserService) UserInfo(ctx context.Context, claims *AuthClaims) (*UserInfo, error) {
+	// service accounts aren't users
+	if claims.ClientID != "" && claims.Subject == claims.ClientID {
+		return nil, ErrInvalidToken
+	}
+	u, err := s.getUser(ctx, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &UserInfo{Subject: u.ID.String()}
+	if !claims.Delegated() || slices.Contains(strings.Fields(claims.Scope), EmailScope) {
+		verified := u.EmailVerified()
+		info.Email = u.Email
+		info.EmailVerified = &verified
+	}
+	if !claims.Delegated() {
+		info.Role = string(u.Role)
+	}
+	return info, nil
+}
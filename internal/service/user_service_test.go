@@ -2,11 +2,19 @@ package service_test
 
 import (
 	"context"
+	"errors"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gojuno/minimock/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
 	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/mailer"
+	"github.com/maisiq/go-auth-service/internal/repository"
 	"github.com/maisiq/go-auth-service/internal/repository/mocks"
 	"github.com/maisiq/go-auth-service/internal/service"
 	"github.com/stretchr/testify/require"
@@ -21,7 +29,7 @@ func TestGetLogs(t *testing.T) {
 
 	ctx := context.Background()
 
-	userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil)
+	userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
 
 	t.Run("logs returns logs", func(t *testing.T) {
 		email := "exAmplE@gmail.com"
@@ -36,3 +44,348 @@ func TestGetLogs(t *testing.T) {
 		require.Equal(t, make([]domain.UserLog, 0), result)
 	})
 }
+
+func TestVerifyEmail(t *testing.T) {
+	logger := zap.NewExample()
+	userRepo := mocks.NewIUserRepositoryMock(t)
+	tokenRepo := mocks.NewITokenRepositoryMock(t)
+	secretRepo := mocks.NewSecretRepositoryMock(t)
+
+	ctx := context.Background()
+
+	userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+	t.Run("verify email consumes token and marks user verified", func(t *testing.T) {
+		id := uuid.New()
+
+		tokenRepo.GetDelMock.Return(id.String(), nil)
+		userRepo.GetByIDMock.Expect(minimock.AnyContext, id).Return(domain.User{ID: id}, nil)
+		userRepo.MarkVerifiedMock.Inspect(func(ctx context.Context, userID uuid.UUID, verifiedAt time.Time) {
+			require.Equal(t, id, userID)
+			require.False(t, verifiedAt.IsZero())
+		}).Return(nil)
+
+		err := userService.VerifyEmail(ctx, "token")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("verify email with unknown token", func(t *testing.T) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		tokenRepo.GetDelMock.Return("", repository.ErrNotFound)
+
+		err := userService.VerifyEmail(ctx, "token")
+
+		require.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	logger := zap.NewExample()
+	userRepo := mocks.NewIUserRepositoryMock(t)
+	tokenRepo := mocks.NewITokenRepositoryMock(t)
+	secretRepo := mocks.NewSecretRepositoryMock(t)
+
+	ctx := context.Background()
+
+	userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+	t.Run("reset password revokes all refresh tokens", func(t *testing.T) {
+		id := uuid.New()
+		email := "example@gmail.com"
+
+		tokenRepo.GetDelMock.Return(id.String()+":"+strconv.FormatInt(time.Now().UnixNano(), 10), nil)
+		tokenRepo.GetMock.Expect(minimock.AnyContext, "password-reset-valid-after:"+id.String()).Return("", repository.ErrNotFound)
+		tokenRepo.ListMock.Expect(minimock.AnyContext, email).Return([]string{"refresh1", "refresh2"}, nil)
+		var deleted []string
+		tokenRepo.DeleteRefreshTokensMock.Set(func(ctx context.Context, tokens ...string) error {
+			deleted = append(deleted, tokens...)
+			return nil
+		})
+		tokenRepo.DeleteMock.Set(func(ctx context.Context, keys ...string) error {
+			deleted = append(deleted, keys...)
+			return nil
+		})
+		tokenRepo.ListSessionsMock.Expect(minimock.AnyContext, email).Return([]domain.Session{{ID: "session"}}, nil)
+		var added []string
+		tokenRepo.AddMock.Set(func(ctx context.Context, key, value string, expiration time.Duration) error {
+			added = append(added, key)
+			return nil
+		})
+		tokenRepo.DeleteSessionsMock.Expect(minimock.AnyContext, email, "session").Return(nil)
+		userRepo.GetByIDMock.Expect(minimock.AnyContext, id).Return(domain.User{ID: id, Email: email}, nil)
+		userRepo.UpdatePasswordMock.Inspect(func(ctx context.Context, userID uuid.UUID, hashedPassword string) {
+			require.NotEmpty(t, hashedPassword)
+		}).Return(nil)
+		userRepo.MarkVerifiedMock.Inspect(func(ctx context.Context, userID uuid.UUID, verifiedAt time.Time) {
+			require.Equal(t, id, userID)
+		}).Return(nil)
+
+		err := userService.ResetPassword(ctx, "token", "new-password")
+
+		require.NoError(t, err)
+		require.Contains(t, deleted, "refresh1")
+		require.Contains(t, deleted, "refresh2")
+		require.Contains(t, deleted, email)
+		require.ElementsMatch(t, []string{"password-reset-valid-after:" + id.String(), "tokens-valid-after:" + id.String()}, added)
+	})
+
+	t.Run("link sent before the last reset is rejected", func(t *testing.T) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userService := service.NewUserService(logger.Sugar(), nil, nil, tokenRepo, nil, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+		id := uuid.New()
+		sent := time.Now()
+
+		tokenRepo.GetDelMock.Return(id.String()+":"+strconv.FormatInt(sent.UnixNano(), 10), nil)
+		tokenRepo.GetMock.Expect(minimock.AnyContext, "password-reset-valid-after:"+id.String()).
+			Return(strconv.FormatInt(sent.Add(time.Second).UnixNano(), 10), nil)
+
+		err := userService.ResetPassword(ctx, "token", "new-password")
+		require.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
+
+// failingMailer records sent messages and fails to send them
+type failingMailer chan mailer.Message
+
+func (m failingMailer) Send(ctx context.Context, msg mailer.Message) error {
+	m <- msg
+	return errors.New("smtp is down")
+}
+
+func TestForgotPassword(t *testing.T) {
+	logger := zap.NewExample()
+	userRepo := mocks.NewIUserRepositoryMock(t)
+	tokenRepo := mocks.NewITokenRepositoryMock(t)
+	sent := make(failingMailer, 1)
+
+	ctx := context.Background()
+
+	userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, nil, nil, nil, sent, &configs.AuthConfig{}, nil, nil)
+
+	t.Run("unknown email is ignored", func(t *testing.T) {
+		userRepo.GetByEmailMock.When(minimock.AnyContext, "unknown@example.com").Then(domain.User{}, repository.ErrNotFound)
+
+		err := userService.ForgotPassword(ctx, "unknown@example.com")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("mailer failure is not revealed", func(t *testing.T) {
+		id := uuid.New()
+		email := "user@example.com"
+
+		userRepo.GetByEmailMock.When(minimock.AnyContext, email).Then(domain.User{ID: id, Email: email}, nil)
+		tokenRepo.AddMock.Inspect(func(ctx context.Context, key, value string, expiration time.Duration) {
+			userID, _, _ := strings.Cut(value, ":")
+			require.Equal(t, id.String(), userID)
+		}).Return(nil)
+
+		err := userService.ForgotPassword(ctx, email)
+
+		require.NoError(t, err)
+		select {
+		case msg := <-sent:
+			require.Equal(t, email, msg.To)
+		case <-time.After(time.Second):
+			t.Fatal("password reset email wasn't sent")
+		}
+	})
+}
+
+func TestNewRefreshToken(t *testing.T) {
+	logger := zap.NewExample()
+	userRepo := mocks.NewIUserRepositoryMock(t)
+	secretRepo := mocks.NewSecretRepositoryMock(t)
+
+	ctx := context.Background()
+
+	t.Run("reused refresh token revokes the whole family", func(t *testing.T) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		tokenRepo.GetRefreshTokenMock.Return(domain.RefreshToken{
+			ID:        "stolen",
+			Email:     "example@gmail.com",
+			FamilyID:  "family",
+			RotatedAt: time.Now(),
+		}, nil)
+		tokenRepo.FamilyTokensMock.Expect(minimock.AnyContext, "family").Return([]string{"stolen", "current"}, nil)
+		tokenRepo.DeleteSessionsMock.Expect(minimock.AnyContext, "example@gmail.com", "family").Return(nil)
+		tokenRepo.AddMock.Expect(minimock.AnyContext, "revoked-session:family", "1", service.AccessTokenTTL).Return(nil)
+		var deleted []string
+		tokenRepo.DeleteRefreshTokensMock.Set(func(ctx context.Context, tokens ...string) error {
+			deleted = append(deleted, tokens...)
+			return nil
+		})
+
+		tokens, err := userService.NewRefreshToken(ctx, "stolen")
+
+		require.ErrorIs(t, err, service.ErrRefreshTokenReused)
+		require.Nil(t, tokens)
+		require.Contains(t, deleted, "current")
+	})
+
+	t.Run("refresh token is looked up by hash", func(t *testing.T) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{TokenSecret: "secret"}, nil, nil)
+
+		tokenRepo.GetRefreshTokenMock.Inspect(func(ctx context.Context, id string) {
+			require.NotEqual(t, "raw-token", id)
+		}).Return(domain.RefreshToken{ID: "id", FamilyID: "family", RotatedAt: time.Now()}, nil)
+		tokenRepo.FamilyTokensMock.Return(nil, nil)
+		tokenRepo.DeleteRefreshTokensMock.Return(nil)
+		tokenRepo.DeleteSessionsMock.Return(nil)
+		tokenRepo.AddMock.Return(nil)
+
+		_, err := userService.NewRefreshToken(ctx, "raw-token")
+
+		require.ErrorIs(t, err, service.ErrRefreshTokenReused)
+	})
+
+	t.Run("new access token carries issuer, role and permissions", func(t *testing.T) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userRepo := mocks.NewIUserRepositoryMock(t)
+		secretRepo := mocks.NewSecretRepositoryMock(t)
+		cfg := &configs.AuthConfig{
+			Issuer:      "https://auth.example.com",
+			Permissions: map[string][]string{"admin": {"users:read", "users:write"}},
+		}
+		userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, cfg, nil, nil)
+
+		email := "example@gmail.com"
+		tokenRepo.GetRefreshTokenMock.Return(domain.RefreshToken{ID: "id", Email: email, FamilyID: "family"}, nil)
+		tokenRepo.MarkRefreshTokenRotatedMock.Return(nil)
+		tokenRepo.AddRefreshTokenMock.Return(nil)
+		tokenRepo.PushMock.Return(nil)
+		tokenRepo.GetSessionMock.Return(domain.Session{ID: "family", Email: email}, nil)
+		tokenRepo.SaveSessionMock.Return(nil)
+		userRepo.GetByEmailMock.Return(domain.User{ID: uuid.New(), Email: email, Role: domain.AdminRole}, nil)
+		allowSigning(secretRepo)
+
+		tokens, err := userService.NewRefreshToken(ctx, "token")
+		require.NoError(t, err)
+
+		claims := &service.AuthClaims{}
+		_, _, err = jwt.NewParser().ParseUnverified(tokens.Access, claims)
+		require.NoError(t, err)
+		require.Equal(t, "https://auth.example.com", claims.Issuer)
+		require.Equal(t, "admin", claims.Role)
+		require.Equal(t, []string{"users:read", "users:write"}, claims.Permissions)
+		require.Equal(t, "family", claims.SessionID)
+	})
+
+	t.Run("refresh is refused for locked account", func(t *testing.T) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userRepo := mocks.NewIUserRepositoryMock(t)
+		userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		email := "example@gmail.com"
+		u := domain.User{ID: uuid.New(), Email: email}
+		u.SetStatus(domain.StatusLocked, "suspicious activity", time.Now())
+		u.LockedUntil = time.Now().Add(time.Hour)
+
+		tokenRepo.GetRefreshTokenMock.Return(domain.RefreshToken{ID: "id", Email: email, FamilyID: "family"}, nil)
+		userRepo.GetByEmailMock.Return(u, nil)
+
+		_, err := userService.NewRefreshToken(ctx, "token")
+
+		require.ErrorIs(t, err, service.ErrAccountLocked)
+	})
+
+	t.Run("unknown refresh token", func(t *testing.T) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		tokenRepo.GetRefreshTokenMock.Return(domain.RefreshToken{}, repository.ErrNotFound)
+		tokenRepo.MigrateRefreshTokenMock.Return(repository.ErrNotFound)
+
+		_, err := userService.NewRefreshToken(ctx, "unknown")
+
+		require.ErrorIs(t, err, service.ErrInvalidRefreshToken)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	logger := zap.NewExample()
+	userRepo := mocks.NewIUserRepositoryMock(t)
+	secretRepo := mocks.NewSecretRepositoryMock(t)
+
+	ctx := context.Background()
+
+	t.Run("revoke session deletes its refresh tokens", func(t *testing.T) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		tokenRepo.GetSessionMock.Expect(minimock.AnyContext, "session").Return(domain.Session{ID: "session", Email: "example@gmail.com"}, nil)
+		tokenRepo.FamilyTokensMock.Expect(minimock.AnyContext, "session").Return([]string{"token1", "token2"}, nil)
+		tokenRepo.DeleteRefreshTokensMock.Expect(minimock.AnyContext, "token1", "token2").Return(nil)
+		tokenRepo.DeleteSessionsMock.Expect(minimock.AnyContext, "example@gmail.com", "session").Return(nil)
+		tokenRepo.AddMock.Expect(minimock.AnyContext, "revoked-session:session", "1", service.AccessTokenTTL).Return(nil)
+
+		err := userService.RevokeSession(ctx, "example@gmail.com", "session")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("revoke session of another user", func(t *testing.T) {
+		tokenRepo := mocks.NewITokenRepositoryMock(t)
+		userService := service.NewUserService(logger.Sugar(), nil, userRepo, tokenRepo, secretRepo, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+
+		tokenRepo.GetSessionMock.Return(domain.Session{ID: "session", Email: "other@gmail.com"}, nil)
+
+		err := userService.RevokeSession(ctx, "example@gmail.com", "session")
+
+		require.ErrorIs(t, err, service.ErrNotFound)
+	})
+}
+
+func TestUserInfo(t *testing.T) {
+	logger := zap.NewExample()
+	ctx := context.Background()
+
+	u := domain.User{ID: uuid.New(), Email: "user@example.com", Role: domain.AdminRole, VerifiedAt: time.Now()}
+	newService := func(t *testing.T) *service.UserService {
+		userRepo := mocks.NewIUserRepositoryMock(t)
+		userRepo.GetByIDMock.Optional().Expect(minimock.AnyContext, u.ID).Return(u, nil)
+		return service.NewUserService(logger.Sugar(), nil, userRepo, nil, nil, nil, nil, nil, &configs.AuthConfig{}, nil, nil)
+	}
+	thirdParty := func(scope string) *service.AuthClaims {
+		return &service.AuthClaims{
+			ClientID:         "client",
+			Scope:            scope,
+			RegisteredClaims: jwt.RegisteredClaims{Subject: u.ID.String(), Audience: jwt.ClaimStrings{"client"}},
+		}
+	}
+
+	t.Run("own tokens get all claims", func(t *testing.T) {
+		info, err := newService(t).UserInfo(ctx, &service.AuthClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: u.ID.String()}})
+		require.NoError(t, err)
+		require.Equal(t, u.Email, info.Email)
+		require.True(t, *info.EmailVerified)
+		require.Equal(t, string(domain.AdminRole), info.Role)
+	})
+
+	t.Run("third-party client gets claims of its scope and never role", func(t *testing.T) {
+		info, err := newService(t).UserInfo(ctx, thirdParty("profile"))
+		require.NoError(t, err)
+		require.Equal(t, &service.UserInfo{Subject: u.ID.String()}, info)
+
+		info, err = newService(t).UserInfo(ctx, thirdParty("profile email"))
+		require.NoError(t, err)
+		require.Equal(t, u.Email, info.Email)
+		require.True(t, *info.EmailVerified)
+		require.Empty(t, info.Role)
+	})
+
+	t.Run("service account has no user info", func(t *testing.T) {
+		claims := &service.AuthClaims{
+			ClientID:         "client",
+			RegisteredClaims: jwt.RegisteredClaims{Subject: "client", Audience: jwt.ClaimStrings{"orders"}},
+		}
+		_, err := newService(t).UserInfo(ctx, claims)
+		require.ErrorIs(t, err, service.ErrInvalidToken)
+	})
+}
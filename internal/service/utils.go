@@ -2,30 +2,85 @@ package service
 
 import (
 	"context"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/alexedwards/argon2id"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
 	"github.com/maisiq/go-auth-service/internal/repository"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
 const AccessTokenTTL = 5 * time.Minute
 const RefreshTokenTTL = 24 * time.Hour
+const PublicKeysCacheTTL = 5 * time.Minute
+
+// detachedTimeout bounds work that outlives the request, e.g. sending emails
+const detachedTimeout = time.Minute
 
 var JWTSingingKey = "jwt-key"
 
+// EmailScope lets third-party client read email of the user at userinfo endpoint
+const EmailScope = "email"
+
+// AuthClaims are claims of access tokens. Tokens of service accounts have client id as subject and no role or email
 type AuthClaims struct {
-	Role  string `json:"role"`
-	Email string `json:"email"`
+	Role        string   `json:"role,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	SessionID   string   `json:"sid,omitempty"`
+	// ClientID and Scope are set in tokens issued to OAuth clients, scope is space separated
+	ClientID string `json:"client_id,omitempty"`
+	Scope    string `json:"scope,omitempty"`
 
 	jwt.RegisteredClaims
 }
 
+// Delegated reports whether token is issued to a third-party client or a service account.
+// Such tokens are meant for the client's resource servers, not for API of this service
+func (c *AuthClaims) Delegated() bool {
+	return len(c.Audience) > 0
+}
+
+// HasPermission reports whether token grants permission
+func (c *AuthClaims) HasPermission(permission string) bool {
+	for _, p := range c.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// JWK is a public key in RFC 7517 format
+type JWK struct {
+	KTY string `json:"kty"`
+	KID string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
 func hashPassword(plain string) (string, error) {
 	return argon2id.CreateHash(plain, argon2id.DefaultParams)
 }
@@ -34,21 +89,120 @@ func authenticate(plain string, hashedPassword string) (bool, error) {
 	return argon2id.ComparePasswordAndHash(plain, hashedPassword)
 }
 
-func createAccessToken(ctx context.Context, repo repository.SecretRepository, userID, email string) (string, error) {
+// tokenGrant is an OAuth client tokens are issued to, zero value is the service's own login
+type tokenGrant struct {
+	ClientID   string
+	Scope      []string
+	ThirdParty bool
+}
+
+func createAccessToken(
+	ctx context.Context,
+	repo repository.SecretRepository,
+	cfg *configs.AuthConfig,
+	u domain.User,
+	sid string,
+) (string, error) {
+	return createGrantAccessToken(ctx, repo, cfg, u, sid, tokenGrant{})
+}
+
+// createGrantAccessToken issues access token to the client of the grant.
+// Tokens of third-party clients are restricted to the client's audience and carry no permissions
+func createGrantAccessToken(
+	ctx context.Context,
+	repo repository.SecretRepository,
+	cfg *configs.AuthConfig,
+	u domain.User,
+	sid string,
+	grant tokenGrant,
+) (string, error) {
 	tr := otel.GetTracerProvider().Tracer("gin-server")
 	ctx, span := tr.Start(ctx, "createAccessToken")
 	defer span.End()
 
+	if err := checkAccountStatus(cfg, u); err != nil {
+		return "", err
+	}
+
+	role := u.Role
+	if role == "" {
+		role = domain.UserRole
+	}
+
+	now := time.Now()
+	claims := AuthClaims{
+		Role:        string(role),
+		Permissions: cfg.Permissions[string(role)],
+		Email:       u.Email,
+		SessionID:   sid,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenTTL)),
+			Subject:   u.ID.String(),
+		},
+	}
+	if grant.ClientID != "" {
+		claims.ClientID = grant.ClientID
+		claims.Scope = strings.Join(grant.Scope, " ")
+	}
+	if grant.ThirdParty {
+		claims.Permissions = nil
+		claims.Audience = jwt.ClaimStrings{grant.ClientID}
+	}
+	return signClaims(ctx, repo, claims)
+}
+
+// createClientAccessToken issues access token of service account to call services of the audience
+func createClientAccessToken(
+	ctx context.Context,
+	repo repository.SecretRepository,
+	issuer string,
+	clientID string,
+	scope []string,
+	audience []string,
+) (string, error) {
+	tr := otel.GetTracerProvider().Tracer("gin-server")
+	ctx, span := tr.Start(ctx, "createClientAccessToken")
+	defer span.End()
+
+	now := time.Now()
 	claims := AuthClaims{
-		"user",
-		email,
-		jwt.RegisteredClaims{
-			Issuer:    "auth-service",
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)),
-			Subject:   userID,
+		ClientID: clientID,
+		Scope:    strings.Join(scope, " "),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(AccessTokenTTL)),
+			Subject:   clientID,
+			Audience:  audience,
 		},
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	return signClaims(ctx, repo, claims)
+}
+
+// signClaims signs claims with the version of the signing key which is put into kid header
+func signClaims(ctx context.Context, repo repository.SecretRepository, claims jwt.Claims) (string, error) {
+	signedString, err := signClaimsWithKID(ctx, repo, claims)
+	// cached kid may be retired by now, the next attempt gets the current one
+	if errors.Is(err, repository.ErrKeyVersionRetired) {
+		signedString, err = signClaimsWithKID(ctx, repo, claims)
+	}
+	return signedString, err
+}
+
+func signClaimsWithKID(ctx context.Context, repo repository.SecretRepository, claims jwt.Claims) (string, error) {
+	alg, err := repo.SigningAlgorithm(ctx, JWTSingingKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to get signing algorithm from secret repository: %w", err)
+	}
+	method := jwt.GetSigningMethod(alg)
+	if method == nil {
+		return "", fmt.Errorf("unsupported signing algorithm: %s", alg)
+	}
+	token := jwt.NewWithClaims(method, claims)
 
 	kid, err := repo.GetKID(ctx, JWTSingingKey)
 	if err != nil {
@@ -61,7 +215,7 @@ func createAccessToken(ctx context.Context, repo repository.SecretRepository, us
 		return "", fmt.Errorf("failed to get signing string")
 	}
 
-	signedString, err := repo.SignJWT(ctx, ss, JWTSingingKey)
+	signedString, err := repo.SignJWT(ctx, ss, JWTSingingKey, kid)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign data via secret repository: %w", err)
 	}
@@ -79,3 +233,140 @@ func createRefreshToken() (string, error) {
 func normalizeEmail(email string) string {
 	return strings.ToLower(email)
 }
+
+// hashToken returns keyed hash of one-time token, so raw token is never stored
+func hashToken(secret, token string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// issueOneTimeToken creates random token and saves its hash with value into token repository
+func issueOneTimeToken(ctx context.Context, repo ITokenRepository, secret, prefix, value string, ttl time.Duration) (string, error) {
+	token, err := createRefreshToken()
+	if err != nil {
+		return "", err
+	}
+	if err := repo.Add(ctx, prefix+hashToken(secret, token), value, ttl); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// consumeOneTimeToken returns value saved with token and deletes it at once,
+// so concurrent requests with the same token can't both use it
+func consumeOneTimeToken(ctx context.Context, repo ITokenRepository, secret, prefix, token string) (string, error) {
+	return repo.GetDel(ctx, prefix+hashToken(secret, token))
+}
+
+// detach runs fn in background with a context detached from the request, so the
+// response time doesn't depend on fn. Only the trace of the request is kept
+func detach(ctx context.Context, fn func(ctx context.Context)) {
+	ctx = trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, detachedTimeout)
+		defer cancel()
+		fn(ctx)
+	}()
+}
+
+// lookupOneTimeToken returns value saved with token without consuming it
+func lookupOneTimeToken(ctx context.Context, repo ITokenRepository, secret, prefix, token string) (string, error) {
+	return repo.Get(ctx, prefix+hashToken(secret, token))
+}
+
+// getUserByID gets user by id from token or session, malformed id means the token is invalid
+func getUserByID(ctx context.Context, repo IUserRepository, log *zap.SugaredLogger, userID string) (domain.User, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return domain.User{}, ErrInvalidToken
+	}
+	u, err := repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return domain.User{}, ErrNotFound
+		}
+		log.Errorf("failed to get user: %w", err)
+		return domain.User{}, ErrInternal
+	}
+	return u, nil
+}
+
+// checkAccountStatus returns error if tokens must not be issued to the user
+func checkAccountStatus(cfg *configs.AuthConfig, u domain.User) error {
+	switch {
+	case u.Disabled():
+		return ErrAccountDisabled
+	case u.Locked(time.Now()):
+		return ErrAccountLocked
+	case cfg.RequireVerifiedEmail && (!u.EmailVerified() || u.Status == domain.StatusPendingVerification):
+		return ErrEmailNotVerified
+	}
+	return nil
+}
+
+// IsAccountStatusError reports whether tokens are refused because of the account status
+func IsAccountStatusError(err error) bool {
+	return errors.Is(err, ErrAccountDisabled) ||
+		errors.Is(err, ErrAccountLocked) ||
+		errors.Is(err, ErrEmailNotVerified)
+}
+
+// createTokenPair issues access and refresh tokens and saves hash of refresh token to user's sessions
+func createTokenPair(
+	ctx context.Context,
+	secretRepo repository.SecretRepository,
+	tokenRepo ITokenRepository,
+	cfg *configs.AuthConfig,
+	u domain.User,
+	method string,
+) (*TokenPair, error) {
+	return createGrantTokenPair(ctx, secretRepo, tokenRepo, cfg, u, method, tokenGrant{})
+}
+
+// createGrantTokenPair is createTokenPair for the client of the grant, its refresh token is bound to the client
+func createGrantTokenPair(
+	ctx context.Context,
+	secretRepo repository.SecretRepository,
+	tokenRepo ITokenRepository,
+	cfg *configs.AuthConfig,
+	u domain.User,
+	method string,
+	grant tokenGrant,
+) (*TokenPair, error) {
+	// every login starts a new session which is a family of refresh tokens
+	sid := uuid.NewString()
+	access, err := createGrantAccessToken(ctx, secretRepo, cfg, u, sid, grant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access token: %w", err)
+	}
+
+	refresh, err := createRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	token := domain.RefreshToken{
+		ID:         hashToken(cfg.TokenSecret, refresh),
+		Email:      u.Email,
+		FamilyID:   sid,
+		IssuedAt:   time.Now(),
+		ClientID:   grant.ClientID,
+		Scope:      grant.Scope,
+		ThirdParty: grant.ThirdParty,
+	}
+	if err := tokenRepo.AddRefreshToken(ctx, token, RefreshTokenTTL); err != nil {
+		return nil, fmt.Errorf("failed to save refresh token: %w", err)
+	}
+	if err := tokenRepo.Push(ctx, u.Email, token.ID); err != nil {
+		return nil, fmt.Errorf("failed to push refresh token to all user's token: %w", err)
+	}
+	if err := startSession(ctx, tokenRepo, sid, u.Email, method, grant.ClientID); err != nil {
+		return nil, fmt.Errorf("failed to save session: %w", err)
+	}
+
+	return &TokenPair{
+		Access:  access,
+		Refresh: refresh,
+	}, nil
+}
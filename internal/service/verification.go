@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/mailer"
+	"github.com/maisiq/go-auth-service/internal/repository"
+)
+
+const DefaultVerificationTTL = 24 * time.Hour
+
+const verificationTokenPrefix = "email-verification:"
+
+func (s *UserService) VerifyEmail(ctx context.Context, token string) error {
+	userID, err := consumeOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, verificationTokenPrefix, token)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrInvalidToken
+		}
+		s.log.Errorf("failed to consume verification token: %w", err)
+		return ErrInternal
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		s.log.Errorf("invalid user id in verification token: %w", err)
+		return ErrInternal
+	}
+	u, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrInvalidToken
+		}
+		s.log.Errorf("failed to get user: %w", err)
+		return ErrInternal
+	}
+	if u.EmailVerified() {
+		return nil
+	}
+
+	if err := s.userRepo.MarkVerified(ctx, u.ID, time.Now()); err != nil {
+		s.log.Errorf("failed to mark user verified: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+// ResendVerification sends a new verification email.
+// Unknown and already verified emails are ignored silently and the email is sent
+// in background, so neither the response nor its timing reveal registered users
+func (s *UserService) ResendVerification(ctx context.Context, email string) error {
+	u, err := s.userRepo.GetByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil
+		}
+		s.log.Errorf("failed to get user: %w", err)
+		return ErrInternal
+	}
+	if u.EmailVerified() {
+		return nil
+	}
+
+	detach(ctx, func(ctx context.Context) {
+		if err := s.sendVerificationEmail(ctx, u); err != nil {
+			s.log.Errorw("failed to send verification email",
+				"user_id", u.ID,
+				"error", err,
+			)
+		}
+	})
+	return nil
+}
+
+func (s *UserService) sendVerificationEmail(ctx context.Context, u domain.User) error {
+	ttl := s.cfg.VerificationTTL
+	if ttl == 0 {
+		ttl = DefaultVerificationTTL
+	}
+	token, err := issueOneTimeToken(ctx, s.tokenRepo, s.cfg.TokenSecret, verificationTokenPrefix, u.ID.String(), ttl)
+	if err != nil {
+		return fmt.Errorf("failed to issue verification token: %w", err)
+	}
+
+	link := fmt.Sprintf("%s?token=%s", s.cfg.VerifyEmailURL, url.QueryEscape(token))
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      u.Email,
+		Subject: "Confirm your email",
+		Body: fmt.Sprintf(
+			"Follow the link to confirm your email: %s\n\nThe link expires in %s.\n",
+			link, ttl,
+		),
+	})
+}
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/pkg/webauthn"
+	"go.uber.org/zap"
+)
+
+const (
+	webAuthnRegistrationPrefix = "webauthn-registration:"
+	webAuthnLoginPrefix        = "webauthn-login:"
+)
+
+type WebAuthnService struct {
+	log        *zap.SugaredLogger
+	userRepo   IUserRepository
+	tokenRepo  ITokenRepository
+	secretRepo repository.SecretRepository
+	passkeys   repository.IWebAuthnRepository
+	authCfg    *configs.AuthConfig
+	cfg        webauthn.Config
+}
+
+func NewWebAuthnService(
+	log *zap.SugaredLogger,
+	userRepo IUserRepository,
+	tokenRepo ITokenRepository,
+	secretRepo repository.SecretRepository,
+	passkeys repository.IWebAuthnRepository,
+	authCfg *configs.AuthConfig,
+	cfg *configs.WebAuthnConfig,
+) *WebAuthnService {
+	return &WebAuthnService{
+		log:        log,
+		userRepo:   userRepo,
+		tokenRepo:  tokenRepo,
+		secretRepo: secretRepo,
+		passkeys:   passkeys,
+		authCfg:    authCfg,
+		cfg: webauthn.Config{
+			RPID:    cfg.RPID,
+			RPName:  cfg.RPName,
+			Origins: cfg.Origins,
+		},
+	}
+}
+
+// BeginRegistration starts registration of a new credential for authenticated user
+func (s *WebAuthnService) BeginRegistration(ctx context.Context, userID string) (*webauthn.CreationOptions, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := s.passkeys.ListByUser(ctx, u.ID)
+	if err != nil {
+		s.log.Errorf("failed to list webauthn credentials: %w", err)
+		return nil, ErrInternal
+	}
+	exclude := make([][]byte, 0, len(creds))
+	for _, c := range creds {
+		exclude = append(exclude, c.ID)
+	}
+
+	// challenge is random one-time token, so client data of the response leads back to the ceremony
+	challenge, err := issueOneTimeToken(ctx, s.tokenRepo, s.authCfg.TokenSecret, webAuthnRegistrationPrefix, u.ID.String(), webauthn.Timeout)
+	if err != nil {
+		s.log.Errorf("failed to save webauthn challenge: %w", err)
+		return nil, ErrInternal
+	}
+
+	options := webauthn.NewCreationOptions(s.cfg, challenge, webauthn.UserEntity{
+		ID:          webauthn.URLEncoding.EncodeToString(u.ID[:]),
+		Name:        u.Email,
+		DisplayName: u.Email,
+	}, exclude)
+	return &options, nil
+}
+
+func (s *WebAuthnService) FinishRegistration(ctx context.Context, userID, name string, resp webauthn.RegistrationResponse) error {
+	challenge, err := webauthn.Challenge(resp.Response.ClientDataJSON)
+	if err != nil {
+		return ErrInvalidCredential
+	}
+	challengeUserID, err := consumeOneTimeToken(ctx, s.tokenRepo, s.authCfg.TokenSecret, webAuthnRegistrationPrefix, challenge)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrInvalidCredential
+		}
+		s.log.Errorf("failed to consume webauthn challenge: %w", err)
+		return ErrInternal
+	}
+	if challengeUserID != userID {
+		return ErrInvalidCredential
+	}
+
+	cred, err := webauthn.VerifyRegistration(s.cfg, challenge, resp)
+	if err != nil {
+		s.log.Debugf("webauthn registration failed: %v", err)
+		return ErrInvalidCredential
+	}
+
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	err = s.passkeys.Add(ctx, domain.WebAuthnCredential{
+		ID:        cred.ID,
+		UserID:    u.ID,
+		Name:      name,
+		PublicKey: cred.PublicKey,
+		SignCount: cred.SignCount,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return ErrAlreadyExists
+		}
+		s.log.Errorf("failed to save webauthn credential: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+// BeginLogin starts assertion ceremony.
+// With mfaToken from password login the credential is a second factor, with email the user is known in advance,
+// without both user picks any of discoverable credentials (passkeys)
+func (s *WebAuthnService) BeginLogin(ctx context.Context, email, mfaToken string) (*webauthn.RequestOptions, error) {
+	var (
+		userID string
+		allow  [][]byte
+	)
+
+	switch {
+	case mfaToken != "":
+		id, err := lookupOneTimeToken(ctx, s.tokenRepo, s.authCfg.TokenSecret, mfaChallengePrefix, mfaToken)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, ErrInvalidToken
+			}
+			s.log.Errorf("failed to get mfa challenge: %w", err)
+			return nil, ErrInternal
+		}
+		userID = id
+	case email != "":
+		u, err := s.userRepo.GetByEmail(ctx, normalizeEmail(email))
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			s.log.Errorf("failed to get user: %w", err)
+			return nil, ErrInternal
+		}
+		// unknown email gets empty allow list to not reveal registered users
+		if err == nil {
+			userID = u.ID.String()
+		}
+	}
+
+	if userID != "" {
+		id, err := uuid.Parse(userID)
+		if err != nil {
+			return nil, ErrInvalidToken
+		}
+		creds, err := s.passkeys.ListByUser(ctx, id)
+		if err != nil {
+			s.log.Errorf("failed to list webauthn credentials: %w", err)
+			return nil, ErrInternal
+		}
+		for _, c := range creds {
+			allow = append(allow, c.ID)
+		}
+	}
+
+	challenge, err := issueOneTimeToken(ctx, s.tokenRepo, s.authCfg.TokenSecret, webAuthnLoginPrefix, userID, webauthn.Timeout)
+	if err != nil {
+		s.log.Errorf("failed to save webauthn challenge: %w", err)
+		return nil, ErrInternal
+	}
+
+	userVerification := "required"
+	if mfaToken != "" {
+		userVerification = "discouraged"
+	}
+	options := webauthn.NewRequestOptions(s.cfg, challenge, allow, userVerification)
+	return &options, nil
+}
+
+// FinishLogin verifies assertion and issues tokens the same way as password login does
+func (s *WebAuthnService) FinishLogin(ctx context.Context, mfaToken string, resp webauthn.AssertionResponse) (*TokenPair, error) {
+	challenge, err := webauthn.Challenge(resp.Response.ClientDataJSON)
+	if err != nil {
+		return nil, ErrInvalidCredential
+	}
+	expectedUserID, err := consumeOneTimeToken(ctx, s.tokenRepo, s.authCfg.TokenSecret, webAuthnLoginPrefix, challenge)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrInvalidCredential
+		}
+		s.log.Errorf("failed to consume webauthn challenge: %w", err)
+		return nil, ErrInternal
+	}
+
+	credID, err := webauthn.URLEncoding.DecodeString(resp.RawID)
+	if err != nil {
+		return nil, ErrInvalidCredential
+	}
+	cred, err := s.passkeys.Get(ctx, credID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrInvalidCredential
+		}
+		s.log.Errorf("failed to get webauthn credential: %w", err)
+		return nil, ErrInternal
+	}
+	if expectedUserID != "" && expectedUserID != cred.UserID.String() {
+		return nil, ErrInvalidCredential
+	}
+
+	signCount, userVerified, err := webauthn.VerifyAssertion(s.cfg, challenge, cred.PublicKey, cred.SignCount, resp)
+	if err != nil {
+		if errors.Is(err, webauthn.ErrSignCount) {
+			s.log.Warnw("webauthn sign count is not increasing, credential may be cloned",
+				"user_id", cred.UserID,
+				"credential_id", webauthn.URLEncoding.EncodeToString(cred.ID),
+			)
+		}
+		return nil, ErrInvalidCredential
+	}
+	if err := s.passkeys.UpdateSignCount(ctx, cred.ID, signCount, time.Now()); err != nil {
+		s.log.Errorf("failed to update sign count: %w", err)
+		return nil, ErrInternal
+	}
+
+	method := PasskeyLogin
+	if mfaToken != "" {
+		method = PasswordLogin
+		// password is already checked, the credential is a second factor
+		userID, err := consumeOneTimeToken(ctx, s.tokenRepo, s.authCfg.TokenSecret, mfaChallengePrefix, mfaToken)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return nil, ErrInvalidToken
+			}
+			s.log.Errorf("failed to consume mfa challenge: %w", err)
+			return nil, ErrInternal
+		}
+		if userID != cred.UserID.String() {
+			return nil, ErrInvalidCredential
+		}
+	} else if !userVerified {
+		// passwordless login is multi-factor only when authenticator verified the user
+		return nil, ErrInvalidCredential
+	}
+
+	u, err := s.userRepo.GetByID(ctx, cred.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrInvalidCredential
+		}
+		s.log.Errorf("failed to get user: %w", err)
+		return nil, ErrInternal
+	}
+
+	tokens, err := createTokenPair(ctx, s.secretRepo, s.tokenRepo, s.authCfg, u, method)
+	if err != nil {
+		if IsAccountStatusError(err) {
+			return nil, err
+		}
+		s.log.Errorf("failed to create tokens: %w", err)
+		return nil, ErrInternal
+	}
+	return tokens, nil
+}
+
+func (s *WebAuthnService) Credentials(ctx context.Context, userID string) ([]domain.WebAuthnCredential, error) {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := s.passkeys.ListByUser(ctx, u.ID)
+	if err != nil {
+		s.log.Errorf("failed to list webauthn credentials: %w", err)
+		return nil, ErrInternal
+	}
+	return creds, nil
+}
+
+func (s *WebAuthnService) DeleteCredential(ctx context.Context, userID string, credentialID []byte) error {
+	u, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.passkeys.Delete(ctx, u.ID, credentialID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrNotFound
+		}
+		s.log.Errorf("failed to delete webauthn credential: %w", err)
+		return ErrInternal
+	}
+	return nil
+}
+
+func (s *WebAuthnService) getUser(ctx context.Context, userID string) (domain.User, error) {
+	return getUserByID(ctx, s.userRepo, s.log, userID)
+}
@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/service"
+)
+
+type AdminHandler struct {
+	service service.IUserService
+}
+
+func NewAdminHandler(s service.IUserService) *AdminHandler {
+	return &AdminHandler{
+		service: s,
+	}
+}
+
+type AdminUserResponse struct {
+	ID             string     `json:"id"`
+	Email          string     `json:"email"`
+	Role           string     `json:"role"`
+	EmailVerified  bool       `json:"email_verified"`
+	Status         string     `json:"status"`
+	StatusReason   string     `json:"status_reason,omitempty"`
+	SocialProvider string     `json:"social_provider,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	VerifiedAt     *time.Time `json:"verified_at,omitempty"`
+	StatusChanged  *time.Time `json:"status_changed_at,omitempty"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
+}
+
+type ListUsersResponse struct {
+	Users  []AdminUserResponse `json:"users"`
+	Total  int                 `json:"total"`
+	Limit  int                 `json:"limit"`
+	Offset int                 `json:"offset"`
+}
+
+type ListUsersQuery struct {
+	Email          string    `form:"email"`
+	Role           string    `form:"role"`
+	Status         string    `form:"status"`
+	SocialProvider string    `form:"social_provider"`
+	CreatedFrom    time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo      time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Limit          int       `form:"limit" binding:"gte=0"`
+	Offset         int       `form:"offset" binding:"gte=0"`
+}
+
+type SetRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+type SetStatusRequest struct {
+	Status      string    `json:"status" binding:"required"`
+	Reason      string    `json:"reason"`
+	LockedUntil time.Time `json:"locked_until"`
+}
+
+type StatusReasonRequest struct {
+	Reason string `json:"reason"`
+}
+
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	var q ListUsersQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid query"})
+		return
+	}
+	if q.Role != "" && !domain.Role(q.Role).Valid() {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": service.ErrInvalidRole.Error()})
+		return
+	}
+	if q.Status != "" && !domain.AccountStatus(q.Status).Valid() {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": service.ErrInvalidStatus.Error()})
+		return
+	}
+
+	filter := domain.UserFilter{
+		Email:          q.Email,
+		Role:           domain.Role(q.Role),
+		Status:         domain.AccountStatus(q.Status),
+		SocialProvider: q.SocialProvider,
+		CreatedFrom:    q.CreatedFrom,
+		CreatedTo:      q.CreatedTo,
+		Limit:          q.Limit,
+		Offset:         q.Offset,
+	}
+	users, total, err := h.service.ListUsers(c, filter)
+	if err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	resp := ListUsersResponse{
+		Users:  make([]AdminUserResponse, 0, len(users)),
+		Total:  total,
+		Limit:  q.Limit,
+		Offset: q.Offset,
+	}
+	if resp.Limit == 0 {
+		resp.Limit = service.DefaultUsersPageSize
+	}
+	for _, u := range users {
+		resp.Users = append(resp.Users, adminUserResponse(u))
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *AdminHandler) GetUser(c *gin.Context) {
+	u, err := h.service.GetUser(c, c.Param("id"))
+	if err != nil {
+		writeAdminError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, adminUserResponse(u))
+}
+
+func (h *AdminHandler) SetRole(c *gin.Context) {
+	var req SetRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid body"})
+		return
+	}
+	if err := h.service.SetRole(c, c.Param("id"), domain.Role(req.Role)); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func (h *AdminHandler) SetStatus(c *gin.Context) {
+	var req SetStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid body"})
+		return
+	}
+	status := domain.AccountStatus(req.Status)
+	if err := h.service.SetAccountStatus(c, c.Param("id"), status, req.Reason, req.LockedUntil); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func (h *AdminHandler) DisableUser(c *gin.Context) {
+	h.setStatus(c, domain.StatusDisabled)
+}
+
+func (h *AdminHandler) EnableUser(c *gin.Context) {
+	h.setStatus(c, domain.StatusActive)
+}
+
+// setStatus handles shortcuts for status change with optional reason in body
+func (h *AdminHandler) setStatus(c *gin.Context, status domain.AccountStatus) {
+	var req StatusReasonRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid body"})
+			return
+		}
+	}
+	if err := h.service.SetAccountStatus(c, c.Param("id"), status, req.Reason, time.Time{}); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func (h *AdminHandler) ForcePasswordReset(c *gin.Context) {
+	if err := h.service.ForcePasswordReset(c, c.Param("id")); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{})
+}
+
+func (h *AdminHandler) RevokeSessions(c *gin.Context) {
+	if err := h.service.RevokeUserSessions(c, c.Param("id")); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func adminUserResponse(u domain.User) AdminUserResponse {
+	resp := AdminUserResponse{
+		ID:             u.ID.String(),
+		Email:          u.Email,
+		Role:           string(u.Role),
+		EmailVerified:  u.EmailVerified(),
+		Status:         string(u.Status),
+		StatusReason:   u.StatusReason,
+		SocialProvider: u.SocialProvider,
+		CreatedAt:      u.CreatedAT,
+	}
+	if u.EmailVerified() {
+		resp.VerifiedAt = &u.VerifiedAt
+	}
+	if !u.StatusChangedAt.IsZero() {
+		resp.StatusChanged = &u.StatusChangedAt
+	}
+	if !u.LockedUntil.IsZero() {
+		resp.LockedUntil = &u.LockedUntil
+	}
+	return resp
+}
+
+func writeAdminError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"detail": "user not found"})
+	case errors.Is(err, service.ErrInvalidRole), errors.Is(err, service.ErrInvalidStatus):
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+	}
+}
@@ -0,0 +1,325 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"embed"
+	"errors"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/logger"
+	"github.com/maisiq/go-auth-service/internal/service"
+)
+
+// authorizeRequestCookie binds the authorization request to the browser which started it
+const authorizeRequestCookie = "authorize_request"
+
+//go:embed templates/authorize.html
+var templatesFS embed.FS
+
+var authorizeTemplates = template.Must(template.ParseFS(templatesFS, "templates/authorize.html"))
+
+type RegisterClientRequest struct {
+	Name         string   `json:"name" binding:"required"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Scopes       []string `json:"scopes"`
+	GrantTypes   []string `json:"grant_types"`
+	Audiences    []string `json:"audiences"`
+	Public       bool     `json:"public"`
+	FirstParty   bool     `json:"first_party"`
+}
+
+type RegisterClientResponse struct {
+	*domain.OAuthClient
+	// ClientSecret is shown once, it is empty for public clients
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
+// AuthorizationHandler serves authorization and token endpoints of OAuth 2.0 authorization server.
+// Users log in and consent on HTML screens of authorization endpoint
+type AuthorizationHandler struct {
+	service service.IAuthorizationService
+	users   service.IUserService
+	secrets service.SecretService
+}
+
+func NewAuthorizationHandler(s service.IAuthorizationService, users service.IUserService, secrets service.SecretService) *AuthorizationHandler {
+	return &AuthorizationHandler{
+		service: s,
+		users:   users,
+		secrets: secrets,
+	}
+}
+
+func (h *AuthorizationHandler) Authorize(c *gin.Context) {
+	requestID, err := h.service.Authorize(c, service.AuthorizeParams{
+		ResponseType:        c.Query("response_type"),
+		ClientID:            c.Query("client_id"),
+		RedirectURI:         c.Query("redirect_uri"),
+		Scope:               c.Query("scope"),
+		State:               c.Query("state"),
+		CodeChallenge:       c.Query("code_challenge"),
+		CodeChallengeMethod: c.Query("code_challenge_method"),
+	})
+	if err != nil {
+		writeAuthorizeError(c, err)
+		return
+	}
+	setAuthorizeRequestCookie(c, requestID, int(service.AuthorizationRequestTTL.Seconds()))
+
+	// user signed in on this service recently isn't asked for password again
+	if token, err := c.Cookie(AccessTokenCookieKey); err == nil && token != "" {
+		if claims, err := h.secrets.ParseJWT(c, token); err == nil && !claims.Delegated() {
+			h.continueAuthorization(c, requestID, claims.Subject)
+			return
+		}
+	}
+	renderAuthorize(c, http.StatusOK, "login", gin.H{"Request": requestID})
+}
+
+func (h *AuthorizationHandler) Login(c *gin.Context) {
+	requestID, ok := formAuthorizeRequest(c)
+	if !ok {
+		return
+	}
+	email := c.PostForm("email")
+
+	tokens, err := h.users.Authenticate(withClientInfo(c), email, c.PostForm("password"))
+	if err != nil {
+		var challenge *service.MFAChallengeError
+		if errors.As(err, &challenge) {
+			renderAuthorize(c, http.StatusOK, "mfa", gin.H{"Request": requestID, "MFAToken": challenge.Token})
+			return
+		}
+		status, msg := loginError(err)
+		renderAuthorize(c, status, "login", gin.H{"Request": requestID, "Email": email, "Error": msg})
+		return
+	}
+	if err := h.users.AddLog(c, email, c.Request.UserAgent(), c.ClientIP()); err != nil {
+		logger.GetLogger().Error(err)
+	}
+	h.signedIn(c, requestID, tokens)
+}
+
+func (h *AuthorizationHandler) LoginMFA(c *gin.Context) {
+	requestID, ok := formAuthorizeRequest(c)
+	if !ok {
+		return
+	}
+	mfaToken := c.PostForm("mfa_token")
+
+	tokens, err := h.users.CompleteMFALogin(withClientInfo(c), mfaToken, c.PostForm("code"))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidMFACode):
+			renderAuthorize(c, http.StatusUnauthorized, "mfa", gin.H{"Request": requestID, "MFAToken": mfaToken, "Error": "invalid code"})
+		case errors.Is(err, service.ErrInvalidToken), errors.Is(err, service.ErrNotFound):
+			renderAuthorize(c, http.StatusUnauthorized, "login", gin.H{"Request": requestID, "Error": "sign in again"})
+		default:
+			status, msg := loginError(err)
+			renderAuthorize(c, status, "error", gin.H{"Error": msg})
+		}
+		return
+	}
+	h.signedIn(c, requestID, tokens)
+}
+
+func (h *AuthorizationHandler) Consent(c *gin.Context) {
+	requestID, ok := formAuthorizeRequest(c)
+	if !ok {
+		return
+	}
+	h.decide(c, requestID, c.PostForm("decision") == "allow")
+}
+
+func (h *AuthorizationHandler) Token(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	c.Header("Pragma", "no-cache")
+
+	params := service.TokenParams{
+		GrantType:    c.PostForm("grant_type"),
+		ClientID:     c.PostForm("client_id"),
+		ClientSecret: c.PostForm("client_secret"),
+		Code:         c.PostForm("code"),
+		RedirectURI:  c.PostForm("redirect_uri"),
+		CodeVerifier: c.PostForm("code_verifier"),
+		RefreshToken: c.PostForm("refresh_token"),
+		Scope:        c.PostForm("scope"),
+		Audience:     c.PostForm("audience"),
+	}
+	// client_secret_basic credentials are form-encoded before base64, RFC 6749 section 2.3.1
+	id, secret, basic := c.Request.BasicAuth()
+	if basic {
+		params.ClientID, _ = url.QueryUnescape(id)
+		params.ClientSecret, _ = url.QueryUnescape(secret)
+	}
+
+	resp, err := h.service.Token(withClientInfo(c), params)
+	if err != nil {
+		var oauthErr *service.OAuthError
+		if !errors.As(err, &oauthErr) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "server_error"})
+			return
+		}
+		status := http.StatusBadRequest
+		if oauthErr.Code == service.OAuthInvalidClient {
+			status = http.StatusUnauthorized
+			if basic {
+				c.Header("WWW-Authenticate", `Basic realm="token"`)
+			}
+		}
+		c.JSON(status, gin.H{"error": oauthErr.Code, "error_description": oauthErr.Description})
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *AuthorizationHandler) RegisterClient(c *gin.Context) {
+	var req RegisterClientRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+
+	client, secret, err := h.service.RegisterClient(c, service.ClientRegistration{
+		Name:         req.Name,
+		RedirectURIs: req.RedirectURIs,
+		Scopes:       req.Scopes,
+		GrantTypes:   req.GrantTypes,
+		Audiences:    req.Audiences,
+		Public:       req.Public,
+		FirstParty:   req.FirstParty,
+	})
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidClientMetadata) {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+	c.JSON(http.StatusCreated, RegisterClientResponse{OAuthClient: client, ClientSecret: secret})
+}
+
+func (h *AuthorizationHandler) Clients(c *gin.Context) {
+	clients, err := h.service.Clients(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+	c.JSON(http.StatusOK, clients)
+}
+
+func (h *AuthorizationHandler) DeleteClient(c *gin.Context) {
+	if err := h.service.DeleteClient(c, c.Param("id")); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"detail": "client not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// signedIn starts session of the user on this service and continues the authorization
+func (h *AuthorizationHandler) signedIn(c *gin.Context, requestID string, tokens *service.TokenPair) {
+	claims, err := h.secrets.ParseJWT(c, tokens.Access)
+	if err != nil {
+		_ = c.Error(err)
+		renderAuthorize(c, http.StatusInternalServerError, "error", gin.H{"Error": "internal error"})
+		return
+	}
+	secure := c.Request.TLS != nil
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(AccessTokenCookieKey, tokens.Access, int(service.AccessTokenTTL.Seconds()), "/", "", secure, true)
+	c.SetCookie(RefreshTokenCookieKey, tokens.Refresh, int(service.RefreshTokenTTL.Seconds()), "/", "", secure, true)
+
+	h.continueAuthorization(c, requestID, claims.Subject)
+}
+
+// continueAuthorization asks signed in user for consent, first-party clients are authorized right away
+func (h *AuthorizationHandler) continueAuthorization(c *gin.Context, requestID, userID string) {
+	consent, err := h.service.BindUser(c, requestID, userID)
+	if err != nil {
+		writeAuthorizeError(c, err)
+		return
+	}
+	if !consent.Required {
+		h.decide(c, requestID, true)
+		return
+	}
+	renderAuthorize(c, http.StatusOK, "consent", gin.H{
+		"Request":    requestID,
+		"ClientName": consent.ClientName,
+		"Scope":      consent.Scope,
+	})
+}
+
+func (h *AuthorizationHandler) decide(c *gin.Context, requestID string, approved bool) {
+	redirectURL, err := h.service.Decide(c, requestID, approved)
+	if err != nil {
+		writeAuthorizeError(c, err)
+		return
+	}
+	setAuthorizeRequestCookie(c, "", -1)
+	c.Redirect(http.StatusSeeOther, redirectURL)
+}
+
+// formAuthorizeRequest returns request id of the form if it was started by this browser
+func formAuthorizeRequest(c *gin.Context) (string, bool) {
+	requestID := c.PostForm("request")
+	cookie, _ := c.Cookie(authorizeRequestCookie)
+	if requestID == "" || subtle.ConstantTimeCompare([]byte(requestID), []byte(cookie)) != 1 {
+		renderAuthorize(c, http.StatusBadRequest, "error", gin.H{"Error": service.ErrInvalidAuthorizationRequest.Error()})
+		return "", false
+	}
+	return requestID, true
+}
+
+func writeAuthorizeError(c *gin.Context, err error) {
+	var oauthErr *service.OAuthError
+	switch {
+	case errors.As(err, &oauthErr) && oauthErr.RedirectURI != "":
+		c.Redirect(http.StatusSeeOther, oauthErr.RedirectURL())
+	case errors.As(err, &oauthErr):
+		renderAuthorize(c, http.StatusBadRequest, "error", gin.H{"Error": oauthErr.Description})
+	case errors.Is(err, service.ErrInvalidAuthorizationRequest):
+		renderAuthorize(c, http.StatusBadRequest, "error", gin.H{"Error": err.Error()})
+	default:
+		_ = c.Error(err)
+		renderAuthorize(c, http.StatusInternalServerError, "error", gin.H{"Error": "internal error"})
+	}
+}
+
+func loginError(err error) (int, string) {
+	var lockedOut *service.LockedOutError
+	switch {
+	case errors.As(err, &lockedOut):
+		return http.StatusTooManyRequests, "too many failed login attempts, try again later"
+	case errors.Is(err, service.ErrNotFound), errors.Is(err, service.ErrBadCredentials):
+		return http.StatusUnauthorized, "invalid email or password"
+	case service.IsAccountStatusError(err):
+		return http.StatusForbidden, err.Error()
+	default:
+		return http.StatusInternalServerError, "internal error"
+	}
+}
+
+// renderAuthorize renders screen of authorization endpoint, the screens must not be framed by other sites
+func renderAuthorize(c *gin.Context, status int, name string, data gin.H) {
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Frame-Options", "DENY")
+	c.Header("Content-Security-Policy", "frame-ancestors 'none'")
+	c.Render(status, render.HTML{Template: authorizeTemplates, Name: name, Data: data})
+}
+
+// setAuthorizeRequestCookie sets host-only cookie sent with forms of authorization screens
+func setAuthorizeRequestCookie(c *gin.Context, requestID string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(authorizeRequestCookie, requestID, maxAge, "/authorize", "", c.Request.TLS != nil, true)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maisiq/go-auth-service/internal/service"
+)
+
+type KeysHandler struct {
+	service service.SecretService
+}
+
+func NewKeysHandler(s service.SecretService) *KeysHandler {
+	return &KeysHandler{
+		service: s,
+	}
+}
+
+// JWKS serves public signing keys, so resource servers can verify access tokens offline
+func (h *KeysHandler) JWKS(c *gin.Context) {
+	set, err := h.service.JWKS(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+
+	body, err := json.Marshal(set)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+	sum := sha256.Sum256(body)
+	etag := fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:16]))
+
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(service.PublicKeysCacheTTL.Seconds())))
+	c.Header("ETag", etag)
+
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	c.Data(http.StatusOK, "application/json", body)
+}
+
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == etag || v == "*" {
+			return true
+		}
+	}
+	return false
+}
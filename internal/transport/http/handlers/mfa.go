@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/maisiq/go-auth-service/internal/transport/http/middleware"
+)
+
+type MFAChallengeResponse struct {
+	MFARequired bool     `json:"mfa_required"`
+	MFAToken    string   `json:"mfa_token"`
+	Methods     []string `json:"methods"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+func (h *UserHadlerGin) EnrollTOTP(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDContextKey)
+
+	enrollment, err := h.service.EnrollTOTP(c, userID)
+	if err != nil {
+		writeMFAError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, enrollment)
+}
+
+func (h *UserHadlerGin) ConfirmTOTP(c *gin.Context) {
+	var req MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+	userID := c.GetString(middleware.UserIDContextKey)
+
+	codes, err := h.service.ConfirmTOTP(c, userID, req.Code)
+	if err != nil {
+		writeMFAError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"recovery_codes": codes})
+}
+
+func (h *UserHadlerGin) DisableTOTP(c *gin.Context) {
+	var req MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+	userID := c.GetString(middleware.UserIDContextKey)
+
+	if err := h.service.DisableTOTP(c, userID, req.Code); err != nil {
+		writeMFAError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func (h *UserHadlerGin) CompleteMFALogin(c *gin.Context) {
+	var req MFALoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+
+	tokens, err := h.service.CompleteMFALogin(withClientInfo(c), req.MFAToken, req.Code)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidToken) {
+			c.JSON(http.StatusUnauthorized, gin.H{"detail": "invalid or expired mfa token"})
+			return
+		}
+		writeMFAError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, tokens)
+}
+
+func writeMFAError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidMFACode):
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid code"})
+	case errors.Is(err, service.ErrMFAAlreadyEnabled), errors.Is(err, service.ErrMFANotEnabled):
+		c.JSON(http.StatusConflict, gin.H{"detail": err.Error()})
+	case errors.Is(err, service.ErrNotFound), errors.Is(err, service.ErrInvalidToken):
+		c.JSON(http.StatusUnauthorized, gin.H{"detail": "invalid token"})
+	case service.IsAccountStatusError(err):
+		c.JSON(http.StatusForbidden, gin.H{"detail": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+	}
+}
@@ -1,65 +1,78 @@
 package handlers
 
 import (
-	"crypto/rand"
-	"encoding/base64"
+	"crypto/subtle"
+	"errors"
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/maisiq/go-auth-service/internal/configs"
 	"github.com/maisiq/go-auth-service/internal/oauth"
 	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/maisiq/go-auth-service/internal/transport/http/middleware"
 )
 
-// utils
-
-func NewState() string {
-	b := make([]byte, 16)
-	_, _ = rand.Read(b)
-	return base64.RawURLEncoding.EncodeToString(b)
-}
+// oauthStateCookie binds the state saved on server to the browser which started the login
+const oauthStateCookie = "oauth_state"
 
 // handlers
 
 type OAuthHandler struct {
-	service        service.IOAuthService
-	yandexProvider oauth.OAuthProvider
+	service service.IOAuthService
 }
 
-func NewOAuthHandler(s service.IOAuthService, yandex oauth.OAuthProvider) *OAuthHandler {
+func NewOAuthHandler(s service.IOAuthService) *OAuthHandler {
 	return &OAuthHandler{
-		service:        s,
-		yandexProvider: yandex,
+		service: s,
 	}
 }
 
 func (h *OAuthHandler) Redirect(c *gin.Context) {
-	providerName := c.Query("provider")
-	state := NewState()
+	redirectURL, state, err := h.service.BeginLogin(
+		c.Request.Context(),
+		oauth.OAuthProviderT(c.Param("provider")),
+		c.Query("redirect_to"),
+	)
+	if err != nil {
+		writeBeginOAuthError(c, err)
+		return
+	}
+	setOAuthStateCookie(c, state, int(service.OAuthStateTTL.Seconds()))
+	c.Redirect(http.StatusTemporaryRedirect, redirectURL)
+}
 
-	var redirectURL string
+// Link starts linking of the provider to signed in user.
+// Frontend navigates to the returned URL, the callback then links the identity instead of logging in
+func (h *OAuthHandler) Link(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDContextKey)
 
-	switch providerName {
-	case string(oauth.YandexProvider):
-		redirectURL = configs.GetConfig().Yandex.GetAuthorizeURL(state)
-	default:
-		c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid provider in query string"})
+	redirectURL, state, err := h.service.BeginLink(
+		c.Request.Context(),
+		userID,
+		oauth.OAuthProviderT(c.Param("provider")),
+		c.Query("redirect_to"),
+	)
+	if err != nil {
+		writeBeginOAuthError(c, err)
 		return
 	}
-	c.SetCookie("state", state, int((3 * time.Minute).Seconds()), "/", "localhost", false, true)
-	c.Redirect(http.StatusTemporaryRedirect, redirectURL)
+	setOAuthStateCookie(c, state, int(service.OAuthStateTTL.Seconds()))
+	c.JSON(http.StatusOK, gin.H{"redirect_url": redirectURL})
 }
 
-func (h *OAuthHandler) YandexCallback(c *gin.Context) {
-	// verify state
-	qState := c.Query("state")
-	cState, _ := c.Cookie("state")
+func (h *OAuthHandler) Callback(c *gin.Context) {
+	if providerErr := c.Query("error"); providerErr != "" {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": providerErr})
+		return
+	}
 
-	if qState != cState {
-		c.JSON(http.StatusBadRequest, gin.H{"detail": "bad request"})
+	// state must be the one saved for this browser
+	qState := c.Query("state")
+	cState, _ := c.Cookie(oauthStateCookie)
+	if qState == "" || subtle.ConstantTimeCompare([]byte(qState), []byte(cState)) != 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": service.ErrInvalidOAuthState.Error()})
 		return
 	}
+	setOAuthStateCookie(c, "", -1)
 
 	code := c.Query("code")
 	if code == "" {
@@ -67,13 +80,92 @@ func (h *OAuthHandler) YandexCallback(c *gin.Context) {
 		return
 	}
 
-	tokens, err := h.service.CreateTokens(c, h.yandexProvider, code)
+	result, err := h.service.Callback(withClientInfo(c), oauth.OAuthProviderT(c.Param("provider")), qState, code)
 
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		switch {
+		case service.IsAccountStatusError(err):
+			c.JSON(http.StatusForbidden, gin.H{"detail": err.Error()})
+		case errors.Is(err, service.ErrIdentityNotLinked), errors.Is(err, service.ErrIdentityLinked):
+			c.JSON(http.StatusConflict, gin.H{"detail": err.Error()})
+		case errors.Is(err, service.ErrInternal):
+			c.JSON(http.StatusInternalServerError, gin.H{"detail": err.Error()})
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		}
 		return
 	}
+	if result.Identity != nil {
+		if result.RedirectTo != "" {
+			c.Redirect(http.StatusSeeOther, result.RedirectTo)
+			return
+		}
+		c.JSON(http.StatusOK, result.Identity)
+		return
+	}
+
+	tokens := result.Tokens
 	c.SetCookie(AccessTokenCookieKey, tokens.Access, int(service.AccessTokenTTL), "/", "localhost", false, true)
 	c.SetCookie(RefreshTokenCookieKey, tokens.Refresh, int(service.RefreshTokenTTL), "/", "localhost", false, true)
+	if result.RedirectTo != "" {
+		c.Redirect(http.StatusSeeOther, result.RedirectTo)
+		return
+	}
 	c.JSON(http.StatusCreated, tokens)
 }
+
+func (h *OAuthHandler) Identities(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDContextKey)
+
+	identities, err := h.service.Identities(c, userID)
+	if err != nil {
+		writeIdentityError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, identities)
+}
+
+func (h *OAuthHandler) Unlink(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDContextKey)
+
+	if err := h.service.Unlink(c, userID, c.Param("provider"), c.Param("subject")); err != nil {
+		writeIdentityError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func writeBeginOAuthError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, oauth.ErrUnknownProvider), errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"detail": err.Error()})
+	case errors.Is(err, service.ErrInvalidRedirect):
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+	case errors.Is(err, service.ErrInvalidToken):
+		c.JSON(http.StatusUnauthorized, gin.H{"detail": err.Error()})
+	case errors.Is(err, service.ErrInternal):
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": err.Error()})
+	default:
+		_ = c.Error(err)
+		c.JSON(http.StatusBadGateway, gin.H{"detail": "oauth provider is unavailable"})
+	}
+}
+
+func writeIdentityError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"detail": "not found"})
+	case errors.Is(err, service.ErrLastLoginMethod):
+		c.JSON(http.StatusConflict, gin.H{"detail": err.Error()})
+	case errors.Is(err, service.ErrInvalidToken):
+		c.JSON(http.StatusUnauthorized, gin.H{"detail": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": service.ErrInternal.Error()})
+	}
+}
+
+// setOAuthStateCookie sets host-only cookie sent back on top-level redirect from the provider
+func setOAuthStateCookie(c *gin.Context, state string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oauthStateCookie, state, maxAge, "/oauth", "", c.Request.TLS != nil, true)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/maisiq/go-auth-service/internal/transport/http/middleware"
+)
+
+// OpenIDConfiguration is OpenID Connect discovery document.
+// More at https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type OpenIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+}
+
+type OIDCHandler struct {
+	baseURL string
+	// issuer is iss claim of access tokens
+	issuer  string
+	service service.IUserService
+}
+
+func NewOIDCHandler(baseURL, issuer string, s service.IUserService) *OIDCHandler {
+	return &OIDCHandler{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		issuer:  issuer,
+		service: s,
+	}
+}
+
+// Discovery lists only what the endpoints implement. ID tokens aren't issued,
+// so openid scope, ID token fields and implicit flow aren't advertised
+func (h *OIDCHandler) Discovery(c *gin.Context) {
+	c.JSON(http.StatusOK, OpenIDConfiguration{
+		Issuer:                            h.issuer,
+		JWKSURI:                           h.baseURL + "/.well-known/jwks.json",
+		AuthorizationEndpoint:             h.baseURL + "/authorize",
+		TokenEndpoint:                     h.baseURL + "/token",
+		UserInfoEndpoint:                  h.baseURL + "/userinfo",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{domain.GrantAuthorizationCode, domain.GrantClientCredentials, domain.GrantRefreshToken},
+		SubjectTypesSupported:             []string{"public"},
+		ScopesSupported:                   []string{service.EmailScope},
+		ClaimsSupported:                   []string{"iss", "sub", "aud", "exp", "email", "email_verified", "role", "permissions", "client_id", "scope"},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+	})
+}
+
+func (h *OIDCHandler) UserInfo(c *gin.Context) {
+	v, _ := c.Get(middleware.ClaimsContextKey)
+	claims, ok := v.(*service.AuthClaims)
+	if !ok || claims.Subject == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"detail": "invalid token"})
+		return
+	}
+
+	info, err := h.service.UserInfo(c, claims)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) || errors.Is(err, service.ErrInvalidToken) {
+			c.JSON(http.StatusUnauthorized, gin.H{"detail": "invalid token"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+	c.JSON(http.StatusOK, info)
+}
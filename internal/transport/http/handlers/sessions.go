@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/maisiq/go-auth-service/internal/transport/http/middleware"
+)
+
+type SessionResponse struct {
+	domain.Session
+	Current bool `json:"current"`
+}
+
+func (h *UserHadlerGin) Sessions(c *gin.Context) {
+	email := c.GetString(middleware.UserEmailContextKey)
+	current := c.GetString(middleware.SessionIDContextKey)
+
+	sessions, err := h.service.Sessions(c, email)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+
+	resp := make([]SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		resp = append(resp, SessionResponse{Session: s, Current: s.ID == current})
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *UserHadlerGin) RevokeSession(c *gin.Context) {
+	email := c.GetString(middleware.UserEmailContextKey)
+
+	if err := h.service.RevokeSession(c, email, c.Param("id")); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"detail": "session not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// withClientInfo passes device of the request to the service, so it is recorded in the session
+func withClientInfo(c *gin.Context) context.Context {
+	return service.WithClientInfo(c.Request.Context(), service.ClientInfo{
+		UserAgent: c.Request.UserAgent(),
+		IP:        c.ClientIP(),
+	})
+}
@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/maisiq/go-auth-service/internal/logger"
@@ -67,14 +69,35 @@ func (h *UserHadlerGin) AuthenticateUser(c *gin.Context) {
 		return
 	}
 
-	token, err := h.service.Authenticate(c.Request.Context(), s.Email, s.Password)
+	token, err := h.service.Authenticate(withClientInfo(c), s.Email, s.Password)
 	if err != nil {
+		var challenge *service.MFAChallengeError
+		if errors.As(err, &challenge) {
+			if err := h.service.AddLog(c, s.Email, c.Request.UserAgent(), c.ClientIP()); err != nil {
+				logger.GetLogger().Error(err)
+			}
+			c.JSON(http.StatusAccepted, MFAChallengeResponse{
+				MFARequired: true,
+				MFAToken:    challenge.Token,
+				Methods:     challenge.Methods,
+			})
+			return
+		}
+		var lockedOut *service.LockedOutError
+		if errors.As(err, &lockedOut) {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(lockedOut.RetryAfter.Seconds()))))
+			c.JSON(http.StatusTooManyRequests, gin.H{"detail": "too many failed login attempts"})
+			return
+		}
 		if errors.Is(err, service.ErrNotFound) {
 			c.JSON(http.StatusNotFound, gin.H{"detail": "user not found"})
 			return
 		} else if errors.Is(err, service.ErrBadCredentials) {
 			c.JSON(http.StatusBadRequest, gin.H{"detail": "email-password pair don't match"})
 			return
+		} else if service.IsAccountStatusError(err) {
+			c.JSON(http.StatusForbidden, gin.H{"detail": err.Error()})
+			return
 		}
 		c.String(http.StatusInternalServerError, "internal error")
 		return
@@ -108,10 +131,14 @@ func (h *UserHadlerGin) Refresh(c *gin.Context) {
 		return
 	}
 
-	tokens, err := h.service.NewRefreshToken(c, t)
+	tokens, err := h.service.NewRefreshToken(withClientInfo(c), t)
 	if err != nil {
 		if errors.Is(err, service.ErrInvalidRefreshToken) {
 			c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		} else if errors.Is(err, service.ErrRefreshTokenReused) {
+			c.JSON(http.StatusUnauthorized, gin.H{"detail": err.Error()})
+		} else if service.IsAccountStatusError(err) {
+			c.JSON(http.StatusForbidden, gin.H{"detail": err.Error()})
 		} else if errors.Is(err, service.ErrNotFound) {
 			c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid user"})
 		} else {
@@ -143,6 +170,15 @@ func (h *UserHadlerGin) Logout(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
 		return
 	}
+
+	// access token of the request must not outlive logout
+	if claims, ok := c.Get(middleware.ClaimsContextKey); ok {
+		claims := claims.(*service.AuthClaims)
+		if err := h.service.RevokeAccessToken(c, claims.ID, claims.ExpiresAt.Time); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+			return
+		}
+	}
 	c.JSON(http.StatusOK, gin.H{})
 }
 
@@ -167,3 +203,86 @@ func (h *UserHadlerGin) UpdatePassword(c *gin.Context) {
 	c.SetCookie(AccessTokenCookieKey, "", 0, "/", "localhost", false, true)
 	c.JSON(http.StatusOK, gin.H{})
 }
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+func (h *UserHadlerGin) VerifyEmail(c *gin.Context) {
+	var req VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+
+	if err := h.service.VerifyEmail(c, req.Token); err != nil {
+		if errors.Is(err, service.ErrInvalidToken) {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid or expired token"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+func (h *UserHadlerGin) ResendVerification(c *gin.Context) {
+	var req ResendVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+
+	if err := h.service.ResendVerification(c, req.Email); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{})
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+func (h *UserHadlerGin) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+
+	// the answer is the same whether user exists or not
+	if err := h.service.ForgotPassword(c, req.Email); err != nil {
+		logger.GetLogger().Error(err)
+	}
+	c.JSON(http.StatusAccepted, gin.H{})
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,gte=6"`
+}
+
+func (h *UserHadlerGin) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+
+	if err := h.service.ResetPassword(c, req.Token, req.Password); err != nil {
+		if errors.Is(err, service.ErrInvalidToken) {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid or expired token"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+		return
+	}
+	c.SetCookie(RefreshTokenCookieKey, "", 0, "/", "localhost", false, true)
+	c.SetCookie(AccessTokenCookieKey, "", 0, "/", "localhost", false, true)
+	c.JSON(http.StatusOK, gin.H{})
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maisiq/go-auth-service/internal/service"
+	"github.com/maisiq/go-auth-service/internal/transport/http/middleware"
+	"github.com/maisiq/go-auth-service/pkg/webauthn"
+)
+
+type FinishRegistrationRequest struct {
+	Name       string                        `json:"name"`
+	Credential webauthn.RegistrationResponse `json:"credential" binding:"required"`
+}
+
+type BeginWebAuthnLoginRequest struct {
+	Email    string `json:"email" binding:"omitempty,email"`
+	MFAToken string `json:"mfa_token"`
+}
+
+type FinishWebAuthnLoginRequest struct {
+	MFAToken   string                     `json:"mfa_token"`
+	Credential webauthn.AssertionResponse `json:"credential" binding:"required"`
+}
+
+type WebAuthnCredentialResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+type WebAuthnHandler struct {
+	service service.IWebAuthnService
+}
+
+func NewWebAuthnHandler(s service.IWebAuthnService) *WebAuthnHandler {
+	return &WebAuthnHandler{
+		service: s,
+	}
+}
+
+func (h *WebAuthnHandler) BeginRegistration(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDContextKey)
+
+	options, err := h.service.BeginRegistration(c, userID)
+	if err != nil {
+		writeWebAuthnError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"publicKey": options})
+}
+
+func (h *WebAuthnHandler) FinishRegistration(c *gin.Context) {
+	var req FinishRegistrationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+	userID := c.GetString(middleware.UserIDContextKey)
+
+	if err := h.service.FinishRegistration(c, userID, req.Name, req.Credential); err != nil {
+		writeWebAuthnError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{})
+}
+
+func (h *WebAuthnHandler) BeginLogin(c *gin.Context) {
+	var req BeginWebAuthnLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+
+	options, err := h.service.BeginLogin(c, req.Email, req.MFAToken)
+	if err != nil {
+		writeWebAuthnError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"publicKey": options})
+}
+
+func (h *WebAuthnHandler) FinishLogin(c *gin.Context) {
+	var req FinishWebAuthnLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ResponseWithError{Detail: "invalid body"})
+		return
+	}
+
+	tokens, err := h.service.FinishLogin(withClientInfo(c), req.MFAToken, req.Credential)
+	if err != nil {
+		writeWebAuthnError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, tokens)
+}
+
+func (h *WebAuthnHandler) Credentials(c *gin.Context) {
+	userID := c.GetString(middleware.UserIDContextKey)
+
+	creds, err := h.service.Credentials(c, userID)
+	if err != nil {
+		writeWebAuthnError(c, err)
+		return
+	}
+
+	resp := make([]WebAuthnCredentialResponse, 0, len(creds))
+	for _, cred := range creds {
+		r := WebAuthnCredentialResponse{
+			ID:        webauthn.URLEncoding.EncodeToString(cred.ID),
+			Name:      cred.Name,
+			CreatedAt: cred.CreatedAt,
+		}
+		if !cred.LastUsedAt.IsZero() {
+			r.LastUsedAt = &cred.LastUsedAt
+		}
+		resp = append(resp, r)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *WebAuthnHandler) DeleteCredential(c *gin.Context) {
+	id, err := webauthn.URLEncoding.DecodeString(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid credential id"})
+		return
+	}
+	userID := c.GetString(middleware.UserIDContextKey)
+
+	if err := h.service.DeleteCredential(c, userID, id); err != nil {
+		writeWebAuthnError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func writeWebAuthnError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidCredential):
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "invalid credential"})
+	case errors.Is(err, service.ErrAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{"detail": "credential is already registered"})
+	case errors.Is(err, service.ErrInvalidToken):
+		c.JSON(http.StatusUnauthorized, gin.H{"detail": "invalid token"})
+	case errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"detail": "not found"})
+	case service.IsAccountStatusError(err):
+		c.JSON(http.StatusForbidden, gin.H{"detail": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "internal error"})
+	}
+}
@@ -9,6 +9,9 @@ import (
 )
 
 const UserEmailContextKey = "userEmail"
+const UserIDContextKey = "userID"
+const SessionIDContextKey = "sessionID"
+const ClaimsContextKey = "claims"
 
 func AuthMiddleware(s service.SecretService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -33,6 +36,9 @@ func AuthMiddleware(s service.SecretService) gin.HandlerFunc {
 			return
 		}
 		c.Set(UserEmailContextKey, claims.Email)
+		c.Set(UserIDContextKey, claims.Subject)
+		c.Set(SessionIDContextKey, claims.SessionID)
+		c.Set(ClaimsContextKey, claims)
 		c.Next()
 	}
 }
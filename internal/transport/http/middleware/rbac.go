@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/maisiq/go-auth-service/internal/domain"
+	"github.com/maisiq/go-auth-service/internal/service"
+)
+
+// RequireRole allows request only for one of the roles.
+// It must be used after AuthMiddleware
+func RequireRole(roles ...domain.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := authClaims(c)
+		if !ok {
+			return
+		}
+		for _, role := range roles {
+			if claims.Role == string(role) {
+				c.Next()
+				return
+			}
+		}
+		c.JSON(http.StatusForbidden, gin.H{"detail": "insufficient role"})
+		c.Abort()
+	}
+}
+
+// RequirePermission allows request only if token grants all of the permissions.
+// It must be used after AuthMiddleware
+func RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := authClaims(c)
+		if !ok {
+			return
+		}
+		for _, p := range permissions {
+			if !claims.HasPermission(p) {
+				c.JSON(http.StatusForbidden, gin.H{"detail": "insufficient permissions"})
+				c.Abort()
+				return
+			}
+		}
+		c.Next()
+	}
+}
+
+// RejectDelegated refuses tokens issued to third-party clients, such tokens are accepted by userinfo only.
+// It must be used after AuthMiddleware
+func RejectDelegated() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := authClaims(c)
+		if !ok {
+			return
+		}
+		if claims.Delegated() {
+			c.JSON(http.StatusForbidden, gin.H{"detail": "token is issued to another audience"})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+func authClaims(c *gin.Context) (*service.AuthClaims, bool) {
+	v, ok := c.Get(ClaimsContextKey)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"detail": "unauthorized"})
+		c.Abort()
+		return nil, false
+	}
+	return v.(*service.AuthClaims), true
+}
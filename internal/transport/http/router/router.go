@@ -1,22 +1,29 @@
 package router
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 	"github.com/maisiq/go-auth-service/internal/configs"
-	"github.com/maisiq/go-auth-service/internal/oauth"
+	"github.com/maisiq/go-auth-service/internal/domain"
 	"github.com/maisiq/go-auth-service/internal/service"
 	"github.com/maisiq/go-auth-service/internal/transport/http/handlers"
 	"github.com/maisiq/go-auth-service/internal/transport/http/middleware"
+	"github.com/maisiq/go-auth-service/pkg/ratelimit"
 	"go.opentelemetry.io/otel/trace"
 )
 
 type RouterParams struct {
-	UserService    service.IUserService
-	OAuthService   service.IOAuthService
-	SecretService  service.SecretService
-	YandexProvider oauth.OAuthProvider
-	Config         *configs.AppConfig
-	Tracer         trace.Tracer
+	UserService   service.IUserService
+	OAuthService  service.IOAuthService
+	SecretService service.SecretService
+	WebAuthn      service.IWebAuthnService
+	Authorization service.IAuthorizationService
+	Limiter       ratelimit.Limiter
+	Config        *configs.AppConfig
+	// Issuer is iss claim of access tokens, advertised in discovery document
+	Issuer string
+	Tracer trace.Tracer
 }
 
 func NewRouter(params *RouterParams) *gin.Engine {
@@ -26,30 +33,98 @@ func NewRouter(params *RouterParams) *gin.Engine {
 		gin.SetMode(gin.ReleaseMode)
 	}
 	r := gin.Default()
+	// client IP keys rate limits and lockouts, so it is taken from proxies only
+	if err := r.SetTrustedProxies(params.Config.TrustedProxies); err != nil {
+		panic(fmt.Sprintf("invalid trusted proxies: %v", err))
+	}
 
 	uh := handlers.NewUserHadler(params.UserService)
-	ah := handlers.NewOAuthHandler(params.OAuthService, params.YandexProvider)
+	ah := handlers.NewOAuthHandler(params.OAuthService)
+	kh := handlers.NewKeysHandler(params.SecretService)
+	oh := handlers.NewOIDCHandler(params.Config.PublicURL, params.Issuer, params.UserService)
+	wh := handlers.NewWebAuthnHandler(params.WebAuthn)
+	adh := handlers.NewAdminHandler(params.UserService)
+	zh := handlers.NewAuthorizationHandler(params.Authorization, params.UserService, params.SecretService)
 
 	traced := r.Group("/")
 	traced.Use(middleware.TracingMiddleware(params.Tracer))
 
+	rateLimit := middleware.RateLimitMiddleware(params.Limiter, params.Config.Limiter)
+
 	throttled := traced.Group("/")
-	throttled.Use(middleware.ThrottleMiddleware(params.Config.Limiter.Limit, params.Config.Limiter.Burst))
+	throttled.Use(rateLimit)
 	{
 		throttled.POST("/create", uh.CreateUser)
 		throttled.POST("/login", uh.AuthenticateUser)
+		throttled.POST("/login/mfa", uh.CompleteMFALogin)
+		throttled.POST("/login/webauthn/begin", wh.BeginLogin)
+		throttled.POST("/login/webauthn/finish", wh.FinishLogin)
 		throttled.POST("/refresh", uh.Refresh)
+		throttled.POST("/verify-email", uh.VerifyEmail)
+		throttled.POST("/verify-email/resend", uh.ResendVerification)
+		throttled.POST("/password/forgot", uh.ForgotPassword)
+		throttled.POST("/password/reset", uh.ResetPassword)
+
+		throttled.GET("/oauth/:provider/redirect", ah.Redirect)
+		throttled.GET("/oauth/:provider/callback", ah.Callback)
 
-		throttled.GET("/oauth/redirect", ah.Redirect)
-		throttled.GET("/oauth/yandex/callback", ah.YandexCallback)
+		throttled.GET("/authorize", zh.Authorize)
+		throttled.POST("/authorize/login", zh.Login)
+		throttled.POST("/authorize/login/mfa", zh.LoginMFA)
+		throttled.POST("/authorize/consent", zh.Consent)
+		throttled.POST("/token", zh.Token)
+
+		throttled.GET("/.well-known/jwks.json", kh.JWKS)
+		throttled.GET("/.well-known/openid-configuration", oh.Discovery)
+	}
+
+	// tokens of third-party clients are accepted by userinfo only
+	userinfo := traced.Group("/")
+	userinfo.Use(middleware.AuthMiddleware(params.SecretService), rateLimit)
+	{
+		userinfo.GET("/userinfo", oh.UserInfo)
+		userinfo.POST("/userinfo", oh.UserInfo)
 	}
 
-	protected := throttled.Group("/")
-	protected.Use(middleware.AuthMiddleware(params.SecretService))
+	// limited after authentication to identify users
+	protected := traced.Group("/")
+	protected.Use(middleware.AuthMiddleware(params.SecretService), middleware.RejectDelegated(), rateLimit)
 	{
 		protected.GET("/logs", uh.Logs)
 		protected.POST("/logout", uh.Logout)
 		protected.POST("/password", uh.UpdatePassword)
+		protected.GET("/sessions", uh.Sessions)
+		protected.DELETE("/sessions/:id", uh.RevokeSession)
+
+		protected.POST("/mfa/totp/enroll", uh.EnrollTOTP)
+		protected.POST("/mfa/totp/confirm", uh.ConfirmTOTP)
+		protected.POST("/mfa/totp/disable", uh.DisableTOTP)
+
+		protected.POST("/webauthn/register/begin", wh.BeginRegistration)
+		protected.POST("/webauthn/register/finish", wh.FinishRegistration)
+		protected.GET("/webauthn/credentials", wh.Credentials)
+		protected.DELETE("/webauthn/credentials/:id", wh.DeleteCredential)
+
+		protected.POST("/oauth/:provider/link", ah.Link)
+		protected.GET("/identities", ah.Identities)
+		protected.DELETE("/identities/:provider/:subject", ah.Unlink)
+	}
+
+	admin := protected.Group("/admin")
+	admin.Use(middleware.RequireRole(domain.AdminRole))
+	{
+		admin.GET("/users", adh.ListUsers)
+		admin.GET("/users/:id", adh.GetUser)
+		admin.PUT("/users/:id/role", adh.SetRole)
+		admin.PUT("/users/:id/status", adh.SetStatus)
+		admin.POST("/users/:id/disable", adh.DisableUser)
+		admin.POST("/users/:id/enable", adh.EnableUser)
+		admin.POST("/users/:id/password-reset", adh.ForcePasswordReset)
+		admin.DELETE("/users/:id/sessions", adh.RevokeSessions)
+
+		admin.POST("/clients", zh.RegisterClient)
+		admin.GET("/clients", zh.Clients)
+		admin.DELETE("/clients/:id", zh.DeleteClient)
 	}
 	return r
 }
@@ -0,0 +1,82 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/pkg/ratelimit"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// failingLimiter is a limiter whose backend is down
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(context.Context, string, ratelimit.Limit) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("connection refused")
+}
+
+func TestClientIP(t *testing.T) {
+	newRouter := func(t *testing.T, trustedProxies []string) http.Handler {
+		limiter := ratelimit.NewMemoryLimiter()
+		t.Cleanup(func() { _ = limiter.Close() })
+		return newTestRouter(limiter, trustedProxies)
+	}
+
+	t.Run("forwarded address of untrusted peer is ignored", func(t *testing.T) {
+		h := newRouter(t, nil)
+
+		require.Equal(t, http.StatusOK, request(h, "203.0.113.7", "198.51.100.1"))
+		require.Equal(t, http.StatusTooManyRequests, request(h, "203.0.113.7", "198.51.100.2"))
+	})
+
+	t.Run("forwarded address of trusted proxy identifies the client", func(t *testing.T) {
+		h := newRouter(t, []string{"172.28.0.10"})
+
+		// proxy appends the address of its peer to the header sent by the client
+		require.Equal(t, http.StatusOK, request(h, "172.28.0.10", "198.51.100.1, 203.0.113.7"))
+		require.Equal(t, http.StatusTooManyRequests, request(h, "172.28.0.10", "198.51.100.2, 203.0.113.7"))
+		require.Equal(t, http.StatusOK, request(h, "172.28.0.10", "203.0.113.8"))
+	})
+}
+
+func TestRateLimiterFailure(t *testing.T) {
+	// limiter outage must not take login down, requests are let through
+	h := newTestRouter(failingLimiter{}, nil)
+
+	require.Equal(t, http.StatusOK, request(h, "203.0.113.7", ""))
+	require.Equal(t, http.StatusOK, request(h, "203.0.113.7", ""))
+}
+
+// newTestRouter returns router allowing one request per client IP
+func newTestRouter(limiter ratelimit.Limiter, trustedProxies []string) http.Handler {
+	return NewRouter(&RouterParams{
+		Limiter: limiter,
+		Issuer:  "http://localhost",
+		Tracer:  noop.NewTracerProvider().Tracer("test"),
+		Config: &configs.AppConfig{
+			PublicURL:      "http://localhost",
+			TrustedProxies: trustedProxies,
+			Limiter: configs.LimiterConfig{
+				Default: configs.RateLimitPolicy{Requests: 1, Period: time.Hour, Key: "ip"},
+			},
+		},
+	})
+}
+
+// request sends discovery request from the peer
+func request(h http.Handler, peer, forwardedFor string) int {
+	req := httptest.NewRequest(http.MethodGet, "/.well-known/openid-configuration", nil)
+	req.RemoteAddr = peer + ":40000"
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w.Code
+}
@@ -0,0 +1,346 @@
+// Package vault authenticates the service in Vault and keeps its token alive
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"go.uber.org/zap"
+)
+
+const (
+	AuthToken      = "token"
+	AuthTokenFile  = "token_file"
+	AuthAppRole    = "approle"
+	AuthKubernetes = "kubernetes"
+)
+
+const defaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// renewFraction is a part of the lease after which the token is renewed
+const renewFraction = 2.0 / 3.0
+
+const (
+	minRetryDelay = time.Second
+	maxRetryDelay = time.Minute
+)
+
+// TokenSource provides the current Vault token
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// lease is a token with its lifetime, zero TTL means the token never expires
+type lease struct {
+	token     string
+	ttl       time.Duration
+	renewable bool
+}
+
+type loginFunc func(ctx context.Context) (lease, error)
+
+// Auth logs in with the configured method and renews the token in background until closed.
+// Token which can't be renewed anymore (e.g. it reached max TTL) is replaced by a new login
+type Auth struct {
+	baseURL string
+	client  *http.Client
+	log     *zap.SugaredLogger
+	login   loginFunc
+	// static token is neither renewed nor replaced
+	static bool
+
+	mu        sync.RWMutex
+	lease     lease
+	expiresAt time.Time
+	renewAt   time.Time
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+func NewAuth(cfg *configs.VaultConfig, client *http.Client, log *zap.SugaredLogger) (*Auth, error) {
+	a := &Auth{
+		baseURL: cfg.BaseURL,
+		client:  client,
+		log:     log,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	switch cfg.Auth {
+	case AuthToken, "":
+		a.static = true
+		a.login = func(context.Context) (lease, error) {
+			return lease{token: cfg.TOKEN}, nil
+		}
+	case AuthTokenFile:
+		a.login = a.tokenFileLogin(cfg.TokenFile)
+	case AuthAppRole:
+		a.login = a.appRoleLogin(cfg.AppRole)
+	case AuthKubernetes:
+		a.login = a.kubernetesLogin(cfg.Kubernetes)
+	default:
+		return nil, fmt.Errorf("unknown vault auth method: %s", cfg.Auth)
+	}
+	return a, nil
+}
+
+// Token returns the current token, logging in if there is no valid one
+func (a *Auth) Token(ctx context.Context) (string, error) {
+	a.mu.RLock()
+	l, expiresAt := a.lease, a.expiresAt
+	a.mu.RUnlock()
+
+	if l.token != "" && (l.ttl == 0 || time.Now().Before(expiresAt)) {
+		return l.token, nil
+	}
+	if err := a.relogin(ctx); err != nil {
+		return "", err
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.lease.token, nil
+}
+
+// Start logs in and starts background renewal, login errors are retried by renewal loop
+func (a *Auth) Start(ctx context.Context) {
+	if err := a.relogin(ctx); err != nil {
+		a.log.Errorw("failed to log in to vault", "error", err)
+	}
+	if a.static {
+		close(a.done)
+		return
+	}
+	go a.run()
+}
+
+func (a *Auth) Close() error {
+	a.once.Do(func() {
+		close(a.stop)
+	})
+	<-a.done
+	return nil
+}
+
+func (a *Auth) run() {
+	defer close(a.done)
+
+	retryDelay := minRetryDelay
+	for {
+		a.mu.RLock()
+		l, renewAt := a.lease, a.renewAt
+		a.mu.RUnlock()
+
+		// non-expiring token waits for stop only
+		var wait <-chan time.Time
+		switch {
+		case l.token == "":
+			wait = time.After(retryDelay)
+		case l.ttl > 0:
+			wait = time.After(time.Until(renewAt))
+		}
+
+		select {
+		case <-a.stop:
+			return
+		case <-wait:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := a.refresh(ctx, l)
+		cancel()
+		if err != nil {
+			a.log.Errorw("failed to refresh vault token", "error", err, "retry_in", retryDelay)
+			a.mu.Lock()
+			// expired token is dropped to retry login
+			if a.lease.ttl > 0 && time.Now().After(a.expiresAt) {
+				a.lease = lease{}
+			}
+			a.mu.Unlock()
+			select {
+			case <-a.stop:
+				return
+			case <-time.After(retryDelay):
+			}
+			retryDelay = min(retryDelay*2, maxRetryDelay)
+			continue
+		}
+		retryDelay = minRetryDelay
+	}
+}
+
+// refresh renews the token, or logs in again if the token can't be renewed to its full TTL
+func (a *Auth) refresh(ctx context.Context, l lease) error {
+	if l.token != "" && l.renewable {
+		renewed, err := a.renew(ctx, l.token)
+		if err == nil && renewed.ttl >= l.ttl {
+			a.setLease(renewed)
+			return nil
+		}
+		if err != nil {
+			a.log.Warnw("failed to renew vault token, logging in again", "error", err)
+		}
+		// renewed TTL is capped by max TTL, new login is needed before it expires
+	}
+	return a.relogin(ctx)
+}
+
+func (a *Auth) relogin(ctx context.Context) error {
+	l, err := a.login(ctx)
+	if err != nil {
+		return err
+	}
+	a.setLease(l)
+	return nil
+}
+
+func (a *Auth) setLease(l lease) {
+	now := time.Now()
+	a.mu.Lock()
+	a.lease = l
+	a.expiresAt = now.Add(l.ttl)
+	a.renewAt = now.Add(time.Duration(float64(l.ttl) * renewFraction))
+	a.mu.Unlock()
+}
+
+func (a *Auth) renew(ctx context.Context, token string) (lease, error) {
+	l, err := a.authRequest(ctx, "/v1/auth/token/renew-self", token, map[string]any{})
+	if err != nil {
+		return lease{}, err
+	}
+	// renewal doesn't return the token itself
+	l.token = token
+	return l, nil
+}
+
+func (a *Auth) appRoleLogin(cfg configs.VaultAppRoleConfig) loginFunc {
+	mount := cfg.Mount
+	if mount == "" {
+		mount = "approle"
+	}
+	return func(ctx context.Context) (lease, error) {
+		secretID := cfg.SecretID
+		if cfg.SecretIDFile != "" {
+			b, err := os.ReadFile(cfg.SecretIDFile)
+			if err != nil {
+				return lease{}, fmt.Errorf("failed to read approle secret id: %w", err)
+			}
+			secretID = strings.TrimSpace(string(b))
+		}
+		return a.authRequest(ctx, "/v1/auth/"+mount+"/login", "", map[string]any{
+			"role_id":   cfg.RoleID,
+			"secret_id": secretID,
+		})
+	}
+}
+
+func (a *Auth) kubernetesLogin(cfg configs.VaultKubernetesConfig) loginFunc {
+	mount := cfg.Mount
+	if mount == "" {
+		mount = "kubernetes"
+	}
+	tokenPath := cfg.TokenPath
+	if tokenPath == "" {
+		tokenPath = defaultKubernetesTokenPath
+	}
+	return func(ctx context.Context) (lease, error) {
+		// projected service account tokens are rotated by kubelet, so the file is read on every login
+		jwt, err := os.ReadFile(tokenPath)
+		if err != nil {
+			return lease{}, fmt.Errorf("failed to read service account token: %w", err)
+		}
+		return a.authRequest(ctx, "/v1/auth/"+mount+"/login", "", map[string]any{
+			"role": cfg.Role,
+			"jwt":  strings.TrimSpace(string(jwt)),
+		})
+	}
+}
+
+// tokenFileLogin reads token written by other process, e.g. Vault Agent, and looks up its lease
+func (a *Auth) tokenFileLogin(path string) loginFunc {
+	return func(ctx context.Context) (lease, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return lease{}, fmt.Errorf("failed to read vault token file: %w", err)
+		}
+		token := strings.TrimSpace(string(b))
+
+		data, err := a.do(ctx, http.MethodGet, "/v1/auth/token/lookup-self", token, nil)
+		if err != nil {
+			return lease{}, err
+		}
+		d, ok := data["data"].(map[string]any)
+		if !ok {
+			return lease{}, fmt.Errorf("unpredictable response from vault")
+		}
+		ttl, _ := d["ttl"].(float64)
+		renewable, _ := d["renewable"].(bool)
+		// zero TTL of expiring token is a truncated last second, not a token without expiration
+		if ttl < 1 && d["expire_time"] != nil {
+			ttl = 1
+		}
+		return lease{token: token, ttl: time.Duration(ttl) * time.Second, renewable: renewable}, nil
+	}
+}
+
+// authRequest makes request to an endpoint returning auth block, like login and renewal
+func (a *Auth) authRequest(ctx context.Context, path, token string, payload map[string]any) (lease, error) {
+	data, err := a.do(ctx, http.MethodPost, path, token, payload)
+	if err != nil {
+		return lease{}, err
+	}
+	auth, ok := data["auth"].(map[string]any)
+	if !ok {
+		return lease{}, fmt.Errorf("unpredictable response from vault")
+	}
+	clientToken, _ := auth["client_token"].(string)
+	ttl, _ := auth["lease_duration"].(float64)
+	renewable, _ := auth["renewable"].(bool)
+	return lease{token: clientToken, ttl: time.Duration(ttl) * time.Second, renewable: renewable}, nil
+}
+
+func (a *Auth) do(ctx context.Context, method, path, token string, payload map[string]any) (map[string]any, error) {
+	var body io.Reader
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, a.baseURL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("bad request to vault %s: %s", path, string(data))
+	}
+	var data map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, fmt.Errorf("failed to decode response from vault: %w", err)
+	}
+	return data, nil
+}
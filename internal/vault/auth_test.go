@@ -0,0 +1,148 @@
+package vault_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/maisiq/go-auth-service/internal/configs"
+	"github.com/maisiq/go-auth-service/internal/repository"
+	"github.com/maisiq/go-auth-service/internal/vault"
+	"github.com/maisiq/go-auth-service/internal/vault/vaulttest"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func startAuth(t *testing.T, cfg *configs.VaultConfig) *vault.Auth {
+	auth, err := vault.NewAuth(cfg, http.DefaultClient, zap.NewNop().Sugar())
+	require.NoError(t, err)
+	auth.Start(context.Background())
+	t.Cleanup(func() { _ = auth.Close() })
+	return auth
+}
+
+func TestAppRoleAuth(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("token is renewed in background", func(t *testing.T) {
+		server := vaulttest.NewServer(t)
+		server.TTL = time.Second
+		server.CreateKey(t, "jwt-key", "ecdsa-p256")
+
+		auth := startAuth(t, &configs.VaultConfig{
+			BaseURL: server.URL,
+			Auth:    vault.AuthAppRole,
+			AppRole: configs.VaultAppRoleConfig{RoleID: server.RoleID, SecretID: server.SecretID},
+		})
+		repo := repository.NewVaultSecretRepository(&configs.VaultConfig{BaseURL: server.URL}, http.DefaultClient, auth)
+
+		// token would have expired without renewal
+		require.Eventually(t, func() bool { return server.Renewals() >= 3 }, 5*time.Second, 50*time.Millisecond)
+		require.Equal(t, 1, server.Logins())
+
+		kid, err := repo.GetKID(ctx, "jwt-key")
+		require.NoError(t, err)
+		require.Equal(t, "1", kid)
+	})
+
+	t.Run("token is replaced when it reaches max ttl", func(t *testing.T) {
+		server := vaulttest.NewServer(t)
+		server.TTL = time.Second
+		server.MaxTTL = 2 * time.Second
+
+		auth := startAuth(t, &configs.VaultConfig{
+			BaseURL: server.URL,
+			Auth:    vault.AuthAppRole,
+			AppRole: configs.VaultAppRoleConfig{RoleID: server.RoleID, SecretID: server.SecretID},
+		})
+		first, err := auth.Token(ctx)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool { return server.Logins() >= 2 }, 5*time.Second, 50*time.Millisecond)
+
+		token, err := auth.Token(ctx)
+		require.NoError(t, err)
+		require.NotEqual(t, first, token)
+	})
+
+	t.Run("secret id is read from file", func(t *testing.T) {
+		server := vaulttest.NewServer(t)
+		path := filepath.Join(t.TempDir(), "secret-id")
+		require.NoError(t, os.WriteFile(path, []byte(server.SecretID+"\n"), 0o600))
+
+		auth := startAuth(t, &configs.VaultConfig{
+			BaseURL: server.URL,
+			Auth:    vault.AuthAppRole,
+			AppRole: configs.VaultAppRoleConfig{RoleID: server.RoleID, SecretIDFile: path},
+		})
+		_, err := auth.Token(ctx)
+		require.NoError(t, err)
+	})
+}
+
+func TestKubernetesAuth(t *testing.T) {
+	ctx := context.Background()
+	server := vaulttest.NewServer(t)
+	path := filepath.Join(t.TempDir(), "token")
+
+	auth := startAuth(t, &configs.VaultConfig{
+		BaseURL:    server.URL,
+		Auth:       vault.AuthKubernetes,
+		Kubernetes: configs.VaultKubernetesConfig{Role: server.KubernetesRole, TokenPath: path},
+	})
+	_, err := auth.Token(ctx)
+	require.Error(t, err)
+
+	// service account token is mounted after the start
+	require.NoError(t, os.WriteFile(path, []byte(server.KubernetesJWT), 0o600))
+	_, err = auth.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, server.Logins())
+}
+
+func TestTokenFileAuth(t *testing.T) {
+	ctx := context.Background()
+	server := vaulttest.NewServer(t)
+	server.TTL = time.Second
+	path := filepath.Join(t.TempDir(), "token")
+	issued := server.IssueToken()
+	require.NoError(t, os.WriteFile(path, []byte(issued), 0o600))
+
+	auth := startAuth(t, &configs.VaultConfig{
+		BaseURL:   server.URL,
+		Auth:      vault.AuthTokenFile,
+		TokenFile: path,
+	})
+	token, err := auth.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, issued, token)
+
+	require.Eventually(t, func() bool { return server.Renewals() >= 2 }, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestAuthClose(t *testing.T) {
+	server := vaulttest.NewServer(t)
+	server.TTL = time.Second
+
+	auth, err := vault.NewAuth(&configs.VaultConfig{
+		BaseURL: server.URL,
+		Auth:    vault.AuthAppRole,
+		AppRole: configs.VaultAppRoleConfig{RoleID: server.RoleID, SecretID: server.SecretID},
+	}, http.DefaultClient, zap.NewNop().Sugar())
+	require.NoError(t, err)
+	auth.Start(context.Background())
+
+	require.NoError(t, auth.Close())
+	renewals := server.Renewals()
+	time.Sleep(1500 * time.Millisecond)
+	require.Equal(t, renewals, server.Renewals())
+	require.Equal(t, 1, server.Logins())
+}
+
+func TestUnknownAuthMethod(t *testing.T) {
+	_, err := vault.NewAuth(&configs.VaultConfig{Auth: "userpass"}, http.DefaultClient, zap.NewNop().Sugar())
+	require.Error(t, err)
+}
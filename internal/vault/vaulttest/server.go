@@ -0,0 +1,391 @@
+// Package vaulttest provides a fake Vault server implementing the auth and transit endpoints used by the service
+package vaulttest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Server is a fake Vault. Tokens issued by logins live TTL and can be renewed up to MaxTTL
+type Server struct {
+	*httptest.Server
+
+	TTL    time.Duration
+	MaxTTL time.Duration
+
+	RoleID         string
+	SecretID       string
+	KubernetesRole string
+	KubernetesJWT  string
+
+	mu       sync.Mutex
+	tokens   map[string]*token
+	keys     map[string]*key
+	logins   int
+	renewals int
+}
+
+type token struct {
+	issuedAt  time.Time
+	expiresAt time.Time
+}
+
+type key struct {
+	keyType  string
+	versions []crypto.Signer
+	created  []time.Time
+	// minVersion is min_decryption_version, versions below it can't be used
+	minVersion int
+}
+
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		TTL:            time.Hour,
+		MaxTTL:         24 * time.Hour,
+		RoleID:         "role-id",
+		SecretID:       "secret-id",
+		KubernetesRole: "auth-service",
+		KubernetesJWT:  "service-account-jwt",
+		tokens:         make(map[string]*token),
+		keys:           make(map[string]*key),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/auth/approle/login", s.appRoleLogin)
+	mux.HandleFunc("POST /v1/auth/kubernetes/login", s.kubernetesLogin)
+	mux.HandleFunc("POST /v1/auth/token/renew-self", s.authorized(s.renewSelf))
+	mux.HandleFunc("GET /v1/auth/token/lookup-self", s.authorized(s.lookupSelf))
+	mux.HandleFunc("GET /v1/transit/keys/{name}", s.authorized(s.readKey))
+	mux.HandleFunc("POST /v1/transit/keys/{name}/rotate", s.authorized(s.rotateKey))
+	mux.HandleFunc("POST /v1/transit/keys/{name}/config", s.authorized(s.configKey))
+	mux.HandleFunc("POST /v1/transit/sign/{name}", s.authorized(s.sign))
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// IssueToken creates a token as if it was issued by other process, e.g. Vault Agent
+func (s *Server) IssueToken() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.issue()
+}
+
+func (s *Server) Logins() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.logins
+}
+
+func (s *Server) Renewals() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.renewals
+}
+
+// CreateKey adds transit key of vault type: ecdsa-p256, rsa-2048 or ed25519
+func (s *Server) CreateKey(t testing.TB, name, keyType string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k := &key{keyType: keyType, minVersion: 1}
+	k.versions = append(k.versions, generate(t, keyType))
+	k.created = append(k.created, time.Now())
+	s.keys[name] = k
+}
+
+func generate(t testing.TB, keyType string) crypto.Signer {
+	var signer crypto.Signer
+	var err error
+	switch keyType {
+	case "ecdsa-p256":
+		signer, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "rsa-2048":
+		signer, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "ed25519":
+		_, signer, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		t.Fatalf("unsupported key type %s", keyType)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+func (s *Server) issue() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	id := "hvs." + base64.RawURLEncoding.EncodeToString(b)
+	now := time.Now()
+	s.tokens[id] = &token{issuedAt: now, expiresAt: now.Add(s.TTL)}
+	return id
+}
+
+func (s *Server) appRoleLogin(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		RoleID   string `json:"role_id"`
+		SecretID string `json:"secret_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.RoleID != s.RoleID || body.SecretID != s.SecretID {
+		writeError(w, http.StatusBadRequest, "invalid role or secret ID")
+		return
+	}
+	s.login(w)
+}
+
+func (s *Server) kubernetesLogin(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Role string `json:"role"`
+		JWT  string `json:"jwt"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Role != s.KubernetesRole || body.JWT != s.KubernetesJWT {
+		writeError(w, http.StatusForbidden, "permission denied")
+		return
+	}
+	s.login(w)
+}
+
+func (s *Server) login(w http.ResponseWriter) {
+	s.mu.Lock()
+	s.logins++
+	id := s.issue()
+	s.mu.Unlock()
+
+	writeJSON(w, map[string]any{
+		"auth": map[string]any{
+			"client_token":   id,
+			"lease_duration": int(s.TTL.Seconds()),
+			"renewable":      true,
+		},
+	})
+}
+
+func (s *Server) renewSelf(w http.ResponseWriter, _ *http.Request, id string) {
+	s.mu.Lock()
+	s.renewals++
+	tok := s.tokens[id]
+	now := time.Now()
+	tok.expiresAt = now.Add(s.TTL)
+	if limit := tok.issuedAt.Add(s.MaxTTL); limit.Before(tok.expiresAt) {
+		tok.expiresAt = limit
+	}
+	ttl := tok.expiresAt.Sub(now)
+	s.mu.Unlock()
+
+	writeJSON(w, map[string]any{
+		"auth": map[string]any{
+			"client_token":   id,
+			"lease_duration": int(ttl.Seconds()),
+			"renewable":      true,
+		},
+	})
+}
+
+func (s *Server) lookupSelf(w http.ResponseWriter, _ *http.Request, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ttl := time.Until(s.tokens[id].expiresAt)
+
+	writeJSON(w, map[string]any{
+		"data": map[string]any{
+			"ttl":         int(ttl.Seconds()),
+			"expire_time": s.tokens[id].expiresAt.Format(time.RFC3339Nano),
+			"renewable":   true,
+		},
+	})
+}
+
+func (s *Server) readKey(w http.ResponseWriter, r *http.Request, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.keys[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "key not found")
+		return
+	}
+	versions := make(map[string]any, len(k.versions))
+	for i, signer := range k.versions {
+		if i+1 < k.minVersion {
+			continue
+		}
+		var public string
+		if pub, ok := signer.Public().(ed25519.PublicKey); ok {
+			public = base64.StdEncoding.EncodeToString(pub)
+		} else {
+			der, _ := x509.MarshalPKIXPublicKey(signer.Public())
+			public = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+		}
+		versions[strconv.Itoa(i+1)] = map[string]any{
+			"public_key":    public,
+			"creation_time": k.created[i].Format(time.RFC3339Nano),
+		}
+	}
+	writeJSON(w, map[string]any{
+		"data": map[string]any{
+			"type":                   k.keyType,
+			"latest_version":         len(k.versions),
+			"min_decryption_version": k.minVersion,
+			"keys":                   versions,
+		},
+	})
+}
+
+func (s *Server) rotateKey(w http.ResponseWriter, r *http.Request, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.keys[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "key not found")
+		return
+	}
+	var signer crypto.Signer
+	switch k.versions[0].(type) {
+	case *ecdsa.PrivateKey:
+		signer, _ = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case *rsa.PrivateKey:
+		signer, _ = rsa.GenerateKey(rand.Reader, 2048)
+	case ed25519.PrivateKey:
+		_, signer, _ = ed25519.GenerateKey(rand.Reader)
+	}
+	k.versions = append(k.versions, signer)
+	k.created = append(k.created, time.Now())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) configKey(w http.ResponseWriter, r *http.Request, _ string) {
+	var body struct {
+		MinDecryptionVersion int `json:"min_decryption_version"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, ok := s.keys[r.PathValue("name")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "key not found")
+		return
+	}
+	if body.MinDecryptionVersion > len(k.versions) {
+		writeError(w, http.StatusBadRequest, "cannot set min decryption version above latest version")
+		return
+	}
+	k.minVersion = max(body.MinDecryptionVersion, 1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) sign(w http.ResponseWriter, r *http.Request, _ string) {
+	var body struct {
+		Input              string `json:"input"`
+		HashAlgorithm      string `json:"hash_algorithm"`
+		SignatureAlgorithm string `json:"signature_algorithm"`
+		KeyVersion         int    `json:"key_version"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	input, err := base64.StdEncoding.DecodeString(body.Input)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	k, ok := s.keys[r.PathValue("name")]
+	if !ok {
+		s.mu.Unlock()
+		writeError(w, http.StatusNotFound, "key not found")
+		return
+	}
+	version := len(k.versions)
+	if body.KeyVersion != 0 {
+		version = body.KeyVersion
+	}
+	if version < k.minVersion || version > len(k.versions) {
+		s.mu.Unlock()
+		writeError(w, http.StatusBadRequest, "requested version for signing is not available")
+		return
+	}
+	signer := k.versions[version-1]
+	s.mu.Unlock()
+
+	var sig []byte
+	digest := sha256.Sum256(input)
+	switch signer := signer.(type) {
+	case *ecdsa.PrivateKey:
+		// vault returns ASN.1 DER encoded ECDSA signatures
+		sig, err = ecdsa.SignASN1(rand.Reader, signer, digest[:])
+	case *rsa.PrivateKey:
+		if body.SignatureAlgorithm != "pkcs1v15" {
+			// vault default, it's not supported by JWS RS256
+			sig, err = rsa.SignPSS(rand.Reader, signer, crypto.SHA256, digest[:], nil)
+		} else {
+			sig, err = rsa.SignPKCS1v15(rand.Reader, signer, crypto.SHA256, digest[:])
+		}
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(signer, input)
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeJSON(w, map[string]any{
+		"data": map[string]any{
+			"signature":   fmt.Sprintf("vault:v%d:%s", version, base64.StdEncoding.EncodeToString(sig)),
+			"key_version": version,
+		},
+	})
+}
+
+// authorized rejects requests without a live token
+func (s *Server) authorized(next func(http.ResponseWriter, *http.Request, string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Vault-Token")
+
+		s.mu.Lock()
+		tok, ok := s.tokens[id]
+		live := ok && time.Now().Before(tok.expiresAt)
+		s.mu.Unlock()
+
+		if !live {
+			writeError(w, http.StatusForbidden, "permission denied")
+			return
+		}
+		next(w, r, id)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{msg}})
+}
@@ -0,0 +1,71 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"golang.org/x/time/rate"
+)
+
+var _ Limiter = (*MemoryLimiter)(nil)
+
+// MemoryLimiter is a token bucket limiter of a single replica
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	buckets *cache.Cache
+}
+
+func NewMemoryLimiter() *MemoryLimiter {
+	return &MemoryLimiter{
+		buckets: cache.New(cache.NoExpiration, 10*time.Minute),
+	}
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string, limit Limit) (Result, error) {
+	now := time.Now()
+	every := rate.Every(limit.Period / time.Duration(limit.Requests))
+	burst := limit.burst()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var bucket *rate.Limiter
+	if x, ok := l.buckets.Get(key); ok {
+		bucket = x.(*rate.Limiter)
+	} else {
+		bucket = rate.NewLimiter(every, burst)
+	}
+	allowed := bucket.AllowN(now, 1)
+	tokens := bucket.TokensAt(now)
+
+	// idle bucket is full again after refill time, so it's the same as a new one
+	refill := tokensDuration(float64(burst)-tokens, every)
+	l.buckets.Set(key, bucket, max(refill, time.Second))
+
+	res := Result{
+		Allowed:    allowed,
+		Limit:      burst,
+		Remaining:  max(int(math.Floor(tokens)), 0),
+		ResetAfter: refill,
+	}
+	if !allowed {
+		res.RetryAfter = tokensDuration(1-tokens, every)
+	}
+	return res, nil
+}
+
+// tokensDuration is time to restore n tokens
+func tokensDuration(n float64, r rate.Limit) time.Duration {
+	if n <= 0 {
+		return 0
+	}
+	return time.Duration(n / float64(r) * float64(time.Second))
+}
+
+func (l *MemoryLimiter) Close() error {
+	l.buckets.Flush()
+	return nil
+}
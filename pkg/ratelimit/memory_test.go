@@ -0,0 +1,39 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maisiq/go-auth-service/pkg/ratelimit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter(t *testing.T) {
+	l := ratelimit.NewMemoryLimiter()
+	defer l.Close()
+
+	ctx := context.Background()
+	limit := ratelimit.Limit{Requests: 2, Period: time.Hour}
+
+	for remaining := 1; remaining >= 0; remaining-- {
+		res, err := l.Allow(ctx, "ip:127.0.0.1", limit)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+		require.Equal(t, 2, res.Limit)
+		require.Equal(t, remaining, res.Remaining)
+		require.Zero(t, res.RetryAfter)
+	}
+
+	res, err := l.Allow(ctx, "ip:127.0.0.1", limit)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+	require.InDelta(t, 30*time.Minute, res.RetryAfter, float64(time.Second))
+	require.InDelta(t, time.Hour, res.ResetAfter, float64(time.Second))
+
+	// keys don't share quota
+	res, err = l.Allow(ctx, "ip:127.0.0.2", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+}
@@ -0,0 +1,38 @@
+// Package ratelimit provides request limiters shared by the service replicas
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit allows Requests per Period.
+// Burst is a capacity of the token bucket, it defaults to Requests and is ignored by sliding window limiters
+type Limit struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+func (l Limit) burst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.Requests
+}
+
+// Result describes limiter decision in terms of RateLimit-* headers
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAfter is time until the quota is fully restored
+	ResetAfter time.Duration
+	// RetryAfter is time until the next request is allowed, zero if the request is allowed
+	RetryAfter time.Duration
+}
+
+type Limiter interface {
+	// Allow takes one request from the quota of the key
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
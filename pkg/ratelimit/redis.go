@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ Limiter = (*RedisLimiter)(nil)
+
+// slidingWindow keeps timestamps of allowed requests of the window in a sorted set.
+// Redis server time is used, so replicas with skewed clocks share the same window
+var slidingWindow = redis.NewScript(`
+local key = KEYS[1]
+local window = tonumber(ARGV[1])
+local limit = tonumber(ARGV[2])
+
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[3])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', key, math.ceil(window / 1000))
+
+local reset = 0
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+return {allowed, limit - count, reset}
+`)
+
+// RedisLimiter is a sliding window limiter shared by all replicas
+type RedisLimiter struct {
+	client redis.Scripter
+	prefix string
+}
+
+func NewRedisLimiter(client redis.Scripter, prefix string) *RedisLimiter {
+	return &RedisLimiter{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (l *RedisLimiter) Allow(ctx context.Context, key string, limit Limit) (Result, error) {
+	res, err := slidingWindow.Run(ctx, l.client,
+		[]string{l.prefix + key},
+		limit.Period.Microseconds(), limit.Requests, uuid.NewString(),
+	).Int64Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to run sliding window script: %w", err)
+	}
+	if len(res) != 3 {
+		return Result{}, fmt.Errorf("unexpected sliding window result: %v", res)
+	}
+
+	resetAfter := time.Duration(res[2]) * time.Microsecond
+	r := Result{
+		Allowed:    res[0] == 1,
+		Limit:      limit.Requests,
+		Remaining:  int(res[1]),
+		ResetAfter: resetAfter,
+	}
+	if !r.Allowed {
+		// the oldest request leaves the window first
+		r.RetryAfter = resetAfter
+	}
+	return r, nil
+}
@@ -0,0 +1,92 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maisiq/go-auth-service/pkg/ratelimit"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeScripter runs sliding window script of RedisLimiter against in-memory sorted sets
+type fakeScripter struct {
+	redis.Scripter
+	now  time.Time
+	sets map[string][]int64
+	err  error
+}
+
+func (s *fakeScripter) EvalSha(ctx context.Context, _ string, keys []string, args ...interface{}) *redis.Cmd {
+	return s.Eval(ctx, "", keys, args...)
+}
+
+func (s *fakeScripter) Eval(_ context.Context, _ string, keys []string, args ...interface{}) *redis.Cmd {
+	if s.err != nil {
+		return redis.NewCmdResult(nil, s.err)
+	}
+	key := keys[0]
+	window, limit := args[0].(int64), int64(args[1].(int))
+	now := s.now.UnixMicro()
+
+	var set []int64
+	for _, ts := range s.sets[key] {
+		if ts > now-window {
+			set = append(set, ts)
+		}
+	}
+	allowed := int64(0)
+	if int64(len(set)) < limit {
+		set = append(set, now)
+		allowed = 1
+	}
+	s.sets[key] = set
+	return redis.NewCmdResult([]interface{}{allowed, limit - int64(len(set)), set[0] + window - now}, nil)
+}
+
+func TestRedisLimiter(t *testing.T) {
+	ctx := context.Background()
+	limit := ratelimit.Limit{Requests: 2, Period: time.Hour}
+
+	t.Run("requests over the limit are denied until the oldest leaves the window", func(t *testing.T) {
+		s := &fakeScripter{now: time.Now(), sets: make(map[string][]int64)}
+		l := ratelimit.NewRedisLimiter(s, "ratelimit:")
+
+		for remaining := 1; remaining >= 0; remaining-- {
+			res, err := l.Allow(ctx, "ip:127.0.0.1", limit)
+			require.NoError(t, err)
+			require.True(t, res.Allowed)
+			require.Equal(t, 2, res.Limit)
+			require.Equal(t, remaining, res.Remaining)
+			require.Zero(t, res.RetryAfter)
+			s.now = s.now.Add(10 * time.Minute)
+		}
+		require.Contains(t, s.sets, "ratelimit:ip:127.0.0.1")
+
+		res, err := l.Allow(ctx, "ip:127.0.0.1", limit)
+		require.NoError(t, err)
+		require.False(t, res.Allowed)
+		require.Equal(t, 0, res.Remaining)
+		require.Equal(t, 40*time.Minute, res.RetryAfter)
+
+		// keys don't share quota
+		res, err = l.Allow(ctx, "ip:127.0.0.2", limit)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+
+		s.now = s.now.Add(40 * time.Minute)
+		res, err = l.Allow(ctx, "ip:127.0.0.1", limit)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+	})
+
+	t.Run("redis error is returned", func(t *testing.T) {
+		s := &fakeScripter{err: errors.New("connection refused")}
+		l := ratelimit.NewRedisLimiter(s, "ratelimit:")
+
+		_, err := l.Allow(ctx, "ip:127.0.0.1", limit)
+		require.ErrorIs(t, err, s.err)
+	})
+}
@@ -0,0 +1,56 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maisiq/go-auth-service/pkg/totp"
+	"github.com/stretchr/testify/require"
+)
+
+// test vectors from RFC 6238 appendix B, truncated to 6 digits
+func TestCode(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	cases := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+	for ts, expected := range cases {
+		code, err := totp.Code(secret, time.Unix(ts, 0))
+		require.NoError(t, err)
+		require.Equal(t, expected, code)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	prev, err := totp.Code(secret, now.Add(-totp.Period))
+	require.NoError(t, err)
+
+	step, ok := totp.Validate(secret, prev, now)
+	require.True(t, ok)
+	require.Equal(t, totp.Step(now)-1, step)
+
+	old, err := totp.Code(secret, now.Add(-5*totp.Period))
+	require.NoError(t, err)
+	_, ok = totp.Validate(secret, old, now)
+	require.False(t, ok)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := totp.ProvisioningURI("auth service", "user@example.com", "SECRET")
+
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/auth%20service:user@example.com?"))
+	require.Contains(t, uri, "secret=SECRET")
+	require.Contains(t, uri, "issuer=auth+service")
+}
@@ -0,0 +1,77 @@
+package webauthn_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maisiq/go-auth-service/pkg/webauthn"
+	"github.com/stretchr/testify/require"
+)
+
+// ceremony is a registration and a following login in PublicKeyCredential.toJSON() format, as sent by browsers.
+// Responses carry members the verifier doesn't use, e.g. clientExtensionResults and crossOrigin
+type ceremony struct {
+	Description  string `json:"description"`
+	RPID         string `json:"rpId"`
+	Origin       string `json:"origin"`
+	Registration struct {
+		Challenge string                        `json:"challenge"`
+		Response  webauthn.RegistrationResponse `json:"response"`
+	} `json:"registration"`
+	Assertion struct {
+		Challenge    string                     `json:"challenge"`
+		Response     webauthn.AssertionResponse `json:"response"`
+		SignCount    uint32                     `json:"signCount"`
+		UserVerified bool                       `json:"userVerified"`
+	} `json:"assertion"`
+}
+
+func loadCeremonies(t testing.TB) map[string]ceremony {
+	files, err := filepath.Glob(filepath.Join("testdata", "ceremonies", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	ceremonies := make(map[string]ceremony, len(files))
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		require.NoError(t, err)
+		var c ceremony
+		require.NoError(t, json.Unmarshal(b, &c), file)
+		ceremonies[strings.TrimSuffix(filepath.Base(file), ".json")] = c
+	}
+	return ceremonies
+}
+
+func TestCeremonies(t *testing.T) {
+	for name, c := range loadCeremonies(t) {
+		t.Run(name, func(t *testing.T) {
+			cfg := webauthn.Config{RPID: c.RPID, Origins: []string{c.Origin}}
+
+			challenge, err := webauthn.Challenge(c.Registration.Response.Response.ClientDataJSON)
+			require.NoError(t, err)
+			require.Equal(t, c.Registration.Challenge, challenge)
+
+			cred, err := webauthn.VerifyRegistration(cfg, c.Registration.Challenge, c.Registration.Response)
+			require.NoError(t, err, c.Description)
+
+			count, uv, err := webauthn.VerifyAssertion(cfg, c.Assertion.Challenge, cred.PublicKey, cred.SignCount, c.Assertion.Response)
+			require.NoError(t, err, c.Description)
+			require.Equal(t, c.Assertion.SignCount, count)
+			require.Equal(t, c.Assertion.UserVerified, uv)
+
+			t.Run("replayed to other relying party", func(t *testing.T) {
+				other := webauthn.Config{RPID: "other.example", Origins: cfg.Origins}
+				_, _, err := webauthn.VerifyAssertion(other, c.Assertion.Challenge, cred.PublicKey, cred.SignCount, c.Assertion.Response)
+				require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+			})
+
+			t.Run("assertion of registration challenge", func(t *testing.T) {
+				_, _, err := webauthn.VerifyAssertion(cfg, c.Registration.Challenge, cred.PublicKey, cred.SignCount, c.Assertion.Response)
+				require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+			})
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+)
+
+// COSE algorithm identifiers, https://www.iana.org/assignments/cose/cose.xhtml#algorithms
+const (
+	AlgES256 int64 = -7
+	AlgEdDSA int64 = -8
+	AlgRS256 int64 = -257
+)
+
+// SupportedAlgorithms are offered to authenticators in order of preference
+var SupportedAlgorithms = []int64{AlgES256, AlgEdDSA, AlgRS256}
+
+const (
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+	coseKeyTypeRSA = 3
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+)
+
+// PublicKey is a credential public key able to verify assertion signatures
+type PublicKey interface {
+	Verify(data, sig []byte) error
+}
+
+// ParsePublicKey parses COSE_Key (RFC 9052) encoded in CBOR
+func ParsePublicKey(coseKey []byte) (PublicKey, error) {
+	v, _, err := decodeCBOR(coseKey)
+	if err != nil {
+		return nil, err
+	}
+	return publicKeyFromMap(v)
+}
+
+func publicKeyFromMap(v interface{}) (PublicKey, error) {
+	m, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("cose key is not a map")
+	}
+	kty, _ := m[int64(1)].(int64)
+	alg, _ := m[int64(3)].(int64)
+
+	switch kty {
+	case coseKeyTypeEC2:
+		crv, _ := m[int64(-1)].(int64)
+		x, _ := m[int64(-2)].([]byte)
+		y, _ := m[int64(-3)].([]byte)
+		if alg != AlgES256 || crv != coseCurveP256 || len(x) != 32 || len(y) != 32 {
+			return nil, fmt.Errorf("unsupported ec2 key: alg %d, crv %d", alg, crv)
+		}
+		pk := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !pk.Curve.IsOnCurve(pk.X, pk.Y) {
+			return nil, fmt.Errorf("ec2 point is not on curve")
+		}
+		return ecdsaKey{pk}, nil
+	case coseKeyTypeOKP:
+		crv, _ := m[int64(-1)].(int64)
+		x, _ := m[int64(-2)].([]byte)
+		if alg != AlgEdDSA || crv != coseCurveEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("unsupported okp key: alg %d, crv %d", alg, crv)
+		}
+		return ed25519Key(x), nil
+	case coseKeyTypeRSA:
+		n, _ := m[int64(-1)].([]byte)
+		e, _ := m[int64(-2)].([]byte)
+		if alg != AlgRS256 || len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("unsupported rsa key: alg %d", alg)
+		}
+		pk := &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+		if pk.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("rsa key is too short")
+		}
+		return rsaKey{pk}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %d", kty)
+}
+
+type ecdsaKey struct {
+	pk *ecdsa.PublicKey
+}
+
+func (k ecdsaKey) Verify(data, sig []byte) error {
+	digest := sha256.Sum256(data)
+	// WebAuthn ES256 signatures are ASN.1 DER encoded
+	if !ecdsa.VerifyASN1(k.pk, digest[:], sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+type ed25519Key ed25519.PublicKey
+
+func (k ed25519Key) Verify(data, sig []byte) error {
+	if !ed25519.Verify(ed25519.PublicKey(k), data, sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+type rsaKey struct {
+	pk *rsa.PublicKey
+}
+
+func (k rsaKey) Verify(data, sig []byte) error {
+	digest := sha256.Sum256(data)
+	if err := rsa.VerifyPKCS1v15(k.pk, crypto.SHA256, digest[:], sig); err != nil {
+		return ErrInvalidSignature
+	}
+	return nil
+}
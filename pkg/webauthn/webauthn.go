@@ -0,0 +1,368 @@
+// Package webauthn implements relying party side of WebAuthn ceremonies (https://www.w3.org/TR/webauthn-2/).
+//
+// Attestation statements aren't verified: options always ask for "none" conveyance
+// and the service doesn't rely on authenticator model.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidSignature = fmt.Errorf("webauthn: invalid signature")
+	ErrInvalidResponse  = fmt.Errorf("webauthn: invalid response")
+	// ErrSignCount means counter of the authenticator went backwards, the credential is probably cloned
+	ErrSignCount = fmt.Errorf("webauthn: sign count is not increasing")
+)
+
+const (
+	challengeSize = 32
+	Timeout       = 5 * time.Minute
+
+	flagUserPresent      = 0x01
+	flagUserVerified     = 0x04
+	flagAttestedCredData = 0x40
+)
+
+type Config struct {
+	// RPID is a domain of the relying party, e.g. example.com
+	RPID   string
+	RPName string
+	// Origins are allowed origins of the clients, e.g. https://login.example.com
+	Origins []string
+}
+
+// URLEncoding is base64url encoding without padding used for binary fields in JSON
+var URLEncoding = base64.RawURLEncoding
+
+// NewChallenge returns random base64url encoded challenge
+func NewChallenge() (string, error) {
+	b := make([]byte, challengeSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return URLEncoding.EncodeToString(b), nil
+}
+
+// options are serialized as expected by PublicKeyCredential.parseCreationOptionsFromJSON
+
+type RelyingParty struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type UserEntity struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type CredentialParameter struct {
+	Type string `json:"type"`
+	Alg  int64  `json:"alg"`
+}
+
+type CredentialDescriptor struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+type AuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+type CreationOptions struct {
+	Challenge              string                 `json:"challenge"`
+	RP                     RelyingParty           `json:"rp"`
+	User                   UserEntity             `json:"user"`
+	PubKeyCredParams       []CredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                  `json:"timeout"`
+	ExcludeCredentials     []CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection AuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                 `json:"attestation"`
+}
+
+type RequestOptions struct {
+	Challenge        string                 `json:"challenge"`
+	RPID             string                 `json:"rpId"`
+	Timeout          int64                  `json:"timeout"`
+	AllowCredentials []CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                 `json:"userVerification"`
+}
+
+// NewCreationOptions returns options for navigator.credentials.create().
+// Credentials from exclude can't be registered twice on the same authenticator
+func NewCreationOptions(cfg Config, challenge string, user UserEntity, exclude [][]byte) CreationOptions {
+	params := make([]CredentialParameter, 0, len(SupportedAlgorithms))
+	for _, alg := range SupportedAlgorithms {
+		params = append(params, CredentialParameter{Type: "public-key", Alg: alg})
+	}
+	return CreationOptions{
+		Challenge:          challenge,
+		RP:                 RelyingParty{ID: cfg.RPID, Name: cfg.RPName},
+		User:               user,
+		PubKeyCredParams:   params,
+		Timeout:            Timeout.Milliseconds(),
+		ExcludeCredentials: descriptors(exclude),
+		AuthenticatorSelection: AuthenticatorSelection{
+			ResidentKey:      "preferred",
+			UserVerification: "preferred",
+		},
+		Attestation: "none",
+	}
+}
+
+// NewRequestOptions returns options for navigator.credentials.get().
+// Empty allow list lets user choose any discoverable credential (passkey)
+func NewRequestOptions(cfg Config, challenge string, allow [][]byte, userVerification string) RequestOptions {
+	return RequestOptions{
+		Challenge:        challenge,
+		RPID:             cfg.RPID,
+		Timeout:          Timeout.Milliseconds(),
+		AllowCredentials: descriptors(allow),
+		UserVerification: userVerification,
+	}
+}
+
+func descriptors(ids [][]byte) []CredentialDescriptor {
+	d := make([]CredentialDescriptor, 0, len(ids))
+	for _, id := range ids {
+		d = append(d, CredentialDescriptor{Type: "public-key", ID: URLEncoding.EncodeToString(id)})
+	}
+	return d
+}
+
+// responses are expected in PublicKeyCredential.toJSON() format
+
+type AttestationResponse struct {
+	ClientDataJSON    string   `json:"clientDataJSON"`
+	AttestationObject string   `json:"attestationObject"`
+	Transports        []string `json:"transports,omitempty"`
+}
+
+type RegistrationResponse struct {
+	ID       string              `json:"id"`
+	RawID    string              `json:"rawId"`
+	Type     string              `json:"type"`
+	Response AttestationResponse `json:"response"`
+}
+
+type AssertionData struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"userHandle,omitempty"`
+}
+
+type AssertionResponse struct {
+	ID       string        `json:"id"`
+	RawID    string        `json:"rawId"`
+	Type     string        `json:"type"`
+	Response AssertionData `json:"response"`
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// Credential is a verified new credential
+type Credential struct {
+	ID        []byte
+	PublicKey []byte // COSE_Key
+	SignCount uint32
+	// UserVerified tells whether authenticator verified the user (PIN, biometrics)
+	UserVerified bool
+}
+
+// Challenge extracts the challenge from client data, so caller can find the ceremony it belongs to
+func Challenge(clientDataJSON string) (string, error) {
+	cd, _, err := parseClientData(clientDataJSON)
+	if err != nil {
+		return "", err
+	}
+	return cd.Challenge, nil
+}
+
+// VerifyRegistration checks the response of navigator.credentials.create() and returns new credential
+func VerifyRegistration(cfg Config, challenge string, resp RegistrationResponse) (*Credential, error) {
+	if resp.Type != "public-key" {
+		return nil, fmt.Errorf("%w: unexpected credential type", ErrInvalidResponse)
+	}
+	if _, _, err := verifyClientData(cfg, resp.Response.ClientDataJSON, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	rawAttestation, err := decodeBase64(resp.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: attestation object: %w", ErrInvalidResponse, err)
+	}
+	v, _, err := decodeCBOR(rawAttestation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: attestation object: %w", ErrInvalidResponse, err)
+	}
+	attestation, ok := v.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%w: attestation object is not a map", ErrInvalidResponse)
+	}
+	authData, ok := attestation["authData"].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("%w: no authenticator data", ErrInvalidResponse)
+	}
+
+	ad, err := parseAuthenticatorData(cfg, authData)
+	if err != nil {
+		return nil, err
+	}
+	if ad.flags&flagAttestedCredData == 0 {
+		return nil, fmt.Errorf("%w: no attested credential data", ErrInvalidResponse)
+	}
+	if _, err := ParsePublicKey(ad.publicKey); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	rawID, err := decodeBase64(resp.RawID)
+	if err != nil || !bytes.Equal(rawID, ad.credentialID) {
+		return nil, fmt.Errorf("%w: credential id mismatch", ErrInvalidResponse)
+	}
+
+	return &Credential{
+		ID:           ad.credentialID,
+		PublicKey:    ad.publicKey,
+		SignCount:    ad.signCount,
+		UserVerified: ad.flags&flagUserVerified != 0,
+	}, nil
+}
+
+// VerifyAssertion checks the response of navigator.credentials.get() against stored credential.
+// It returns a new sign count of the authenticator and whether user was verified
+func VerifyAssertion(cfg Config, challenge string, publicKey []byte, storedSignCount uint32, resp AssertionResponse) (uint32, bool, error) {
+	if resp.Type != "public-key" {
+		return 0, false, fmt.Errorf("%w: unexpected credential type", ErrInvalidResponse)
+	}
+	_, rawClientData, err := verifyClientData(cfg, resp.Response.ClientDataJSON, "webauthn.get", challenge)
+	if err != nil {
+		return 0, false, err
+	}
+
+	authData, err := decodeBase64(resp.Response.AuthenticatorData)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: authenticator data: %w", ErrInvalidResponse, err)
+	}
+	ad, err := parseAuthenticatorData(cfg, authData)
+	if err != nil {
+		return 0, false, err
+	}
+	sig, err := decodeBase64(resp.Response.Signature)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: signature: %w", ErrInvalidResponse, err)
+	}
+
+	pk, err := ParsePublicKey(publicKey)
+	if err != nil {
+		return 0, false, err
+	}
+	clientDataHash := sha256.Sum256(rawClientData)
+	if err := pk.Verify(append(authData, clientDataHash[:]...), sig); err != nil {
+		return 0, false, err
+	}
+
+	// authenticators without counter always send zero
+	if (ad.signCount != 0 || storedSignCount != 0) && ad.signCount <= storedSignCount {
+		return 0, false, ErrSignCount
+	}
+	return ad.signCount, ad.flags&flagUserVerified != 0, nil
+}
+
+func parseClientData(clientDataJSON string) (clientData, []byte, error) {
+	raw, err := decodeBase64(clientDataJSON)
+	if err != nil {
+		return clientData{}, nil, fmt.Errorf("%w: client data: %w", ErrInvalidResponse, err)
+	}
+	var cd clientData
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return clientData{}, nil, fmt.Errorf("%w: client data: %w", ErrInvalidResponse, err)
+	}
+	return cd, raw, nil
+}
+
+func verifyClientData(cfg Config, clientDataJSON, ceremony, challenge string) (clientData, []byte, error) {
+	cd, raw, err := parseClientData(clientDataJSON)
+	if err != nil {
+		return clientData{}, nil, err
+	}
+	if cd.Type != ceremony {
+		return clientData{}, nil, fmt.Errorf("%w: unexpected client data type %s", ErrInvalidResponse, cd.Type)
+	}
+	if subtle.ConstantTimeCompare([]byte(cd.Challenge), []byte(challenge)) != 1 {
+		return clientData{}, nil, fmt.Errorf("%w: challenge mismatch", ErrInvalidResponse)
+	}
+	if !slices.Contains(cfg.Origins, cd.Origin) {
+		return clientData{}, nil, fmt.Errorf("%w: origin %s is not allowed", ErrInvalidResponse, cd.Origin)
+	}
+	return cd, raw, nil
+}
+
+type authenticatorData struct {
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte
+}
+
+func parseAuthenticatorData(cfg Config, data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, fmt.Errorf("%w: authenticator data is too short", ErrInvalidResponse)
+	}
+	rpIDHash := sha256.Sum256([]byte(cfg.RPID))
+	if subtle.ConstantTimeCompare(data[:32], rpIDHash[:]) != 1 {
+		return nil, fmt.Errorf("%w: rp id hash mismatch", ErrInvalidResponse)
+	}
+
+	ad := &authenticatorData{
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	if ad.flags&flagUserPresent == 0 {
+		return nil, fmt.Errorf("%w: user is not present", ErrInvalidResponse)
+	}
+
+	if ad.flags&flagAttestedCredData != 0 {
+		rest := data[37:]
+		// aaguid (16 bytes) and credential id length (2 bytes)
+		if len(rest) < 18 {
+			return nil, fmt.Errorf("%w: attested credential data is too short", ErrInvalidResponse)
+		}
+		idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if len(rest) < idLen {
+			return nil, fmt.Errorf("%w: invalid credential id length", ErrInvalidResponse)
+		}
+		ad.credentialID = append([]byte(nil), rest[:idLen]...)
+		rest = rest[idLen:]
+
+		_, n, err := decodeCBOR(rest)
+		if err != nil {
+			return nil, fmt.Errorf("%w: credential public key: %w", ErrInvalidResponse, err)
+		}
+		ad.publicKey = append([]byte(nil), rest[:n]...)
+	}
+	return ad, nil
+}
+
+// decodeBase64 accepts base64url with or without padding
+func decodeBase64(s string) ([]byte, error) {
+	return URLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
@@ -0,0 +1,168 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/maisiq/go-auth-service/pkg/webauthn"
+	"github.com/stretchr/testify/require"
+)
+
+var cfg = webauthn.Config{
+	RPID:    "example.com",
+	RPName:  "Example",
+	Origins: []string{"https://example.com"},
+}
+
+// cbor encodes the few types needed to build authenticator responses
+func cbor(v interface{}) []byte {
+	head := func(major byte, n int) []byte {
+		switch {
+		case n < 24:
+			return []byte{major<<5 | byte(n)}
+		case n < 256:
+			return []byte{major<<5 | 24, byte(n)}
+		default:
+			return []byte{major<<5 | 25, byte(n >> 8), byte(n)}
+		}
+	}
+	switch x := v.(type) {
+	case int:
+		if x < 0 {
+			return head(1, -1-x)
+		}
+		return head(0, x)
+	case []byte:
+		return append(head(2, len(x)), x...)
+	case string:
+		return append(head(3, len(x)), x...)
+	case [][2]interface{}: // map with ordered keys
+		out := head(5, len(x))
+		for _, kv := range x {
+			out = append(out, cbor(kv[0])...)
+			out = append(out, cbor(kv[1])...)
+		}
+		return out
+	}
+	panic("unsupported type")
+}
+
+type authenticator struct {
+	key   *ecdsa.PrivateKey
+	id    []byte
+	count uint32
+}
+
+func (a *authenticator) authData(flags byte, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(cfg.RPID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.count)
+	if attested {
+		data = append(data, make([]byte, 16)...) // aaguid
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.id)))
+		data = append(data, a.id...)
+		data = append(data, a.coseKey()...)
+	}
+	return data
+}
+
+func (a *authenticator) coseKey() []byte {
+	return cbor([][2]interface{}{
+		{1, 2},
+		{3, -7},
+		{-1, 1},
+		{-2, a.key.X.FillBytes(make([]byte, 32))},
+		{-3, a.key.Y.FillBytes(make([]byte, 32))},
+	})
+}
+
+func clientDataJSON(t *testing.T, typ, challenge, origin string) string {
+	b, err := json.Marshal(map[string]string{"type": typ, "challenge": challenge, "origin": origin})
+	require.NoError(t, err)
+	return webauthn.URLEncoding.EncodeToString(b)
+}
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	a := &authenticator{key: key, id: []byte("credential-id")}
+
+	challenge, err := webauthn.NewChallenge()
+	require.NoError(t, err)
+
+	attestation := cbor([][2]interface{}{
+		{"fmt", "none"},
+		{"attStmt", [][2]interface{}{}},
+		{"authData", a.authData(0x41, true)},
+	})
+	reg := webauthn.RegistrationResponse{
+		ID:    webauthn.URLEncoding.EncodeToString(a.id),
+		RawID: webauthn.URLEncoding.EncodeToString(a.id),
+		Type:  "public-key",
+		Response: webauthn.AttestationResponse{
+			ClientDataJSON:    clientDataJSON(t, "webauthn.create", challenge, "https://example.com"),
+			AttestationObject: webauthn.URLEncoding.EncodeToString(attestation),
+		},
+	}
+
+	cred, err := webauthn.VerifyRegistration(cfg, challenge, reg)
+	require.NoError(t, err)
+	require.Equal(t, a.id, cred.ID)
+
+	t.Run("registration with other challenge", func(t *testing.T) {
+		_, err := webauthn.VerifyRegistration(cfg, "other", reg)
+		require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+	})
+
+	assert := func(t *testing.T, origin string) webauthn.AssertionResponse {
+		a.count++
+		authData := a.authData(0x05, false)
+		cd := clientDataJSON(t, "webauthn.get", challenge, origin)
+		rawCD, err := webauthn.URLEncoding.DecodeString(cd)
+		require.NoError(t, err)
+		hash := sha256.Sum256(rawCD)
+		digest := sha256.Sum256(append(append([]byte{}, authData...), hash[:]...))
+		sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		require.NoError(t, err)
+
+		return webauthn.AssertionResponse{
+			ID:   webauthn.URLEncoding.EncodeToString(a.id),
+			Type: "public-key",
+			Response: webauthn.AssertionData{
+				ClientDataJSON:    cd,
+				AuthenticatorData: webauthn.URLEncoding.EncodeToString(authData),
+				Signature:         webauthn.URLEncoding.EncodeToString(sig),
+			},
+		}
+	}
+
+	t.Run("valid assertion", func(t *testing.T) {
+		count, uv, err := webauthn.VerifyAssertion(cfg, challenge, cred.PublicKey, cred.SignCount, assert(t, "https://example.com"))
+		require.NoError(t, err)
+		require.True(t, uv)
+		require.Equal(t, a.count, count)
+	})
+
+	t.Run("assertion from other origin", func(t *testing.T) {
+		_, _, err := webauthn.VerifyAssertion(cfg, challenge, cred.PublicKey, cred.SignCount, assert(t, "https://evil.com"))
+		require.ErrorIs(t, err, webauthn.ErrInvalidResponse)
+	})
+
+	t.Run("sign count going backwards", func(t *testing.T) {
+		_, _, err := webauthn.VerifyAssertion(cfg, challenge, cred.PublicKey, 100, assert(t, "https://example.com"))
+		require.ErrorIs(t, err, webauthn.ErrSignCount)
+	})
+
+	t.Run("tampered signature", func(t *testing.T) {
+		resp := assert(t, "https://example.com")
+		resp.Response.AuthenticatorData = webauthn.URLEncoding.EncodeToString(a.authData(0x01, false))
+		_, _, err := webauthn.VerifyAssertion(cfg, challenge, cred.PublicKey, 0, resp)
+		require.ErrorIs(t, err, webauthn.ErrInvalidSignature)
+	})
+}